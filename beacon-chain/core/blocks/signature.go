@@ -3,6 +3,7 @@ package blocks
 import (
 	"context"
 	"encoding/binary"
+	"fmt"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -11,6 +12,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/attestationutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -227,3 +229,51 @@ func AttestationSignatureSet(ctx context.Context, beaconState *stateTrie.BeaconS
 	}
 	return set.Join(aSet), nil
 }
+
+// AttestationSignatureSetUseCheckPt retrieves the signature set of an attestation, using the checkpoint info
+// object rather than a beacon state to look up its committee and public keys.
+func AttestationSignatureSetUseCheckPt(ctx context.Context, c *pb.CheckPtInfo, att *ethpb.Attestation) (*bls.SignatureSet, error) {
+	if att == nil || att.Data == nil || att.AggregationBits.Count() == 0 {
+		return nil, fmt.Errorf("nil or missing attestation data: %v", att)
+	}
+	committee, err := helpers.BeaconCommittee(c.ActiveIndices, bytesutil.ToBytes32(c.Seed), att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	indexedAtt := attestationutil.ConvertToIndexed(ctx, att, committee)
+	if err := attestationutil.IsValidAttestationIndices(ctx, indexedAtt); err != nil {
+		return nil, err
+	}
+	domain, err := helpers.Domain(c.Fork, indexedAtt.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester, c.GenesisRoot)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bls.SignatureFromBytes(indexedAtt.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert bytes to signature")
+	}
+	var pk bls.PublicKey
+	for _, idx := range indexedAtt.AttestingIndices {
+		if idx >= uint64(len(c.PubKeys)) {
+			return nil, fmt.Errorf("validator index %d out of range", idx)
+		}
+		p, err := bls.PublicKeyFromBytes(c.PubKeys[idx])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not deserialize validator public key")
+		}
+		if pk == nil {
+			pk = p
+		} else {
+			pk.Aggregate(p)
+		}
+	}
+	root, err := helpers.ComputeSigningRoot(indexedAtt.Data, domain)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get signing root of object")
+	}
+	return &bls.SignatureSet{
+		Signatures: []bls.Signature{sig},
+		PublicKeys: []bls.PublicKey{pk},
+		Messages:   [][32]byte{root},
+	}, nil
+}
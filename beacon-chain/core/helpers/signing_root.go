@@ -86,24 +86,57 @@ func ComputeDomainVerifySigningRoot(state *state.BeaconState, index uint64, epoc
 	return VerifySigningRoot(obj, v.PublicKey, sig, d)
 }
 
+// ComputeDomainSignatureSet computes the domain of the validator at the given index and retrieves the signature
+// set of the object signed by it, so that it can be verified later on, typically in a batch.
+func ComputeDomainSignatureSet(state *state.BeaconState, index uint64, epoch uint64, obj interface{}, domain [4]byte, sig []byte) (*bls.SignatureSet, error) {
+	v, err := state.ValidatorAtIndex(index)
+	if err != nil {
+		return nil, err
+	}
+	d, err := Domain(state.Fork(), epoch, domain, state.GenesisValidatorRoot())
+	if err != nil {
+		return nil, err
+	}
+	return RetrieveSignatureSet(obj, v.PublicKey, sig, d)
+}
+
 // VerifySigningRoot verifies the signing root of an object given it's public key, signature and domain.
 func VerifySigningRoot(obj interface{}, pub []byte, signature []byte, domain []byte) error {
+	set, err := RetrieveSignatureSet(obj, pub, signature, domain)
+	if err != nil {
+		return err
+	}
+	// We assume only one signature set is returned here.
+	sig := set.Signatures[0]
+	publicKey := set.PublicKeys[0]
+	root := set.Messages[0]
+
+	if !sig.Verify(publicKey, root[:]) {
+		return ErrSigFailedToVerify
+	}
+	return nil
+}
+
+// RetrieveSignatureSet retrieves the signature, public key and signing root of an object and collates them
+// into a signature set object.
+func RetrieveSignatureSet(obj interface{}, pub []byte, signature []byte, domain []byte) (*bls.SignatureSet, error) {
 	publicKey, err := bls.PublicKeyFromBytes(pub)
 	if err != nil {
-		return errors.Wrap(err, "could not convert bytes to public key")
+		return nil, errors.Wrap(err, "could not convert bytes to public key")
 	}
 	sig, err := bls.SignatureFromBytes(signature)
 	if err != nil {
-		return errors.Wrap(err, "could not convert bytes to signature")
+		return nil, errors.Wrap(err, "could not convert bytes to signature")
 	}
 	root, err := ComputeSigningRoot(obj, domain)
 	if err != nil {
-		return errors.Wrap(err, "could not compute signing root")
-	}
-	if !sig.Verify(publicKey, root[:]) {
-		return ErrSigFailedToVerify
+		return nil, errors.Wrap(err, "could not compute signing root")
 	}
-	return nil
+	return &bls.SignatureSet{
+		Signatures: []bls.Signature{sig},
+		PublicKeys: []bls.PublicKey{publicKey},
+		Messages:   [][32]byte{root},
+	}, nil
 }
 
 // VerifyBlockSigningRoot verifies the signing root of a block given it's public key, signature and domain.
@@ -139,4 +139,15 @@ var (
 		Name:  "network-id",
 		Usage: "Sets the network id of the beacon chain.",
 	}
+	// RecordTrafficDir defines a directory in which all received gossip messages, rpc requests and rpc responses are recorded.
+	RecordTrafficDir = &cli.StringFlag{
+		Name:  "record-traffic-dir",
+		Usage: "Records every received gossip message, rpc request and rpc response into rotating files in this directory, for offline replay.",
+	}
+	// ReplayTrafficPath defines a traffic recording to replay instead of connecting to the network.
+	ReplayTrafficPath = &cli.StringFlag{
+		Name: "replay-traffic",
+		Usage: "Replays the gossip messages of a traffic recording (file or directory) through the sync pipeline. " +
+			"Peer discovery and static peers are disabled while replaying.",
+	}
 )
@@ -76,6 +76,7 @@ type BeaconNode struct {
 	opFeed            *event.Feed
 	forkChoiceStore   forkchoice.ForkChoicer
 	stateGen          *stategen.State
+	trafficRecorder   *prysmsync.TrafficRecorder
 }
 
 // NewBeaconNode creates a new node instance, sets up configuration options, and registers
@@ -195,6 +196,8 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	beacon.startTrafficRecorder()
+
 	if err := beacon.registerInitialSyncService(); err != nil {
 		return nil, err
 	}
@@ -277,6 +280,9 @@ func (b *BeaconNode) Close() {
 	log.Info("Stopping beacon node")
 	b.cancel() // Cancel the beacon node struct's context.
 	b.services.StopAll()
+	if err := b.trafficRecorder.Close(); err != nil {
+		log.WithError(err).Error("Failed to close traffic recording")
+	}
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
@@ -288,6 +294,22 @@ func (b *BeaconNode) startForkChoice() {
 	b.forkChoiceStore = f
 }
 
+// startTrafficRecorder sets up the recorder shared by the sync services when traffic
+// recording is enabled. The node runs without recording if it cannot be set up.
+func (b *BeaconNode) startTrafficRecorder() {
+	dir := b.cliCtx.String(flags.RecordTrafficDir.Name)
+	if dir == "" {
+		return
+	}
+	recorder, err := prysmsync.NewTrafficRecorder(dir, b.fetchP2P().Encoding())
+	if err != nil {
+		log.WithError(err).Error("Could not set up traffic recording, traffic will not be recorded")
+		return
+	}
+	b.trafficRecorder = recorder
+	log.WithField("dir", dir).Info("Recording gossip and rpc traffic")
+}
+
 func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
 	dbPath := filepath.Join(baseDir, beaconChainDBName)
@@ -384,9 +406,18 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		}
 	}
 
+	noDiscovery := cliCtx.Bool(cmd.NoDiscovery.Name)
+	staticPeers := sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name))
+	// A node replaying recorded traffic must not receive anything from the live network.
+	if cliCtx.String(flags.ReplayTrafficPath.Name) != "" {
+		noDiscovery = true
+		staticPeers = nil
+		bootnodeAddrs = nil
+	}
+
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		NoDiscovery:       noDiscovery,
+		StaticPeers:       staticPeers,
 		BootstrapNodeAddr: bootnodeAddrs,
 		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
 		DataDir:           datadir,
@@ -533,6 +564,8 @@ func (b *BeaconNode) registerSyncService() error {
 		SlashingPool:        b.slashingsPool,
 		StateSummaryCache:   b.stateSummaryCache,
 		StateGen:            b.stateGen,
+		TrafficRecorder:     b.trafficRecorder,
+		ReplayTrafficPath:   b.cliCtx.String(flags.ReplayTrafficPath.Name),
 	})
 
 	return b.services.RegisterService(rs)
@@ -545,11 +578,12 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	}
 
 	is := initialsync.NewInitialSync(b.ctx, &initialsync.Config{
-		DB:            b.db,
-		Chain:         chainService,
-		P2P:           b.fetchP2P(),
-		StateNotifier: b,
-		BlockNotifier: b,
+		DB:              b.db,
+		Chain:           chainService,
+		P2P:             b.fetchP2P(),
+		StateNotifier:   b,
+		BlockNotifier:   b,
+		TrafficRecorder: b.trafficRecorder,
 	})
 	return b.services.RegisterService(is)
 }
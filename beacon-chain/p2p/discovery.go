@@ -155,13 +155,43 @@ func (s *Service) createListener(
 			localNode.SetFallbackIP(firstIP)
 		}
 	}
+	return listenV5(conn, localNode, privKey, s.cfg.Discv5BootStrapAddr)
+}
+
+// ListenDiscoveryV5 starts discovery v5 on the given UDP port of every interface, with a
+// local node record advertising the given ip address and nothing else, for tools which
+// walk the network without joining it as a beacon node. The bootstrap addresses are ENRs.
+func ListenDiscoveryV5(ipAddr net.IP, udpPort int, privKey *ecdsa.PrivateKey, bootstrapAddrs []string) (*discover.UDPv5, error) {
+	networkVersion := "udp6"
+	if ipAddr.To4() != nil {
+		networkVersion = "udp4"
+	}
+	conn, err := net.ListenUDP(networkVersion, &net.UDPAddr{Port: udpPort})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not listen to UDP")
+	}
+	db, err := enode.OpenDB("")
+	if err != nil {
+		closeUDPConn(conn)
+		return nil, errors.Wrap(err, "could not open node's peer database")
+	}
+	localNode := enode.NewLocalNode(db, privKey)
+	localNode.SetFallbackIP(ipAddr)
+	localNode.SetFallbackUDP(udpPort)
+	return listenV5(conn, localNode, privKey, bootstrapAddrs)
+}
+
+// listenV5 starts discovery v5 on a connection, bootstrapping from the given ENRs. The
+// connection is closed if discovery cannot start.
+func listenV5(conn *net.UDPConn, localNode *enode.LocalNode, privKey *ecdsa.PrivateKey, bootstrapAddrs []string) (*discover.UDPv5, error) {
 	dv5Cfg := discover.Config{
 		PrivateKey: privKey,
 	}
 	dv5Cfg.Bootnodes = []*enode.Node{}
-	for _, addr := range s.cfg.Discv5BootStrapAddr {
+	for _, addr := range bootstrapAddrs {
 		bootNode, err := enode.Parse(enode.ValidSchemes, addr)
 		if err != nil {
+			closeUDPConn(conn)
 			return nil, errors.Wrap(err, "could not bootstrap addr")
 		}
 		dv5Cfg.Bootnodes = append(dv5Cfg.Bootnodes, bootNode)
@@ -169,11 +199,18 @@ func (s *Service) createListener(
 
 	network, err := discover.ListenV5(conn, localNode, dv5Cfg)
 	if err != nil {
+		closeUDPConn(conn)
 		return nil, errors.Wrap(err, "could not listen to discV5")
 	}
 	return network, nil
 }
 
+func closeUDPConn(conn *net.UDPConn) {
+	if err := conn.Close(); err != nil {
+		log.WithError(err).Debug("Could not close UDP connection")
+	}
+}
+
 func (s *Service) createLocalNode(
 	privKey *ecdsa.PrivateKey,
 	ipAddr net.IP,
@@ -352,6 +389,13 @@ func convertToMultiAddr(nodes []*enode.Node) []ma.Multiaddr {
 	return multiAddrs
 }
 
+// PeerInfoFromNode returns the libp2p address info of the beacon node behind a record
+// found through discovery.
+func PeerInfoFromNode(node *enode.Node) (*peer.AddrInfo, error) {
+	info, _, err := convertToAddrInfo(node)
+	return info, err
+}
+
 func convertToAddrInfo(node *enode.Node) (*peer.AddrInfo, ma.Multiaddr, error) {
 	multiAddr, err := convertToSingleMultiAddr(node)
 	if err != nil {
@@ -69,6 +69,24 @@ func TestCreateListener(t *testing.T) {
 	}
 }
 
+func TestListenDiscoveryV5(t *testing.T) {
+	port := 1025
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	bootListener, err := ListenDiscoveryV5(ipAddr, port, pkey, nil)
+	require.NoError(t, err)
+	defer bootListener.Close()
+	assert.Equal(t, true, bootListener.Self().IP().Equal(ipAddr), "IP address is not the expected type")
+	assert.Equal(t, port, bootListener.Self().UDP(), "Incorrect port number")
+
+	_, otherKey := createAddrAndPrivKey(t)
+	listener, err := ListenDiscoveryV5(ipAddr, port+1, otherKey, []string{bootListener.Self().String()})
+	require.NoError(t, err)
+	listener.Close()
+
+	_, err = ListenDiscoveryV5(ipAddr, port+2, otherKey, []string{"bad"})
+	assert.ErrorContains(t, "could not bootstrap addr", err)
+}
+
 func TestStartDiscV5_DiscoverAllPeers(t *testing.T) {
 	port := 2000
 	ipAddr, pkey := createAddrAndPrivKey(t)
@@ -21,6 +21,10 @@ var eth2ENRKey = params.BeaconNetworkConfig().ETH2Key
 
 // ForkDigest returns the current fork digest of
 // the node.
+func (s *Service) ForkDigest() ([4]byte, error) {
+	return s.forkDigest()
+}
+
 func (s *Service) forkDigest() ([4]byte, error) {
 	return p2putils.CreateForkDigest(s.genesisTime, s.genesisValidatorsRoot)
 }
@@ -115,6 +119,11 @@ func addForkEntry(
 	return node, nil
 }
 
+// ForkEntryFromRecord returns the eth2 fork entry advertised in a node's ENR.
+func ForkEntryFromRecord(record *enr.Record) (*pb.ENRForkID, error) {
+	return retrieveForkEntry(record)
+}
+
 // Retrieves an enrForkID from an ENR record by key lookup
 // under the eth2EnrKey.
 func retrieveForkEntry(record *enr.Record) (*pb.ENRForkID, error) {
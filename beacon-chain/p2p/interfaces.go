@@ -28,6 +28,7 @@ type P2P interface {
 	ConnectionHandler
 	PeersProvider
 	MetadataProvider
+	ForkDigestProvider
 }
 
 // Broadcaster broadcasts messages to peers over the p2p pubsub protocol.
@@ -92,3 +93,8 @@ type MetadataProvider interface {
 	Metadata() *pb.MetaData
 	MetadataSeq() uint64
 }
+
+// ForkDigestProvider returns the fork digest of the local node.
+type ForkDigestProvider interface {
+	ForkDigest() ([4]byte, error)
+}
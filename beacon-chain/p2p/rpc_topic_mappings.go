@@ -2,8 +2,12 @@ package p2p
 
 import (
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
@@ -35,6 +39,120 @@ var RPCTopicMappings = map[string]interface{}{
 	RPCMetaDataTopic:      new(interface{}),
 }
 
+// RPCResponseMappings map the rpc topic to the message type of its response
+// chunks. Topics without a response payload are not present.
+var RPCResponseMappings = map[string]interface{}{
+	RPCStatusTopic:        new(pb.Status),
+	RPCBlocksByRangeTopic: new(eth.SignedBeaconBlock),
+	RPCBlocksByRootTopic:  new(eth.SignedBeaconBlock),
+	RPCPingTopic:          new(uint64),
+	RPCMetaDataTopic:      new(pb.MetaData),
+}
+
+// rpcForkDigestContext is the set of rpc topics whose response chunks are
+// prefixed with the fork digest of their payload.
+var rpcForkDigestContext = map[string]bool{}
+
+// RPCSchema defines a schema version of an rpc method. Every schema version is
+// advertised as its own protocol ID, so that peers negotiate the newest version
+// they both support.
+type RPCSchema struct {
+	// Request is the message type of the request.
+	Request interface{}
+	// Response is the message type of each response chunk, nil if the method
+	// has no response payload.
+	Response interface{}
+	// ForkDigestContext prefixes each response chunk with the fork digest of its
+	// payload.
+	ForkDigestContext bool
+}
+
+// RegisterRPCSchema registers a new schema version for an existing rpc method.
+// The topic is the method followed by the schema version, for example
+// "/eth2/beacon_chain/req/status/2". Schemas must be registered before the node
+// starts.
+func RegisterRPCSchema(topic string, schema RPCSchema) error {
+	if _, err := rpcSchemaVersion(topic); err != nil {
+		return err
+	}
+	if _, ok := RPCTopicMappings[topic]; ok {
+		return errors.Errorf("rpc topic %s is already registered", topic)
+	}
+	if len(RPCTopicVersions(topic)) == 0 {
+		return errors.Errorf("rpc method %s is not registered", RPCMethod(topic))
+	}
+	if schema.Request == nil {
+		return errors.New("rpc schema has no request type")
+	}
+	RPCTopicMappings[topic] = schema.Request
+	if schema.Response != nil {
+		RPCResponseMappings[topic] = schema.Response
+	}
+	if schema.ForkDigestContext {
+		rpcForkDigestContext[topic] = true
+	}
+	return nil
+}
+
+// RPCMethod returns the rpc method of a topic, which is the topic without its
+// schema version.
+func RPCMethod(topic string) string {
+	i := strings.LastIndex(topic, "/")
+	if i <= 0 {
+		return topic
+	}
+	return topic[:i]
+}
+
+// RPCTopicVersions returns the registered topics of all the schema versions of
+// the rpc method of the given topic, newest first.
+func RPCTopicVersions(topic string) []string {
+	method := RPCMethod(topic)
+	var topics []string
+	for t := range RPCTopicMappings {
+		if RPCMethod(t) == method {
+			topics = append(topics, t)
+		}
+	}
+	sort.Slice(topics, func(i, j int) bool {
+		vi, erri := rpcSchemaVersion(topics[i])
+		vj, errj := rpcSchemaVersion(topics[j])
+		if erri != nil || errj != nil {
+			return topics[i] > topics[j]
+		}
+		return vi > vj
+	})
+	return topics
+}
+
+// RPCNegotiableTopics returns the topics of the schema versions of the rpc method of the base
+// topic which may be negotiated to send a request, newest first. These accept the message as
+// request, and have the response type of the base topic, which the sender decodes the
+// response chunks into.
+func RPCNegotiableTopics(baseTopic string, message interface{}) []string {
+	response, hasResponse := RPCResponseMappings[baseTopic]
+	var topics []string
+	for _, topic := range RPCTopicVersions(baseTopic) {
+		if err := VerifyTopicMapping(topic, message); err != nil {
+			continue
+		}
+		if _, ok := RPCResponseMappings[topic]; ok != hasResponse {
+			continue
+		}
+		if hasResponse && VerifyResponseMapping(topic, response) != nil {
+			continue
+		}
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+// RPCForkDigestContext returns true if the response chunks of the topic are
+// prefixed with the fork digest of their payload.
+func RPCForkDigestContext(topic string) bool {
+	return rpcForkDigestContext[topic]
+}
+
 // VerifyTopicMapping verifies that the topic and its accompanying
 // message type is correct.
 func VerifyTopicMapping(topic string, msg interface{}) error {
@@ -42,6 +160,20 @@ func VerifyTopicMapping(topic string, msg interface{}) error {
 	if !ok {
 		return errors.New("rpc topic is not registered currently")
 	}
+	return verifyMessageType(topic, msgType, msg)
+}
+
+// VerifyResponseMapping verifies that the message type is the response type of
+// the topic. Topics without a registered response type accept any message.
+func VerifyResponseMapping(topic string, msg interface{}) error {
+	msgType, ok := RPCResponseMappings[topic]
+	if !ok {
+		return nil
+	}
+	return verifyMessageType(topic, msgType, msg)
+}
+
+func verifyMessageType(topic string, msgType interface{}, msg interface{}) error {
 	receivedType := reflect.TypeOf(msg)
 	registeredType := reflect.TypeOf(msgType)
 	typeMatches := registeredType.AssignableTo(receivedType)
@@ -52,3 +184,16 @@ func VerifyTopicMapping(topic string, msg interface{}) error {
 	}
 	return nil
 }
+
+// rpcSchemaVersion parses the schema version at the end of a topic.
+func rpcSchemaVersion(topic string) (uint64, error) {
+	i := strings.LastIndex(topic, "/")
+	if i <= 0 {
+		return 0, errors.Errorf("rpc topic %s has no schema version", topic)
+	}
+	version, err := strconv.ParseUint(topic[i+1:], 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid schema version in rpc topic %s", topic)
+	}
+	return version, nil
+}
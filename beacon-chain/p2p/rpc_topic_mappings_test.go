@@ -5,6 +5,7 @@ import (
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
 func TestVerifyRPCMappings(t *testing.T) {
@@ -16,3 +17,45 @@ func TestVerifyRPCMappings(t *testing.T) {
 
 	assert.NoError(t, VerifyTopicMapping(RPCBlocksByRootTopic, [][32]byte{}), "Failed to verify blocks by root rpc topic")
 }
+
+func TestRegisterRPCSchema(t *testing.T) {
+	topic := "/eth2/beacon_chain/req/status/2"
+	require.NoError(t, RegisterRPCSchema(topic, RPCSchema{
+		Request:           new(pb.Status),
+		Response:          new(pb.Status),
+		ForkDigestContext: true,
+	}))
+	defer func() {
+		delete(RPCTopicMappings, topic)
+		delete(RPCResponseMappings, topic)
+		delete(rpcForkDigestContext, topic)
+	}()
+	assert.DeepEqual(t, []string{topic, RPCStatusTopic}, RPCTopicVersions(RPCStatusTopic))
+	assert.DeepEqual(t, []string{topic, RPCStatusTopic}, RPCTopicVersions(topic))
+	assert.Equal(t, true, RPCForkDigestContext(topic))
+	assert.Equal(t, false, RPCForkDigestContext(RPCStatusTopic))
+	assert.NoError(t, VerifyTopicMapping(topic, &pb.Status{}))
+	assert.NoError(t, VerifyResponseMapping(topic, &pb.Status{}))
+	assert.NotNil(t, VerifyResponseMapping(topic, new(uint64)))
+
+	assert.ErrorContains(t, "already registered", RegisterRPCSchema(topic, RPCSchema{Request: new(pb.Status)}))
+	assert.ErrorContains(t, "invalid schema version", RegisterRPCSchema("/eth2/beacon_chain/req/status/v3", RPCSchema{Request: new(pb.Status)}))
+	assert.ErrorContains(t, "is not registered", RegisterRPCSchema("/eth2/beacon_chain/req/unknown/2", RPCSchema{Request: new(pb.Status)}))
+	assert.ErrorContains(t, "no request type", RegisterRPCSchema("/eth2/beacon_chain/req/status/3", RPCSchema{}))
+}
+
+func TestRPCTopicVersions_OrdersNumerically(t *testing.T) {
+	topics := []string{"/testing/versions/2", "/testing/versions/10"}
+	RPCTopicMappings["/testing/versions/1"] = new(uint64)
+	for _, topic := range topics {
+		require.NoError(t, RegisterRPCSchema(topic, RPCSchema{Request: new(uint64)}))
+	}
+	defer func() {
+		delete(RPCTopicMappings, "/testing/versions/1")
+		for _, topic := range topics {
+			delete(RPCTopicMappings, topic)
+		}
+	}()
+	assert.DeepEqual(t, []string{"/testing/versions/10", "/testing/versions/2", "/testing/versions/1"}, RPCTopicVersions("/testing/versions/1"))
+	assert.Equal(t, "/testing/versions", RPCMethod("/testing/versions/10"))
+}
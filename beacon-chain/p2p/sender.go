@@ -10,7 +10,10 @@ import (
 	"go.opencensus.io/trace"
 )
 
-// Send a message to a specific peer. The returned stream may be used for reading, but has been
+// Send a message to a specific peer. The stream is opened with every schema version of the
+// topic's rpc method which accepts the message and has the response type of the topic, newest
+// first, so that the newest version the peer supports is negotiated. The negotiated topic is the
+// protocol of the returned stream. The returned stream may be used for reading, but has been
 // closed for writing.
 func (s *Service) Send(ctx context.Context, message interface{}, baseTopic string, pid peer.ID) (network.Stream, error) {
 	ctx, span := trace.StartSpan(ctx, "p2p.Send")
@@ -18,20 +21,24 @@ func (s *Service) Send(ctx context.Context, message interface{}, baseTopic strin
 	if err := VerifyTopicMapping(baseTopic, message); err != nil {
 		return nil, err
 	}
-	topic := baseTopic + s.Encoding().ProtocolSuffix()
-	span.AddAttributes(trace.StringAttribute("topic", topic))
+	var protocols []protocol.ID
+	for _, topic := range RPCNegotiableTopics(baseTopic, message) {
+		protocols = append(protocols, protocol.ID(topic+s.Encoding().ProtocolSuffix()))
+	}
+	span.AddAttributes(trace.StringAttribute("topic", baseTopic+s.Encoding().ProtocolSuffix()))
 
 	// Apply max dial timeout when opening a new stream.
 	ctx, cancel := context.WithTimeout(ctx, maxDialTimeout)
 	defer cancel()
 
-	stream, err := s.host.NewStream(ctx, pid, protocol.ID(topic))
+	stream, err := s.host.NewStream(ctx, pid, protocols...)
 	if err != nil {
 		traceutil.AnnotateError(span, err)
 		return nil, err
 	}
+	span.AddAttributes(trace.StringAttribute("negotiatedTopic", string(stream.Protocol())))
 	// do not encode anything if we are sending a metadata request
-	if baseTopic == RPCMetaDataTopic {
+	if RPCMethod(baseTopic) == RPCMethod(RPCMetaDataTopic) {
 		return stream, nil
 	}
 
@@ -58,3 +58,87 @@ func TestService_Send(t *testing.T) {
 		t.Errorf("Expected identical message to be received. got %v want %v", rcvd, msg)
 	}
 }
+
+func TestService_Send_NegotiatesNewestVersion(t *testing.T) {
+	p1 := testp2p.NewTestP2P(t)
+	p2 := testp2p.NewTestP2P(t)
+	p3 := testp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Connect(p3)
+
+	svc := &Service{
+		host: p1.BHost,
+		cfg:  &Config{},
+	}
+
+	topicV1 := "/testing/negotiation/1"
+	topicV2 := "/testing/negotiation/2"
+	RPCTopicMappings[topicV1] = new(testpb.TestSimpleMessage)
+	require.NoError(t, RegisterRPCSchema(topicV2, RPCSchema{Request: new(testpb.TestSimpleMessage)}))
+	defer func() {
+		delete(RPCTopicMappings, topicV1)
+		delete(RPCTopicMappings, topicV2)
+	}()
+	handler := func(stream network.Stream) {
+		rcvd := &testpb.TestSimpleMessage{}
+		assert.NoError(t, svc.Encoding().DecodeWithMaxLength(stream, rcvd))
+		assert.NoError(t, stream.Close())
+	}
+	// p2 supports both versions, p3 only the first one.
+	p2.SetStreamHandler(topicV1+"/ssz_snappy", handler)
+	p2.SetStreamHandler(topicV2+"/ssz_snappy", handler)
+	p3.SetStreamHandler(topicV1+"/ssz_snappy", handler)
+
+	msg := &testpb.TestSimpleMessage{Foo: []byte("hello")}
+	stream, err := svc.Send(context.Background(), msg, topicV1, p2.BHost.ID())
+	require.NoError(t, err)
+	assert.Equal(t, topicV2+"/ssz_snappy", string(stream.Protocol()))
+
+	stream, err = svc.Send(context.Background(), msg, topicV1, p3.BHost.ID())
+	require.NoError(t, err)
+	assert.Equal(t, topicV1+"/ssz_snappy", string(stream.Protocol()))
+}
+
+func TestService_Send_KeepsResponseType(t *testing.T) {
+	p1 := testp2p.NewTestP2P(t)
+	p2 := testp2p.NewTestP2P(t)
+	p1.Connect(p2)
+
+	svc := &Service{
+		host: p1.BHost,
+		cfg:  &Config{},
+	}
+
+	// The second version keeps the request type but changes the response type.
+	topicV1 := "/testing/response/1"
+	topicV2 := "/testing/response/2"
+	RPCTopicMappings[topicV1] = new(testpb.TestSimpleMessage)
+	RPCResponseMappings[topicV1] = new(testpb.TestSimpleMessage)
+	require.NoError(t, RegisterRPCSchema(topicV2, RPCSchema{
+		Request:  new(testpb.TestSimpleMessage),
+		Response: new(testpb.Puzzle),
+	}))
+	defer func() {
+		delete(RPCTopicMappings, topicV1)
+		delete(RPCTopicMappings, topicV2)
+		delete(RPCResponseMappings, topicV1)
+		delete(RPCResponseMappings, topicV2)
+	}()
+	handler := func(stream network.Stream) {
+		rcvd := &testpb.TestSimpleMessage{}
+		assert.NoError(t, svc.Encoding().DecodeWithMaxLength(stream, rcvd))
+		assert.NoError(t, stream.Close())
+	}
+	p2.SetStreamHandler(topicV1+"/ssz_snappy", handler)
+	p2.SetStreamHandler(topicV2+"/ssz_snappy", handler)
+
+	msg := &testpb.TestSimpleMessage{Foo: []byte("hello")}
+	stream, err := svc.Send(context.Background(), msg, topicV1, p2.BHost.ID())
+	require.NoError(t, err)
+	assert.Equal(t, topicV1+"/ssz_snappy", string(stream.Protocol()))
+
+	// Asking for the second version negotiates it.
+	stream, err = svc.Send(context.Background(), msg, topicV2, p2.BHost.ID())
+	require.NoError(t, err)
+	assert.Equal(t, topicV2+"/ssz_snappy", string(stream.Protocol()))
+}
@@ -121,6 +121,11 @@ func retrieveAttSubnets(record *enr.Record) ([]uint64, error) {
 	return committeeIdxs, nil
 }
 
+// AttSubnetsFromRecord returns the attestation subnets bitvector advertised in a node's ENR.
+func AttSubnetsFromRecord(record *enr.Record) (bitfield.Bitvector64, error) {
+	return retrieveBitvector(record)
+}
+
 // Parses the attestation subnets ENR entry in a node and extracts its value
 // as a bitvector for further manipulation.
 func retrieveBitvector(record *enr.Record) (bitfield.Bitvector64, error) {
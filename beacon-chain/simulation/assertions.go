@@ -0,0 +1,110 @@
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+// pollInterval is the interval at which the wait helpers check the network state.
+const pollInterval = 100 * time.Millisecond
+
+// CheckForkChoiceAgreement returns an error if the running nodes of the network
+// disagree on their head, justified checkpoint or finalized checkpoint.
+func (n *Network) CheckForkChoiceAgreement(ctx context.Context) error {
+	var reference *Node
+	var headRoot []byte
+	for _, node := range n.nodes {
+		chain := node.Chain()
+		if chain == nil {
+			continue
+		}
+		root, err := chain.HeadRoot(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "could not get head root of node %d", node.index)
+		}
+		if reference == nil {
+			reference = node
+			headRoot = root
+			continue
+		}
+		refChain := reference.Chain()
+		if refChain == nil {
+			return fmt.Errorf("node %d stopped", reference.index)
+		}
+		if !bytes.Equal(headRoot, root) {
+			return fmt.Errorf("nodes %d and %d have different heads: %#x != %#x", reference.index, node.index, headRoot, root)
+		}
+		if !proto.Equal(chain.CurrentJustifiedCheckpt(), refChain.CurrentJustifiedCheckpt()) {
+			return fmt.Errorf("nodes %d and %d have different justified checkpoints", reference.index, node.index)
+		}
+		if !proto.Equal(chain.FinalizedCheckpt(), refChain.FinalizedCheckpt()) {
+			return fmt.Errorf("nodes %d and %d have different finalized checkpoints", reference.index, node.index)
+		}
+	}
+	if reference == nil {
+		return errors.New("no node is running")
+	}
+	return nil
+}
+
+// WaitForConvergence waits until all running nodes agree on fork choice, or returns
+// the last disagreement once the context is done.
+func (n *Network) WaitForConvergence(ctx context.Context) error {
+	var err error
+	return n.poll(ctx, func() bool {
+		err = n.CheckForkChoiceAgreement(ctx)
+		return err == nil
+	}, func() error {
+		return errors.Wrap(err, "nodes did not converge")
+	})
+}
+
+// WaitForFinalizedEpoch waits until every running node has finalized at least the
+// given epoch.
+func (n *Network) WaitForFinalizedEpoch(ctx context.Context, epoch uint64) error {
+	var lagging int
+	return n.poll(ctx, func() bool {
+		for _, node := range n.nodes {
+			chain := node.Chain()
+			if chain == nil {
+				continue
+			}
+			if chain.FinalizedCheckpt().Epoch < epoch {
+				lagging = node.index
+				return false
+			}
+		}
+		return true
+	}, func() error {
+		return fmt.Errorf("node %d did not finalize epoch %d", lagging, epoch)
+	})
+}
+
+// WaitForSlot waits until the fake clock of the simulated chain reaches the given slot.
+func (n *Network) WaitForSlot(ctx context.Context, slot uint64) error {
+	return n.poll(ctx, func() bool {
+		return n.CurrentSlot() >= slot
+	}, func() error {
+		return fmt.Errorf("slot %d was not reached", slot)
+	})
+}
+
+func (n *Network) poll(ctx context.Context, done func() bool, timeoutErr func() error) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		if done() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return timeoutErr()
+		case <-ticker.C:
+		}
+	}
+}
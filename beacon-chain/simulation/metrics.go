@@ -0,0 +1,17 @@
+package simulation
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	droppedMessagesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "simulation_dropped_messages_total",
+		Help: "Count of gossip messages dropped by the network simulation.",
+	})
+	proposedBlocksCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "simulation_proposed_blocks_total",
+		Help: "Count of blocks proposed by the network simulation.",
+	})
+)
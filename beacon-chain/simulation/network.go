@@ -0,0 +1,468 @@
+// Package simulation runs several complete beacon nodes in a single process, connected
+// by an in-memory libp2p network, so that tests can script link latency, partitions,
+// message drops and node restarts and quickly assert that the nodes converge, finalize
+// and agree on fork choice. The simulated chain runs on a fake clock, which moves to the
+// next slot as soon as the blocks of the previous one had time to propagate, rather than
+// in real time.
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// Config for a simulated network.
+type Config struct {
+	// NumNodes is the number of beacon nodes in the network.
+	NumNodes int
+	// DataDir is the directory under which every node keeps its database, so that it
+	// survives node restarts. A temporary directory, removed when the network is
+	// stopped, is used if it is empty.
+	DataDir string
+	// GenesisState is the state every node starts from. Its genesis time is
+	// overridden to the time the network is created.
+	GenesisState *stateTrie.BeaconState
+	// PrivKeys of all the validators in the genesis state, used to propose and
+	// attest on behalf of the validators.
+	PrivKeys []bls.SecretKey
+	// SlotInterval is the real time between two slots of the fake clock, which the
+	// blocks have to propagate and be processed.
+	SlotInterval time.Duration
+	// Latency is the default latency of every link of the network.
+	Latency time.Duration
+}
+
+// Network is a simulated network of beacon nodes. Every slot, one running node of
+// each partition proposes a block, including attestations from all validators, and
+// gossips it to its peers.
+//
+// The nodes read the time through roughtime, which the network pins to the start of
+// the current slot of the fake clock while it is running. Only one network may run
+// in a process at a time.
+type Network struct {
+	cfg         *Config
+	ctx         context.Context
+	cancel      context.CancelFunc
+	mocknet     mocknet.Mocknet
+	nodes       []*Node
+	genesisTime time.Time
+	removeDir   bool
+	lock        sync.RWMutex
+	groups      []int
+	slot        uint64
+	proposing   chan struct{} // Closed once the blocks are no longer proposed.
+}
+
+// NewNetwork creates the nodes of a simulated network. Call Start to begin the chain.
+func NewNetwork(ctx context.Context, cfg *Config) (*Network, error) {
+	if cfg.NumNodes < 1 {
+		return nil, errors.New("network needs at least one node")
+	}
+	if cfg.GenesisState == nil {
+		return nil, errors.New("no genesis state provided")
+	}
+	if cfg.SlotInterval == 0 {
+		cfg.SlotInterval = 250 * time.Millisecond
+	}
+	removeDir := false
+	if cfg.DataDir == "" {
+		dir, err := ioutil.TempDir(testutil.TempDir(), "simulation")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create data directory")
+		}
+		cfg.DataDir = dir
+		removeDir = true
+	}
+	// Initial sync of restarted nodes should start as soon as one peer is connected.
+	flags.Init(&flags.GlobalFlags{
+		MinimumSyncPeers:           1,
+		BlockBatchLimit:            64,
+		BlockBatchLimitBurstFactor: 10,
+	})
+
+	ctx, cancel := context.WithCancel(ctx)
+	mn := mocknet.New(ctx)
+	mn.SetLinkDefaults(mocknet.LinkOptions{Latency: cfg.Latency})
+	genesisTime := time.Now().Truncate(time.Second)
+	genesisRoot := cfg.GenesisState.GenesisValidatorRoot()
+	digest, err := p2putils.CreateForkDigest(genesisTime, genesisRoot)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not create fork digest")
+	}
+
+	n := &Network{
+		cfg:         cfg,
+		ctx:         ctx,
+		cancel:      cancel,
+		mocknet:     mn,
+		nodes:       make([]*Node, cfg.NumNodes),
+		genesisTime: genesisTime,
+		removeDir:   removeDir,
+		groups:      make([]int, cfg.NumNodes),
+	}
+	for i := 0; i < cfg.NumNodes; i++ {
+		h, err := mn.GenPeer()
+		if err != nil {
+			cancel()
+			return nil, errors.Wrap(err, "could not create host")
+		}
+		dataDir := path.Join(cfg.DataDir, fmt.Sprintf("node-%d", i))
+		if err := os.RemoveAll(dataDir); err != nil {
+			cancel()
+			return nil, err
+		}
+		n.nodes[i] = &Node{
+			index:   i,
+			dataDir: dataDir,
+			host:    h,
+			digest:  digest,
+		}
+	}
+	return n, nil
+}
+
+// Nodes of the network.
+func (n *Network) Nodes() []*Node {
+	return n.nodes
+}
+
+// GenesisTime of the simulated chain.
+func (n *Network) GenesisTime() time.Time {
+	return n.genesisTime
+}
+
+// CurrentSlot of the fake clock of the simulated chain.
+func (n *Network) CurrentSlot() uint64 {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return n.slot
+}
+
+// Start writes the genesis state to every node, starts them, connects them all
+// together and begins proposing blocks, with the fake clock at genesis.
+func (n *Network) Start() error {
+	roughtime.Pin(n.genesisTime)
+	genesisState := n.cfg.GenesisState.Copy()
+	if err := genesisState.SetGenesisTime(uint64(n.genesisTime.Unix())); err != nil {
+		return err
+	}
+	for _, node := range n.nodes {
+		if err := node.saveGenesis(n.ctx, genesisState); err != nil {
+			return errors.Wrapf(err, "could not save genesis of node %d", node.index)
+		}
+		if err := node.start(n.ctx); err != nil {
+			return errors.Wrapf(err, "could not start node %d", node.index)
+		}
+	}
+	if err := n.mocknet.LinkAll(); err != nil {
+		return errors.Wrap(err, "could not link nodes")
+	}
+	for i := range n.nodes {
+		n.connectNode(i)
+	}
+	n.proposing = make(chan struct{})
+	go func() {
+		n.proposeBlocks()
+		close(n.proposing)
+	}()
+	return nil
+}
+
+// Stop all nodes, tear down the network and give the nodes of the process the real
+// clock back.
+func (n *Network) Stop() error {
+	n.cancel()
+	if n.proposing != nil {
+		<-n.proposing
+	}
+	defer roughtime.Pin(time.Time{})
+	for _, node := range n.nodes {
+		if err := node.stop(); err != nil {
+			return errors.Wrapf(err, "could not stop node %d", node.index)
+		}
+	}
+	if n.removeDir {
+		return os.RemoveAll(n.cfg.DataDir)
+	}
+	return nil
+}
+
+// SetLatency sets the latency of every link of the network.
+func (n *Network) SetLatency(latency time.Duration) {
+	n.mocknet.SetLinkDefaults(mocknet.LinkOptions{Latency: latency})
+	for _, byPeer := range n.mocknet.Links() {
+		for _, links := range byPeer {
+			for l := range links {
+				l.SetOptions(mocknet.LinkOptions{Latency: latency})
+			}
+		}
+	}
+}
+
+// SetLinkLatency sets the latency between two nodes.
+func (n *Network) SetLinkLatency(a, b int, latency time.Duration) {
+	for _, l := range n.mocknet.LinksBetweenPeers(n.peerID(a), n.peerID(b)) {
+		l.SetOptions(mocknet.LinkOptions{Latency: latency})
+	}
+}
+
+// SetDropRate makes a node silently drop the given share, between 0 and 1, of the
+// gossip messages it broadcasts.
+func (n *Network) SetDropRate(node int, rate float64) {
+	n.nodes[node].setDropRate(rate)
+}
+
+// Partition splits the network into the given groups of node indices. Nodes of
+// different groups are disconnected and cannot reach each other until Heal is called.
+// Nodes not listed in any group are isolated. The network may be partitioned again
+// without healing it first, which reconnects the nodes a previous partition split but
+// this one puts in the same group.
+func (n *Network) Partition(groups ...[]int) error {
+	n.lock.Lock()
+	for i := range n.groups {
+		n.groups[i] = -1 - i
+	}
+	for g, members := range groups {
+		for _, i := range members {
+			if i < 0 || i >= len(n.nodes) {
+				n.lock.Unlock()
+				return fmt.Errorf("no node with index %d", i)
+			}
+			n.groups[i] = g
+		}
+	}
+	n.lock.Unlock()
+
+	for a := range n.nodes {
+		for b := a + 1; b < len(n.nodes); b++ {
+			if n.sameGroup(a, b) {
+				// The nodes are not linked if a previous partition split them.
+				if len(n.mocknet.LinksBetweenPeers(n.peerID(a), n.peerID(b))) > 0 {
+					continue
+				}
+				if _, err := n.mocknet.LinkPeers(n.peerID(a), n.peerID(b)); err != nil {
+					return errors.Wrapf(err, "could not link nodes %d and %d", a, b)
+				}
+				continue
+			}
+			if len(n.mocknet.LinksBetweenPeers(n.peerID(a), n.peerID(b))) == 0 {
+				continue
+			}
+			if err := n.mocknet.UnlinkPeers(n.peerID(a), n.peerID(b)); err != nil {
+				return errors.Wrapf(err, "could not unlink nodes %d and %d", a, b)
+			}
+			if err := n.mocknet.DisconnectPeers(n.peerID(a), n.peerID(b)); err != nil {
+				log.WithError(err).Debugf("Could not disconnect nodes %d and %d", a, b)
+			}
+		}
+	}
+	for i := range n.nodes {
+		n.connectNode(i)
+	}
+	return nil
+}
+
+// Heal removes all partitions and reconnects every pair of nodes.
+func (n *Network) Heal() error {
+	n.lock.Lock()
+	for i := range n.groups {
+		n.groups[i] = 0
+	}
+	n.lock.Unlock()
+
+	for a := range n.nodes {
+		for b := a + 1; b < len(n.nodes); b++ {
+			if len(n.mocknet.LinksBetweenPeers(n.peerID(a), n.peerID(b))) > 0 {
+				continue
+			}
+			if _, err := n.mocknet.LinkPeers(n.peerID(a), n.peerID(b)); err != nil {
+				return errors.Wrapf(err, "could not link nodes %d and %d", a, b)
+			}
+		}
+	}
+	for i := range n.nodes {
+		n.connectNode(i)
+	}
+	return nil
+}
+
+// StopNode shuts a node down and disconnects it from its peers.
+func (n *Network) StopNode(i int) error {
+	if err := n.nodes[i].stop(); err != nil {
+		return err
+	}
+	for j := range n.nodes {
+		if j == i {
+			continue
+		}
+		if err := n.mocknet.DisconnectPeers(n.peerID(i), n.peerID(j)); err != nil {
+			log.WithError(err).Debugf("Could not disconnect nodes %d and %d", i, j)
+		}
+	}
+	return nil
+}
+
+// StartNode starts a previously stopped node from its database and reconnects it
+// to the peers it is linked to. The node catches up through initial sync.
+func (n *Network) StartNode(i int) error {
+	if err := n.nodes[i].start(n.ctx); err != nil {
+		return err
+	}
+	n.connectNode(i)
+	return nil
+}
+
+// RestartNode stops and starts a node again.
+func (n *Network) RestartNode(i int) error {
+	if err := n.StopNode(i); err != nil {
+		return err
+	}
+	return n.StartNode(i)
+}
+
+// connectNode connects a running node to every running node it is linked to.
+func (n *Network) connectNode(i int) {
+	if !n.nodes[i].Running() {
+		return
+	}
+	for j := range n.nodes {
+		if j == i || !n.nodes[j].Running() {
+			continue
+		}
+		if len(n.mocknet.LinksBetweenPeers(n.peerID(i), n.peerID(j))) == 0 {
+			continue
+		}
+		if len(n.nodes[i].host.Network().ConnsToPeer(n.peerID(j))) > 0 {
+			continue
+		}
+		if _, err := n.mocknet.ConnectPeers(n.peerID(i), n.peerID(j)); err != nil {
+			log.WithError(err).Debugf("Could not connect nodes %d and %d", i, j)
+		}
+	}
+}
+
+func (n *Network) peerID(i int) peer.ID {
+	return n.nodes[i].host.ID()
+}
+
+func (n *Network) sameGroup(a, b int) bool {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return n.groups[a] == n.groups[b]
+}
+
+// proposers returns, for every partition, the node proposing at the given slot.
+func (n *Network) proposers(slot uint64) []*Node {
+	n.lock.RLock()
+	members := make(map[int][]*Node)
+	var order []int
+	for i, g := range n.groups {
+		if _, ok := members[g]; !ok {
+			order = append(order, g)
+		}
+		members[g] = append(members[g], n.nodes[i])
+	}
+	n.lock.RUnlock()
+
+	var proposers []*Node
+	for _, g := range order {
+		group := members[g]
+		// Rotate proposals between the synced nodes of the group.
+		for k := 0; k < len(group); k++ {
+			node := group[(int(slot)+k)%len(group)]
+			if !node.Syncing() {
+				proposers = append(proposers, node)
+				break
+			}
+		}
+	}
+	return proposers
+}
+
+// proposeBlocks moves the fake clock to the next slot every slot interval, and proposes
+// the blocks of that slot.
+func (n *Network) proposeBlocks() {
+	ticker := time.NewTicker(n.cfg.SlotInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-ticker.C:
+			slot := n.advanceSlot()
+			for _, node := range n.proposers(slot) {
+				if err := n.propose(node, slot); err != nil {
+					log.WithError(err).WithField("node", node.index).Warn("Could not propose block")
+				}
+			}
+		}
+	}
+}
+
+// advanceSlot moves the fake clock to the start of the next slot, and returns that slot.
+func (n *Network) advanceSlot() uint64 {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.slot++
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	roughtime.Pin(n.genesisTime.Add(time.Duration(n.slot) * slotDuration))
+	return n.slot
+}
+
+// propose builds a block for the slot on top of the node's head, with attestations
+// of all committees of the previous slot, then processes and gossips it from the node.
+func (n *Network) propose(node *Node, slot uint64) error {
+	chain := node.Chain()
+	if chain == nil {
+		return nil
+	}
+	ctx := n.ctx
+	headState, err := chain.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	conf := &testutil.BlockGenConfig{}
+	// Attestations of the last slot of an epoch are skipped as the generated
+	// attestation data would target the wrong epoch.
+	if !helpers.IsEpochStart(slot) {
+		activeCount, err := helpers.ActiveValidatorCount(headState, helpers.SlotToEpoch(slot))
+		if err != nil {
+			return err
+		}
+		conf.NumAttestations = helpers.SlotCommitteeCount(activeCount)
+	}
+	blk, err := testutil.GenerateFullBlock(headState, n.cfg.PrivKeys, conf, slot)
+	if err != nil {
+		return errors.Wrap(err, "could not generate block")
+	}
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if err := chain.ReceiveBlock(ctx, blk, root); err != nil {
+		return errors.Wrap(err, "could not process block")
+	}
+	proposedBlocksCounter.Inc()
+	node.lock.RLock()
+	p := node.p2p
+	node.lock.RUnlock()
+	return p.Broadcast(ctx, blk)
+}
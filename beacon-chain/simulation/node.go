@@ -0,0 +1,303 @@
+package simulation
+
+import (
+	"context"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	mockPOW "github.com/prysmaticlabs/prysm/beacon-chain/powchain/testing"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Node is a complete beacon node of the simulation, running the blockchain, initial
+// sync, regular sync and attestation pool services in process. Its database lives in
+// its own directory, so that the node keeps its chain across restarts.
+type Node struct {
+	index             int
+	dataDir           string
+	host              host.Host
+	digest            [4]byte
+	lock              sync.RWMutex
+	ctx               context.Context
+	cancel            context.CancelFunc
+	db                db.Database
+	stateSummaryCache *cache.StateSummaryCache
+	p2p               *simP2P
+	services          *shared.ServiceRegistry
+	chain             *blockchain.Service
+	initSync          *initialsync.Service
+	dropRate          float64
+	stateFeed         *event.Feed
+	blockFeed         *event.Feed
+	opFeed            *event.Feed
+	running           bool
+}
+
+// StateFeed implements statefeed.Notifier.
+func (n *Node) StateFeed() *event.Feed {
+	return n.stateFeed
+}
+
+// BlockFeed implements blockfeed.Notifier.
+func (n *Node) BlockFeed() *event.Feed {
+	return n.blockFeed
+}
+
+// OperationFeed implements opfeed.Notifier.
+func (n *Node) OperationFeed() *event.Feed {
+	return n.opFeed
+}
+
+// Index of the node in the simulated network.
+func (n *Node) Index() int {
+	return n.index
+}
+
+// Running returns whether the node services are currently started.
+func (n *Node) Running() bool {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return n.running
+}
+
+// Chain returns the blockchain service of the node, to inspect its head, fork choice
+// and finality. It returns nil while the node is stopped.
+func (n *Node) Chain() *blockchain.Service {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	if !n.running {
+		return nil
+	}
+	return n.chain
+}
+
+// DB returns the database of the node. It returns nil while the node is stopped.
+func (n *Node) DB() db.Database {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	if !n.running {
+		return nil
+	}
+	return n.db
+}
+
+// Syncing returns whether the node is stopped or still running initial sync.
+func (n *Node) Syncing() bool {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return !n.running || n.initSync.Syncing()
+}
+
+// setDropRate sets the share of outgoing gossip messages dropped by the node. The
+// rate is kept across restarts.
+func (n *Node) setDropRate(rate float64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.dropRate = rate
+	if n.running {
+		n.p2p.setDropRate(rate)
+	}
+}
+
+// saveGenesis writes the genesis block and state to the node's database, the same
+// way the beacon node does at chain start.
+func (n *Node) saveGenesis(ctx context.Context, genesisState *stateTrie.BeaconState) error {
+	beaconDB, _, err := openDB(n.dataDir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := beaconDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	stateRoot, err := genesisState.HashTreeRoot(ctx)
+	if err != nil {
+		return err
+	}
+	genesisBlk := blocks.NewGenesisBlock(stateRoot[:])
+	genesisBlkRoot, err := genesisBlk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis block root")
+	}
+	if err := beaconDB.SaveBlock(ctx, genesisBlk); err != nil {
+		return errors.Wrap(err, "could not save genesis block")
+	}
+	if err := beaconDB.SaveStateSummary(ctx, &pb.StateSummary{
+		Slot: 0,
+		Root: genesisBlkRoot[:],
+	}); err != nil {
+		return err
+	}
+	if err := beaconDB.SaveState(ctx, genesisState, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could not save genesis state")
+	}
+	if err := beaconDB.SaveGenesisBlockRoot(ctx, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could not save genesis block root")
+	}
+	if err := beaconDB.SaveHeadBlockRoot(ctx, genesisBlkRoot); err != nil {
+		return errors.Wrap(err, "could not save head block root")
+	}
+	genesisCheckpoint := &ethpb.Checkpoint{Root: genesisBlkRoot[:]}
+	if err := beaconDB.SaveJustifiedCheckpoint(ctx, genesisCheckpoint); err != nil {
+		return errors.Wrap(err, "could not save justified checkpoint")
+	}
+	if err := beaconDB.SaveFinalizedCheckpoint(ctx, genesisCheckpoint); err != nil {
+		return errors.Wrap(err, "could not save finalized checkpoint")
+	}
+	return nil
+}
+
+// start opens the node database and starts all of its services.
+func (n *Node) start(ctx context.Context) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.running {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	beaconDB, sc, err := openDB(n.dataDir)
+	if err != nil {
+		cancel()
+		return err
+	}
+	p, err := newSimP2P(ctx, n.host, n.digest)
+	if err != nil {
+		cancel()
+		if closeErr := beaconDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close database")
+		}
+		return err
+	}
+	p.setDropRate(n.dropRate)
+	n.ctx = ctx
+	n.cancel = cancel
+	n.db = beaconDB
+	n.stateSummaryCache = sc
+	n.p2p = p
+	n.stateFeed = new(event.Feed)
+	n.blockFeed = new(event.Feed)
+	n.opFeed = new(event.Feed)
+	n.services = shared.NewServiceRegistry()
+	if err := n.registerServices(); err != nil {
+		cancel()
+		return err
+	}
+	n.services.StartAll()
+	n.running = true
+	return nil
+}
+
+// stop stops all services of the node and closes its database, as a process
+// shutdown would. The libp2p host is kept so that the node keeps its identity.
+func (n *Node) stop() error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if !n.running {
+		return nil
+	}
+	n.services.StopAll()
+	n.p2p.close()
+	n.cancel()
+	n.running = false
+	return n.db.Close()
+}
+
+func (n *Node) registerServices() error {
+	attPool := attestations.NewPool()
+	exitPool := voluntaryexits.NewPool()
+	slashingPool := slashings.NewPool()
+	stateGen := stategen.New(n.db, n.stateSummaryCache)
+	depositCache, err := depositcache.NewDepositCache()
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit cache")
+	}
+
+	opsService, err := attestations.NewService(n.ctx, &attestations.Config{
+		Pool: attPool,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not register atts pool service")
+	}
+	if err := n.services.RegisterService(opsService); err != nil {
+		return err
+	}
+
+	chainService, err := blockchain.NewService(n.ctx, &blockchain.Config{
+		BeaconDB:          n.db,
+		DepositCache:      depositCache,
+		ChainStartFetcher: &mockPOW.POWChain{},
+		AttPool:           attPool,
+		ExitPool:          exitPool,
+		SlashingPool:      slashingPool,
+		P2p:               n.p2p,
+		StateNotifier:     n,
+		ForkChoiceStore:   protoarray.New(0, 0, params.BeaconConfig().ZeroHash),
+		OpsService:        opsService,
+		StateGen:          stateGen,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not register blockchain service")
+	}
+	if err := n.services.RegisterService(chainService); err != nil {
+		return err
+	}
+	n.chain = chainService
+
+	initSync := initialsync.NewInitialSync(n.ctx, &initialsync.Config{
+		DB:            n.db,
+		Chain:         chainService,
+		P2P:           n.p2p,
+		StateNotifier: n,
+		BlockNotifier: n,
+	})
+	if err := n.services.RegisterService(initSync); err != nil {
+		return err
+	}
+	n.initSync = initSync
+
+	regularSync := prysmsync.NewRegularSync(n.ctx, &prysmsync.Config{
+		DB:                  n.db,
+		P2P:                 n.p2p,
+		Chain:               chainService,
+		InitialSync:         initSync,
+		StateNotifier:       n,
+		BlockNotifier:       n,
+		AttestationNotifier: n,
+		AttPool:             attPool,
+		ExitPool:            exitPool,
+		SlashingPool:        slashingPool,
+		StateSummaryCache:   n.stateSummaryCache,
+		StateGen:            stateGen,
+	})
+	return n.services.RegisterService(regularSync)
+}
+
+func openDB(dataDir string) (db.Database, *cache.StateSummaryCache, error) {
+	sc := cache.NewStateSummaryCache()
+	beaconDB, err := kv.NewKVStore(dataDir, sc)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not open database")
+	}
+	return beaconDB, sc, nil
+}
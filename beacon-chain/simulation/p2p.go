@@ -0,0 +1,341 @@
+package simulation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/gogo/protobuf/proto"
+	core "github.com/libp2p/go-libp2p-core"
+	"github.com/libp2p/go-libp2p-core/control"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+)
+
+var _ = p2p.P2P(&simP2P{})
+
+// simP2P implements the beacon node p2p interface on top of a host of the in-process
+// mock network. Unlike the p2p testing mock, it runs gossipsub and can drop a share
+// of the messages it broadcasts, as instructed by the simulation.
+type simP2P struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	host         host.Host
+	pubsub       *pubsub.PubSub
+	peers        *peers.Status
+	digest       [4]byte
+	metadata     *pb.MetaData
+	topicsLock   sync.Mutex
+	joinedTopics map[string]*pubsub.Topic
+	notifeesLock sync.Mutex
+	notifees     []network.Notifiee
+	dropLock     sync.Mutex
+	dropRate     float64
+	rand         *rand.Rand
+}
+
+func newSimP2P(ctx context.Context, h host.Host, digest [4]byte) (*simP2P, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	ps, err := pubsub.NewGossipSub(ctx, h,
+		pubsub.WithMessageSigning(false),
+		pubsub.WithStrictSignatureVerification(false),
+	)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "could not create gossipsub")
+	}
+	return &simP2P{
+		ctx:    ctx,
+		cancel: cancel,
+		host:   h,
+		pubsub: ps,
+		peers: peers.NewStatus(ctx, &peers.StatusConfig{
+			PeerLimit: 50,
+			ScorerParams: &peers.PeerScorerConfig{
+				BadResponsesScorerConfig: &peers.BadResponsesScorerConfig{
+					Threshold: 5,
+				},
+			},
+		}),
+		digest: digest,
+		metadata: &pb.MetaData{
+			SeqNumber: 0,
+			Attnets:   bitfield.NewBitvector64(),
+		},
+		joinedTopics: make(map[string]*pubsub.Topic),
+		rand:         rand.NewDeterministicGenerator(),
+	}, nil
+}
+
+// close stops gossipsub and unregisters all connection handlers from the host, so
+// that the host can be reused by a restarted node.
+func (p *simP2P) close() {
+	p.notifeesLock.Lock()
+	for _, n := range p.notifees {
+		p.host.Network().StopNotify(n)
+	}
+	p.notifees = nil
+	p.notifeesLock.Unlock()
+	p.cancel()
+}
+
+// setDropRate sets the share, between 0 and 1, of outgoing gossip messages which are
+// silently dropped.
+func (p *simP2P) setDropRate(rate float64) {
+	p.dropLock.Lock()
+	defer p.dropLock.Unlock()
+	p.dropRate = rate
+}
+
+func (p *simP2P) shouldDrop() bool {
+	p.dropLock.Lock()
+	defer p.dropLock.Unlock()
+	return p.dropRate > 0 && p.rand.Float64() < p.dropRate
+}
+
+// Broadcast a message to the gossip topic mapped to its type.
+func (p *simP2P) Broadcast(ctx context.Context, msg proto.Message) error {
+	topic, ok := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
+	if !ok {
+		return p2p.ErrMessageNotMapped
+	}
+	return p.broadcastObject(ctx, msg, fmt.Sprintf(topic, p.digest))
+}
+
+// BroadcastAttestation broadcasts an attestation to the given subnet.
+func (p *simP2P) BroadcastAttestation(ctx context.Context, subnet uint64, att *ethpb.Attestation) error {
+	return p.broadcastObject(ctx, att, fmt.Sprintf(p2p.AttestationSubnetTopicFormat, p.digest, subnet))
+}
+
+func (p *simP2P) broadcastObject(ctx context.Context, obj interface{}, topic string) error {
+	if p.shouldDrop() {
+		droppedMessagesCounter.Inc()
+		return nil
+	}
+	buf := new(bytes.Buffer)
+	if _, err := p.Encoding().EncodeGossip(buf, obj); err != nil {
+		return errors.Wrap(err, "could not encode message")
+	}
+	return p.PublishToTopic(ctx, topic+p.Encoding().ProtocolSuffix(), buf.Bytes())
+}
+
+// SetStreamHandler for RPC.
+func (p *simP2P) SetStreamHandler(topic string, handler network.StreamHandler) {
+	p.host.SetStreamHandler(core.ProtocolID(topic), handler)
+}
+
+// JoinTopic will join PubSub topic, if not already joined.
+func (p *simP2P) JoinTopic(topic string, opts ...pubsub.TopicOpt) (*pubsub.Topic, error) {
+	p.topicsLock.Lock()
+	defer p.topicsLock.Unlock()
+	if _, ok := p.joinedTopics[topic]; !ok {
+		joinedTopic, err := p.pubsub.Join(topic, opts...)
+		if err != nil {
+			return nil, err
+		}
+		p.joinedTopics[topic] = joinedTopic
+	}
+	return p.joinedTopics[topic], nil
+}
+
+// LeaveTopic closes topic and removes corresponding handler from list of joined topics.
+func (p *simP2P) LeaveTopic(topic string) error {
+	p.topicsLock.Lock()
+	defer p.topicsLock.Unlock()
+	if t, ok := p.joinedTopics[topic]; ok {
+		if err := t.Close(); err != nil {
+			return err
+		}
+		delete(p.joinedTopics, topic)
+	}
+	return nil
+}
+
+// PublishToTopic publishes message to previously joined topic.
+func (p *simP2P) PublishToTopic(ctx context.Context, topic string, data []byte, opts ...pubsub.PubOpt) error {
+	joinedTopic, err := p.JoinTopic(topic)
+	if err != nil {
+		return err
+	}
+	return joinedTopic.Publish(ctx, data, opts...)
+}
+
+// SubscribeToTopic joins (if necessary) and subscribes to PubSub topic.
+func (p *simP2P) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub.Subscription, error) {
+	joinedTopic, err := p.JoinTopic(topic)
+	if err != nil {
+		return nil, err
+	}
+	return joinedTopic.Subscribe(opts...)
+}
+
+// Encoding returns the ssz snappy network encoding.
+func (p *simP2P) Encoding() encoder.NetworkEncoding {
+	return &encoder.SszNetworkEncoder{}
+}
+
+// PubSub returns the underlying gossipsub router.
+func (p *simP2P) PubSub() *pubsub.PubSub {
+	return p.pubsub
+}
+
+// Disconnect from a peer.
+func (p *simP2P) Disconnect(pid peer.ID) error {
+	return p.host.Network().ClosePeer(pid)
+}
+
+// PeerID returns the peer id of the local peer.
+func (p *simP2P) PeerID() peer.ID {
+	return p.host.ID()
+}
+
+// Host returns the libp2p host of the local peer.
+func (p *simP2P) Host() host.Host {
+	return p.host
+}
+
+// ENR returns an empty record, as discovery does not run in the simulation.
+func (p *simP2P) ENR() *enr.Record {
+	return new(enr.Record)
+}
+
+// RefreshENR is a no-op in the simulation.
+func (p *simP2P) RefreshENR() {}
+
+// FindPeersWithSubnet reports no new peers, as the simulated topology is fixed.
+func (p *simP2P) FindPeersWithSubnet(_ context.Context, _ uint64) (bool, error) {
+	return false, nil
+}
+
+// AddPingMethod is a no-op in the simulation.
+func (p *simP2P) AddPingMethod(_ func(ctx context.Context, id peer.ID) error) {}
+
+// AddConnectionHandler handles the connection with a newly connected peer.
+func (p *simP2P) AddConnectionHandler(f func(ctx context.Context, id peer.ID) error) {
+	p.notify(&network.NotifyBundle{
+		ConnectedF: func(net network.Network, conn network.Conn) {
+			// Must be handled in a goroutine as this callback cannot be blocking.
+			go func() {
+				p.peers.Add(new(enr.Record), conn.RemotePeer(), conn.RemoteMultiaddr(), conn.Stat().Direction)
+				p.peers.SetConnectionState(conn.RemotePeer(), peers.PeerConnecting)
+				if err := f(p.ctx, conn.RemotePeer()); err != nil {
+					log.WithError(err).Debug("Could not handshake with peer")
+					if err := p.Disconnect(conn.RemotePeer()); err != nil {
+						log.WithError(err).Debugf("Unable to close peer %s", conn.RemotePeer())
+					}
+					p.peers.SetConnectionState(conn.RemotePeer(), peers.PeerDisconnected)
+					return
+				}
+				p.peers.SetConnectionState(conn.RemotePeer(), peers.PeerConnected)
+			}()
+		},
+	})
+}
+
+// AddDisconnectionHandler handles the disconnection of a peer.
+func (p *simP2P) AddDisconnectionHandler(f func(ctx context.Context, id peer.ID) error) {
+	p.notify(&network.NotifyBundle{
+		DisconnectedF: func(net network.Network, conn network.Conn) {
+			// Must be handled in a goroutine as this callback cannot be blocking.
+			go func() {
+				p.peers.SetConnectionState(conn.RemotePeer(), peers.PeerDisconnecting)
+				if err := f(p.ctx, conn.RemotePeer()); err != nil {
+					log.WithError(err).Debug("Unable to invoke callback")
+				}
+				p.peers.SetConnectionState(conn.RemotePeer(), peers.PeerDisconnected)
+			}()
+		},
+	})
+}
+
+func (p *simP2P) notify(n network.Notifiee) {
+	p.notifeesLock.Lock()
+	defer p.notifeesLock.Unlock()
+	p.notifees = append(p.notifees, n)
+	p.host.Network().Notify(n)
+}
+
+// Send a message to a specific peer, negotiating the newest schema version of the topic.
+func (p *simP2P) Send(ctx context.Context, msg interface{}, topic string, pid peer.ID) (network.Stream, error) {
+	var protocols []core.ProtocolID
+	for _, t := range p2p.RPCNegotiableTopics(topic, msg) {
+		protocols = append(protocols, core.ProtocolID(t+p.Encoding().ProtocolSuffix()))
+	}
+	if len(protocols) == 0 {
+		return nil, p2p.VerifyTopicMapping(topic, msg)
+	}
+	stream, err := p.host.NewStream(ctx, pid, protocols...)
+	if err != nil {
+		return nil, err
+	}
+	// Metadata requests carry no payload.
+	if p2p.RPCMethod(topic) != p2p.RPCMethod(p2p.RPCMetaDataTopic) {
+		if _, err := p.Encoding().EncodeWithMaxLength(stream, msg); err != nil {
+			return nil, err
+		}
+	}
+	// Close stream for writing.
+	if err := stream.Close(); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// Peers returns the peer status.
+func (p *simP2P) Peers() *peers.Status {
+	return p.peers
+}
+
+// Metadata returns a copy of the local metadata.
+func (p *simP2P) Metadata() *pb.MetaData {
+	return proto.Clone(p.metadata).(*pb.MetaData)
+}
+
+// MetadataSeq returns the local metadata sequence number.
+func (p *simP2P) MetadataSeq() uint64 {
+	return p.metadata.SeqNumber
+}
+
+// ForkDigest returns the fork digest of the simulated network.
+func (p *simP2P) ForkDigest() ([4]byte, error) {
+	return p.digest, nil
+}
+
+// InterceptPeerDial allows all dials, the topology is controlled by the mock network links.
+func (p *simP2P) InterceptPeerDial(peer.ID) (allow bool) {
+	return true
+}
+
+// InterceptAddrDial allows all dials.
+func (p *simP2P) InterceptAddrDial(peer.ID, multiaddr.Multiaddr) (allow bool) {
+	return true
+}
+
+// InterceptAccept allows all connections.
+func (p *simP2P) InterceptAccept(network.ConnMultiaddrs) (allow bool) {
+	return true
+}
+
+// InterceptSecured allows all connections.
+func (p *simP2P) InterceptSecured(network.Direction, peer.ID, network.ConnMultiaddrs) (allow bool) {
+	return true
+}
+
+// InterceptUpgraded allows all connections.
+func (p *simP2P) InterceptUpgraded(network.Conn) (allow bool, reason control.DisconnectReason) {
+	return true, 0
+}
@@ -0,0 +1,105 @@
+package simulation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// simulationTimeout is the real time the simulated networks of the tests have to reach
+// their goal, which is far more than the fake clock needs.
+const simulationTimeout = 30 * time.Second
+
+func setupNetwork(t *testing.T, numNodes int) *Network {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MinimalSpecConfig())
+
+	genesisState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	n, err := NewNetwork(context.Background(), &Config{
+		NumNodes:     numNodes,
+		GenesisState: genesisState,
+		PrivKeys:     privKeys,
+		Latency:      10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	t.Cleanup(func() {
+		require.NoError(t, n.Stop())
+	})
+	return n
+}
+
+func TestNetwork_Finalizes(t *testing.T) {
+	n := setupNetwork(t, 4)
+	n.SetDropRate(3, 0.1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), simulationTimeout)
+	defer cancel()
+	require.NoError(t, n.WaitForFinalizedEpoch(ctx, 3))
+	require.NoError(t, n.WaitForConvergence(ctx))
+}
+
+func TestNetwork_ConvergesAfterPartition(t *testing.T) {
+	n := setupNetwork(t, 4)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	ctx, cancel := context.WithTimeout(context.Background(), simulationTimeout)
+	defer cancel()
+	require.NoError(t, n.WaitForSlot(ctx, 2))
+	require.NoError(t, n.Partition([]int{0, 1}, []int{2, 3}))
+	require.NoError(t, n.WaitForSlot(ctx, 2+slotsPerEpoch))
+	require.NotNil(t, n.CheckForkChoiceAgreement(ctx), "Expected partitions to diverge")
+
+	require.NoError(t, n.Heal())
+	require.NoError(t, n.WaitForConvergence(ctx))
+	require.NoError(t, n.WaitForFinalizedEpoch(ctx, 3))
+}
+
+func TestNetwork_RestartedNodeCatchesUp(t *testing.T) {
+	n := setupNetwork(t, 3)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	ctx, cancel := context.WithTimeout(context.Background(), simulationTimeout)
+	defer cancel()
+	require.NoError(t, n.WaitForSlot(ctx, 2))
+	require.NoError(t, n.StopNode(2))
+	require.NoError(t, n.WaitForSlot(ctx, 2+slotsPerEpoch))
+	require.NoError(t, n.StartNode(2))
+
+	require.NoError(t, n.WaitForFinalizedEpoch(ctx, 3))
+	require.NoError(t, n.WaitForConvergence(ctx))
+	require.Equal(t, true, n.Nodes()[2].Running())
+}
+
+func TestNetwork_PartitionAgain(t *testing.T) {
+	n := setupNetwork(t, 3)
+
+	require.NoError(t, n.Partition([]int{0, 1}, []int{2}))
+	// Nodes 0 and 2 were already split by the first partition.
+	require.NoError(t, n.Partition([]int{0}, []int{1, 2}))
+	assert.Equal(t, 0, len(n.mocknet.LinksBetweenPeers(n.peerID(0), n.peerID(1))))
+	assert.Equal(t, 0, len(n.mocknet.LinksBetweenPeers(n.peerID(0), n.peerID(2))))
+	// Nodes 1 and 2, split by the first partition, are in the same group again.
+	assert.Equal(t, true, len(n.mocknet.LinksBetweenPeers(n.peerID(1), n.peerID(2))) > 0)
+	assert.Equal(t, true, len(n.nodes[1].host.Network().ConnsToPeer(n.peerID(2))) > 0)
+	require.NoError(t, n.Heal())
+	assert.Equal(t, true, len(n.mocknet.LinksBetweenPeers(n.peerID(0), n.peerID(2))) > 0)
+}
+
+func TestNetwork_FakeClock(t *testing.T) {
+	n := setupNetwork(t, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), simulationTimeout)
+	defer cancel()
+	require.NoError(t, n.WaitForSlot(ctx, 2))
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	assert.Equal(t, false, roughtime.Now().Before(n.GenesisTime().Add(2*slotDuration)))
+	// The chain moved faster than the real clock.
+	assert.Equal(t, true, roughtime.Now().After(time.Now()))
+}
@@ -0,0 +1,138 @@
+package sync
+
+import (
+	"context"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+const (
+	// Maximum time a signature set waits in the queue before its batch is verified.
+	signatureVerificationInterval = 50 * time.Millisecond
+	// Number of queued signature sets which triggers the verification of a batch.
+	verifierLimit = 50
+)
+
+var errSignatureSetInvalid = errors.New("signature set did not verify")
+
+// signatureVerifier is a signature set queued for batch verification, along with
+// the channel on which the result of its verification is sent.
+type signatureVerifier struct {
+	set     *bls.SignatureSet
+	resChan chan error
+}
+
+// verifierRoutine runs in the background and verifies the signature sets queued by
+// gossip validators in batches, once enough of them are queued or once the oldest
+// one has waited for the verification interval.
+func (s *Service) verifierRoutine() {
+	ticker := time.NewTicker(signatureVerificationInterval)
+	defer ticker.Stop()
+	var verifierBatch []*signatureVerifier
+	for {
+		select {
+		case <-s.ctx.Done():
+			// Release the validators waiting on the current batch or still queued.
+			for _, v := range verifierBatch {
+				v.resChan <- s.ctx.Err()
+			}
+			for {
+				select {
+				case v := <-s.signatureChan:
+					v.resChan <- s.ctx.Err()
+				default:
+					return
+				}
+			}
+		case v := <-s.signatureChan:
+			verifierBatch = append(verifierBatch, v)
+			if len(verifierBatch) >= verifierLimit {
+				verifyBatch(verifierBatch)
+				verifierBatch = nil
+			}
+		case <-ticker.C:
+			if len(verifierBatch) > 0 {
+				verifyBatch(verifierBatch)
+				verifierBatch = nil
+			}
+		}
+	}
+}
+
+// validateWithBatchVerifier queues a signature set for batch verification and
+// returns the validation result of the message it belongs to. The set is verified
+// inline if the batch verifier is not running.
+func (s *Service) validateWithBatchVerifier(ctx context.Context, message string, set *bls.SignatureSet) pubsub.ValidationResult {
+	ctx, span := trace.StartSpan(ctx, "sync.validateWithBatchVerifier")
+	defer span.End()
+
+	if s.signatureChan == nil {
+		if err := verifySet(set); err != nil {
+			traceutil.AnnotateError(span, err)
+			log.WithError(err).Debugf("Could not verify %s signature", message)
+			return pubsub.ValidationReject
+		}
+		return pubsub.ValidationAccept
+	}
+
+	// The channel is buffered so that the verifier never blocks on a validator
+	// which stopped waiting.
+	resChan := make(chan error, 1)
+	select {
+	case s.signatureChan <- &signatureVerifier{set: set, resChan: resChan}:
+	case <-ctx.Done():
+		return pubsub.ValidationIgnore
+	}
+	select {
+	case <-ctx.Done():
+		return pubsub.ValidationIgnore
+	case err := <-resChan:
+		if err == nil {
+			return pubsub.ValidationAccept
+		}
+		traceutil.AnnotateError(span, err)
+		if errors.Is(err, context.Canceled) {
+			return pubsub.ValidationIgnore
+		}
+		log.WithError(err).Debugf("Could not verify %s signature", message)
+		return pubsub.ValidationReject
+	}
+}
+
+// verifyBatch verifies all signature sets of the batch at once. If the batch does
+// not verify, every set is verified individually so that only the messages with an
+// invalid signature are rejected.
+func verifyBatch(verifierBatch []*signatureVerifier) {
+	signatureBatchSize.Observe(float64(len(verifierBatch)))
+	aggSet := bls.NewSet()
+	for _, v := range verifierBatch {
+		aggSet.Join(v.set)
+	}
+	verified, err := aggSet.Verify()
+	if err == nil && verified {
+		for _, v := range verifierBatch {
+			v.resChan <- nil
+		}
+		return
+	}
+	signatureBatchFallbackCounter.Inc()
+	for _, v := range verifierBatch {
+		v.resChan <- verifySet(v.set)
+	}
+}
+
+func verifySet(set *bls.SignatureSet) error {
+	verified, err := set.Verify()
+	if err != nil {
+		return errors.Wrap(err, "could not verify signature set")
+	}
+	if !verified {
+		return errSignatureSetInvalid
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package sync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func signatureSet(msg byte, valid bool) *bls.SignatureSet {
+	priv := bls.RandKey()
+	root := [32]byte{msg}
+	signed := root
+	if !valid {
+		signed = [32]byte{msg + 1}
+	}
+	return &bls.SignatureSet{
+		Signatures: []bls.Signature{priv.Sign(signed[:])},
+		PublicKeys: []bls.PublicKey{priv.PublicKey()},
+		Messages:   [][32]byte{root},
+	}
+}
+
+func TestVerifyBatch_AllValid(t *testing.T) {
+	var batch []*signatureVerifier
+	for i := 0; i < 5; i++ {
+		batch = append(batch, &signatureVerifier{set: signatureSet(byte(i), true), resChan: make(chan error, 1)})
+	}
+	verifyBatch(batch)
+	for _, v := range batch {
+		assert.NoError(t, <-v.resChan)
+	}
+}
+
+func TestVerifyBatch_FallsBackToIndividualVerification(t *testing.T) {
+	var batch []*signatureVerifier
+	for i := 0; i < 5; i++ {
+		batch = append(batch, &signatureVerifier{set: signatureSet(byte(i), i != 3), resChan: make(chan error, 1)})
+	}
+	verifyBatch(batch)
+	for i, v := range batch {
+		err := <-v.resChan
+		if i == 3 {
+			assert.ErrorContains(t, errSignatureSetInvalid.Error(), err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestValidateWithBatchVerifier(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Service{
+		ctx:           ctx,
+		signatureChan: make(chan *signatureVerifier, verifierLimit),
+	}
+	go s.verifierRoutine()
+
+	results := make(chan pubsub.ValidationResult, 2)
+	go func() {
+		results <- s.validateWithBatchVerifier(ctx, "attestation", signatureSet(1, true))
+	}()
+	go func() {
+		results <- s.validateWithBatchVerifier(ctx, "attestation", signatureSet(2, false))
+	}()
+	var accepted, rejected int
+	for i := 0; i < 2; i++ {
+		select {
+		case res := <-results:
+			switch res {
+			case pubsub.ValidationAccept:
+				accepted++
+			case pubsub.ValidationReject:
+				rejected++
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for batch verification")
+		}
+	}
+	assert.Equal(t, 1, accepted)
+	assert.Equal(t, 1, rejected)
+}
+
+func TestValidateWithBatchVerifier_Inline(t *testing.T) {
+	s := &Service{}
+	ctx := context.Background()
+	assert.Equal(t, pubsub.ValidationAccept, s.validateWithBatchVerifier(ctx, "attestation", signatureSet(1, true)))
+	assert.Equal(t, pubsub.ValidationReject, s.validateWithBatchVerifier(ctx, "attestation", signatureSet(1, false)))
+}
+
+func TestVerifierRoutine_ReleasesBatchOnShutdown(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Service{
+		ctx:           ctx,
+		signatureChan: make(chan *signatureVerifier, verifierLimit),
+	}
+	done := make(chan struct{})
+	go func() {
+		s.verifierRoutine()
+		close(done)
+	}()
+	v := &signatureVerifier{set: signatureSet(1, true), resChan: make(chan error, 1)}
+	s.signatureChan <- v
+	cancel()
+	<-done
+	// The set is either verified before the shutdown or released with the context error.
+	err := <-v.resChan
+	if err != nil {
+		require.ErrorContains(t, context.Canceled.Error(), err)
+	}
+}
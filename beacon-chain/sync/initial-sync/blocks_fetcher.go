@@ -60,6 +60,7 @@ type blocksFetcherConfig struct {
 	p2p                      p2p.P2P
 	peerFilterCapacityWeight float64
 	mode                     syncMode
+	trafficRecorder          *prysmsync.TrafficRecorder
 }
 
 // blocksFetcher is a service to fetch chain data from peers.
@@ -78,6 +79,7 @@ type blocksFetcher struct {
 	peerLocks           map[peer.ID]*peerLock
 	fetchRequests       chan *fetchRequestParams
 	fetchResponses      chan *fetchRequestResponse
+	trafficRecorder     *prysmsync.TrafficRecorder
 	capacityWeight      float64       // how remaining capacity affects peer selection
 	mode                syncMode      // allows to use fetcher in different sync scenarios
 	quit                chan struct{} // termination notifier
@@ -133,6 +135,7 @@ func newBlocksFetcher(ctx context.Context, cfg *blocksFetcherConfig) *blocksFetc
 		fetchRequests:       make(chan *fetchRequestParams, maxPendingRequests),
 		fetchResponses:      make(chan *fetchRequestResponse, maxPendingRequests),
 		capacityWeight:      capacityWeight,
+		trafficRecorder:     cfg.trafficRecorder,
 		quit:                make(chan struct{}),
 	}
 }
@@ -384,6 +387,7 @@ func (f *blocksFetcher) requestBlocks(
 			return nil, errInvalidFetchedData
 		}
 		prevSlot = blk.Block.Slot
+		f.trafficRecorder.RecordRPCResponse(p2p.RPCBlocksByRangeTopic, pid, blk)
 		blocks = append(blocks, blk)
 	}
 	return blocks, nil
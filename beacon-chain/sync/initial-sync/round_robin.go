@@ -57,6 +57,7 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 		p2p:                 s.p2p,
 		headFetcher:         s.chain,
 		finalizationFetcher: s.chain,
+		trafficRecorder:     s.trafficRecorder,
 		highestExpectedSlot: highestFinalizedSlot,
 		mode:                modeStopOnFinalizedEpoch,
 	})
@@ -91,6 +92,7 @@ func (s *Service) roundRobinSync(genesis time.Time) error {
 		p2p:                 s.p2p,
 		headFetcher:         s.chain,
 		finalizationFetcher: s.chain,
+		trafficRecorder:     s.trafficRecorder,
 		highestExpectedSlot: helpers.SlotsSince(genesis),
 		mode:                modeNonConstrained,
 	})
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
@@ -35,11 +36,12 @@ type blockchainService interface {
 
 // Config to set up the initial sync service.
 type Config struct {
-	P2P           p2p.P2P
-	DB            db.ReadOnlyDatabase
-	Chain         blockchainService
-	StateNotifier statefeed.Notifier
-	BlockNotifier blockfeed.Notifier
+	P2P             p2p.P2P
+	DB              db.ReadOnlyDatabase
+	Chain           blockchainService
+	StateNotifier   statefeed.Notifier
+	BlockNotifier   blockfeed.Notifier
+	TrafficRecorder *prysmsync.TrafficRecorder
 }
 
 // Service service.
@@ -54,6 +56,7 @@ type Service struct {
 	stateNotifier     statefeed.Notifier
 	counter           *ratecounter.RateCounter
 	lastProcessedSlot uint64
+	trafficRecorder   *prysmsync.TrafficRecorder
 }
 
 // NewInitialSync configures the initial sync service responsible for bringing the node up to the
@@ -61,13 +64,14 @@ type Service struct {
 func NewInitialSync(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
 	return &Service{
-		ctx:           ctx,
-		cancel:        cancel,
-		chain:         cfg.Chain,
-		p2p:           cfg.P2P,
-		db:            cfg.DB,
-		stateNotifier: cfg.StateNotifier,
-		counter:       ratecounter.NewRateCounter(counterSeconds * time.Second),
+		ctx:             ctx,
+		cancel:          cancel,
+		chain:           cfg.Chain,
+		p2p:             cfg.P2P,
+		db:              cfg.DB,
+		stateNotifier:   cfg.StateNotifier,
+		counter:         ratecounter.NewRateCounter(counterSeconds * time.Second),
+		trafficRecorder: cfg.TrafficRecorder,
 	}
 }
 
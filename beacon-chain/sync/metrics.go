@@ -78,6 +78,19 @@ var (
 			Buckets: []float64{1000, 2000, 3000, 4000, 5000, 6000},
 		},
 	)
+	signatureBatchSize = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "gossip_signature_batch_size",
+			Help:    "Number of signature sets verified together by the gossip batch verifier",
+			Buckets: []float64{1, 2, 5, 10, 20, 30, 40, 50},
+		},
+	)
+	signatureBatchFallbackCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gossip_signature_batch_fallback_total",
+			Help: "Count the number of times a batch failed verification and its signature sets were verified individually",
+		},
+	)
 )
 
 func (s *Service) updateMetrics() {
@@ -23,33 +23,36 @@ type limiter struct {
 // Instantiates a multi-rpc protocol rate limiter, providing
 // separate collectors for each topic.
 func newRateLimiter(p2pProvider p2p.P2P) *limiter {
-	// add encoding suffix
-	addEncoding := func(topic string) string {
-		return topic + p2pProvider.Encoding().ProtocolSuffix()
-	}
 	// Initialize block limits.
 	allowedBlocksPerSecond := float64(flags.Get().BlockBatchLimit)
 	allowedBlocksBurst := int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit)
 
 	// Set topic map for all rpc topics.
 	topicMap := make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings))
+	// All schema versions of a method share its collector, so that a peer cannot
+	// exceed the limit by alternating between versions.
+	setCollector := func(topic string, collector *leakybucket.Collector) {
+		for _, t := range p2p.RPCTopicVersions(topic) {
+			topicMap[t+p2pProvider.Encoding().ProtocolSuffix()] = collector
+		}
+	}
 	// Goodbye Message
-	topicMap[addEncoding(p2p.RPCGoodByeTopic)] = leakybucket.NewCollector(1, 1, false /* deleteEmptyBuckets */)
+	setCollector(p2p.RPCGoodByeTopic, leakybucket.NewCollector(1, 1, false /* deleteEmptyBuckets */))
 	// Metadata Message
-	topicMap[addEncoding(p2p.RPCMetaDataTopic)] = leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */)
+	setCollector(p2p.RPCMetaDataTopic, leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */))
 	// Ping Message
-	topicMap[addEncoding(p2p.RPCPingTopic)] = leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */)
+	setCollector(p2p.RPCPingTopic, leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */))
 	// Status Message
-	topicMap[addEncoding(p2p.RPCStatusTopic)] = leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */)
+	setCollector(p2p.RPCStatusTopic, leakybucket.NewCollector(1, defaultBurstLimit, false /* deleteEmptyBuckets */))
 
 	// Use a single collector for block requests
 	blockCollector := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, false /* deleteEmptyBuckets */)
 
 	// BlocksByRoots requests
-	topicMap[addEncoding(p2p.RPCBlocksByRootTopic)] = blockCollector
+	setCollector(p2p.RPCBlocksByRootTopic, blockCollector)
 
 	// BlockByRange requests
-	topicMap[addEncoding(p2p.RPCBlocksByRangeTopic)] = blockCollector
+	setCollector(p2p.RPCBlocksByRangeTopic, blockCollector)
 
 	return &limiter{limiterMap: topicMap, p2p: p2pProvider}
 }
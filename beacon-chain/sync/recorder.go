@@ -0,0 +1,245 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+)
+
+const (
+	// Prefix and extension used by the recorder for every traffic file it writes.
+	trafficFilePrefix = "traffic-"
+	trafficFileExt    = ".jsonl"
+	// Size at which the recorder rotates to a new traffic file.
+	defaultTrafficFileSize = 64 * 1024 * 1024
+	// Number of rotated traffic files kept on disk before the oldest one is removed.
+	defaultTrafficFileCount = 16
+)
+
+// Kinds of traffic entries written by the recorder.
+const (
+	gossipRecordKind     = "gossip"
+	rpcRecordKind        = "rpc"
+	rpcRequestRecordKind = "rpc_request"
+)
+
+// trafficRecord is a single entry of a traffic recording. Message bytes are stored
+// exactly as they are sent on the wire for gossip (snappy compressed SSZ), and as
+// gossip encoded bytes for rpc requests and responses, so all of them can be decoded
+// with the node's network encoding during replay. Requests without a payload, such as
+// metadata requests, are recorded without data.
+type trafficRecord struct {
+	Kind      string    `json:"kind"`
+	Topic     string    `json:"topic"`
+	Peer      string    `json:"peer"`
+	Timestamp time.Time `json:"timestamp"`
+	Data      []byte    `json:"data"`
+	Result    string    `json:"result,omitempty"`
+}
+
+// TrafficRecorder writes received gossip messages, rpc requests and rpc responses into
+// rotating files in a directory. It is shared by the regular and initial sync services,
+// is safe for concurrent use, and a nil recorder is a no-op.
+type TrafficRecorder struct {
+	dir      string
+	maxSize  int64
+	maxFiles int
+	encoding encoder.NetworkEncoding
+	lock     sync.Mutex
+	file     *os.File
+	written  int64
+	seq      uint64
+}
+
+// NewTrafficRecorder creates a recorder writing to the given directory.
+func NewTrafficRecorder(dir string, encoding encoder.NetworkEncoding) (*TrafficRecorder, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "could not create traffic recording directory")
+	}
+	r := &TrafficRecorder{
+		dir:      dir,
+		maxSize:  defaultTrafficFileSize,
+		maxFiles: defaultTrafficFileCount,
+		encoding: encoding,
+	}
+	if err := r.rotate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// recordGossip writes a received gossip message along with the result of its validation.
+func (r *TrafficRecorder) recordGossip(topic string, pid peer.ID, data []byte, result pubsub.ValidationResult) {
+	if r == nil {
+		return
+	}
+	r.write(&trafficRecord{
+		Kind:      gossipRecordKind,
+		Topic:     topic,
+		Peer:      pid.String(),
+		Timestamp: roughtime.Now(),
+		Data:      data,
+		Result:    validationResultString(result),
+	})
+}
+
+// RecordRPCResponse writes a decoded rpc response chunk received from a peer.
+func (r *TrafficRecorder) RecordRPCResponse(topic string, pid peer.ID, msg interface{}) {
+	r.recordRPC(rpcRecordKind, topic, pid, msg)
+}
+
+// recordRPCRequest writes a decoded rpc request received from a peer.
+func (r *TrafficRecorder) recordRPCRequest(topic string, pid peer.ID, msg interface{}) {
+	r.recordRPC(rpcRequestRecordKind, topic, pid, msg)
+}
+
+func (r *TrafficRecorder) recordRPC(kind, topic string, pid peer.ID, msg interface{}) {
+	if r == nil {
+		return
+	}
+	var data []byte
+	if msg != nil {
+		buf := new(bytes.Buffer)
+		if _, err := r.encoding.EncodeGossip(buf, msg); err != nil {
+			log.WithError(err).Debug("Could not encode rpc message for recording")
+			return
+		}
+		data = buf.Bytes()
+	}
+	r.write(&trafficRecord{
+		Kind:      kind,
+		Topic:     topic,
+		Peer:      pid.String(),
+		Timestamp: roughtime.Now(),
+		Data:      data,
+	})
+}
+
+func (r *TrafficRecorder) write(rec *trafficRecord) {
+	enc, err := json.Marshal(rec)
+	if err != nil {
+		log.WithError(err).Debug("Could not marshal traffic record")
+		return
+	}
+	enc = append(enc, '\n')
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.file == nil {
+		return
+	}
+	if r.written+int64(len(enc)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			log.WithError(err).Error("Could not rotate traffic recording file")
+			return
+		}
+	}
+	n, err := r.file.Write(enc)
+	r.written += int64(n)
+	if err != nil {
+		log.WithError(err).Error("Could not write traffic record")
+	}
+}
+
+// rotate closes the current file, opens a new one and prunes the oldest files
+// above the retention limit. The caller must hold the lock, if any.
+func (r *TrafficRecorder) rotate() error {
+	if r.file != nil {
+		if err := r.file.Close(); err != nil {
+			return err
+		}
+		r.file = nil
+	}
+	// File names are fixed width so that lexicographic order is chronological order.
+	name := fmt.Sprintf("%s%020d-%06d%s", trafficFilePrefix, roughtime.Now().UnixNano(), r.seq, trafficFileExt)
+	r.seq++
+	f, err := os.OpenFile(filepath.Join(r.dir, name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrap(err, "could not open traffic recording file")
+	}
+	r.file = f
+	r.written = 0
+
+	files, err := trafficFiles(r.dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > r.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+	return nil
+}
+
+// Close flushes and closes the current traffic file. Messages recorded afterwards are
+// dropped.
+func (r *TrafficRecorder) Close() error {
+	if r == nil {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// recordingValidator wraps a pubsub validator so that every message it sees is
+// written to the traffic recorder together with its validation result.
+func (s *Service) recordingValidator(v pubsub.ValidatorEx) pubsub.ValidatorEx {
+	if s.recorder == nil {
+		return v
+	}
+	return func(ctx context.Context, pid peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		// Capture the topic before validation, as some validators override it while decoding.
+		var topic string
+		if msg != nil && len(msg.TopicIDs) > 0 {
+			topic = msg.TopicIDs[0]
+		}
+		res := v(ctx, pid, msg)
+		if msg != nil {
+			s.recorder.recordGossip(topic, pid, msg.Data, res)
+		}
+		return res
+	}
+}
+
+// trafficFiles returns all traffic files in a directory, ordered from oldest to newest.
+func trafficFiles(dir string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, trafficFilePrefix+"*"+trafficFileExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+func validationResultString(res pubsub.ValidationResult) string {
+	switch res {
+	case pubsub.ValidationAccept:
+		return "accept"
+	case pubsub.ValidationReject:
+		return "reject"
+	case pubsub.ValidationIgnore:
+		return "ignore"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,207 @@
+package sync
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func setupTrafficDir(t *testing.T) string {
+	dir := path.Join(testutil.TempDir(), fmt.Sprintf("traffic-%d", rand.NewGenerator().Int()))
+	require.NoError(t, os.RemoveAll(dir))
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	return dir
+}
+
+func readTrafficRecords(t *testing.T, dir string) []*trafficRecord {
+	files, err := trafficFiles(dir)
+	require.NoError(t, err)
+	var records []*trafficRecord
+	for _, name := range files {
+		f, err := os.Open(name)
+		require.NoError(t, err)
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			rec := &trafficRecord{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), rec))
+			records = append(records, rec)
+		}
+		require.NoError(t, scanner.Err())
+		require.NoError(t, f.Close())
+	}
+	return records
+}
+
+func TestTrafficRecorder_RecordsGossipAndRPC(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	dir := setupTrafficDir(t)
+	r, err := NewTrafficRecorder(dir, p.Encoding())
+	require.NoError(t, err)
+
+	r.recordGossip("/eth2/%x/voluntary_exit", p.PeerID(), []byte{'a', 'b'}, pubsub.ValidationReject)
+	seq := uint64(5)
+	r.RecordRPCResponse(p2p.RPCPingTopic, p.PeerID(), &seq)
+	req := &pb.BeaconBlocksByRangeRequest{StartSlot: 10, Count: 5, Step: 1}
+	r.recordRPCRequest(p2p.RPCBlocksByRangeTopic, p.PeerID(), req)
+	r.recordRPCRequest(p2p.RPCMetaDataTopic, p.PeerID(), nil)
+	require.NoError(t, r.Close())
+
+	records := readTrafficRecords(t, dir)
+	require.Equal(t, 4, len(records))
+	assert.Equal(t, gossipRecordKind, records[0].Kind)
+	assert.Equal(t, "reject", records[0].Result)
+	assert.Equal(t, p.PeerID().String(), records[0].Peer)
+	assert.DeepEqual(t, []byte{'a', 'b'}, records[0].Data)
+	assert.Equal(t, rpcRecordKind, records[1].Kind)
+	assert.Equal(t, p2p.RPCPingTopic, records[1].Topic)
+
+	decoded := new(uint64)
+	require.NoError(t, p.Encoding().DecodeGossip(records[1].Data, decoded))
+	assert.Equal(t, seq, *decoded)
+
+	assert.Equal(t, rpcRequestRecordKind, records[2].Kind)
+	assert.Equal(t, p2p.RPCBlocksByRangeTopic, records[2].Topic)
+	decodedReq := &pb.BeaconBlocksByRangeRequest{}
+	require.NoError(t, p.Encoding().DecodeGossip(records[2].Data, decodedReq))
+	assert.DeepEqual(t, req, decodedReq)
+	assert.Equal(t, rpcRequestRecordKind, records[3].Kind)
+	assert.Equal(t, 0, len(records[3].Data))
+}
+
+func TestTrafficRecorder_RotatesFiles(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	dir := setupTrafficDir(t)
+	r, err := NewTrafficRecorder(dir, p.Encoding())
+	require.NoError(t, err)
+	r.maxSize = 1
+	r.maxFiles = 3
+
+	for i := 0; i < 5; i++ {
+		r.recordGossip("/eth2/%x/beacon_block", p.PeerID(), []byte{byte(i)}, pubsub.ValidationAccept)
+	}
+	require.NoError(t, r.Close())
+
+	files, err := trafficFiles(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(files))
+	records := readTrafficRecords(t, dir)
+	require.Equal(t, 3, len(records))
+	// Only the newest records are retained.
+	assert.DeepEqual(t, []byte{4}, records[len(records)-1].Data)
+}
+
+func TestTrafficRecorder_NilIsNoop(t *testing.T) {
+	var r *TrafficRecorder
+	r.recordGossip("topic", "", nil, pubsub.ValidationAccept)
+	r.RecordRPCResponse("topic", "", nil)
+	r.recordRPCRequest("topic", "", nil)
+	assert.NoError(t, r.Close())
+}
+
+func TestReplayRecording_FeedsSubscriber(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	ctx := context.Background()
+	exit, s := setupValidExit(t)
+
+	c, err := lru.New(10)
+	require.NoError(t, err)
+	r := &Service{
+		p2p: p,
+		chain: &mock.ChainService{
+			State: s,
+		},
+		initialSync:   &mockSync.Sync{IsSyncing: false},
+		seenExitCache: c,
+		exitPool:      voluntaryexits.NewPool(),
+	}
+
+	buf := new(bytes.Buffer)
+	_, err = p.Encoding().EncodeGossip(buf, exit)
+	require.NoError(t, err)
+	dir := setupTrafficDir(t)
+	recorder, err := NewTrafficRecorder(dir, p.Encoding())
+	require.NoError(t, err)
+	recorder.recordGossip(p2p.GossipTypeMapping[reflect.TypeOf(exit)], "", buf.Bytes(), pubsub.ValidationAccept)
+	require.NoError(t, recorder.Close())
+
+	require.NoError(t, r.ReplayRecording(ctx, dir))
+	assert.Equal(t, true, r.hasSeenExitIndex(exit.Exit.ValidatorIndex))
+	pending := r.exitPool.PendingExits(s, s.Slot())
+	require.Equal(t, 1, len(pending))
+	assert.DeepEqual(t, exit, pending[0])
+}
+
+func TestReplayRecording_LogsValidationMismatch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	p := p2ptest.NewTestP2P(t)
+	r := &Service{
+		p2p:         p,
+		initialSync: &mockSync.Sync{IsSyncing: true},
+	}
+	exit := &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{Epoch: 1}, Signature: make([]byte, 96)}
+	buf := new(bytes.Buffer)
+	_, err := p.Encoding().EncodeGossip(buf, exit)
+	require.NoError(t, err)
+
+	r.replayRecord(context.Background(), &trafficRecord{
+		Kind:   gossipRecordKind,
+		Topic:  p2p.GossipTypeMapping[reflect.TypeOf(exit)],
+		Data:   buf.Bytes(),
+		Result: "accept",
+	})
+	require.LogsContain(t, hook, "Replayed validation result differs from recording")
+}
+
+func TestReplayRecord_PinsClock(t *testing.T) {
+	r := &Service{p2p: p2ptest.NewTestP2P(t)}
+	recorded := roughtime.Now().Add(-time.Hour).Truncate(time.Second)
+	defer roughtime.Pin(time.Time{})
+
+	r.replayRecord(context.Background(), &trafficRecord{
+		Kind:      rpcRecordKind,
+		Timestamp: recorded,
+	})
+	assert.Equal(t, true, recorded.Equal(roughtime.Now()), "Expected the clock to be pinned to the recording time")
+	roughtime.Pin(time.Time{})
+	assert.Equal(t, true, roughtime.Now().After(recorded))
+}
+
+func TestService_GossipPipelineFor(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	r := &Service{p2p: p}
+	suffix := p.Encoding().ProtocolSuffix()
+
+	_, _, ok := r.gossipPipelineFor("/eth2/01020304/beacon_block" + suffix)
+	assert.Equal(t, true, ok)
+	_, _, ok = r.gossipPipelineFor("/eth2/01020304/beacon_attestation_12" + suffix)
+	assert.Equal(t, true, ok)
+	_, _, ok = r.gossipPipelineFor("/eth2/01020304/unknown_topic" + suffix)
+	assert.Equal(t, false, ok)
+	_, _, ok = r.gossipPipelineFor("bad")
+	assert.Equal(t, false, ok)
+}
@@ -0,0 +1,152 @@
+package sync
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/sirupsen/logrus"
+)
+
+// Largest single line accepted from a traffic recording. Blocks are base64 encoded
+// in the recording, so this leaves plenty of room above the gossip max size.
+const maxTrafficRecordSize = 16 * 1024 * 1024
+
+// ReplayRecording feeds the gossip messages of a traffic recording through the same
+// validation and subscriber pipeline used for live gossip, in the order they were
+// recorded. The path may either be a single traffic file or a recording directory.
+// Recorded rpc requests and responses are skipped, as they are kept for offline
+// inspection only. While replaying, the clock of the node is pinned to the time each
+// message was recorded at, so that slot timing checks pass as they did live.
+func (s *Service) ReplayRecording(ctx context.Context, path string) error {
+	defer roughtime.Pin(time.Time{})
+	info, err := os.Stat(path)
+	if err != nil {
+		return errors.Wrap(err, "could not read traffic recording")
+	}
+	files := []string{path}
+	if info.IsDir() {
+		files, err = trafficFiles(path)
+		if err != nil {
+			return err
+		}
+	}
+	log.WithField("files", len(files)).Info("Replaying traffic recording")
+	for _, f := range files {
+		if err := s.replayFile(ctx, f); err != nil {
+			return errors.Wrapf(err, "could not replay %s", f)
+		}
+	}
+	log.Info("Finished replaying traffic recording")
+	return nil
+}
+
+func (s *Service) replayFile(ctx context.Context, fileName string) error {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Debug("Could not close traffic file")
+		}
+	}()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxTrafficRecordSize)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		rec := &trafficRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			return errors.Wrap(err, "could not decode traffic record")
+		}
+		s.replayRecord(ctx, rec)
+	}
+	return scanner.Err()
+}
+
+// replayRecord runs a single recorded gossip message through its validator and, if
+// accepted, its subscriber. A validation result that differs from the recorded one
+// is logged, as it usually points at the bug being reproduced.
+func (s *Service) replayRecord(ctx context.Context, rec *trafficRecord) {
+	if !rec.Timestamp.IsZero() {
+		roughtime.Pin(rec.Timestamp)
+	}
+	if rec.Kind != gossipRecordKind {
+		return
+	}
+	log := log.WithField("topic", rec.Topic)
+	validate, handle, ok := s.gossipPipelineFor(rec.Topic)
+	if !ok {
+		log.Debug("No gossip pipeline registered for recorded topic")
+		return
+	}
+	pid, err := peer.Decode(rec.Peer)
+	if err != nil {
+		log.WithError(err).Debug("Could not decode recorded peer id")
+	}
+	msg := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:     rec.Data,
+			TopicIDs: []string{rec.Topic},
+		},
+		ReceivedFrom: pid,
+	}
+	res := validate(ctx, pid, msg)
+	if got := validationResultString(res); rec.Result != "" && got != rec.Result {
+		log.WithFields(logrus.Fields{
+			"recorded": rec.Result,
+			"replayed": got,
+		}).Warn("Replayed validation result differs from recording")
+	}
+	if res != pubsub.ValidationAccept || msg.ValidatorData == nil {
+		return
+	}
+	m, ok := msg.ValidatorData.(proto.Message)
+	if !ok {
+		return
+	}
+	if err := handle(ctx, m); err != nil {
+		log.WithError(err).Debug("Failed to handle replayed message")
+	}
+}
+
+// gossipPipelineFor returns the validator and subscriber registered for the given
+// full gossip topic, as it appears on the wire.
+func (s *Service) gossipPipelineFor(topic string) (pubsub.ValidatorEx, subHandler, bool) {
+	topic = strings.TrimSuffix(topic, s.p2p.Encoding().ProtocolSuffix())
+	if strings.Count(topic, "/") < 3 {
+		return nil, nil, false
+	}
+	format := s.replaceForkDigest(topic)
+	if strings.HasPrefix(format, strings.TrimSuffix(p2p.AttestationSubnetTopicFormat, "%d")) {
+		format = p2p.AttestationSubnetTopicFormat
+	}
+	switch format {
+	case p2p.BlockSubnetTopicFormat:
+		return s.validateBeaconBlockPubSub, s.beaconBlockSubscriber, true
+	case p2p.AggregateAndProofSubnetTopicFormat:
+		return s.validateAggregateAndProof, s.beaconAggregateProofSubscriber, true
+	case p2p.ExitSubnetTopicFormat:
+		return s.validateVoluntaryExit, s.voluntaryExitSubscriber, true
+	case p2p.ProposerSlashingSubnetTopicFormat:
+		return s.validateProposerSlashing, s.proposerSlashingSubscriber, true
+	case p2p.AttesterSlashingSubnetTopicFormat:
+		return s.validateAttesterSlashing, s.attesterSlashingSubscriber, true
+	case p2p.AttestationSubnetTopicFormat:
+		return s.validateCommitteeIndexBeaconAttestation, s.committeeIndexBeaconAttestationSubscriber, true
+	default:
+		return nil, nil, false
+	}
+}
@@ -29,7 +29,9 @@ var respTimeout = params.BeaconNetworkConfig().RespTimeout
 // not be relayed to the peer.
 type rpcHandler func(context.Context, interface{}, libp2pcore.Stream) error
 
-// registerRPCHandlers for p2p RPC.
+// registerRPCHandlers for p2p RPC. Every schema version of an rpc method is registered
+// with its own handler, so that a new version of a method is only served once it has a
+// handler of its own.
 func (s *Service) registerRPCHandlers() {
 	s.registerRPC(
 		p2p.RPCStatusTopic,
@@ -57,7 +59,10 @@ func (s *Service) registerRPCHandlers() {
 	)
 }
 
-// registerRPC for a given topic with an expected protobuf message type.
+// registerRPC registers the handler of the schema version of an rpc method given by the
+// topic, under the protocol ID of that version. The request is decoded with the message
+// type of that version, and the response chunks are encoded as that version requires,
+// since the chunk writers follow the protocol of the stream.
 func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 	topic := baseTopic + s.p2p.Encoding().ProtocolSuffix()
 	log := log.WithField("topic", topic)
@@ -94,7 +99,8 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 
 		// since metadata requests do not have any data in the payload, we
 		// do not decode anything.
-		if baseTopic == p2p.RPCMetaDataTopic {
+		if p2p.RPCMethod(baseTopic) == p2p.RPCMethod(p2p.RPCMetaDataTopic) {
+			s.recorder.recordRPCRequest(baseTopic, stream.Conn().RemotePeer(), nil)
 			if err := handle(ctx, base, stream); err != nil {
 				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
 				if err != errWrongForkDigestVersion {
@@ -112,7 +118,7 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 			msg := reflect.New(t.Elem())
 			if err := s.p2p.Encoding().DecodeWithMaxLength(stream, msg.Interface()); err != nil {
 				// Debug logs for goodbye/status errors
-				if strings.Contains(topic, p2p.RPCGoodByeTopic) || strings.Contains(topic, p2p.RPCStatusTopic) {
+				if strings.Contains(topic, p2p.RPCMethod(p2p.RPCGoodByeTopic)) || strings.Contains(topic, p2p.RPCMethod(p2p.RPCStatusTopic)) {
 					log.WithError(err).Debug("Failed to decode goodbye stream message")
 					traceutil.AnnotateError(span, err)
 					return
@@ -121,6 +127,7 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 				traceutil.AnnotateError(span, err)
 				return
 			}
+			s.recorder.recordRPCRequest(baseTopic, stream.Conn().RemotePeer(), msg.Interface())
 			if err := handle(ctx, msg.Interface(), stream); err != nil {
 				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
 				if err != errWrongForkDigestVersion {
@@ -135,6 +142,7 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 				traceutil.AnnotateError(span, err)
 				return
 			}
+			s.recorder.recordRPCRequest(baseTopic, stream.Conn().RemotePeer(), msg.Interface())
 			if err := handle(ctx, msg.Elem().Interface(), stream); err != nil {
 				messageFailedProcessingCounter.WithLabelValues(topic).Inc()
 				if err != errWrongForkDigestVersion {
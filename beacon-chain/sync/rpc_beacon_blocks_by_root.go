@@ -41,6 +41,7 @@ func (s *Service) sendRecentBeaconBlocksRequest(ctx context.Context, blockRoots
 			log.WithError(err).Debug("Unable to retrieve block from stream")
 			return err
 		}
+		s.recorder.RecordRPCResponse(p2p.RPCBlocksByRootTopic, id, blk)
 
 		blkRoot, err := blk.Block.HashTreeRoot()
 		if err != nil {
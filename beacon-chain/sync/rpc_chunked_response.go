@@ -1,7 +1,10 @@
 package sync
 
 import (
+	"bytes"
 	"errors"
+	"io"
+	"strings"
 
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -11,13 +14,35 @@ import (
 
 // chunkWriter writes the given message as a chunked response to the given network
 // stream.
-// response_chunk ::= | <result> | <encoding-dependent-header> | <encoded-payload>
+// response_chunk ::= | <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
 func (s *Service) chunkWriter(stream libp2pcore.Stream, msg interface{}) error {
 	SetStreamWriteDeadline(stream, defaultWriteDuration)
-	return WriteChunk(stream, s.p2p.Encoding(), msg)
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	if err := s.writeContextToStream(stream); err != nil {
+		return err
+	}
+	_, err := s.p2p.Encoding().EncodeWithMaxLength(stream, msg)
+	return err
 }
 
-// WriteChunk object to stream.
+// writeContextToStream writes the context bytes of a response chunk, which is the
+// fork digest of the local node if the schema version negotiated on the stream
+// requires it.
+func (s *Service) writeContextToStream(stream libp2pcore.Stream) error {
+	if !p2p.RPCForkDigestContext(streamTopic(stream, s.p2p.Encoding())) {
+		return nil
+	}
+	digest, err := s.p2p.ForkDigest()
+	if err != nil {
+		return err
+	}
+	_, err = stream.Write(digest[:])
+	return err
+}
+
+// WriteChunk object to stream, for schema versions without context bytes.
 // response_chunk ::= | <result> | <encoding-dependent-header> | <encoded-payload>
 func WriteChunk(stream libp2pcore.Stream, encoding encoder.NetworkEncoding, msg interface{}) error {
 	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
@@ -52,7 +77,7 @@ func readFirstChunkedBlock(stream libp2pcore.Stream, p2p p2p.P2P) (*eth.SignedBe
 	if code != 0 {
 		return nil, errors.New(errMsg)
 	}
-	err = p2p.Encoding().DecodeWithMaxLength(stream, blk)
+	err = readChunkPayload(stream, p2p, blk)
 	return blk, err
 }
 
@@ -68,5 +93,35 @@ func readResponseChunk(stream libp2pcore.Stream, p2p p2p.P2P, to interface{}) er
 	if code != 0 {
 		return errors.New(errMsg)
 	}
-	return p2p.Encoding().DecodeWithMaxLength(stream, to)
+	return readChunkPayload(stream, p2p, to)
+}
+
+// readChunkPayload reads the context bytes and the payload of a response chunk,
+// following its result, according to the schema version negotiated on the stream.
+// The context must be the fork digest of the local node, as the chunks of other
+// forks cannot be processed.
+func readChunkPayload(stream libp2pcore.Stream, p2pProvider p2p.P2P, to interface{}) error {
+	topic := streamTopic(stream, p2pProvider.Encoding())
+	if err := p2p.VerifyResponseMapping(topic, to); err != nil {
+		return err
+	}
+	if p2p.RPCForkDigestContext(topic) {
+		contextBytes := make([]byte, 4)
+		if _, err := io.ReadFull(stream, contextBytes); err != nil {
+			return err
+		}
+		digest, err := p2pProvider.ForkDigest()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(contextBytes, digest[:]) {
+			return errWrongForkDigestVersion
+		}
+	}
+	return p2pProvider.Encoding().DecodeWithMaxLength(stream, to)
+}
+
+// streamTopic returns the rpc topic negotiated on the stream.
+func streamTopic(stream libp2pcore.Stream, encoding encoder.NetworkEncoding) string {
+	return strings.TrimSuffix(string(stream.Protocol()), encoding.ProtocolSuffix())
 }
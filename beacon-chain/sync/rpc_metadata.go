@@ -30,6 +30,9 @@ func (s *Service) metaDataHandler(ctx context.Context, msg interface{}, stream l
 	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
 		return err
 	}
+	if err := s.writeContextToStream(stream); err != nil {
+		return err
+	}
 	_, err := s.p2p.Encoding().EncodeWithMaxLength(stream, s.p2p.Metadata())
 	return err
 }
@@ -59,8 +62,9 @@ func (s *Service) sendMetaDataRequest(ctx context.Context, id peer.ID) (*pb.Meta
 		return nil, errors.New(errMsg)
 	}
 	msg := new(pb.MetaData)
-	if err := s.p2p.Encoding().DecodeWithMaxLength(stream, msg); err != nil {
+	if err := readChunkPayload(stream, s.p2p, msg); err != nil {
 		return nil, err
 	}
+	s.recorder.RecordRPCResponse(p2p.RPCMetaDataTopic, id, msg)
 	return msg, nil
 }
@@ -47,6 +47,12 @@ func (s *Service) pingHandler(ctx context.Context, msg interface{}, stream libp2
 		}
 		return err
 	}
+	if err := s.writeContextToStream(stream); err != nil {
+		if err := stream.Close(); err != nil {
+			log.WithError(err).Debug("Failed to close stream")
+		}
+		return err
+	}
 	if _, err := s.p2p.Encoding().EncodeWithMaxLength(stream, s.p2p.MetadataSeq()); err != nil {
 		if err := stream.Close(); err != nil {
 			log.WithError(err).Debug("Failed to close stream")
@@ -114,9 +120,10 @@ func (s *Service) sendPingRequest(ctx context.Context, id peer.ID) error {
 		return errors.New(errMsg)
 	}
 	msg := new(uint64)
-	if err := s.p2p.Encoding().DecodeWithMaxLength(stream, msg); err != nil {
+	if err := readChunkPayload(stream, s.p2p, msg); err != nil {
 		return err
 	}
+	s.recorder.RecordRPCResponse(p2p.RPCPingTopic, id, msg)
 	valid, err := s.validateSequenceNum(*msg, stream.Conn().RemotePeer())
 	if err != nil {
 		// Descore peer for giving us a bad sequence number.
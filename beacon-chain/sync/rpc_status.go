@@ -147,9 +147,10 @@ func (s *Service) sendRPCStatusRequest(ctx context.Context, id peer.ID) error {
 	}
 
 	msg := &pb.Status{}
-	if err := s.p2p.Encoding().DecodeWithMaxLength(stream, msg); err != nil {
+	if err := readChunkPayload(stream, s.p2p, msg); err != nil {
 		return err
 	}
+	s.recorder.RecordRPCResponse(p2p.RPCStatusTopic, id, msg)
 	s.p2p.Peers().SetChainState(stream.Conn().RemotePeer(), msg)
 
 	err = s.validateStatusMessage(ctx, msg)
@@ -267,6 +268,9 @@ func (s *Service) respondWithStatus(ctx context.Context, stream network.Stream)
 	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
 		log.WithError(err).Debug("Failed to write to stream")
 	}
+	if err := s.writeContextToStream(stream); err != nil {
+		return err
+	}
 	_, err = s.p2p.Encoding().EncodeWithMaxLength(stream, resp)
 	return err
 }
@@ -9,12 +9,15 @@ import (
 
 	libp2pcore "github.com/libp2p/go-libp2p-core"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	prysmP2P "github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/testing"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -81,3 +84,138 @@ func TestRegisterRPC_ReceivesValidMessage(t *testing.T) {
 		t.Fatal("Did not receive RPC in 1 second")
 	}
 }
+
+func TestRegisterRPC_RoutesEachVersionToItsHandler(t *testing.T) {
+	p2p := p2ptest.NewTestP2P(t)
+	r := &Service{
+		ctx: context.Background(),
+		p2p: p2p,
+	}
+
+	var wg sync.WaitGroup
+	topicV1 := "/testing/versioned/1"
+	topicV2 := "/testing/versioned/2"
+	handlerV1 := func(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+		defer wg.Done()
+		assert.Equal(t, topicV1+p2p.Encoding().ProtocolSuffix(), string(stream.Protocol()))
+		m, ok := msg.(*pb.TestSimpleMessage)
+		if !ok {
+			t.Error("Object is not of type *pb.TestSimpleMessage")
+			return nil
+		}
+		assert.DeepEqual(t, []byte("foo"), m.Foo)
+		return nil
+	}
+	handlerV2 := func(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+		defer wg.Done()
+		assert.Equal(t, topicV2+p2p.Encoding().ProtocolSuffix(), string(stream.Protocol()))
+		m, ok := msg.(*pb.Puzzle)
+		if !ok {
+			t.Error("Object is not of type *pb.Puzzle")
+			return nil
+		}
+		assert.Equal(t, "answer", m.Answer)
+		return nil
+	}
+	prysmP2P.RPCTopicMappings[topicV1] = new(pb.TestSimpleMessage)
+	require.NoError(t, prysmP2P.RegisterRPCSchema(topicV2, prysmP2P.RPCSchema{Request: new(pb.Puzzle)}))
+	defer func() {
+		delete(prysmP2P.RPCTopicMappings, topicV1)
+		delete(prysmP2P.RPCTopicMappings, topicV2)
+	}()
+	r.registerRPC(topicV1, handlerV1)
+	r.registerRPC(topicV2, handlerV2)
+
+	wg.Add(1)
+	p2p.ReceiveRPC(topicV2, &pb.Puzzle{Answer: "answer"})
+	if testutil.WaitTimeout(&wg, time.Second) {
+		t.Fatal("Did not receive RPC in 1 second")
+	}
+	wg.Add(1)
+	p2p.ReceiveRPC(topicV1, &pb.TestSimpleMessage{Foo: []byte("foo")})
+	if testutil.WaitTimeout(&wg, time.Second) {
+		t.Fatal("Did not receive RPC in 1 second")
+	}
+}
+
+func TestRegisterRPC_OnlyServesRegisteredVersion(t *testing.T) {
+	p2p := p2ptest.NewTestP2P(t)
+	r := &Service{
+		ctx: context.Background(),
+		p2p: p2p,
+	}
+
+	topicV1 := "/testing/unserved/1"
+	topicV2 := "/testing/unserved/2"
+	prysmP2P.RPCTopicMappings[topicV1] = new(pb.TestSimpleMessage)
+	require.NoError(t, prysmP2P.RegisterRPCSchema(topicV2, prysmP2P.RPCSchema{Request: new(pb.Puzzle)}))
+	defer func() {
+		delete(prysmP2P.RPCTopicMappings, topicV1)
+		delete(prysmP2P.RPCTopicMappings, topicV2)
+	}()
+	r.registerRPC(topicV1, func(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+		return nil
+	})
+
+	protocols := p2p.BHost.Mux().Protocols()
+	assert.Equal(t, true, containsString(protocols, topicV1+p2p.Encoding().ProtocolSuffix()))
+	assert.Equal(t, false, containsString(protocols, topicV2+p2p.Encoding().ProtocolSuffix()))
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestChunkWriter_ForkDigestContext(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	p2.Digest = [4]byte{'a', 'b', 'c', 'd'}
+	r := &Service{
+		ctx: context.Background(),
+		p2p: p2,
+	}
+
+	topic := "/testing/context/2"
+	prysmP2P.RPCTopicMappings["/testing/context/1"] = new(uint64)
+	require.NoError(t, prysmP2P.RegisterRPCSchema(topic, prysmP2P.RPCSchema{
+		Request:           new(uint64),
+		Response:          new(ethpb.SignedBeaconBlock),
+		ForkDigestContext: true,
+	}))
+	defer func() {
+		delete(prysmP2P.RPCTopicMappings, "/testing/context/1")
+		delete(prysmP2P.RPCTopicMappings, topic)
+		delete(prysmP2P.RPCResponseMappings, topic)
+	}()
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 5
+	p2.BHost.SetStreamHandler(protocol.ID(topic+p2.Encoding().ProtocolSuffix()), func(stream network.Stream) {
+		assert.NoError(t, r.chunkWriter(stream, blk))
+		assert.NoError(t, stream.Close())
+	})
+
+	request := func() network.Stream {
+		stream, err := p1.Send(context.Background(), new(uint64), topic, p2.BHost.ID())
+		require.NoError(t, err)
+		return stream
+	}
+
+	// The fork digest context matches the local fork digest.
+	p1.Digest = p2.Digest
+	received := &ethpb.SignedBeaconBlock{}
+	require.NoError(t, readResponseChunk(request(), p1, received))
+	assert.Equal(t, blk.Block.Slot, received.Block.Slot)
+
+	// The response type of the schema is enforced.
+	assert.ErrorContains(t, "message type is incorrect", readResponseChunk(request(), p1, new(uint64)))
+
+	// Chunks of another fork are rejected.
+	p1.Digest = [4]byte{'e', 'f', 'g', 'h'}
+	assert.ErrorContains(t, errWrongForkDigestVersion.Error(), readResponseChunk(request(), p1, &ethpb.SignedBeaconBlock{}))
+}
@@ -57,6 +57,8 @@ type Config struct {
 	AttestationNotifier operation.Notifier
 	StateSummaryCache   *cache.StateSummaryCache
 	StateGen            *stategen.State
+	TrafficRecorder     *TrafficRecorder
+	ReplayTrafficPath   string
 }
 
 // This defines the interface for interacting with block chain service
@@ -108,6 +110,9 @@ type Service struct {
 	badBlockLock              sync.RWMutex
 	stateSummaryCache         *cache.StateSummaryCache
 	stateGen                  *stategen.State
+	replayTrafficPath         string
+	recorder                  *TrafficRecorder
+	signatureChan             chan *signatureVerifier
 }
 
 // NewRegularSync service.
@@ -133,9 +138,13 @@ func NewRegularSync(ctx context.Context, cfg *Config) *Service {
 		stateSummaryCache:    cfg.StateSummaryCache,
 		stateGen:             cfg.StateGen,
 		rateLimiter:          rLimiter,
+		recorder:             cfg.TrafficRecorder,
+		replayTrafficPath:    cfg.ReplayTrafficPath,
+		signatureChan:        make(chan *signatureVerifier, verifierLimit),
 	}
 
 	go r.registerHandlers()
+	go r.verifierRoutine()
 
 	return r
 }
@@ -242,9 +251,18 @@ func (s *Service) registerHandlers() {
 				}
 				log.WithField("starttime", data.StartTime).Debug("Received state initialized event")
 
-				// Register respective rpc and pubsub handlers at state initialized event.
-				s.registerRPCHandlers()
-				s.registerSubscribers()
+				if s.replayTrafficPath != "" {
+					// In replay mode the node is fed from a recording instead of the network.
+					go func() {
+						if err := s.ReplayRecording(s.ctx, s.replayTrafficPath); err != nil {
+							log.WithError(err).Error("Could not replay traffic recording")
+						}
+					}()
+				} else {
+					// Register respective rpc and pubsub handlers at state initialized event.
+					s.registerRPCHandlers()
+					s.registerSubscribers()
+				}
 
 				if data.StartTime.After(roughtime.Now()) {
 					stateSub.Unsubscribe()
@@ -95,7 +95,7 @@ func (s *Service) subscribeWithBase(base proto.Message, topic string, validator
 	topic += s.p2p.Encoding().ProtocolSuffix()
 	log := log.WithField("topic", topic)
 
-	if err := s.p2p.PubSub().RegisterTopicValidator(wrapAndReportValidation(topic, validator)); err != nil {
+	if err := s.p2p.PubSub().RegisterTopicValidator(wrapAndReportValidation(topic, s.recordingValidator(validator))); err != nil {
 		log.WithError(err).Error("Failed to register validator")
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -126,28 +127,33 @@ func (s *Service) validateAggregatedAtt(ctx context.Context, signed *ethpb.Signe
 		if !aggregator {
 			return pubsub.ValidationReject
 		}
-		// Are the aggregate and proof by the aggregator.
+		// Retrieve the selection proof signed by the aggregator.
 		d, err := helpers.Domain(c.Fork, helpers.SlotToEpoch(a.Data.Slot), params.BeaconConfig().DomainSelectionProof, c.GenesisRoot)
 		if err != nil {
 			return pubsub.ValidationReject
 		}
 		pk := c.PubKeys[signed.Message.AggregatorIndex]
-		if err := helpers.VerifySigningRoot(a.Data.Slot, pk[:], signed.Message.SelectionProof, d); err != nil {
+		selectionSet, err := helpers.RetrieveSignatureSet(a.Data.Slot, pk[:], signed.Message.SelectionProof, d)
+		if err != nil {
 			return pubsub.ValidationReject
 		}
-		// Is the attestation signature correct.
+		// Retrieve the aggregate and proof signed by the aggregator.
 		d, err = helpers.Domain(c.Fork, helpers.SlotToEpoch(a.Data.Slot), params.BeaconConfig().DomainAggregateAndProof, c.GenesisRoot)
 		if err != nil {
 			return pubsub.ValidationReject
 		}
-		if err := helpers.VerifySigningRoot(signed.Message, pk[:], signed.Signature, d); err != nil {
+		aggregatorSet, err := helpers.RetrieveSignatureSet(signed.Message, pk[:], signed.Signature, d)
+		if err != nil {
 			return pubsub.ValidationReject
 		}
-		if err := blocks.VerifyAttSigUseCheckPt(ctx, c, signed.Message.Aggregate); err != nil {
+		// Retrieve the aggregated attestation's signature.
+		attSet, err := blocks.AttestationSignatureSetUseCheckPt(ctx, c, signed.Message.Aggregate)
+		if err != nil {
 			return pubsub.ValidationReject
 		}
-
-		return pubsub.ValidationAccept
+		// Verify all signatures of the aggregate, batched with other gossip messages.
+		set := bls.NewSet().Join(selectionSet).Join(aggregatorSet).Join(attSet)
+		return s.validateWithBatchVerifier(ctx, "aggregate", set)
 	}
 
 	bs, err := s.chain.AttestationPreState(ctx, signed.Message.Aggregate)
@@ -175,27 +181,33 @@ func (s *Service) validateAggregatedAtt(ctx context.Context, signed *ethpb.Signe
 		return pubsub.ValidationReject
 	}
 
-	// Verify selection proof reflects to the right validator and signature is valid.
-	if err := validateSelection(ctx, bs, signed.Message.Aggregate.Data, signed.Message.AggregatorIndex, signed.Message.SelectionProof); err != nil {
+	// Verify selection proof reflects to the right validator.
+	selectionSet, err := validateSelectionIndex(ctx, bs, signed.Message.Aggregate.Data, signed.Message.AggregatorIndex, signed.Message.SelectionProof)
+	if err != nil {
 		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not validate selection for validator %d", signed.Message.AggregatorIndex))
 		return pubsub.ValidationReject
 	}
 
-	// Verify the aggregator's signature is valid.
-	if err := validateAggregatorSignature(bs, signed); err != nil {
+	// Retrieve the aggregator's signature.
+	aggregatorSet, err := aggSigSet(bs, signed)
+	if err != nil {
 		traceutil.AnnotateError(span, errors.Wrapf(err, "Could not verify aggregator signature %d", signed.Message.AggregatorIndex))
 		return pubsub.ValidationReject
 	}
+	set := bls.NewSet().Join(selectionSet).Join(aggregatorSet)
 
-	// Verify aggregated attestation has a valid signature.
+	// Retrieve the aggregated attestation's signature.
 	if !featureconfig.Get().DisableStrictAttestationPubsubVerification {
-		if err := blocks.VerifyAttestationSignature(ctx, bs, signed.Message.Aggregate); err != nil {
+		attSet, err := blocks.AttestationSignatureSet(ctx, bs, []*ethpb.Attestation{signed.Message.Aggregate})
+		if err != nil {
 			traceutil.AnnotateError(span, err)
 			return pubsub.ValidationReject
 		}
+		set.Join(attSet)
 	}
 
-	return pubsub.ValidationAccept
+	// Verify all signatures of the aggregate, batched with other gossip messages.
+	return s.validateWithBatchVerifier(ctx, "aggregate", set)
 }
 
 func (s *Service) validateBlockInAttestation(ctx context.Context, satt *ethpb.SignedAggregateAttestationAndProof) bool {
@@ -253,35 +265,30 @@ func validateIndexInCommittee(ctx context.Context, bs *stateTrie.BeaconState, a
 	return nil
 }
 
-// This validates selection proof by validating it's from the correct validator index of the slot and selection
-// proof is a valid signature.
-func validateSelection(ctx context.Context, bs *stateTrie.BeaconState, data *ethpb.AttestationData, validatorIndex uint64, proof []byte) error {
-	_, span := trace.StartSpan(ctx, "sync.validateSelection")
+// This validates the validator is an aggregator of the committee, and returns the signature set of its selection
+// proof, to be verified with the other signatures of the aggregate.
+func validateSelectionIndex(ctx context.Context, bs *stateTrie.BeaconState, data *ethpb.AttestationData, validatorIndex uint64, proof []byte) (*bls.SignatureSet, error) {
+	_, span := trace.StartSpan(ctx, "sync.validateSelectionIndex")
 	defer span.End()
 
 	committee, err := helpers.BeaconCommitteeFromState(bs, data.Slot, data.CommitteeIndex)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	aggregator, err := helpers.IsAggregator(uint64(len(committee)), proof)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if !aggregator {
-		return fmt.Errorf("validator is not an aggregator for slot %d", data.Slot)
-	}
-
-	if err := helpers.ComputeDomainVerifySigningRoot(bs, validatorIndex,
-		helpers.SlotToEpoch(data.Slot), data.Slot, params.BeaconConfig().DomainSelectionProof, proof); err != nil {
-		return err
+		return nil, fmt.Errorf("validator is not an aggregator for slot %d", data.Slot)
 	}
 
-	return nil
+	return helpers.ComputeDomainSignatureSet(bs, validatorIndex,
+		helpers.SlotToEpoch(data.Slot), data.Slot, params.BeaconConfig().DomainSelectionProof, proof)
 }
 
-// This verifies aggregator signature over the signed aggregate and proof object.
-func validateAggregatorSignature(s *stateTrie.BeaconState, a *ethpb.SignedAggregateAttestationAndProof) error {
-	return helpers.ComputeDomainVerifySigningRoot(s, a.Message.AggregatorIndex,
+// This returns the signature set of the aggregator's signature over the signed aggregate and proof object.
+func aggSigSet(s *stateTrie.BeaconState, a *ethpb.SignedAggregateAttestationAndProof) (*bls.SignatureSet, error) {
+	return helpers.ComputeDomainSignatureSet(s, a.Message.AggregatorIndex,
 		helpers.SlotToEpoch(a.Message.Aggregate.Data.Slot), a.Message, params.BeaconConfig().DomainAggregateAndProof, a.Signature)
-
 }
@@ -92,7 +92,8 @@ func TestVerifySelection_NotAnAggregator(t *testing.T) {
 	}
 
 	wanted := "validator is not an aggregator for slot"
-	assert.ErrorContains(t, wanted, validateSelection(ctx, beaconState, data, 0, sig.Marshal()))
+	_, err := validateSelectionIndex(ctx, beaconState, data, 0, sig.Marshal())
+	assert.ErrorContains(t, wanted, err)
 }
 
 func TestVerifySelection_BadSignature(t *testing.T) {
@@ -107,8 +108,11 @@ func TestVerifySelection_BadSignature(t *testing.T) {
 		Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
 	}
 
-	wanted := "signature did not verify"
-	assert.ErrorContains(t, wanted, validateSelection(ctx, beaconState, data, 0, sig.Marshal()))
+	set, err := validateSelectionIndex(ctx, beaconState, data, 0, sig.Marshal())
+	require.NoError(t, err)
+	verified, err := set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, false, verified, "Expected selection proof signature to not verify")
 }
 
 func TestVerifySelection_CanVerify(t *testing.T) {
@@ -123,7 +127,11 @@ func TestVerifySelection_CanVerify(t *testing.T) {
 	}
 	sig, err := helpers.ComputeDomainAndSign(beaconState, 0, data.Slot, params.BeaconConfig().DomainSelectionProof, privKeys[0])
 	require.NoError(t, err)
-	require.NoError(t, validateSelection(ctx, beaconState, data, 0, sig))
+	set, err := validateSelectionIndex(ctx, beaconState, data, 0, sig)
+	require.NoError(t, err)
+	verified, err := set.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, true, verified, "Expected selection proof signature to verify")
 }
 
 func TestValidateAggregateAndProof_NoBlock(t *testing.T) {
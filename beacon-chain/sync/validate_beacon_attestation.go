@@ -120,9 +120,13 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 			return pubsub.ValidationReject
 		}
 		// Is the attestation signature correct.
-		if err := blocks.VerifyAttSigUseCheckPt(ctx, c, att); err != nil {
+		set, err := blocks.AttestationSignatureSetUseCheckPt(ctx, c, att)
+		if err != nil {
 			return pubsub.ValidationReject
 		}
+		if res := s.validateWithBatchVerifier(ctx, "attestation", set); res != pubsub.ValidationAccept {
+			return res
+		}
 
 		s.setSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
 		msg.ValidatorData = att
@@ -162,11 +166,15 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 
 	// Attestation's signature is a valid BLS signature and belongs to correct public key..
 	if !featureconfig.Get().DisableStrictAttestationPubsubVerification {
-		if err := blocks.VerifyAttestationSignature(ctx, preState, att); err != nil {
+		set, err := blocks.AttestationSignatureSet(ctx, preState, []*eth.Attestation{att})
+		if err != nil {
 			log.WithError(err).Error("Could not verify attestation")
 			traceutil.AnnotateError(span, err)
 			return pubsub.ValidationReject
 		}
+		if res := s.validateWithBatchVerifier(ctx, "attestation", set); res != pubsub.ValidationAccept {
+			return res
+		}
 	}
 
 	s.setSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
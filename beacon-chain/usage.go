@@ -107,6 +107,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
+			flags.RecordTrafficDir,
+			flags.ReplayTrafficPath,
 		},
 	},
 	{
@@ -72,6 +72,11 @@ func StartNewValidatorClient(t *testing.T, config *types.E2EConfig, validatorNum
 		"--e2e-config",
 	}
 	args = append(args, featureconfig.E2EValidatorFlags...)
+	// Only every other validator client attests timely, so that attesting one third through the
+	// slot is covered as well.
+	if index%2 == 1 {
+		args = append(args, "--attest-timely")
+	}
 	args = append(args, config.ValidatorFlags...)
 
 	cmd := exec.Command(binaryPath, args...)
@@ -54,6 +54,65 @@ func (CreateWalletRequest_KeymanagerKind) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8a5153635bfe042e, []int{0, 0}
 }
 
+type DutyOutcome_Duty int32
+
+const (
+	DutyOutcome_ATTESTATION DutyOutcome_Duty = 0
+	DutyOutcome_PROPOSAL    DutyOutcome_Duty = 1
+	DutyOutcome_AGGREGATION DutyOutcome_Duty = 2
+)
+
+var DutyOutcome_Duty_name = map[int32]string{
+	0: "ATTESTATION",
+	1: "PROPOSAL",
+	2: "AGGREGATION",
+}
+
+var DutyOutcome_Duty_value = map[string]int32{
+	"ATTESTATION": 0,
+	"PROPOSAL":    1,
+	"AGGREGATION": 2,
+}
+
+func (x DutyOutcome_Duty) String() string {
+	return proto.EnumName(DutyOutcome_Duty_name, int32(x))
+}
+
+func (DutyOutcome_Duty) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{28, 0}
+}
+
+type DutyOutcome_Outcome int32
+
+const (
+	DutyOutcome_SUCCEEDED              DutyOutcome_Outcome = 0
+	DutyOutcome_SKIPPED                DutyOutcome_Outcome = 1
+	DutyOutcome_REJECTED_BY_PROTECTION DutyOutcome_Outcome = 2
+	DutyOutcome_FAILED                 DutyOutcome_Outcome = 3
+)
+
+var DutyOutcome_Outcome_name = map[int32]string{
+	0: "SUCCEEDED",
+	1: "SKIPPED",
+	2: "REJECTED_BY_PROTECTION",
+	3: "FAILED",
+}
+
+var DutyOutcome_Outcome_value = map[string]int32{
+	"SUCCEEDED":              0,
+	"SKIPPED":                1,
+	"REJECTED_BY_PROTECTION": 2,
+	"FAILED":                 3,
+}
+
+func (x DutyOutcome_Outcome) String() string {
+	return proto.EnumName(DutyOutcome_Outcome_name, int32(x))
+}
+
+func (DutyOutcome_Outcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{28, 1}
+}
+
 type CreateWalletRequest struct {
 	WalletPath           string                             `protobuf:"bytes,1,opt,name=wallet_path,json=walletPath,proto3" json:"wallet_path,omitempty"`
 	Keymanager           CreateWalletRequest_KeymanagerKind `protobuf:"varint,2,opt,name=keymanager,proto3,enum=ethereum.validator.accounts.v2.CreateWalletRequest_KeymanagerKind" json:"keymanager,omitempty"`
@@ -275,6 +334,61 @@ func (m *GenerateMnemonicResponse) GetMnemonic() string {
 	return ""
 }
 
+type RecoverWalletRequest struct {
+	Mnemonic             string   `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	NumAccounts          uint64   `protobuf:"varint,2,opt,name=num_accounts,json=numAccounts,proto3" json:"num_accounts,omitempty"`
+	WalletPassword       string   `protobuf:"bytes,3,opt,name=wallet_password,json=walletPassword,proto3" json:"wallet_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecoverWalletRequest) Reset()         { *m = RecoverWalletRequest{} }
+func (m *RecoverWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*RecoverWalletRequest) ProtoMessage()    {}
+func (*RecoverWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{3}
+}
+
+func (m *RecoverWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecoverWalletRequest.Unmarshal(m, b)
+}
+func (m *RecoverWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecoverWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *RecoverWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoverWalletRequest.Merge(m, src)
+}
+func (m *RecoverWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_RecoverWalletRequest.Size(m)
+}
+func (m *RecoverWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoverWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoverWalletRequest proto.InternalMessageInfo
+
+func (m *RecoverWalletRequest) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *RecoverWalletRequest) GetNumAccounts() uint64 {
+	if m != nil {
+		return m.NumAccounts
+	}
+	return 0
+}
+
+func (m *RecoverWalletRequest) GetWalletPassword() string {
+	if m != nil {
+		return m.WalletPassword
+	}
+	return ""
+}
+
 type WalletResponse struct {
 	WalletPath           string                           `protobuf:"bytes,1,opt,name=wallet_path,json=walletPath,proto3" json:"wallet_path,omitempty"`
 	KeymanagerConfig     *WalletResponse_KeymanagerConfig `protobuf:"bytes,2,opt,name=keymanager_config,json=keymanagerConfig,proto3" json:"keymanager_config,omitempty"`
@@ -287,7 +401,7 @@ func (m *WalletResponse) Reset()         { *m = WalletResponse{} }
 func (m *WalletResponse) String() string { return proto.CompactTextString(m) }
 func (*WalletResponse) ProtoMessage()    {}
 func (*WalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{3}
+	return fileDescriptor_8a5153635bfe042e, []int{4}
 }
 
 func (m *WalletResponse) XXX_Unmarshal(b []byte) error {
@@ -333,7 +447,7 @@ func (m *WalletResponse_KeymanagerConfig) Reset()         { *m = WalletResponse_
 func (m *WalletResponse_KeymanagerConfig) String() string { return proto.CompactTextString(m) }
 func (*WalletResponse_KeymanagerConfig) ProtoMessage()    {}
 func (*WalletResponse_KeymanagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{3, 0}
+	return fileDescriptor_8a5153635bfe042e, []int{4, 0}
 }
 
 func (m *WalletResponse_KeymanagerConfig) XXX_Unmarshal(b []byte) error {
@@ -372,7 +486,7 @@ func (m *CreateAccountResponse) Reset()         { *m = CreateAccountResponse{} }
 func (m *CreateAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateAccountResponse) ProtoMessage()    {}
 func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{4}
+	return fileDescriptor_8a5153635bfe042e, []int{5}
 }
 
 func (m *CreateAccountResponse) XXX_Unmarshal(b []byte) error {
@@ -411,7 +525,7 @@ func (m *ListAccountsRequest) Reset()         { *m = ListAccountsRequest{} }
 func (m *ListAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAccountsRequest) ProtoMessage()    {}
 func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{5}
+	return fileDescriptor_8a5153635bfe042e, []int{6}
 }
 
 func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
@@ -450,7 +564,7 @@ func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
 func (m *ListAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListAccountsResponse) ProtoMessage()    {}
 func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{6}
+	return fileDescriptor_8a5153635bfe042e, []int{7}
 }
 
 func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
@@ -492,7 +606,7 @@ func (m *Account) Reset()         { *m = Account{} }
 func (m *Account) String() string { return proto.CompactTextString(m) }
 func (*Account) ProtoMessage()    {}
 func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{7}
+	return fileDescriptor_8a5153635bfe042e, []int{8}
 }
 
 func (m *Account) XXX_Unmarshal(b []byte) error {
@@ -553,7 +667,7 @@ func (m *AccountRequest) Reset()         { *m = AccountRequest{} }
 func (m *AccountRequest) String() string { return proto.CompactTextString(m) }
 func (*AccountRequest) ProtoMessage()    {}
 func (*AccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{8}
+	return fileDescriptor_8a5153635bfe042e, []int{9}
 }
 
 func (m *AccountRequest) XXX_Unmarshal(b []byte) error {
@@ -588,293 +702,2206 @@ func (m *AccountRequest) GetIndices() []uint64 {
 	return nil
 }
 
-type AuthRequest struct {
-	Password             string   `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+type ImportKeystoresRequest struct {
+	KeystoresImported    []string `protobuf:"bytes,1,rep,name=keystores_imported,json=keystoresImported,proto3" json:"keystores_imported,omitempty"`
+	KeystoresPassword    string   `protobuf:"bytes,2,opt,name=keystores_password,json=keystoresPassword,proto3" json:"keystores_password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthRequest) Reset()         { *m = AuthRequest{} }
-func (m *AuthRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthRequest) ProtoMessage()    {}
-func (*AuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{9}
+func (m *ImportKeystoresRequest) Reset()         { *m = ImportKeystoresRequest{} }
+func (m *ImportKeystoresRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportKeystoresRequest) ProtoMessage()    {}
+func (*ImportKeystoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{10}
 }
 
-func (m *AuthRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuthRequest.Unmarshal(m, b)
+func (m *ImportKeystoresRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportKeystoresRequest.Unmarshal(m, b)
 }
-func (m *AuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuthRequest.Marshal(b, m, deterministic)
+func (m *ImportKeystoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportKeystoresRequest.Marshal(b, m, deterministic)
 }
-func (m *AuthRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthRequest.Merge(m, src)
+func (m *ImportKeystoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportKeystoresRequest.Merge(m, src)
 }
-func (m *AuthRequest) XXX_Size() int {
-	return xxx_messageInfo_AuthRequest.Size(m)
+func (m *ImportKeystoresRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportKeystoresRequest.Size(m)
 }
-func (m *AuthRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthRequest.DiscardUnknown(m)
+func (m *ImportKeystoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportKeystoresRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthRequest proto.InternalMessageInfo
+var xxx_messageInfo_ImportKeystoresRequest proto.InternalMessageInfo
 
-func (m *AuthRequest) GetPassword() string {
+func (m *ImportKeystoresRequest) GetKeystoresImported() []string {
 	if m != nil {
-		return m.Password
+		return m.KeystoresImported
+	}
+	return nil
+}
+
+func (m *ImportKeystoresRequest) GetKeystoresPassword() string {
+	if m != nil {
+		return m.KeystoresPassword
 	}
 	return ""
 }
 
-type AuthResponse struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	TokenExpiration      uint64   `protobuf:"varint,2,opt,name=token_expiration,json=tokenExpiration,proto3" json:"token_expiration,omitempty"`
+type ImportKeystoresResponse struct {
+	ImportedPublicKeys   [][]byte `protobuf:"bytes,1,rep,name=imported_public_keys,json=importedPublicKeys,proto3" json:"imported_public_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuthResponse) Reset()         { *m = AuthResponse{} }
-func (m *AuthResponse) String() string { return proto.CompactTextString(m) }
-func (*AuthResponse) ProtoMessage()    {}
-func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{10}
+func (m *ImportKeystoresResponse) Reset()         { *m = ImportKeystoresResponse{} }
+func (m *ImportKeystoresResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportKeystoresResponse) ProtoMessage()    {}
+func (*ImportKeystoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{11}
 }
 
-func (m *AuthResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuthResponse.Unmarshal(m, b)
+func (m *ImportKeystoresResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportKeystoresResponse.Unmarshal(m, b)
 }
-func (m *AuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuthResponse.Marshal(b, m, deterministic)
+func (m *ImportKeystoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportKeystoresResponse.Marshal(b, m, deterministic)
 }
-func (m *AuthResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthResponse.Merge(m, src)
+func (m *ImportKeystoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportKeystoresResponse.Merge(m, src)
 }
-func (m *AuthResponse) XXX_Size() int {
-	return xxx_messageInfo_AuthResponse.Size(m)
+func (m *ImportKeystoresResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportKeystoresResponse.Size(m)
 }
-func (m *AuthResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthResponse.DiscardUnknown(m)
+func (m *ImportKeystoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportKeystoresResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuthResponse proto.InternalMessageInfo
+var xxx_messageInfo_ImportKeystoresResponse proto.InternalMessageInfo
 
-func (m *AuthResponse) GetToken() string {
+func (m *ImportKeystoresResponse) GetImportedPublicKeys() [][]byte {
 	if m != nil {
-		return m.Token
+		return m.ImportedPublicKeys
 	}
-	return ""
+	return nil
 }
 
-func (m *AuthResponse) GetTokenExpiration() uint64 {
+type DeleteAccountsRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAccountsRequest) Reset()         { *m = DeleteAccountsRequest{} }
+func (m *DeleteAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountsRequest) ProtoMessage()    {}
+func (*DeleteAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{12}
+}
+
+func (m *DeleteAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountsRequest.Unmarshal(m, b)
+}
+func (m *DeleteAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountsRequest.Merge(m, src)
+}
+func (m *DeleteAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountsRequest.Size(m)
+}
+func (m *DeleteAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAccountsRequest proto.InternalMessageInfo
+
+func (m *DeleteAccountsRequest) GetPublicKeys() [][]byte {
 	if m != nil {
-		return m.TokenExpiration
+		return m.PublicKeys
 	}
-	return 0
+	return nil
 }
 
-type NodeConnectionResponse struct {
-	BeaconNodeEndpoint     string   `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
-	Connected              bool     `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
-	Syncing                bool     `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
-	GenesisTime            uint64   `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
-	DepositContractAddress []byte   `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+type DeleteAccountsResponse struct {
+	DeletedKeys          [][]byte `protobuf:"bytes,1,rep,name=deleted_keys,json=deletedKeys,proto3" json:"deleted_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeConnectionResponse) Reset()         { *m = NodeConnectionResponse{} }
-func (m *NodeConnectionResponse) String() string { return proto.CompactTextString(m) }
-func (*NodeConnectionResponse) ProtoMessage()    {}
-func (*NodeConnectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8a5153635bfe042e, []int{11}
+func (m *DeleteAccountsResponse) Reset()         { *m = DeleteAccountsResponse{} }
+func (m *DeleteAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountsResponse) ProtoMessage()    {}
+func (*DeleteAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{13}
 }
 
-func (m *NodeConnectionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeConnectionResponse.Unmarshal(m, b)
+func (m *DeleteAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountsResponse.Unmarshal(m, b)
 }
-func (m *NodeConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeConnectionResponse.Marshal(b, m, deterministic)
+func (m *DeleteAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountsResponse.Marshal(b, m, deterministic)
 }
-func (m *NodeConnectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeConnectionResponse.Merge(m, src)
+func (m *DeleteAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountsResponse.Merge(m, src)
 }
-func (m *NodeConnectionResponse) XXX_Size() int {
-	return xxx_messageInfo_NodeConnectionResponse.Size(m)
+func (m *DeleteAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountsResponse.Size(m)
 }
-func (m *NodeConnectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeConnectionResponse.DiscardUnknown(m)
+func (m *DeleteAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeConnectionResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteAccountsResponse proto.InternalMessageInfo
 
-func (m *NodeConnectionResponse) GetBeaconNodeEndpoint() string {
+func (m *DeleteAccountsResponse) GetDeletedKeys() [][]byte {
 	if m != nil {
-		return m.BeaconNodeEndpoint
+		return m.DeletedKeys
 	}
-	return ""
+	return nil
 }
 
-func (m *NodeConnectionResponse) GetConnected() bool {
-	if m != nil {
-		return m.Connected
-	}
-	return false
+type BackupAccountsRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	BackupPassword       string   `protobuf:"bytes,2,opt,name=backup_password,json=backupPassword,proto3" json:"backup_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeConnectionResponse) GetSyncing() bool {
-	if m != nil {
-		return m.Syncing
-	}
-	return false
+func (m *BackupAccountsRequest) Reset()         { *m = BackupAccountsRequest{} }
+func (m *BackupAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupAccountsRequest) ProtoMessage()    {}
+func (*BackupAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{14}
 }
 
-func (m *NodeConnectionResponse) GetGenesisTime() uint64 {
-	if m != nil {
-		return m.GenesisTime
-	}
-	return 0
+func (m *BackupAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupAccountsRequest.Unmarshal(m, b)
+}
+func (m *BackupAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *BackupAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupAccountsRequest.Merge(m, src)
+}
+func (m *BackupAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_BackupAccountsRequest.Size(m)
+}
+func (m *BackupAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupAccountsRequest.DiscardUnknown(m)
 }
 
-func (m *NodeConnectionResponse) GetDepositContractAddress() []byte {
+var xxx_messageInfo_BackupAccountsRequest proto.InternalMessageInfo
+
+func (m *BackupAccountsRequest) GetPublicKeys() [][]byte {
 	if m != nil {
-		return m.DepositContractAddress
+		return m.PublicKeys
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.validator.accounts.v2.CreateWalletRequest_KeymanagerKind", CreateWalletRequest_KeymanagerKind_name, CreateWalletRequest_KeymanagerKind_value)
-	proto.RegisterType((*CreateWalletRequest)(nil), "ethereum.validator.accounts.v2.CreateWalletRequest")
-	proto.RegisterType((*EditWalletConfigRequest)(nil), "ethereum.validator.accounts.v2.EditWalletConfigRequest")
-	proto.RegisterType((*GenerateMnemonicResponse)(nil), "ethereum.validator.accounts.v2.GenerateMnemonicResponse")
-	proto.RegisterType((*WalletResponse)(nil), "ethereum.validator.accounts.v2.WalletResponse")
-	proto.RegisterType((*WalletResponse_KeymanagerConfig)(nil), "ethereum.validator.accounts.v2.WalletResponse.KeymanagerConfig")
-	proto.RegisterMapType((map[string]string)(nil), "ethereum.validator.accounts.v2.WalletResponse.KeymanagerConfig.ConfigsEntry")
-	proto.RegisterType((*CreateAccountResponse)(nil), "ethereum.validator.accounts.v2.CreateAccountResponse")
-	proto.RegisterType((*ListAccountsRequest)(nil), "ethereum.validator.accounts.v2.ListAccountsRequest")
-	proto.RegisterType((*ListAccountsResponse)(nil), "ethereum.validator.accounts.v2.ListAccountsResponse")
-	proto.RegisterType((*Account)(nil), "ethereum.validator.accounts.v2.Account")
-	proto.RegisterType((*AccountRequest)(nil), "ethereum.validator.accounts.v2.AccountRequest")
-	proto.RegisterType((*AuthRequest)(nil), "ethereum.validator.accounts.v2.AuthRequest")
-	proto.RegisterType((*AuthResponse)(nil), "ethereum.validator.accounts.v2.AuthResponse")
-	proto.RegisterType((*NodeConnectionResponse)(nil), "ethereum.validator.accounts.v2.NodeConnectionResponse")
+func (m *BackupAccountsRequest) GetBackupPassword() string {
+	if m != nil {
+		return m.BackupPassword
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("proto/validator/accounts/v2/web_api.proto", fileDescriptor_8a5153635bfe042e)
+type BackupAccountsResponse struct {
+	ZipFile              []byte   `protobuf:"bytes,1,opt,name=zip_file,json=zipFile,proto3" json:"zip_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var fileDescriptor_8a5153635bfe042e = []byte{
-	// 1265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xff, 0xae, 0xed, 0x24, 0xce, 0xb3, 0x9b, 0xba, 0x93, 0x34, 0xdd, 0xaf, 0x5b, 0x1a, 0x77,
-	0x05, 0xad, 0xfb, 0xcb, 0x46, 0x2e, 0x2d, 0x55, 0x2e, 0x28, 0x75, 0xac, 0x52, 0xa5, 0xbf, 0xb4,
-	0x44, 0x20, 0xc1, 0x61, 0x35, 0xd9, 0x7d, 0x75, 0x46, 0xf1, 0xce, 0x2c, 0xbb, 0xe3, 0xb4, 0xbe,
-	0x56, 0x1c, 0xb8, 0x70, 0x81, 0x03, 0x42, 0xdc, 0xfa, 0x1f, 0xf4, 0x00, 0xfc, 0x1d, 0x88, 0x0b,
-	0x37, 0x2e, 0xfc, 0x21, 0x68, 0x67, 0x66, 0xd7, 0x76, 0xe4, 0xe0, 0x04, 0xc4, 0x69, 0x77, 0xde,
-	0xfb, 0xcc, 0x7b, 0x9f, 0x79, 0xf3, 0x7e, 0x0c, 0x5c, 0x8f, 0x62, 0x21, 0x45, 0xfb, 0x90, 0x0e,
-	0x58, 0x40, 0xa5, 0x88, 0xdb, 0xd4, 0xf7, 0xc5, 0x90, 0xcb, 0xa4, 0x7d, 0xd8, 0x69, 0xbf, 0xc4,
-	0x3d, 0x8f, 0x46, 0xac, 0xa5, 0x30, 0xe4, 0x32, 0xca, 0x7d, 0x8c, 0x71, 0x18, 0xb6, 0x72, 0x74,
-	0x2b, 0x43, 0xb7, 0x0e, 0x3b, 0xf5, 0x4b, 0x7d, 0x21, 0xfa, 0x03, 0x6c, 0xd3, 0x88, 0xb5, 0x29,
-	0xe7, 0x42, 0x52, 0xc9, 0x04, 0x4f, 0xf4, 0xee, 0xfa, 0x45, 0xa3, 0x55, 0xab, 0xbd, 0xe1, 0x8b,
-	0x36, 0x86, 0x91, 0x1c, 0x69, 0xa5, 0xf3, 0x53, 0x09, 0x56, 0xbb, 0x31, 0x52, 0x89, 0x9f, 0xd1,
-	0xc1, 0x00, 0xa5, 0x8b, 0x5f, 0x0e, 0x31, 0x91, 0x64, 0x03, 0x2a, 0x2f, 0x95, 0xc0, 0x8b, 0xa8,
-	0xdc, 0xb7, 0xad, 0x86, 0xd5, 0x5c, 0x76, 0x41, 0x8b, 0x9e, 0x53, 0xb9, 0x4f, 0xf6, 0x00, 0x0e,
-	0x70, 0x14, 0x52, 0x4e, 0xfb, 0x18, 0xdb, 0x85, 0x86, 0xd5, 0x5c, 0xe9, 0x3c, 0x68, 0xfd, 0x3d,
-	0xd1, 0xd6, 0x0c, 0x4f, 0xad, 0x9d, 0xdc, 0xca, 0x0e, 0xe3, 0x81, 0x3b, 0x61, 0x95, 0x5c, 0x83,
-	0xb3, 0x39, 0x89, 0x24, 0x79, 0x29, 0xe2, 0xc0, 0x2e, 0x2a, 0x22, 0x2b, 0x19, 0x11, 0x2d, 0x25,
-	0x75, 0x28, 0x87, 0x1c, 0x43, 0xc1, 0x99, 0x6f, 0x97, 0x14, 0x22, 0x5f, 0x93, 0x2b, 0x50, 0xe5,
-	0xc3, 0xd0, 0xcb, 0x68, 0xd8, 0x0b, 0x0d, 0xab, 0x59, 0x72, 0x2b, 0x7c, 0x18, 0x6e, 0x19, 0x11,
-	0xb9, 0x0d, 0xe4, 0x00, 0x47, 0x89, 0x14, 0x31, 0x26, 0x1e, 0x0b, 0x23, 0x11, 0x4b, 0x0c, 0xec,
-	0xc5, 0x46, 0xb1, 0xb9, 0xec, 0x9e, 0xcb, 0x35, 0x8f, 0x8c, 0x62, 0x1a, 0x9e, 0x33, 0x5b, 0x52,
-	0x7e, 0xc7, 0xf0, 0x9c, 0xdc, 0x06, 0x54, 0x62, 0x0c, 0x85, 0x44, 0x8f, 0x06, 0x41, 0x6c, 0x97,
-	0x75, 0x28, 0xb5, 0x68, 0x2b, 0x08, 0x62, 0x72, 0x15, 0xce, 0x1a, 0x80, 0x1f, 0x9b, 0x78, 0x2f,
-	0x2b, 0xd0, 0x19, 0x2d, 0xee, 0xc6, 0x3a, 0xe4, 0x63, 0xdc, 0x01, 0x8e, 0x34, 0x0e, 0x26, 0x71,
-	0x3b, 0x38, 0x52, 0xb8, 0x9b, 0x40, 0x32, 0x7b, 0x74, 0x6c, 0xb2, 0xa2, 0xa0, 0xc6, 0x42, 0x97,
-	0x1a, 0xa3, 0xce, 0x5d, 0x58, 0x99, 0xbe, 0x01, 0x52, 0x81, 0xa5, 0xed, 0x9e, 0xfb, 0xe8, 0xd3,
-	0xde, 0x76, 0xed, 0x7f, 0x04, 0x60, 0x71, 0xfb, 0x91, 0xdb, 0xeb, 0xee, 0xd6, 0xac, 0xf4, 0xdf,
-	0xed, 0x3d, 0x79, 0xb6, 0xdb, 0xab, 0x15, 0x9c, 0x5f, 0x2c, 0xb8, 0xd0, 0x0b, 0x98, 0xd4, 0x77,
-	0xd9, 0x15, 0xfc, 0x05, 0xeb, 0x4f, 0xe4, 0xce, 0xe4, 0x81, 0xad, 0x93, 0x1c, 0xb8, 0x70, 0xc2,
-	0x03, 0x17, 0x4f, 0x7e, 0xe0, 0xd2, 0xec, 0x03, 0xdf, 0x03, 0xfb, 0x21, 0x72, 0x8c, 0xa9, 0xc4,
-	0x27, 0x26, 0x47, 0x5c, 0x4c, 0x22, 0xc1, 0x13, 0x9c, 0xca, 0x23, 0x6b, 0x3a, 0x8f, 0x9c, 0xdf,
-	0x0b, 0xb0, 0x92, 0x65, 0xae, 0x81, 0xcf, 0x2d, 0x92, 0x01, 0x9c, 0x1b, 0xa7, 0xb3, 0xe7, 0xab,
-	0x28, 0xa9, 0xa3, 0x56, 0x3a, 0x1f, 0xcd, 0xab, 0x95, 0x69, 0x5f, 0x13, 0x65, 0x62, 0x82, 0x5d,
-	0x3b, 0x38, 0x22, 0xa9, 0xff, 0x6c, 0x41, 0xed, 0x28, 0x8c, 0xbc, 0x80, 0x25, 0xed, 0x37, 0xb1,
-	0xad, 0x46, 0xb1, 0x59, 0xe9, 0x3c, 0xfe, 0x97, 0x8e, 0x5b, 0xfa, 0x93, 0xf4, 0xb8, 0x8c, 0x47,
-	0x6e, 0x66, 0xbc, 0xbe, 0x09, 0xd5, 0x49, 0x05, 0xa9, 0x41, 0xf1, 0x00, 0x47, 0x26, 0x26, 0xe9,
-	0x2f, 0x59, 0x83, 0x85, 0x43, 0x3a, 0x18, 0xa2, 0xb9, 0x6b, 0xbd, 0xd8, 0x2c, 0xdc, 0xb7, 0x9c,
-	0xcf, 0xe1, 0xbc, 0xee, 0x0c, 0xa6, 0x22, 0xf3, 0x00, 0x6f, 0xc1, 0x92, 0x61, 0xa6, 0x0c, 0x55,
-	0x3a, 0xd7, 0xe6, 0x91, 0xcf, 0x2c, 0x64, 0xfb, 0x9c, 0x6d, 0x58, 0x7d, 0xcc, 0x12, 0x99, 0xd5,
-	0x7a, 0x96, 0xa3, 0xb7, 0x61, 0xb5, 0x8f, 0xd2, 0x0b, 0x30, 0x12, 0x09, 0x93, 0x9e, 0x7c, 0xe5,
-	0x05, 0x54, 0x52, 0xe5, 0xa5, 0xec, 0xd6, 0xfa, 0x28, 0xb7, 0xb5, 0x66, 0xf7, 0xd5, 0x36, 0x95,
-	0xd4, 0xf9, 0x02, 0xd6, 0xa6, 0xad, 0x18, 0x82, 0x5d, 0x28, 0xe7, 0x8d, 0x45, 0x87, 0xf7, 0xc4,
-	0x0c, 0xf3, 0x8d, 0xce, 0x5b, 0x0b, 0x96, 0x8c, 0x94, 0x74, 0xe0, 0xbc, 0xd9, 0xc6, 0x78, 0xdf,
-	0x8b, 0x86, 0x7b, 0x03, 0xe6, 0x7b, 0x59, 0x20, 0xab, 0xee, 0xea, 0x58, 0xf9, 0x5c, 0xe9, 0x76,
-	0x70, 0x94, 0x76, 0x38, 0x63, 0xcb, 0xe3, 0x34, 0xcc, 0xe2, 0x5b, 0x31, 0xb2, 0xa7, 0x34, 0xc4,
-	0xb4, 0x92, 0x8e, 0x1e, 0xb5, 0xa8, 0x0c, 0x9e, 0x09, 0x26, 0xcf, 0x99, 0x76, 0xdc, 0x00, 0x63,
-	0x76, 0xa8, 0x06, 0xc8, 0x64, 0x19, 0xad, 0x8c, 0xc5, 0xaa, 0x8a, 0x76, 0x60, 0x25, 0xbf, 0xac,
-	0xbc, 0xea, 0xc7, 0x74, 0x75, 0x34, 0xaa, 0x2e, 0x44, 0x19, 0xcb, 0x84, 0xd8, 0xb0, 0xc4, 0x78,
-	0xc0, 0x7c, 0x4c, 0xec, 0x42, 0xa3, 0xd8, 0x2c, 0xb9, 0xd9, 0xd2, 0xb9, 0x0e, 0x95, 0xad, 0xa1,
-	0xdc, 0xcf, 0x2c, 0xd5, 0xa1, 0x9c, 0x77, 0x55, 0x53, 0x85, 0xd9, 0xda, 0x79, 0x06, 0x55, 0x0d,
-	0x35, 0x17, 0xb0, 0x06, 0x0b, 0x52, 0x1c, 0x20, 0x37, 0x40, 0xbd, 0x20, 0xd7, 0xa1, 0xa6, 0x7e,
-	0x3c, 0x7c, 0x15, 0xb1, 0x58, 0xb1, 0x56, 0x51, 0x29, 0xb9, 0x67, 0x95, 0xbc, 0x97, 0x8b, 0x9d,
-	0x3f, 0x2c, 0x58, 0x7f, 0x2a, 0x02, 0xec, 0x0a, 0xce, 0xd1, 0x4f, 0x45, 0xb9, 0xed, 0xf7, 0x61,
-	0x6d, 0x0f, 0xa9, 0x2f, 0xb8, 0xc7, 0x45, 0x80, 0x1e, 0xf2, 0x20, 0x12, 0xcc, 0xa4, 0xe2, 0xb2,
-	0x4b, 0xb4, 0x2e, 0xdd, 0xdb, 0x33, 0x1a, 0x72, 0x09, 0x96, 0x7d, 0x6d, 0x07, 0x03, 0xe5, 0xb0,
-	0xec, 0x8e, 0x05, 0x69, 0x00, 0x92, 0x11, 0xf7, 0x19, 0xef, 0xab, 0xe0, 0x97, 0xdd, 0x6c, 0x99,
-	0xde, 0x60, 0x1f, 0x39, 0x26, 0x2c, 0xf1, 0x24, 0x0b, 0x51, 0xc5, 0xbc, 0xe4, 0x56, 0x8c, 0x6c,
-	0x97, 0x85, 0x48, 0xee, 0x83, 0x9d, 0xdd, 0xa0, 0x2f, 0xb8, 0x8c, 0xa9, 0x2f, 0x55, 0x7b, 0xc5,
-	0x44, 0x8f, 0xb4, 0xaa, 0xbb, 0x6e, 0xf4, 0x5d, 0xa3, 0xde, 0xd2, 0xda, 0xce, 0xaf, 0x25, 0x58,
-	0xd4, 0x35, 0x4d, 0x7e, 0xb4, 0xa0, 0x3a, 0x39, 0x83, 0xc9, 0x9d, 0x7f, 0x30, 0xb1, 0xeb, 0xad,
-	0xd3, 0x75, 0x10, 0xe7, 0xea, 0xeb, 0xdf, 0xfe, 0xfc, 0xae, 0xd0, 0x70, 0x2e, 0xa6, 0x2f, 0x9b,
-	0xf1, 0x7b, 0x47, 0xf7, 0xc9, 0xb6, 0xaf, 0x3c, 0x6c, 0x5a, 0x37, 0xc8, 0x1b, 0x0b, 0x20, 0x9d,
-	0x29, 0xa6, 0x73, 0x7d, 0x38, 0xcf, 0xcd, 0x31, 0xf3, 0xe7, 0xd4, 0xfc, 0x6e, 0x2a, 0x7e, 0xef,
-	0x39, 0x8d, 0xd9, 0xfc, 0x94, 0xed, 0x36, 0x06, 0x4c, 0xa6, 0x24, 0x25, 0x54, 0x27, 0x7d, 0x92,
-	0xf5, 0x96, 0x7e, 0x5e, 0xb5, 0xb2, 0xe7, 0x55, 0xab, 0x97, 0x3e, 0xaf, 0x4e, 0x4d, 0xe2, 0x92,
-	0x22, 0xb1, 0x4e, 0xd6, 0x66, 0x91, 0x20, 0xdf, 0x58, 0x50, 0x3b, 0x3a, 0xb5, 0x8e, 0x75, 0x7d,
-	0x7f, 0x9e, 0xeb, 0xe3, 0xe6, 0x9f, 0x73, 0x4d, 0x91, 0xb8, 0x42, 0x36, 0xa6, 0x49, 0x64, 0x33,
-	0xb0, 0xdd, 0x37, 0x1b, 0x3b, 0x6f, 0x0b, 0x50, 0xce, 0x9f, 0x4f, 0x5f, 0x5b, 0x70, 0x66, 0xaa,
-	0x7f, 0x1f, 0xcb, 0xec, 0xee, 0xc9, 0xd2, 0xed, 0xc8, 0x18, 0x70, 0x9a, 0x8a, 0x96, 0xe3, 0xbc,
-	0x33, 0x4d, 0x2b, 0x7f, 0x30, 0x8f, 0x53, 0xe8, 0x07, 0x0b, 0xaa, 0x93, 0x8d, 0x7a, 0x7e, 0x82,
-	0xcf, 0x18, 0x0e, 0xf5, 0x0f, 0x4e, 0xb7, 0xc9, 0xb0, 0xbc, 0xac, 0x58, 0xda, 0x64, 0x7d, 0x36,
-	0xcb, 0xce, 0x1b, 0x0b, 0x16, 0x3f, 0x46, 0x3a, 0x90, 0xfb, 0xe4, 0x7b, 0x0b, 0x2e, 0x3c, 0x44,
-	0xf9, 0x20, 0xef, 0x20, 0xe3, 0xee, 0x73, 0x6c, 0xec, 0xee, 0xcd, 0x23, 0x35, 0xbb, 0x8b, 0x39,
-	0xb7, 0x14, 0xad, 0xab, 0xe4, 0xdd, 0x69, 0x5a, 0xfb, 0x8a, 0x49, 0x5b, 0x75, 0x36, 0x3f, 0xdf,
-	0xd5, 0xf9, 0xb6, 0x00, 0xa5, 0xb4, 0xc1, 0x92, 0xd7, 0x16, 0x2c, 0x3c, 0x16, 0x7d, 0xc6, 0xc9,
-	0xcd, 0xb9, 0x13, 0x6d, 0xdc, 0xbb, 0xeb, 0xb7, 0x4e, 0x06, 0x9e, 0x0e, 0x99, 0xb3, 0x3a, 0xcd,
-	0x6d, 0x90, 0xfa, 0x4d, 0xaf, 0xf3, 0x2b, 0x0b, 0x16, 0x3f, 0x61, 0x7d, 0x3e, 0x8c, 0xfe, 0x4b,
-	0x16, 0x1b, 0x8a, 0xc5, 0xff, 0x9d, 0x23, 0xa5, 0x97, 0x28, 0xc7, 0x9b, 0xd6, 0x8d, 0xbd, 0x45,
-	0x75, 0x15, 0x77, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xb7, 0x90, 0x4f, 0xb3, 0x0d, 0x00,
-	0x00,
+func (m *BackupAccountsResponse) Reset()         { *m = BackupAccountsResponse{} }
+func (m *BackupAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupAccountsResponse) ProtoMessage()    {}
+func (*BackupAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{15}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (m *BackupAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupAccountsResponse.Unmarshal(m, b)
+}
+func (m *BackupAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupAccountsResponse.Marshal(b, m, deterministic)
+}
+func (m *BackupAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupAccountsResponse.Merge(m, src)
+}
+func (m *BackupAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_BackupAccountsResponse.Size(m)
+}
+func (m *BackupAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupAccountsResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+var xxx_messageInfo_BackupAccountsResponse proto.InternalMessageInfo
 
-// WalletClient is the client API for Wallet service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WalletClient interface {
-	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error)
-	EditConfig(ctx context.Context, in *EditWalletConfigRequest, opts ...grpc.CallOption) (*WalletResponse, error)
-	WalletConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WalletResponse, error)
-	GenerateMnemonic(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error)
+func (m *BackupAccountsResponse) GetZipFile() []byte {
+	if m != nil {
+		return m.ZipFile
+	}
+	return nil
 }
 
-type walletClient struct {
-	cc grpc.ClientConnInterface
+type VoluntaryExitRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewWalletClient(cc grpc.ClientConnInterface) WalletClient {
-	return &walletClient{cc}
+func (m *VoluntaryExitRequest) Reset()         { *m = VoluntaryExitRequest{} }
+func (m *VoluntaryExitRequest) String() string { return proto.CompactTextString(m) }
+func (*VoluntaryExitRequest) ProtoMessage()    {}
+func (*VoluntaryExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{16}
+}
+
+func (m *VoluntaryExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoluntaryExitRequest.Unmarshal(m, b)
+}
+func (m *VoluntaryExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoluntaryExitRequest.Marshal(b, m, deterministic)
+}
+func (m *VoluntaryExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoluntaryExitRequest.Merge(m, src)
+}
+func (m *VoluntaryExitRequest) XXX_Size() int {
+	return xxx_messageInfo_VoluntaryExitRequest.Size(m)
+}
+func (m *VoluntaryExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoluntaryExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoluntaryExitRequest proto.InternalMessageInfo
+
+func (m *VoluntaryExitRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type VoluntaryExitResponse struct {
+	ExitedKeys           [][]byte `protobuf:"bytes,1,rep,name=exited_keys,json=exitedKeys,proto3" json:"exited_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoluntaryExitResponse) Reset()         { *m = VoluntaryExitResponse{} }
+func (m *VoluntaryExitResponse) String() string { return proto.CompactTextString(m) }
+func (*VoluntaryExitResponse) ProtoMessage()    {}
+func (*VoluntaryExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{17}
+}
+
+func (m *VoluntaryExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoluntaryExitResponse.Unmarshal(m, b)
+}
+func (m *VoluntaryExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoluntaryExitResponse.Marshal(b, m, deterministic)
+}
+func (m *VoluntaryExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoluntaryExitResponse.Merge(m, src)
+}
+func (m *VoluntaryExitResponse) XXX_Size() int {
+	return xxx_messageInfo_VoluntaryExitResponse.Size(m)
+}
+func (m *VoluntaryExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoluntaryExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoluntaryExitResponse proto.InternalMessageInfo
+
+func (m *VoluntaryExitResponse) GetExitedKeys() [][]byte {
+	if m != nil {
+		return m.ExitedKeys
+	}
+	return nil
+}
+
+type ListValidatingKeysResponse struct {
+	Keys                 []*ValidatingKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListValidatingKeysResponse) Reset()         { *m = ListValidatingKeysResponse{} }
+func (m *ListValidatingKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListValidatingKeysResponse) ProtoMessage()    {}
+func (*ListValidatingKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{18}
+}
+
+func (m *ListValidatingKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListValidatingKeysResponse.Unmarshal(m, b)
+}
+func (m *ListValidatingKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListValidatingKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListValidatingKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListValidatingKeysResponse.Merge(m, src)
+}
+func (m *ListValidatingKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListValidatingKeysResponse.Size(m)
+}
+func (m *ListValidatingKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListValidatingKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListValidatingKeysResponse proto.InternalMessageInfo
+
+func (m *ListValidatingKeysResponse) GetKeys() []*ValidatingKey {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type ValidatingKey struct {
+	PublicKey            []byte            `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Enabled              bool              `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Graffiti             string            `protobuf:"bytes,3,opt,name=graffiti,proto3" json:"graffiti,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValidatingKey) Reset()         { *m = ValidatingKey{} }
+func (m *ValidatingKey) String() string { return proto.CompactTextString(m) }
+func (*ValidatingKey) ProtoMessage()    {}
+func (*ValidatingKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{19}
+}
+
+func (m *ValidatingKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatingKey.Unmarshal(m, b)
+}
+func (m *ValidatingKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatingKey.Marshal(b, m, deterministic)
+}
+func (m *ValidatingKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatingKey.Merge(m, src)
+}
+func (m *ValidatingKey) XXX_Size() int {
+	return xxx_messageInfo_ValidatingKey.Size(m)
+}
+func (m *ValidatingKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatingKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatingKey proto.InternalMessageInfo
+
+func (m *ValidatingKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatingKey) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ValidatingKey) GetGraffiti() string {
+	if m != nil {
+		return m.Graffiti
+	}
+	return ""
+}
+
+func (m *ValidatingKey) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type SetValidatingKeyEnabledRequest struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Enabled              bool     `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetValidatingKeyEnabledRequest) Reset()         { *m = SetValidatingKeyEnabledRequest{} }
+func (m *SetValidatingKeyEnabledRequest) String() string { return proto.CompactTextString(m) }
+func (*SetValidatingKeyEnabledRequest) ProtoMessage()    {}
+func (*SetValidatingKeyEnabledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{20}
+}
+
+func (m *SetValidatingKeyEnabledRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetValidatingKeyEnabledRequest.Unmarshal(m, b)
+}
+func (m *SetValidatingKeyEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetValidatingKeyEnabledRequest.Marshal(b, m, deterministic)
+}
+func (m *SetValidatingKeyEnabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetValidatingKeyEnabledRequest.Merge(m, src)
+}
+func (m *SetValidatingKeyEnabledRequest) XXX_Size() int {
+	return xxx_messageInfo_SetValidatingKeyEnabledRequest.Size(m)
+}
+func (m *SetValidatingKeyEnabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetValidatingKeyEnabledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetValidatingKeyEnabledRequest proto.InternalMessageInfo
+
+func (m *SetValidatingKeyEnabledRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SetValidatingKeyEnabledRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type AuthRequest struct {
+	Password             string   `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthRequest) Reset()         { *m = AuthRequest{} }
+func (m *AuthRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthRequest) ProtoMessage()    {}
+func (*AuthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{21}
+}
+
+func (m *AuthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthRequest.Unmarshal(m, b)
+}
+func (m *AuthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthRequest.Marshal(b, m, deterministic)
+}
+func (m *AuthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthRequest.Merge(m, src)
+}
+func (m *AuthRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthRequest.Size(m)
+}
+func (m *AuthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthRequest proto.InternalMessageInfo
+
+func (m *AuthRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *AuthRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword      string       `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	Password             string       `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	PasswordConfirmation string       `protobuf:"bytes,3,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
+	Kdf                  *KeystoreKdf `protobuf:"bytes,4,opt,name=kdf,proto3" json:"kdf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{22}
+}
+
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
+}
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(m, src)
+}
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordRequest.Size(m)
+}
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
+
+func (m *ChangePasswordRequest) GetCurrentPassword() string {
+	if m != nil {
+		return m.CurrentPassword
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetPasswordConfirmation() string {
+	if m != nil {
+		return m.PasswordConfirmation
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetKdf() *KeystoreKdf {
+	if m != nil {
+		return m.Kdf
+	}
+	return nil
+}
+
+type KeystoreKdf struct {
+	Function             string   `protobuf:"bytes,1,opt,name=function,proto3" json:"function,omitempty"`
+	ScryptN              uint64   `protobuf:"varint,2,opt,name=scrypt_n,json=scryptN,proto3" json:"scrypt_n,omitempty"`
+	ScryptR              uint64   `protobuf:"varint,3,opt,name=scrypt_r,json=scryptR,proto3" json:"scrypt_r,omitempty"`
+	ScryptP              uint64   `protobuf:"varint,4,opt,name=scrypt_p,json=scryptP,proto3" json:"scrypt_p,omitempty"`
+	Pbkdf2C              uint64   `protobuf:"varint,5,opt,name=pbkdf2_c,json=pbkdf2C,proto3" json:"pbkdf2_c,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeystoreKdf) Reset()         { *m = KeystoreKdf{} }
+func (m *KeystoreKdf) String() string { return proto.CompactTextString(m) }
+func (*KeystoreKdf) ProtoMessage()    {}
+func (*KeystoreKdf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{23}
+}
+
+func (m *KeystoreKdf) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeystoreKdf.Unmarshal(m, b)
+}
+func (m *KeystoreKdf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeystoreKdf.Marshal(b, m, deterministic)
+}
+func (m *KeystoreKdf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeystoreKdf.Merge(m, src)
+}
+func (m *KeystoreKdf) XXX_Size() int {
+	return xxx_messageInfo_KeystoreKdf.Size(m)
+}
+func (m *KeystoreKdf) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeystoreKdf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeystoreKdf proto.InternalMessageInfo
+
+func (m *KeystoreKdf) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *KeystoreKdf) GetScryptN() uint64 {
+	if m != nil {
+		return m.ScryptN
+	}
+	return 0
+}
+
+func (m *KeystoreKdf) GetScryptR() uint64 {
+	if m != nil {
+		return m.ScryptR
+	}
+	return 0
+}
+
+func (m *KeystoreKdf) GetScryptP() uint64 {
+	if m != nil {
+		return m.ScryptP
+	}
+	return 0
+}
+
+func (m *KeystoreKdf) GetPbkdf2C() uint64 {
+	if m != nil {
+		return m.Pbkdf2C
+	}
+	return 0
+}
+
+type AuthResponse struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	TokenExpiration      uint64   `protobuf:"varint,2,opt,name=token_expiration,json=tokenExpiration,proto3" json:"token_expiration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthResponse) Reset()         { *m = AuthResponse{} }
+func (m *AuthResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthResponse) ProtoMessage()    {}
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{24}
+}
+
+func (m *AuthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthResponse.Unmarshal(m, b)
+}
+func (m *AuthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthResponse.Marshal(b, m, deterministic)
+}
+func (m *AuthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthResponse.Merge(m, src)
+}
+func (m *AuthResponse) XXX_Size() int {
+	return xxx_messageInfo_AuthResponse.Size(m)
+}
+func (m *AuthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthResponse proto.InternalMessageInfo
+
+func (m *AuthResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *AuthResponse) GetTokenExpiration() uint64 {
+	if m != nil {
+		return m.TokenExpiration
+	}
+	return 0
+}
+
+type NodeConnectionResponse struct {
+	BeaconNodeEndpoint     string   `protobuf:"bytes,1,opt,name=beacon_node_endpoint,json=beaconNodeEndpoint,proto3" json:"beacon_node_endpoint,omitempty"`
+	Connected              bool     `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	Syncing                bool     `protobuf:"varint,3,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	GenesisTime            uint64   `protobuf:"varint,4,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	DepositContractAddress []byte   `protobuf:"bytes,5,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *NodeConnectionResponse) Reset()         { *m = NodeConnectionResponse{} }
+func (m *NodeConnectionResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeConnectionResponse) ProtoMessage()    {}
+func (*NodeConnectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{25}
+}
+
+func (m *NodeConnectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeConnectionResponse.Unmarshal(m, b)
+}
+func (m *NodeConnectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeConnectionResponse.Marshal(b, m, deterministic)
+}
+func (m *NodeConnectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeConnectionResponse.Merge(m, src)
+}
+func (m *NodeConnectionResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeConnectionResponse.Size(m)
+}
+func (m *NodeConnectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeConnectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeConnectionResponse proto.InternalMessageInfo
+
+func (m *NodeConnectionResponse) GetBeaconNodeEndpoint() string {
+	if m != nil {
+		return m.BeaconNodeEndpoint
+	}
+	return ""
+}
+
+func (m *NodeConnectionResponse) GetConnected() bool {
+	if m != nil {
+		return m.Connected
+	}
+	return false
+}
+
+func (m *NodeConnectionResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *NodeConnectionResponse) GetGenesisTime() uint64 {
+	if m != nil {
+		return m.GenesisTime
+	}
+	return 0
+}
+
+func (m *NodeConnectionResponse) GetDepositContractAddress() []byte {
+	if m != nil {
+		return m.DepositContractAddress
+	}
+	return nil
+}
+
+type ValidatorEvent struct {
+	// Types that are valid to be assigned to Event:
+	//	*ValidatorEvent_DutyAssignment
+	//	*ValidatorEvent_DutyOutcome
+	//	*ValidatorEvent_EpochPerformance
+	Event                isValidatorEvent_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ValidatorEvent) Reset()         { *m = ValidatorEvent{} }
+func (m *ValidatorEvent) String() string { return proto.CompactTextString(m) }
+func (*ValidatorEvent) ProtoMessage()    {}
+func (*ValidatorEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{26}
+}
+
+func (m *ValidatorEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorEvent.Unmarshal(m, b)
+}
+func (m *ValidatorEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorEvent.Marshal(b, m, deterministic)
+}
+func (m *ValidatorEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorEvent.Merge(m, src)
+}
+func (m *ValidatorEvent) XXX_Size() int {
+	return xxx_messageInfo_ValidatorEvent.Size(m)
+}
+func (m *ValidatorEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorEvent proto.InternalMessageInfo
+
+type isValidatorEvent_Event interface {
+	isValidatorEvent_Event()
+}
+
+type ValidatorEvent_DutyAssignment struct {
+	DutyAssignment *DutyAssignment `protobuf:"bytes,1,opt,name=duty_assignment,json=dutyAssignment,proto3,oneof" json:"duty_assignment,omitempty"`
+}
+type ValidatorEvent_DutyOutcome struct {
+	DutyOutcome *DutyOutcome `protobuf:"bytes,2,opt,name=duty_outcome,json=dutyOutcome,proto3,oneof" json:"duty_outcome,omitempty"`
+}
+type ValidatorEvent_EpochPerformance struct {
+	EpochPerformance *EpochPerformance `protobuf:"bytes,3,opt,name=epoch_performance,json=epochPerformance,proto3,oneof" json:"epoch_performance,omitempty"`
+}
+
+func (*ValidatorEvent_DutyAssignment) isValidatorEvent_Event()   {}
+func (*ValidatorEvent_DutyOutcome) isValidatorEvent_Event()      {}
+func (*ValidatorEvent_EpochPerformance) isValidatorEvent_Event() {}
+
+func (m *ValidatorEvent) GetEvent() isValidatorEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *ValidatorEvent) GetDutyAssignment() *DutyAssignment {
+	if x, ok := m.GetEvent().(*ValidatorEvent_DutyAssignment); ok {
+		return x.DutyAssignment
+	}
+	return nil
+}
+
+func (m *ValidatorEvent) GetDutyOutcome() *DutyOutcome {
+	if x, ok := m.GetEvent().(*ValidatorEvent_DutyOutcome); ok {
+		return x.DutyOutcome
+	}
+	return nil
+}
+
+func (m *ValidatorEvent) GetEpochPerformance() *EpochPerformance {
+	if x, ok := m.GetEvent().(*ValidatorEvent_EpochPerformance); ok {
+		return x.EpochPerformance
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ValidatorEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ValidatorEvent_DutyAssignment)(nil),
+		(*ValidatorEvent_DutyOutcome)(nil),
+		(*ValidatorEvent_EpochPerformance)(nil),
+	}
+}
+
+type DutyAssignment struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ValidatorIndex       uint64   `protobuf:"varint,3,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	AttesterSlot         uint64   `protobuf:"varint,4,opt,name=attester_slot,json=attesterSlot,proto3" json:"attester_slot,omitempty"`
+	CommitteeIndex       uint64   `protobuf:"varint,5,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	ProposerSlots        []uint64 `protobuf:"varint,6,rep,packed,name=proposer_slots,json=proposerSlots,proto3" json:"proposer_slots,omitempty"`
+	Status               string   `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DutyAssignment) Reset()         { *m = DutyAssignment{} }
+func (m *DutyAssignment) String() string { return proto.CompactTextString(m) }
+func (*DutyAssignment) ProtoMessage()    {}
+func (*DutyAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{27}
+}
+
+func (m *DutyAssignment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DutyAssignment.Unmarshal(m, b)
+}
+func (m *DutyAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DutyAssignment.Marshal(b, m, deterministic)
+}
+func (m *DutyAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutyAssignment.Merge(m, src)
+}
+func (m *DutyAssignment) XXX_Size() int {
+	return xxx_messageInfo_DutyAssignment.Size(m)
+}
+func (m *DutyAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutyAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutyAssignment proto.InternalMessageInfo
+
+func (m *DutyAssignment) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *DutyAssignment) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *DutyAssignment) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *DutyAssignment) GetAttesterSlot() uint64 {
+	if m != nil {
+		return m.AttesterSlot
+	}
+	return 0
+}
+
+func (m *DutyAssignment) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *DutyAssignment) GetProposerSlots() []uint64 {
+	if m != nil {
+		return m.ProposerSlots
+	}
+	return nil
+}
+
+func (m *DutyAssignment) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type DutyOutcome struct {
+	PublicKey            []byte              `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Slot                 uint64              `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Duty                 DutyOutcome_Duty    `protobuf:"varint,3,opt,name=duty,proto3,enum=ethereum.validator.accounts.v2.DutyOutcome_Duty" json:"duty,omitempty"`
+	Outcome              DutyOutcome_Outcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=ethereum.validator.accounts.v2.DutyOutcome_Outcome" json:"outcome,omitempty"`
+	Error                string              `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DutyOutcome) Reset()         { *m = DutyOutcome{} }
+func (m *DutyOutcome) String() string { return proto.CompactTextString(m) }
+func (*DutyOutcome) ProtoMessage()    {}
+func (*DutyOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{28}
+}
+
+func (m *DutyOutcome) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DutyOutcome.Unmarshal(m, b)
+}
+func (m *DutyOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DutyOutcome.Marshal(b, m, deterministic)
+}
+func (m *DutyOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutyOutcome.Merge(m, src)
+}
+func (m *DutyOutcome) XXX_Size() int {
+	return xxx_messageInfo_DutyOutcome.Size(m)
+}
+func (m *DutyOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutyOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutyOutcome proto.InternalMessageInfo
+
+func (m *DutyOutcome) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *DutyOutcome) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *DutyOutcome) GetDuty() DutyOutcome_Duty {
+	if m != nil {
+		return m.Duty
+	}
+	return DutyOutcome_ATTESTATION
+}
+
+func (m *DutyOutcome) GetOutcome() DutyOutcome_Outcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return DutyOutcome_SUCCEEDED
+}
+
+func (m *DutyOutcome) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type EpochPerformance struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BalanceBefore        uint64   `protobuf:"varint,3,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter         uint64   `protobuf:"varint,4,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	CorrectlyVotedSource bool     `protobuf:"varint,5,opt,name=correctly_voted_source,json=correctlyVotedSource,proto3" json:"correctly_voted_source,omitempty"`
+	CorrectlyVotedTarget bool     `protobuf:"varint,6,opt,name=correctly_voted_target,json=correctlyVotedTarget,proto3" json:"correctly_voted_target,omitempty"`
+	CorrectlyVotedHead   bool     `protobuf:"varint,7,opt,name=correctly_voted_head,json=correctlyVotedHead,proto3" json:"correctly_voted_head,omitempty"`
+	InclusionSlot        uint64   `protobuf:"varint,8,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	InclusionDistance    uint64   `protobuf:"varint,9,opt,name=inclusion_distance,json=inclusionDistance,proto3" json:"inclusion_distance,omitempty"`
+	Effectiveness        float64  `protobuf:"fixed64,10,opt,name=effectiveness,proto3" json:"effectiveness,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochPerformance) Reset()         { *m = EpochPerformance{} }
+func (m *EpochPerformance) String() string { return proto.CompactTextString(m) }
+func (*EpochPerformance) ProtoMessage()    {}
+func (*EpochPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{29}
+}
+
+func (m *EpochPerformance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochPerformance.Unmarshal(m, b)
+}
+func (m *EpochPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochPerformance.Marshal(b, m, deterministic)
+}
+func (m *EpochPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochPerformance.Merge(m, src)
+}
+func (m *EpochPerformance) XXX_Size() int {
+	return xxx_messageInfo_EpochPerformance.Size(m)
+}
+func (m *EpochPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochPerformance proto.InternalMessageInfo
+
+func (m *EpochPerformance) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *EpochPerformance) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetBalanceBefore() uint64 {
+	if m != nil {
+		return m.BalanceBefore
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetBalanceAfter() uint64 {
+	if m != nil {
+		return m.BalanceAfter
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetCorrectlyVotedSource() bool {
+	if m != nil {
+		return m.CorrectlyVotedSource
+	}
+	return false
+}
+
+func (m *EpochPerformance) GetCorrectlyVotedTarget() bool {
+	if m != nil {
+		return m.CorrectlyVotedTarget
+	}
+	return false
+}
+
+func (m *EpochPerformance) GetCorrectlyVotedHead() bool {
+	if m != nil {
+		return m.CorrectlyVotedHead
+	}
+	return false
+}
+
+func (m *EpochPerformance) GetInclusionSlot() uint64 {
+	if m != nil {
+		return m.InclusionSlot
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetInclusionDistance() uint64 {
+	if m != nil {
+		return m.InclusionDistance
+	}
+	return 0
+}
+
+func (m *EpochPerformance) GetEffectiveness() float64 {
+	if m != nil {
+		return m.Effectiveness
+	}
+	return 0
+}
+
+type StreamLogsRequest struct {
+	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Backfill             uint64   `protobuf:"varint,2,opt,name=backfill,proto3" json:"backfill,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamLogsRequest) Reset()         { *m = StreamLogsRequest{} }
+func (m *StreamLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamLogsRequest) ProtoMessage()    {}
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{30}
+}
+
+func (m *StreamLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamLogsRequest.Unmarshal(m, b)
+}
+func (m *StreamLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamLogsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamLogsRequest.Merge(m, src)
+}
+func (m *StreamLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamLogsRequest.Size(m)
+}
+func (m *StreamLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamLogsRequest proto.InternalMessageInfo
+
+func (m *StreamLogsRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *StreamLogsRequest) GetBackfill() uint64 {
+	if m != nil {
+		return m.Backfill
+	}
+	return 0
+}
+
+type LogEntry struct {
+	Timestamp            int64             `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Level                string            `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Message              string            `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Fields               map[string]string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LogEntry) Reset()         { *m = LogEntry{} }
+func (m *LogEntry) String() string { return proto.CompactTextString(m) }
+func (*LogEntry) ProtoMessage()    {}
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{31}
+}
+
+func (m *LogEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogEntry.Unmarshal(m, b)
+}
+func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogEntry.Marshal(b, m, deterministic)
+}
+func (m *LogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntry.Merge(m, src)
+}
+func (m *LogEntry) XXX_Size() int {
+	return xxx_messageInfo_LogEntry.Size(m)
+}
+func (m *LogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogEntry proto.InternalMessageInfo
+
+func (m *LogEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *LogEntry) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *LogEntry) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *LogEntry) GetFields() map[string]string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type CreateUserRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
+func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateUserRequest) ProtoMessage()    {}
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{32}
+}
+
+func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateUserRequest.Unmarshal(m, b)
+}
+func (m *CreateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateUserRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserRequest.Merge(m, src)
+}
+func (m *CreateUserRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateUserRequest.Size(m)
+}
+func (m *CreateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateUserRequest proto.InternalMessageInfo
+
+func (m *CreateUserRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CreateUserRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *CreateUserRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type User struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	WalletOwner          bool     `protobuf:"varint,3,opt,name=wallet_owner,json=walletOwner,proto3" json:"wallet_owner,omitempty"`
+	CreatedAt            uint64   `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{33}
+}
+
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User.Unmarshal(m, b)
+}
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+}
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
+}
+func (m *User) XXX_Size() int {
+	return xxx_messageInfo_User.Size(m)
+}
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User proto.InternalMessageInfo
+
+func (m *User) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *User) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *User) GetWalletOwner() bool {
+	if m != nil {
+		return m.WalletOwner
+	}
+	return false
+}
+
+func (m *User) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	Users                []*User  `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
+func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{34}
+}
+
+func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersResponse.Unmarshal(m, b)
+}
+func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse.Merge(m, src)
+}
+func (m *ListUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUsersResponse.Size(m)
+}
+func (m *ListUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
+
+func (m *ListUsersResponse) GetUsers() []*User {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
+func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserRequest) ProtoMessage()    {}
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{35}
+}
+
+func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserRequest.Unmarshal(m, b)
+}
+func (m *DeleteUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserRequest.Merge(m, src)
+}
+func (m *DeleteUserRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserRequest.Size(m)
+}
+func (m *DeleteUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserRequest proto.InternalMessageInfo
+
+func (m *DeleteUserRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type SetUserRoleRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUserRoleRequest) Reset()         { *m = SetUserRoleRequest{} }
+func (m *SetUserRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetUserRoleRequest) ProtoMessage()    {}
+func (*SetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{36}
+}
+
+func (m *SetUserRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserRoleRequest.Unmarshal(m, b)
+}
+func (m *SetUserRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *SetUserRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserRoleRequest.Merge(m, src)
+}
+func (m *SetUserRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetUserRoleRequest.Size(m)
+}
+func (m *SetUserRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserRoleRequest proto.InternalMessageInfo
+
+func (m *SetUserRoleRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SetUserRoleRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type CreateApiTokenRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiresInSeconds     uint64   `protobuf:"varint,3,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateApiTokenRequest) Reset()         { *m = CreateApiTokenRequest{} }
+func (m *CreateApiTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateApiTokenRequest) ProtoMessage()    {}
+func (*CreateApiTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{37}
+}
+
+func (m *CreateApiTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiTokenRequest.Unmarshal(m, b)
+}
+func (m *CreateApiTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateApiTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiTokenRequest.Merge(m, src)
+}
+func (m *CreateApiTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateApiTokenRequest.Size(m)
+}
+func (m *CreateApiTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateApiTokenRequest proto.InternalMessageInfo
+
+func (m *CreateApiTokenRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateApiTokenRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *CreateApiTokenRequest) GetExpiresInSeconds() uint64 {
+	if m != nil {
+		return m.ExpiresInSeconds
+	}
+	return 0
+}
+
+type CreateApiTokenResponse struct {
+	Token                string    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ApiToken             *ApiToken `protobuf:"bytes,2,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateApiTokenResponse) Reset()         { *m = CreateApiTokenResponse{} }
+func (m *CreateApiTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateApiTokenResponse) ProtoMessage()    {}
+func (*CreateApiTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{38}
+}
+
+func (m *CreateApiTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiTokenResponse.Unmarshal(m, b)
+}
+func (m *CreateApiTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiTokenResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateApiTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiTokenResponse.Merge(m, src)
+}
+func (m *CreateApiTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateApiTokenResponse.Size(m)
+}
+func (m *CreateApiTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateApiTokenResponse proto.InternalMessageInfo
+
+func (m *CreateApiTokenResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *CreateApiTokenResponse) GetApiToken() *ApiToken {
+	if m != nil {
+		return m.ApiToken
+	}
+	return nil
+}
+
+type ApiToken struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedBy            string   `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt            uint64   `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt            uint64   `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Revoked              bool     `protobuf:"varint,7,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiToken) Reset()         { *m = ApiToken{} }
+func (m *ApiToken) String() string { return proto.CompactTextString(m) }
+func (*ApiToken) ProtoMessage()    {}
+func (*ApiToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{39}
+}
+
+func (m *ApiToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiToken.Unmarshal(m, b)
+}
+func (m *ApiToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiToken.Marshal(b, m, deterministic)
+}
+func (m *ApiToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiToken.Merge(m, src)
+}
+func (m *ApiToken) XXX_Size() int {
+	return xxx_messageInfo_ApiToken.Size(m)
+}
+func (m *ApiToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiToken proto.InternalMessageInfo
+
+func (m *ApiToken) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApiToken) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiToken) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ApiToken) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *ApiToken) GetCreatedAt() uint64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ApiToken) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *ApiToken) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+type ListApiTokensResponse struct {
+	Tokens               []*ApiToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListApiTokensResponse) Reset()         { *m = ListApiTokensResponse{} }
+func (m *ListApiTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*ListApiTokensResponse) ProtoMessage()    {}
+func (*ListApiTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{40}
+}
+
+func (m *ListApiTokensResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApiTokensResponse.Unmarshal(m, b)
+}
+func (m *ListApiTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApiTokensResponse.Marshal(b, m, deterministic)
+}
+func (m *ListApiTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApiTokensResponse.Merge(m, src)
+}
+func (m *ListApiTokensResponse) XXX_Size() int {
+	return xxx_messageInfo_ListApiTokensResponse.Size(m)
+}
+func (m *ListApiTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApiTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApiTokensResponse proto.InternalMessageInfo
+
+func (m *ListApiTokensResponse) GetTokens() []*ApiToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type RevokeApiTokenRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeApiTokenRequest) Reset()         { *m = RevokeApiTokenRequest{} }
+func (m *RevokeApiTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeApiTokenRequest) ProtoMessage()    {}
+func (*RevokeApiTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{41}
+}
+
+func (m *RevokeApiTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeApiTokenRequest.Unmarshal(m, b)
+}
+func (m *RevokeApiTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeApiTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeApiTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeApiTokenRequest.Merge(m, src)
+}
+func (m *RevokeApiTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeApiTokenRequest.Size(m)
+}
+func (m *RevokeApiTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeApiTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeApiTokenRequest proto.InternalMessageInfo
+
+func (m *RevokeApiTokenRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ListAuthEventsRequest struct {
+	Limit                uint64   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuthEventsRequest) Reset()         { *m = ListAuthEventsRequest{} }
+func (m *ListAuthEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuthEventsRequest) ProtoMessage()    {}
+func (*ListAuthEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{42}
+}
+
+func (m *ListAuthEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuthEventsRequest.Unmarshal(m, b)
+}
+func (m *ListAuthEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuthEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuthEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuthEventsRequest.Merge(m, src)
+}
+func (m *ListAuthEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuthEventsRequest.Size(m)
+}
+func (m *ListAuthEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuthEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuthEventsRequest proto.InternalMessageInfo
+
+func (m *ListAuthEventsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AuthEvent struct {
+	Time                 uint64   `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Username             string   `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	TokenId              string   `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Method               string   `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Details              string   `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthEvent) Reset()         { *m = AuthEvent{} }
+func (m *AuthEvent) String() string { return proto.CompactTextString(m) }
+func (*AuthEvent) ProtoMessage()    {}
+func (*AuthEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{43}
+}
+
+func (m *AuthEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthEvent.Unmarshal(m, b)
+}
+func (m *AuthEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthEvent.Marshal(b, m, deterministic)
+}
+func (m *AuthEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthEvent.Merge(m, src)
+}
+func (m *AuthEvent) XXX_Size() int {
+	return xxx_messageInfo_AuthEvent.Size(m)
+}
+func (m *AuthEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthEvent proto.InternalMessageInfo
+
+func (m *AuthEvent) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *AuthEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AuthEvent) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AuthEvent) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *AuthEvent) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuthEvent) GetDetails() string {
+	if m != nil {
+		return m.Details
+	}
+	return ""
+}
+
+type ListAuthEventsResponse struct {
+	Events               []*AuthEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListAuthEventsResponse) Reset()         { *m = ListAuthEventsResponse{} }
+func (m *ListAuthEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuthEventsResponse) ProtoMessage()    {}
+func (*ListAuthEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a5153635bfe042e, []int{44}
+}
+
+func (m *ListAuthEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuthEventsResponse.Unmarshal(m, b)
+}
+func (m *ListAuthEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuthEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAuthEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuthEventsResponse.Merge(m, src)
+}
+func (m *ListAuthEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuthEventsResponse.Size(m)
+}
+func (m *ListAuthEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuthEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuthEventsResponse proto.InternalMessageInfo
+
+func (m *ListAuthEventsResponse) GetEvents() []*AuthEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.validator.accounts.v2.CreateWalletRequest_KeymanagerKind", CreateWalletRequest_KeymanagerKind_name, CreateWalletRequest_KeymanagerKind_value)
+	proto.RegisterEnum("ethereum.validator.accounts.v2.DutyOutcome_Duty", DutyOutcome_Duty_name, DutyOutcome_Duty_value)
+	proto.RegisterEnum("ethereum.validator.accounts.v2.DutyOutcome_Outcome", DutyOutcome_Outcome_name, DutyOutcome_Outcome_value)
+	proto.RegisterType((*CreateWalletRequest)(nil), "ethereum.validator.accounts.v2.CreateWalletRequest")
+	proto.RegisterType((*EditWalletConfigRequest)(nil), "ethereum.validator.accounts.v2.EditWalletConfigRequest")
+	proto.RegisterType((*GenerateMnemonicResponse)(nil), "ethereum.validator.accounts.v2.GenerateMnemonicResponse")
+	proto.RegisterType((*RecoverWalletRequest)(nil), "ethereum.validator.accounts.v2.RecoverWalletRequest")
+	proto.RegisterType((*WalletResponse)(nil), "ethereum.validator.accounts.v2.WalletResponse")
+	proto.RegisterType((*WalletResponse_KeymanagerConfig)(nil), "ethereum.validator.accounts.v2.WalletResponse.KeymanagerConfig")
+	proto.RegisterMapType((map[string]string)(nil), "ethereum.validator.accounts.v2.WalletResponse.KeymanagerConfig.ConfigsEntry")
+	proto.RegisterType((*CreateAccountResponse)(nil), "ethereum.validator.accounts.v2.CreateAccountResponse")
+	proto.RegisterType((*ListAccountsRequest)(nil), "ethereum.validator.accounts.v2.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "ethereum.validator.accounts.v2.ListAccountsResponse")
+	proto.RegisterType((*Account)(nil), "ethereum.validator.accounts.v2.Account")
+	proto.RegisterType((*AccountRequest)(nil), "ethereum.validator.accounts.v2.AccountRequest")
+	proto.RegisterType((*ImportKeystoresRequest)(nil), "ethereum.validator.accounts.v2.ImportKeystoresRequest")
+	proto.RegisterType((*ImportKeystoresResponse)(nil), "ethereum.validator.accounts.v2.ImportKeystoresResponse")
+	proto.RegisterType((*DeleteAccountsRequest)(nil), "ethereum.validator.accounts.v2.DeleteAccountsRequest")
+	proto.RegisterType((*DeleteAccountsResponse)(nil), "ethereum.validator.accounts.v2.DeleteAccountsResponse")
+	proto.RegisterType((*BackupAccountsRequest)(nil), "ethereum.validator.accounts.v2.BackupAccountsRequest")
+	proto.RegisterType((*BackupAccountsResponse)(nil), "ethereum.validator.accounts.v2.BackupAccountsResponse")
+	proto.RegisterType((*VoluntaryExitRequest)(nil), "ethereum.validator.accounts.v2.VoluntaryExitRequest")
+	proto.RegisterType((*VoluntaryExitResponse)(nil), "ethereum.validator.accounts.v2.VoluntaryExitResponse")
+	proto.RegisterType((*ListValidatingKeysResponse)(nil), "ethereum.validator.accounts.v2.ListValidatingKeysResponse")
+	proto.RegisterType((*ValidatingKey)(nil), "ethereum.validator.accounts.v2.ValidatingKey")
+	proto.RegisterMapType((map[string]string)(nil), "ethereum.validator.accounts.v2.ValidatingKey.LabelsEntry")
+	proto.RegisterType((*SetValidatingKeyEnabledRequest)(nil), "ethereum.validator.accounts.v2.SetValidatingKeyEnabledRequest")
+	proto.RegisterType((*AuthRequest)(nil), "ethereum.validator.accounts.v2.AuthRequest")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "ethereum.validator.accounts.v2.ChangePasswordRequest")
+	proto.RegisterType((*KeystoreKdf)(nil), "ethereum.validator.accounts.v2.KeystoreKdf")
+	proto.RegisterType((*AuthResponse)(nil), "ethereum.validator.accounts.v2.AuthResponse")
+	proto.RegisterType((*NodeConnectionResponse)(nil), "ethereum.validator.accounts.v2.NodeConnectionResponse")
+	proto.RegisterType((*ValidatorEvent)(nil), "ethereum.validator.accounts.v2.ValidatorEvent")
+	proto.RegisterType((*DutyAssignment)(nil), "ethereum.validator.accounts.v2.DutyAssignment")
+	proto.RegisterType((*DutyOutcome)(nil), "ethereum.validator.accounts.v2.DutyOutcome")
+	proto.RegisterType((*EpochPerformance)(nil), "ethereum.validator.accounts.v2.EpochPerformance")
+	proto.RegisterType((*StreamLogsRequest)(nil), "ethereum.validator.accounts.v2.StreamLogsRequest")
+	proto.RegisterType((*LogEntry)(nil), "ethereum.validator.accounts.v2.LogEntry")
+	proto.RegisterMapType((map[string]string)(nil), "ethereum.validator.accounts.v2.LogEntry.FieldsEntry")
+	proto.RegisterType((*CreateUserRequest)(nil), "ethereum.validator.accounts.v2.CreateUserRequest")
+	proto.RegisterType((*User)(nil), "ethereum.validator.accounts.v2.User")
+	proto.RegisterType((*ListUsersResponse)(nil), "ethereum.validator.accounts.v2.ListUsersResponse")
+	proto.RegisterType((*DeleteUserRequest)(nil), "ethereum.validator.accounts.v2.DeleteUserRequest")
+	proto.RegisterType((*SetUserRoleRequest)(nil), "ethereum.validator.accounts.v2.SetUserRoleRequest")
+	proto.RegisterType((*CreateApiTokenRequest)(nil), "ethereum.validator.accounts.v2.CreateApiTokenRequest")
+	proto.RegisterType((*CreateApiTokenResponse)(nil), "ethereum.validator.accounts.v2.CreateApiTokenResponse")
+	proto.RegisterType((*ApiToken)(nil), "ethereum.validator.accounts.v2.ApiToken")
+	proto.RegisterType((*ListApiTokensResponse)(nil), "ethereum.validator.accounts.v2.ListApiTokensResponse")
+	proto.RegisterType((*RevokeApiTokenRequest)(nil), "ethereum.validator.accounts.v2.RevokeApiTokenRequest")
+	proto.RegisterType((*ListAuthEventsRequest)(nil), "ethereum.validator.accounts.v2.ListAuthEventsRequest")
+	proto.RegisterType((*AuthEvent)(nil), "ethereum.validator.accounts.v2.AuthEvent")
+	proto.RegisterType((*ListAuthEventsResponse)(nil), "ethereum.validator.accounts.v2.ListAuthEventsResponse")
+}
+
+func init() {
+	proto.RegisterFile("proto/validator/accounts/v2/web_api.proto", fileDescriptor_8a5153635bfe042e)
+}
+
+var fileDescriptor_8a5153635bfe042e = []byte{
+	// 3181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x73, 0x1b, 0xc7,
+	0xb1, 0xd7, 0x82, 0x20, 0x01, 0x34, 0x48, 0x08, 0x1c, 0x91, 0x14, 0x04, 0xc9, 0x16, 0x35, 0xb6,
+	0x2c, 0xda, 0xb2, 0x00, 0x1b, 0xb2, 0x65, 0x59, 0xaf, 0xde, 0x07, 0x05, 0xc0, 0x12, 0x1f, 0x29,
+	0x91, 0x6f, 0x49, 0xeb, 0x95, 0x9e, 0x0f, 0x5b, 0x4b, 0xec, 0x00, 0xdc, 0xe2, 0x62, 0x17, 0x6f,
+	0x77, 0x40, 0x09, 0xae, 0x94, 0x0f, 0xae, 0x54, 0x92, 0x83, 0x9d, 0x4b, 0x92, 0x72, 0x52, 0x49,
+	0xe5, 0xe0, 0x4a, 0x95, 0x2b, 0xa9, 0xe4, 0x90, 0x43, 0xbe, 0xfe, 0x8d, 0xe4, 0x92, 0x5b, 0x2a,
+	0x55, 0xf9, 0x0b, 0x7c, 0xcd, 0x25, 0x35, 0x5f, 0x8b, 0x5d, 0x10, 0xe0, 0x02, 0x4a, 0xe5, 0x04,
+	0x4c, 0xf7, 0x74, 0xcf, 0x6f, 0x7a, 0x7a, 0x7a, 0xba, 0x7b, 0xe1, 0xf5, 0x9e, 0xef, 0x51, 0xaf,
+	0x7a, 0x62, 0x3a, 0xb6, 0x65, 0x52, 0xcf, 0xaf, 0x9a, 0xad, 0x96, 0xd7, 0x77, 0x69, 0x50, 0x3d,
+	0xa9, 0x55, 0x9f, 0x91, 0x43, 0xc3, 0xec, 0xd9, 0x15, 0x3e, 0x07, 0xbd, 0x4c, 0xe8, 0x11, 0xf1,
+	0x49, 0xbf, 0x5b, 0x09, 0x67, 0x57, 0xd4, 0xec, 0xca, 0x49, 0xad, 0x7c, 0xa5, 0xe3, 0x79, 0x1d,
+	0x87, 0x54, 0xcd, 0x9e, 0x5d, 0x35, 0x5d, 0xd7, 0xa3, 0x26, 0xb5, 0x3d, 0x37, 0x10, 0xd2, 0xe5,
+	0xcb, 0x92, 0xcb, 0x47, 0x87, 0xfd, 0x76, 0x95, 0x74, 0x7b, 0x74, 0x20, 0x98, 0xf8, 0x37, 0x69,
+	0xb8, 0x50, 0xf7, 0x89, 0x49, 0xc9, 0xff, 0x9a, 0x8e, 0x43, 0xa8, 0x4e, 0xfe, 0xbf, 0x4f, 0x02,
+	0x8a, 0xae, 0x42, 0xfe, 0x19, 0x27, 0x18, 0x3d, 0x93, 0x1e, 0x95, 0xb4, 0x75, 0x6d, 0x23, 0xa7,
+	0x83, 0x20, 0xed, 0x99, 0xf4, 0x08, 0x1d, 0x02, 0x1c, 0x93, 0x41, 0xd7, 0x74, 0xcd, 0x0e, 0xf1,
+	0x4b, 0xa9, 0x75, 0x6d, 0xa3, 0x50, 0xbb, 0x5f, 0x39, 0x1b, 0x68, 0x65, 0xcc, 0x4a, 0x95, 0xed,
+	0x50, 0xcb, 0xb6, 0xed, 0x5a, 0x7a, 0x44, 0x2b, 0xba, 0x01, 0xe7, 0x43, 0x10, 0x41, 0xf0, 0xcc,
+	0xf3, 0xad, 0xd2, 0x1c, 0x07, 0x52, 0x50, 0x40, 0x04, 0x15, 0x95, 0x21, 0xdb, 0x75, 0x49, 0xd7,
+	0x73, 0xed, 0x56, 0x29, 0xcd, 0x67, 0x84, 0x63, 0x74, 0x0d, 0x16, 0xdd, 0x7e, 0xd7, 0x50, 0x30,
+	0x4a, 0xf3, 0xeb, 0xda, 0x46, 0x5a, 0xcf, 0xbb, 0xfd, 0xee, 0xa6, 0x24, 0xa1, 0x5b, 0x80, 0x8e,
+	0xc9, 0x20, 0xa0, 0x9e, 0x4f, 0x02, 0xc3, 0xee, 0xf6, 0x3c, 0x9f, 0x12, 0xab, 0xb4, 0xb0, 0x3e,
+	0xb7, 0x91, 0xd3, 0x97, 0x43, 0xce, 0x96, 0x64, 0xc4, 0xa7, 0x87, 0xc8, 0x32, 0xeb, 0x5a, 0x6c,
+	0x7a, 0x08, 0xee, 0x2a, 0xe4, 0x7d, 0xd2, 0xf5, 0x28, 0x31, 0x4c, 0xcb, 0xf2, 0x4b, 0x59, 0x61,
+	0x4a, 0x41, 0xda, 0xb4, 0x2c, 0x1f, 0xbd, 0x06, 0xe7, 0xe5, 0x84, 0x96, 0x2f, 0xed, 0x9d, 0xe3,
+	0x93, 0x96, 0x04, 0xb9, 0xee, 0x0b, 0x93, 0x0f, 0xe7, 0x1d, 0x93, 0x81, 0x98, 0x07, 0xd1, 0x79,
+	0xdb, 0x64, 0xc0, 0xe7, 0xdd, 0x04, 0xa4, 0xf4, 0x99, 0x43, 0x95, 0x79, 0x3e, 0x55, 0x6a, 0xa8,
+	0x9b, 0x52, 0x29, 0x7e, 0x17, 0x0a, 0xf1, 0x13, 0x40, 0x79, 0xc8, 0x34, 0x9a, 0xfa, 0xd6, 0x93,
+	0x66, 0xa3, 0x78, 0x0e, 0x01, 0x2c, 0x34, 0xb6, 0xf4, 0x66, 0xfd, 0xa0, 0xa8, 0xb1, 0xff, 0x7a,
+	0xf3, 0xd1, 0xee, 0x41, 0xb3, 0x98, 0xc2, 0xbf, 0xd3, 0xe0, 0x62, 0xd3, 0xb2, 0xa9, 0x38, 0xcb,
+	0xba, 0xe7, 0xb6, 0xed, 0x4e, 0xc4, 0x77, 0xa2, 0x1b, 0xd6, 0xa6, 0xd9, 0x70, 0x6a, 0xca, 0x0d,
+	0xcf, 0x4d, 0xbf, 0xe1, 0xf4, 0xf8, 0x0d, 0xdf, 0x81, 0xd2, 0x03, 0xe2, 0x12, 0xdf, 0xa4, 0xe4,
+	0x91, 0xf4, 0x11, 0x9d, 0x04, 0x3d, 0xcf, 0x0d, 0x48, 0xcc, 0x8f, 0xb4, 0xb8, 0x1f, 0xe1, 0x4f,
+	0x60, 0x45, 0x27, 0x2d, 0xef, 0x84, 0xf8, 0xf1, 0x9b, 0x72, 0x86, 0xcc, 0x29, 0xdf, 0x4b, 0x9d,
+	0xf6, 0xbd, 0x69, 0x7d, 0x1c, 0xff, 0x39, 0x05, 0x05, 0xb5, 0xb2, 0x84, 0x9b, 0x78, 0x49, 0x1d,
+	0x58, 0x1e, 0x5e, 0x27, 0xa3, 0xc5, 0x4f, 0x89, 0x83, 0xc8, 0xd7, 0xfe, 0x33, 0xe9, 0xae, 0xc6,
+	0xd7, 0x8a, 0x5c, 0x53, 0x79, 0xd8, 0xc5, 0xe3, 0x11, 0x4a, 0xf9, 0xb7, 0x1a, 0x14, 0x47, 0xa7,
+	0xa1, 0x36, 0x64, 0xc4, 0xba, 0x41, 0x49, 0x5b, 0x9f, 0xdb, 0xc8, 0xd7, 0x76, 0xfe, 0xc9, 0x85,
+	0x2b, 0xe2, 0x27, 0x68, 0xba, 0xd4, 0x1f, 0xe8, 0x4a, 0x79, 0xf9, 0x1e, 0x2c, 0x46, 0x19, 0xa8,
+	0x08, 0x73, 0xc7, 0x64, 0x20, 0x6d, 0xc2, 0xfe, 0xa2, 0x15, 0x98, 0x3f, 0x31, 0x9d, 0x3e, 0x91,
+	0xbe, 0x26, 0x06, 0xf7, 0x52, 0x77, 0x35, 0xfc, 0x7f, 0xb0, 0x2a, 0x22, 0x93, 0x3c, 0x95, 0xd0,
+	0xc0, 0x9b, 0x90, 0x91, 0xc8, 0xb8, 0xa2, 0x7c, 0xed, 0x46, 0x12, 0x78, 0xa5, 0x41, 0xc9, 0xe1,
+	0x06, 0x5c, 0xd8, 0xb1, 0x03, 0xaa, 0xce, 0x5b, 0x79, 0xcd, 0x2d, 0xb8, 0xd0, 0x21, 0xd4, 0xb0,
+	0x48, 0xcf, 0x0b, 0x6c, 0x6a, 0xd0, 0xe7, 0x86, 0x65, 0x52, 0x93, 0xaf, 0x92, 0xd5, 0x8b, 0x1d,
+	0x42, 0x1b, 0x82, 0x73, 0xf0, 0xbc, 0x61, 0x52, 0x13, 0x7f, 0x04, 0x2b, 0x71, 0x2d, 0x12, 0x60,
+	0x1d, 0xb2, 0xa1, 0x73, 0x09, 0xf3, 0x4e, 0x8d, 0x30, 0x14, 0xc4, 0xbf, 0xd6, 0x20, 0x23, 0xa9,
+	0xa8, 0x06, 0xab, 0x52, 0xcc, 0x76, 0x3b, 0x46, 0xaf, 0x7f, 0xe8, 0xd8, 0x2d, 0x43, 0x19, 0x72,
+	0x51, 0xbf, 0x30, 0x64, 0xee, 0x71, 0xde, 0x36, 0x19, 0x30, 0x2f, 0x97, 0xba, 0x0c, 0xd7, 0xec,
+	0x2a, 0xfb, 0xe6, 0x25, 0xed, 0xb1, 0xd9, 0x25, 0xec, 0x26, 0x8f, 0x6e, 0x75, 0x8e, 0x2b, 0x5c,
+	0xb2, 0xa2, 0xfb, 0x64, 0xb7, 0xc1, 0x22, 0xbe, 0x7d, 0xc2, 0x1f, 0xb0, 0xe8, 0x35, 0x2e, 0x0c,
+	0xc9, 0xfc, 0x16, 0x6f, 0x43, 0x21, 0x3c, 0xac, 0x30, 0xea, 0x0c, 0xe1, 0x0a, 0x6b, 0x2c, 0xea,
+	0xd0, 0x53, 0x28, 0x03, 0x54, 0x82, 0x8c, 0xed, 0x5a, 0x76, 0x8b, 0xb0, 0x7b, 0x38, 0xb7, 0x91,
+	0xd6, 0xd5, 0x10, 0x9f, 0xc0, 0x9a, 0x08, 0xee, 0xdb, 0x2a, 0x78, 0x0f, 0x8f, 0x69, 0xdc, 0xcb,
+	0xa0, 0xcd, 0xf6, 0x32, 0xa4, 0x26, 0xbc, 0x0c, 0x78, 0x1b, 0x2e, 0x9e, 0x5a, 0x57, 0x1e, 0xec,
+	0x5b, 0xb0, 0xa2, 0x96, 0x33, 0x4e, 0x6f, 0x0b, 0x29, 0x5e, 0x78, 0x08, 0x01, 0xbe, 0x0b, 0xab,
+	0x0d, 0xe2, 0x90, 0xd0, 0x89, 0x83, 0x69, 0x0d, 0x83, 0xff, 0x0d, 0xd6, 0x46, 0x25, 0x25, 0x8a,
+	0x6b, 0xb0, 0x68, 0x71, 0x8e, 0x15, 0x95, 0xcd, 0x4b, 0x1a, 0x17, 0x36, 0x61, 0xf5, 0xbe, 0xd9,
+	0x3a, 0xee, 0xf7, 0x66, 0x5d, 0x96, 0x9d, 0xf5, 0x21, 0x97, 0x1c, 0xb5, 0x54, 0x41, 0x90, 0x43,
+	0x33, 0xdd, 0x86, 0xb5, 0xd1, 0x25, 0x24, 0xbe, 0x4b, 0x90, 0xfd, 0xd8, 0xee, 0x19, 0x6d, 0xdb,
+	0x21, 0xd2, 0x41, 0x33, 0x1f, 0xdb, 0xbd, 0x0f, 0x6c, 0x87, 0xe0, 0xf7, 0x60, 0xe5, 0x89, 0xe7,
+	0xf4, 0x5d, 0x6a, 0xfa, 0x83, 0xe6, 0x73, 0x7b, 0x6a, 0x37, 0x61, 0x76, 0x1c, 0x11, 0x1c, 0x46,
+	0x5b, 0xf2, 0xdc, 0x1e, 0xb1, 0x05, 0x08, 0x12, 0x97, 0x34, 0xa0, 0xcc, 0x2e, 0xe9, 0x93, 0xf0,
+	0x8a, 0x30, 0x6a, 0x24, 0x96, 0xa4, 0x43, 0xb9, 0x7c, 0xed, 0x56, 0xd2, 0x35, 0x8d, 0x69, 0xd1,
+	0xb9, 0x28, 0xfe, 0x5a, 0x83, 0xa5, 0x18, 0x1d, 0xbd, 0x04, 0x70, 0xea, 0x8e, 0xe6, 0xc2, 0xcd,
+	0x30, 0x97, 0x27, 0xae, 0x79, 0xe8, 0x10, 0x61, 0xda, 0xac, 0xae, 0x86, 0xec, 0xd5, 0xea, 0xf8,
+	0x66, 0xbb, 0x6d, 0x53, 0x5b, 0xbe, 0x37, 0xe1, 0x18, 0xfd, 0x0f, 0x2c, 0x38, 0xe6, 0x21, 0x71,
+	0x82, 0x52, 0x9a, 0x63, 0x7d, 0x7f, 0x26, 0xac, 0x95, 0x1d, 0x2e, 0x2b, 0xc2, 0xb3, 0x54, 0x54,
+	0x7e, 0x1f, 0xf2, 0x11, 0xf2, 0x4c, 0xc1, 0xf9, 0x29, 0xbc, 0xbc, 0x4f, 0xe2, 0x46, 0x6d, 0x8a,
+	0x4d, 0xa8, 0x23, 0x7d, 0x51, 0x23, 0xe0, 0x26, 0xe4, 0x37, 0xfb, 0xf4, 0x28, 0xf2, 0x92, 0x87,
+	0x9e, 0x28, 0x5f, 0xf2, 0x5e, 0x24, 0xc3, 0xec, 0x07, 0xc4, 0x8f, 0xc4, 0xb7, 0x70, 0x8c, 0xff,
+	0xa8, 0xc1, 0x6a, 0xfd, 0xc8, 0x74, 0x3b, 0x44, 0xb9, 0xac, 0xd2, 0xf8, 0x3a, 0x14, 0x5b, 0x7d,
+	0xdf, 0x27, 0x2e, 0x35, 0x46, 0x34, 0x9f, 0x97, 0xf4, 0x68, 0x0a, 0x3b, 0x72, 0x0d, 0x86, 0x8b,
+	0xdf, 0x86, 0x55, 0xf5, 0x5f, 0x3c, 0xe2, 0x7e, 0x97, 0x47, 0x42, 0x79, 0x72, 0x2b, 0x8a, 0x59,
+	0x8f, 0xf0, 0xd0, 0xbf, 0xc3, 0xdc, 0xb1, 0xd5, 0xe6, 0xe1, 0x33, 0x5f, 0xbb, 0x99, 0x74, 0x84,
+	0x2a, 0x02, 0x6d, 0x5b, 0x6d, 0x9d, 0xc9, 0xe1, 0x1f, 0x68, 0x90, 0x8f, 0x10, 0x19, 0xbe, 0x76,
+	0xdf, 0x6d, 0xf1, 0x65, 0xa5, 0x71, 0xd4, 0x98, 0x5d, 0xc3, 0xa0, 0xe5, 0x0f, 0x7a, 0xd4, 0x70,
+	0x65, 0x8a, 0x93, 0x11, 0xe3, 0xc7, 0x11, 0x96, 0x5f, 0x9a, 0x8b, 0xb2, 0xf4, 0x08, 0xab, 0x57,
+	0x4a, 0x47, 0x59, 0x7b, 0x8c, 0xd5, 0x3b, 0x3c, 0xb6, 0xda, 0x35, 0xa3, 0x25, 0xf3, 0xf5, 0x8c,
+	0x18, 0xd7, 0xf1, 0x2e, 0x2c, 0x8a, 0x33, 0x93, 0xd7, 0x6a, 0x05, 0xe6, 0xa9, 0x77, 0x4c, 0x14,
+	0x28, 0x31, 0x60, 0x86, 0xe7, 0x7f, 0x0c, 0xf2, 0xbc, 0x67, 0xfb, 0xc2, 0x58, 0x02, 0xd9, 0x79,
+	0x4e, 0x6f, 0x86, 0x64, 0xfc, 0x17, 0x0d, 0xd6, 0x1e, 0x7b, 0x16, 0xa9, 0x7b, 0xae, 0x4b, 0xf8,
+	0x7e, 0xa2, 0x41, 0xf8, 0x90, 0x98, 0x2d, 0xcf, 0x35, 0x5c, 0xcf, 0x22, 0x06, 0x71, 0xad, 0x9e,
+	0x67, 0xcb, 0x5c, 0x20, 0xa7, 0x23, 0xc1, 0x63, 0xb2, 0x4d, 0xc9, 0x41, 0x57, 0x20, 0xd7, 0x12,
+	0x7a, 0x42, 0x6f, 0x1b, 0x12, 0x98, 0x27, 0x06, 0x03, 0xb7, 0x65, 0xbb, 0x1d, 0x6e, 0x8b, 0xac,
+	0xae, 0x86, 0x2c, 0xd0, 0x76, 0x88, 0x4b, 0x02, 0x3b, 0x30, 0xa8, 0xdd, 0x25, 0xd2, 0x1e, 0x79,
+	0x49, 0x3b, 0xb0, 0xbb, 0x04, 0xdd, 0x85, 0x92, 0x7a, 0x42, 0x5b, 0x9e, 0x4b, 0x7d, 0xb3, 0x45,
+	0x79, 0x7e, 0x4d, 0x02, 0x51, 0xd3, 0x2c, 0xea, 0x6b, 0x92, 0x5f, 0x97, 0xec, 0x4d, 0xc1, 0xc5,
+	0x5f, 0xa5, 0xa0, 0xf0, 0x44, 0x9d, 0x7a, 0xf3, 0x84, 0xb8, 0x14, 0x3d, 0x85, 0xf3, 0x56, 0x9f,
+	0x0e, 0x0c, 0x33, 0x08, 0xec, 0x8e, 0xdb, 0x25, 0x61, 0x82, 0x53, 0x49, 0x72, 0x94, 0x46, 0x9f,
+	0x0e, 0x36, 0x43, 0xa9, 0x87, 0xe7, 0xf4, 0x82, 0x15, 0xa3, 0xa0, 0x3d, 0x58, 0xe4, 0xaa, 0xbd,
+	0x3e, 0x6d, 0x79, 0xf2, 0xb6, 0x4c, 0xe1, 0x80, 0x4c, 0xef, 0xae, 0x10, 0x79, 0x78, 0x4e, 0xcf,
+	0x5b, 0xc3, 0x21, 0x32, 0x60, 0x99, 0xf4, 0xbc, 0xd6, 0x91, 0xd1, 0x23, 0x7e, 0xdb, 0xf3, 0xbb,
+	0xa6, 0xdb, 0x22, 0xdc, 0x80, 0xf9, 0xda, 0x5b, 0x49, 0x6a, 0x9b, 0x4c, 0x70, 0x6f, 0x28, 0xf7,
+	0xf0, 0x9c, 0x5e, 0x24, 0x23, 0xb4, 0xfb, 0x19, 0x98, 0x27, 0xcc, 0x2c, 0xf8, 0xef, 0x1a, 0x14,
+	0xe2, 0x1b, 0x4c, 0x0a, 0x2e, 0x2b, 0x30, 0xcf, 0xd5, 0x49, 0xef, 0x12, 0x03, 0xf6, 0xb4, 0x85,
+	0x70, 0x0c, 0xdb, 0xb5, 0xc8, 0x73, 0xe9, 0xfc, 0x85, 0x90, 0xbc, 0xc5, 0xa8, 0xe8, 0x15, 0x58,
+	0x32, 0x29, 0x25, 0x01, 0x25, 0xbe, 0x11, 0x38, 0x1e, 0x95, 0x07, 0xbf, 0xa8, 0x88, 0xfb, 0x8e,
+	0x47, 0x99, 0xb6, 0x96, 0xd7, 0xed, 0xda, 0x94, 0x12, 0x22, 0xb5, 0x89, 0x4b, 0x51, 0x08, 0xc9,
+	0x42, 0xdb, 0x75, 0x28, 0xf4, 0x7c, 0xaf, 0xe7, 0x05, 0x52, 0x5b, 0xc0, 0x6b, 0xd8, 0xb4, 0xbe,
+	0xa4, 0xa8, 0x4c, 0x5d, 0x80, 0xd6, 0x60, 0x21, 0xa0, 0x26, 0xed, 0x07, 0xb2, 0x66, 0x95, 0x23,
+	0xfc, 0x75, 0x0a, 0xf2, 0x91, 0x63, 0x48, 0xda, 0x3a, 0x82, 0x34, 0x87, 0x2c, 0x76, 0xce, 0xff,
+	0xa3, 0x06, 0xa4, 0xd9, 0xc9, 0xf1, 0xdd, 0x16, 0x92, 0x4f, 0x27, 0xb2, 0x1a, 0xff, 0xaf, 0x73,
+	0x69, 0xf4, 0x08, 0x32, 0xca, 0x7b, 0xd2, 0x5c, 0xd1, 0xed, 0x59, 0x14, 0xc9, 0x5f, 0x5d, 0xe9,
+	0xe0, 0x67, 0xe4, 0xfb, 0x9e, 0xcf, 0xad, 0x96, 0xd3, 0xc5, 0x00, 0xdf, 0x81, 0x34, 0x93, 0x42,
+	0xe7, 0x21, 0xbf, 0x79, 0x70, 0xd0, 0xdc, 0x3f, 0xd8, 0x3c, 0xd8, 0xda, 0x7d, 0x5c, 0x3c, 0x87,
+	0x16, 0x21, 0xbb, 0xa7, 0xef, 0xee, 0xed, 0xee, 0x6f, 0xee, 0x14, 0x35, 0xce, 0x7e, 0xf0, 0x40,
+	0x6f, 0x3e, 0x10, 0xec, 0x14, 0x7e, 0x04, 0x19, 0x65, 0xa0, 0x25, 0xc8, 0xed, 0x7f, 0x58, 0xaf,
+	0x37, 0x9b, 0x0d, 0x5e, 0x2b, 0xe7, 0x21, 0xb3, 0xbf, 0xbd, 0xb5, 0xb7, 0xd7, 0x6c, 0x14, 0x35,
+	0x54, 0x86, 0x35, 0xbd, 0xf9, 0xdf, 0xcd, 0xfa, 0x41, 0xb3, 0x61, 0xdc, 0x7f, 0x6a, 0xec, 0xe9,
+	0xbb, 0x07, 0xcd, 0xba, 0x50, 0xc1, 0x0a, 0xe9, 0x0f, 0x36, 0xb7, 0x76, 0x9a, 0x8d, 0xe2, 0x1c,
+	0xfe, 0xd5, 0x1c, 0x14, 0x47, 0x9d, 0xf4, 0xc5, 0x9c, 0xee, 0x3a, 0x14, 0x0e, 0x4d, 0x87, 0xc9,
+	0x1b, 0x87, 0xa4, 0xed, 0xf9, 0x44, 0xfa, 0xdc, 0x92, 0xa4, 0xde, 0xe7, 0x44, 0xe6, 0x72, 0x6a,
+	0x9a, 0xd9, 0xa6, 0xc4, 0x57, 0x2e, 0x27, 0x89, 0x9b, 0x8c, 0x86, 0xde, 0x81, 0xb5, 0x96, 0xe7,
+	0xfb, 0xa4, 0x45, 0x9d, 0x81, 0x71, 0xe2, 0xb1, 0xa4, 0x27, 0xf0, 0xfa, 0x7e, 0x8b, 0x70, 0x1b,
+	0x66, 0xf5, 0x95, 0x90, 0xfb, 0x84, 0x31, 0xf7, 0x39, 0x6f, 0x9c, 0x14, 0x35, 0xfd, 0x0e, 0xa1,
+	0xa5, 0x85, 0x71, 0x52, 0x07, 0x9c, 0xc7, 0xa2, 0xec, 0xa8, 0xd4, 0x11, 0x31, 0x45, 0x43, 0x25,
+	0xab, 0xa3, 0xb8, 0xcc, 0x43, 0x62, 0x5a, 0x6c, 0xa7, 0xb6, 0xdb, 0x72, 0xfa, 0x01, 0x2b, 0x12,
+	0xb8, 0x0f, 0x66, 0xc5, 0x4e, 0x43, 0x2a, 0xbf, 0x37, 0xb7, 0x00, 0x0d, 0xa7, 0x59, 0x76, 0x40,
+	0x79, 0xe0, 0xc8, 0xf1, 0xa9, 0xcb, 0x21, 0xa7, 0x21, 0x19, 0xe8, 0x55, 0x58, 0x22, 0xed, 0x36,
+	0x7b, 0x02, 0x4e, 0x88, 0xcb, 0xa2, 0x2a, 0x6b, 0xae, 0x68, 0x7a, 0x9c, 0x88, 0x9b, 0xb0, 0xbc,
+	0x4f, 0x7d, 0x62, 0x76, 0x77, 0xbc, 0x4e, 0x98, 0xeb, 0xae, 0xc0, 0xbc, 0x43, 0x4e, 0x88, 0xa3,
+	0x1e, 0x21, 0x3e, 0x60, 0x4f, 0x26, 0xcb, 0x64, 0xdb, 0xb6, 0xe3, 0xc8, 0x93, 0x0a, 0xc7, 0xf8,
+	0xaf, 0x1a, 0x64, 0x77, 0xbc, 0x8e, 0x48, 0x87, 0xae, 0x40, 0x8e, 0x45, 0xfd, 0x80, 0x9a, 0xdd,
+	0x1e, 0x57, 0x31, 0xa7, 0x0f, 0x09, 0x43, 0xe5, 0xa9, 0xa8, 0xf2, 0x12, 0x64, 0xba, 0x24, 0x08,
+	0xcc, 0x0e, 0x91, 0x59, 0x80, 0x1a, 0xa2, 0x1d, 0x58, 0x68, 0xdb, 0xc4, 0xb1, 0x54, 0xfa, 0xf6,
+	0x4e, 0xd2, 0xe5, 0x51, 0x38, 0x2a, 0x1f, 0x70, 0x31, 0x99, 0xb9, 0x09, 0x1d, 0x2c, 0x73, 0x8b,
+	0x90, 0x67, 0xca, 0xdc, 0x0c, 0x58, 0x16, 0x65, 0xf5, 0x87, 0x01, 0xf1, 0x23, 0x49, 0x56, 0x98,
+	0x48, 0x69, 0xf1, 0x44, 0xea, 0xcc, 0x1c, 0x08, 0x41, 0xda, 0xf7, 0x1c, 0xb5, 0x59, 0xfe, 0x1f,
+	0x3f, 0x87, 0x34, 0x53, 0x7d, 0xa6, 0x4e, 0x25, 0x97, 0x1a, 0xca, 0xb1, 0xd7, 0x56, 0xf6, 0x4d,
+	0xbc, 0x67, 0x2e, 0xf1, 0xe5, 0x63, 0x2c, 0x7b, 0x29, 0xbb, 0x8c, 0xc4, 0x6e, 0x60, 0x8b, 0x63,
+	0xb7, 0x0c, 0x53, 0x45, 0xe5, 0x9c, 0xa4, 0x6c, 0x52, 0xbc, 0x0b, 0xcb, 0x2c, 0xd5, 0x67, 0xab,
+	0x0f, 0x33, 0xfc, 0x7b, 0x30, 0xcf, 0x96, 0x55, 0x29, 0xfe, 0xab, 0x49, 0x76, 0xe7, 0x66, 0x11,
+	0x22, 0xb8, 0x0a, 0xcb, 0xa2, 0x06, 0x9b, 0xd2, 0x56, 0xb8, 0x01, 0x68, 0x9f, 0x70, 0x00, 0xba,
+	0xe7, 0x90, 0x69, 0xac, 0x3b, 0xc6, 0x12, 0xb8, 0x1b, 0x76, 0x3e, 0x7a, 0xf6, 0x01, 0x4b, 0x8c,
+	0x94, 0x22, 0x04, 0xe9, 0x88, 0x92, 0xf4, 0x44, 0x53, 0xbe, 0x09, 0x88, 0xa7, 0x58, 0xac, 0x3c,
+	0x76, 0x8d, 0x80, 0xb4, 0x3c, 0xd7, 0x0a, 0x64, 0xe0, 0x29, 0x4a, 0xce, 0x96, 0xbb, 0x2f, 0xe8,
+	0xb8, 0x0f, 0x6b, 0xa3, 0xcb, 0x9d, 0x99, 0xc6, 0x35, 0x21, 0x67, 0xf6, 0x6c, 0x43, 0x70, 0x44,
+	0x22, 0xb1, 0x91, 0xd8, 0xdf, 0x50, 0xaa, 0xb3, 0xa6, 0xfc, 0x87, 0xff, 0xa0, 0x41, 0x56, 0x91,
+	0x51, 0x01, 0x52, 0xb6, 0xca, 0xc2, 0x53, 0xb6, 0x15, 0xee, 0x34, 0x35, 0x66, 0xa7, 0x11, 0x67,
+	0x8b, 0x7a, 0xc4, 0xe1, 0x40, 0x76, 0x25, 0x94, 0x47, 0xdc, 0x1f, 0x8c, 0x38, 0xcc, 0xfc, 0x88,
+	0xc3, 0x30, 0xb6, 0xb2, 0x93, 0x29, 0xc2, 0x61, 0x5a, 0xcf, 0x49, 0xca, 0x26, 0x65, 0xb7, 0xd9,
+	0x27, 0x27, 0xde, 0x31, 0x51, 0x61, 0x4f, 0x0d, 0xf1, 0x53, 0x58, 0xe5, 0x9d, 0x1f, 0x09, 0x7f,
+	0xe8, 0x6d, 0xff, 0x05, 0x0b, 0xdc, 0x2e, 0xca, 0xdd, 0xa6, 0x37, 0x8c, 0x94, 0xc3, 0x37, 0x60,
+	0x55, 0xe7, 0xab, 0x8c, 0x1e, 0xfe, 0x88, 0x89, 0xf0, 0x2d, 0x89, 0xa1, 0x4f, 0x8f, 0x78, 0xfa,
+	0x18, 0x8b, 0x7b, 0x76, 0xd7, 0x16, 0xc9, 0x63, 0x5a, 0x17, 0x03, 0xfc, 0x13, 0x0d, 0x72, 0xe1,
+	0x5c, 0x66, 0x4b, 0x9e, 0xd2, 0x8a, 0x29, 0xfc, 0x3f, 0xa7, 0x0d, 0x7a, 0xa1, 0xcd, 0xd9, 0xff,
+	0x98, 0xeb, 0xce, 0x8d, 0xb8, 0xee, 0x25, 0xc8, 0x8a, 0x74, 0xde, 0xb6, 0xa4, 0xe5, 0x33, 0x7c,
+	0xbc, 0x65, 0xb1, 0x64, 0xa6, 0x4b, 0xe8, 0x91, 0x67, 0xc9, 0xd7, 0x5d, 0x8e, 0x98, 0x45, 0x2d,
+	0x42, 0x4d, 0xdb, 0x09, 0xb8, 0xb5, 0x73, 0xba, 0x1a, 0xe2, 0x8f, 0x60, 0x6d, 0x74, 0x37, 0x61,
+	0x89, 0xbe, 0xc0, 0xf3, 0x40, 0x65, 0xd2, 0xd7, 0x13, 0x4d, 0xaa, 0x74, 0xe8, 0x52, 0xb0, 0xf6,
+	0xf5, 0x3c, 0x2c, 0x88, 0x06, 0x26, 0xfa, 0xb1, 0x06, 0x8b, 0xd1, 0x0f, 0x1e, 0xe8, 0xf6, 0x0b,
+	0x7c, 0x1e, 0x29, 0x57, 0x66, 0x6b, 0x97, 0xe2, 0xd7, 0x3e, 0xfd, 0xd3, 0xdf, 0xbe, 0x97, 0x5a,
+	0xc7, 0x97, 0xd9, 0x67, 0xa4, 0x50, 0xa2, 0x2a, 0x62, 0x5b, 0x55, 0x38, 0xe4, 0x3d, 0xed, 0x0d,
+	0xf4, 0xa5, 0x06, 0xc0, 0x1a, 0xf8, 0xb2, 0x4d, 0xfb, 0x5e, 0x62, 0x22, 0x3d, 0xbe, 0xd9, 0x3f,
+	0x33, 0xbe, 0x9b, 0x1c, 0xdf, 0x75, 0xbc, 0x3e, 0x1e, 0x1f, 0xd7, 0x5d, 0x25, 0x96, 0x4d, 0x19,
+	0x48, 0x0a, 0x8b, 0xd1, 0x35, 0xd1, 0x5a, 0x45, 0x7c, 0xcb, 0xaa, 0xa8, 0x6f, 0x59, 0x95, 0x26,
+	0xfb, 0x96, 0x35, 0x33, 0x88, 0x2b, 0x1c, 0xc4, 0x1a, 0x5a, 0x19, 0x07, 0x02, 0x7d, 0xae, 0x41,
+	0x71, 0xf4, 0x13, 0xc1, 0xc4, 0xa5, 0xef, 0x26, 0x2d, 0x3d, 0xe9, 0x63, 0x03, 0xbe, 0xc1, 0x41,
+	0x5c, 0x43, 0x57, 0xe3, 0x20, 0xd4, 0xc7, 0x83, 0x6a, 0x47, 0x0a, 0xa2, 0x9f, 0x6a, 0xb0, 0x14,
+	0xfb, 0xf4, 0x80, 0x12, 0x9f, 0xf4, 0x71, 0x5f, 0x2a, 0x66, 0xb6, 0x92, 0x04, 0x88, 0xaf, 0x8c,
+	0x3d, 0x2a, 0x5f, 0x2c, 0x71, 0x4f, 0x7b, 0xa3, 0xf6, 0x0b, 0x80, 0x6c, 0xf8, 0x41, 0xe3, 0x3b,
+	0x1a, 0x2c, 0xc5, 0xba, 0xe9, 0x13, 0x4d, 0xf7, 0xee, 0x74, 0xf7, 0x61, 0xa4, 0x29, 0x8f, 0x37,
+	0x38, 0x2c, 0x8c, 0x5f, 0x8a, 0xc3, 0x52, 0x82, 0x11, 0x1f, 0xff, 0x91, 0x06, 0x8b, 0xd1, 0xb6,
+	0x79, 0xf2, 0x0d, 0x1c, 0xd3, 0xaa, 0x2f, 0xbf, 0x33, 0x9b, 0x90, 0x44, 0xf9, 0x32, 0x47, 0x59,
+	0x42, 0x6b, 0xe3, 0x51, 0xa2, 0x5f, 0x6a, 0x70, 0x7e, 0xa4, 0xf9, 0x8b, 0xee, 0x24, 0xad, 0x34,
+	0xbe, 0x4b, 0x5d, 0x7e, 0x6f, 0x66, 0xb9, 0x29, 0x4d, 0x29, 0xda, 0xcc, 0xcc, 0x94, 0x3f, 0x67,
+	0x95, 0x71, 0xac, 0x49, 0x8c, 0x12, 0x8f, 0x6f, 0x6c, 0x3b, 0xba, 0x7c, 0x67, 0x56, 0xb1, 0x29,
+	0xb1, 0x8a, 0xa6, 0xb4, 0xc2, 0x1a, 0x6f, 0x18, 0x27, 0x63, 0x1d, 0xdb, 0xc3, 0x2e, 0xdf, 0x99,
+	0x55, 0x6c, 0x4a, 0xac, 0xa2, 0xc1, 0xcd, 0xb0, 0xfe, 0x8c, 0xb5, 0x74, 0xa3, 0xed, 0xe6, 0xe4,
+	0xbb, 0x3d, 0xae, 0xad, 0x5d, 0x7e, 0x77, 0x46, 0xa9, 0xb3, 0x5f, 0x8b, 0x10, 0x28, 0xeb, 0x6e,
+	0x33, 0x98, 0x9f, 0x6b, 0x80, 0x4e, 0xf7, 0xb6, 0x27, 0xde, 0xec, 0x7b, 0xd3, 0x5c, 0x99, 0xf1,
+	0x7d, 0x72, 0xfc, 0x0a, 0x87, 0xf4, 0x12, 0x9a, 0x04, 0x89, 0x75, 0xc2, 0xd1, 0xef, 0x35, 0xb8,
+	0x38, 0xa1, 0x2b, 0x8c, 0xfe, 0x23, 0x69, 0xf1, 0xb3, 0xdb, 0xc9, 0xe5, 0xd9, 0x5a, 0xf3, 0xb8,
+	0xc2, 0xf1, 0x6e, 0xe0, 0x57, 0xce, 0xc0, 0x5b, 0x95, 0x1d, 0x67, 0x16, 0x2c, 0xbf, 0xd4, 0x60,
+	0xe1, 0x21, 0x31, 0x1d, 0x7a, 0x84, 0xbe, 0xd0, 0xe0, 0xe2, 0x03, 0x42, 0xef, 0x87, 0x7d, 0xc4,
+	0x61, 0x0f, 0x72, 0xa2, 0x69, 0x13, 0x5d, 0x72, 0x7c, 0x2f, 0x13, 0xbf, 0xc9, 0x61, 0xbe, 0x86,
+	0x5e, 0x8d, 0xc3, 0x3c, 0xe2, 0x48, 0xaa, 0xbc, 0xbf, 0xd9, 0x0a, 0xa5, 0x6a, 0x3f, 0x5c, 0x84,
+	0x34, 0x4b, 0x6e, 0xd0, 0xa7, 0x1a, 0xcc, 0xef, 0x78, 0x1d, 0xdb, 0x45, 0x37, 0xa7, 0x49, 0x86,
+	0x94, 0x0d, 0xdf, 0x9c, 0x6e, 0x72, 0x3c, 0x56, 0xe2, 0x0b, 0x71, 0x6c, 0x0e, 0x5b, 0x97, 0x79,
+	0xdf, 0x37, 0x35, 0x58, 0xd8, 0xb7, 0x3b, 0x6e, 0xbf, 0xf7, 0xaf, 0x44, 0x71, 0x95, 0xa3, 0xb8,
+	0x84, 0x47, 0x92, 0x82, 0x80, 0x2f, 0xcc, 0x60, 0x7c, 0x5b, 0x83, 0x42, 0xbc, 0xcf, 0x9f, 0x1c,
+	0x57, 0xc6, 0x7e, 0x17, 0x28, 0x4f, 0x38, 0xdc, 0x49, 0xd7, 0x51, 0x15, 0xc1, 0x61, 0x5e, 0xf4,
+	0x99, 0x06, 0x30, 0x2c, 0xad, 0xd1, 0xdb, 0xd3, 0x3d, 0xa4, 0x91, 0xd2, 0xb2, 0x3c, 0x55, 0x71,
+	0x8a, 0xaf, 0x73, 0x3c, 0x57, 0x71, 0x39, 0x8e, 0x87, 0x97, 0xac, 0x91, 0x77, 0x36, 0x80, 0x5c,
+	0x58, 0x0d, 0x4f, 0x74, 0xdc, 0xb7, 0xa7, 0x89, 0x09, 0xb1, 0x82, 0x1a, 0x5f, 0xe6, 0xcb, 0xaf,
+	0xa2, 0x0b, 0x63, 0x96, 0x47, 0x9f, 0x00, 0x0c, 0x2b, 0xe6, 0x64, 0x13, 0x9c, 0xaa, 0xae, 0x27,
+	0x1e, 0xc2, 0x99, 0x9b, 0x1e, 0xbe, 0x32, 0x9f, 0x69, 0x90, 0x8f, 0x54, 0xe0, 0xa8, 0x36, 0x45,
+	0xd8, 0x19, 0x29, 0xd7, 0xa7, 0x3c, 0x05, 0x19, 0x11, 0x71, 0x69, 0x1c, 0x20, 0xdf, 0x73, 0x38,
+	0x9c, 0xaf, 0x98, 0x73, 0xc6, 0x6a, 0x6b, 0x34, 0x6d, 0x7e, 0x15, 0xaf, 0xfe, 0xca, 0x77, 0x66,
+	0x15, 0x3b, 0xfb, 0x2d, 0x11, 0xc5, 0x66, 0xc4, 0x5b, 0xbe, 0x01, 0x4b, 0xb1, 0x8a, 0xf6, 0xc5,
+	0xf3, 0xc3, 0xb1, 0x85, 0xf1, 0xa4, 0xe4, 0x5e, 0xe0, 0x60, 0x39, 0x61, 0x21, 0x5e, 0xf5, 0x26,
+	0xdb, 0x69, 0x6c, 0x95, 0x5c, 0x9e, 0xba, 0xe0, 0x4e, 0xb0, 0x8c, 0xa8, 0xf4, 0x65, 0x4d, 0x56,
+	0x88, 0x97, 0xa6, 0x68, 0x3a, 0x1b, 0x8c, 0x16, 0xe6, 0xe5, 0x3b, 0xb3, 0x8a, 0x49, 0xdb, 0x5d,
+	0xe3, 0x48, 0x2f, 0xa3, 0x4b, 0x23, 0x8f, 0x59, 0x9f, 0x1e, 0x55, 0x65, 0x85, 0xfb, 0x7d, 0x0d,
+	0x72, 0xe1, 0xd7, 0x24, 0xf4, 0x2d, 0x0d, 0x56, 0x45, 0x3f, 0x34, 0xfe, 0x85, 0x29, 0x78, 0xf1,
+	0x5a, 0x2d, 0xae, 0x68, 0x52, 0x3e, 0x20, 0xd0, 0x54, 0x03, 0xbe, 0xf6, 0x5b, 0x5a, 0xed, 0x0b,
+	0x0d, 0xd2, 0xac, 0x25, 0x8b, 0xbe, 0xab, 0x01, 0x0c, 0x3b, 0xb4, 0xc9, 0x81, 0xe1, 0x54, 0x37,
+	0xb7, 0xbc, 0x31, 0x6d, 0xc3, 0x74, 0x92, 0xb9, 0x1c, 0xaf, 0x33, 0x44, 0x76, 0xb8, 0xc0, 0x0d,
+	0x70, 0xfb, 0x1f, 0x03, 0x00, 0x02, 0x76, 0xbe, 0x88, 0xf1, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// WalletClient is the client API for Wallet service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletClient interface {
+	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error)
+	EditConfig(ctx context.Context, in *EditWalletConfigRequest, opts ...grpc.CallOption) (*WalletResponse, error)
+	WalletConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WalletResponse, error)
+	GenerateMnemonic(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error)
+	RecoverWallet(ctx context.Context, in *RecoverWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error)
+}
+
+type walletClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWalletClient(cc grpc.ClientConnInterface) WalletClient {
+	return &walletClient{cc}
 }
 
 func (c *walletClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error) {
@@ -886,443 +2913,1216 @@ func (c *walletClient) CreateWallet(ctx context.Context, in *CreateWalletRequest
 	return out, nil
 }
 
-func (c *walletClient) EditConfig(ctx context.Context, in *EditWalletConfigRequest, opts ...grpc.CallOption) (*WalletResponse, error) {
-	out := new(WalletResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/EditConfig", in, out, opts...)
+func (c *walletClient) EditConfig(ctx context.Context, in *EditWalletConfigRequest, opts ...grpc.CallOption) (*WalletResponse, error) {
+	out := new(WalletResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/EditConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) WalletConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WalletResponse, error) {
+	out := new(WalletResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/WalletConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) GenerateMnemonic(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error) {
+	out := new(GenerateMnemonicResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletClient) RecoverWallet(ctx context.Context, in *RecoverWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error) {
+	out := new(WalletResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/RecoverWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletServer is the server API for Wallet service.
+type WalletServer interface {
+	CreateWallet(context.Context, *CreateWalletRequest) (*WalletResponse, error)
+	EditConfig(context.Context, *EditWalletConfigRequest) (*WalletResponse, error)
+	WalletConfig(context.Context, *empty.Empty) (*WalletResponse, error)
+	GenerateMnemonic(context.Context, *empty.Empty) (*GenerateMnemonicResponse, error)
+	RecoverWallet(context.Context, *RecoverWalletRequest) (*WalletResponse, error)
+}
+
+// UnimplementedWalletServer can be embedded to have forward compatible implementations.
+type UnimplementedWalletServer struct {
+}
+
+func (*UnimplementedWalletServer) CreateWallet(ctx context.Context, req *CreateWalletRequest) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
+}
+func (*UnimplementedWalletServer) EditConfig(ctx context.Context, req *EditWalletConfigRequest) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditConfig not implemented")
+}
+func (*UnimplementedWalletServer) WalletConfig(ctx context.Context, req *empty.Empty) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletConfig not implemented")
+}
+func (*UnimplementedWalletServer) GenerateMnemonic(ctx context.Context, req *empty.Empty) (*GenerateMnemonicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateMnemonic not implemented")
+}
+func (*UnimplementedWalletServer) RecoverWallet(ctx context.Context, req *RecoverWalletRequest) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverWallet not implemented")
+}
+
+func RegisterWalletServer(s *grpc.Server, srv WalletServer) {
+	s.RegisterService(&_Wallet_serviceDesc, srv)
+}
+
+func _Wallet_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).CreateWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/CreateWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_EditConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditWalletConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).EditConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/EditConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).EditConfig(ctx, req.(*EditWalletConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_WalletConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).WalletConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/WalletConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).WalletConfig(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_GenerateMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).GenerateMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).GenerateMnemonic(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallet_RecoverWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServer).RecoverWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Wallet/RecoverWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServer).RecoverWallet(ctx, req.(*RecoverWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Wallet_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Wallet",
+	HandlerType: (*WalletServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWallet",
+			Handler:    _Wallet_CreateWallet_Handler,
+		},
+		{
+			MethodName: "EditConfig",
+			Handler:    _Wallet_EditConfig_Handler,
+		},
+		{
+			MethodName: "WalletConfig",
+			Handler:    _Wallet_WalletConfig_Handler,
+		},
+		{
+			MethodName: "GenerateMnemonic",
+			Handler:    _Wallet_GenerateMnemonic_Handler,
+		},
+		{
+			MethodName: "RecoverWallet",
+			Handler:    _Wallet_RecoverWallet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
+}
+
+// AccountsClient is the client API for Accounts service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AccountsClient interface {
+	CreateAccount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	ImportKeystores(ctx context.Context, in *ImportKeystoresRequest, opts ...grpc.CallOption) (*ImportKeystoresResponse, error)
+	DeleteAccounts(ctx context.Context, in *DeleteAccountsRequest, opts ...grpc.CallOption) (*DeleteAccountsResponse, error)
+	BackupAccounts(ctx context.Context, in *BackupAccountsRequest, opts ...grpc.CallOption) (*BackupAccountsResponse, error)
+	VoluntaryExit(ctx context.Context, in *VoluntaryExitRequest, opts ...grpc.CallOption) (*VoluntaryExitResponse, error)
+	ListValidatingKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListValidatingKeysResponse, error)
+	SetValidatingKeyEnabled(ctx context.Context, in *SetValidatingKeyEnabledRequest, opts ...grpc.CallOption) (*ValidatingKey, error)
+}
+
+type accountsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountsClient(cc grpc.ClientConnInterface) AccountsClient {
+	return &accountsClient{cc}
+}
+
+func (c *accountsClient) CreateAccount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ImportKeystores(ctx context.Context, in *ImportKeystoresRequest, opts ...grpc.CallOption) (*ImportKeystoresResponse, error) {
+	out := new(ImportKeystoresResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ImportKeystores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) DeleteAccounts(ctx context.Context, in *DeleteAccountsRequest, opts ...grpc.CallOption) (*DeleteAccountsResponse, error) {
+	out := new(DeleteAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/DeleteAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) BackupAccounts(ctx context.Context, in *BackupAccountsRequest, opts ...grpc.CallOption) (*BackupAccountsResponse, error) {
+	out := new(BackupAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/BackupAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) VoluntaryExit(ctx context.Context, in *VoluntaryExitRequest, opts ...grpc.CallOption) (*VoluntaryExitResponse, error) {
+	out := new(VoluntaryExitResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/VoluntaryExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ListValidatingKeys(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListValidatingKeysResponse, error) {
+	out := new(ListValidatingKeysResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ListValidatingKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) SetValidatingKeyEnabled(ctx context.Context, in *SetValidatingKeyEnabledRequest, opts ...grpc.CallOption) (*ValidatingKey, error) {
+	out := new(ValidatingKey)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/SetValidatingKeyEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountsServer is the server API for Accounts service.
+type AccountsServer interface {
+	CreateAccount(context.Context, *empty.Empty) (*CreateAccountResponse, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	ImportKeystores(context.Context, *ImportKeystoresRequest) (*ImportKeystoresResponse, error)
+	DeleteAccounts(context.Context, *DeleteAccountsRequest) (*DeleteAccountsResponse, error)
+	BackupAccounts(context.Context, *BackupAccountsRequest) (*BackupAccountsResponse, error)
+	VoluntaryExit(context.Context, *VoluntaryExitRequest) (*VoluntaryExitResponse, error)
+	ListValidatingKeys(context.Context, *empty.Empty) (*ListValidatingKeysResponse, error)
+	SetValidatingKeyEnabled(context.Context, *SetValidatingKeyEnabledRequest) (*ValidatingKey, error)
+}
+
+// UnimplementedAccountsServer can be embedded to have forward compatible implementations.
+type UnimplementedAccountsServer struct {
+}
+
+func (*UnimplementedAccountsServer) CreateAccount(ctx context.Context, req *empty.Empty) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (*UnimplementedAccountsServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (*UnimplementedAccountsServer) ImportKeystores(ctx context.Context, req *ImportKeystoresRequest) (*ImportKeystoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKeystores not implemented")
+}
+func (*UnimplementedAccountsServer) DeleteAccounts(ctx context.Context, req *DeleteAccountsRequest) (*DeleteAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccounts not implemented")
+}
+func (*UnimplementedAccountsServer) BackupAccounts(ctx context.Context, req *BackupAccountsRequest) (*BackupAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupAccounts not implemented")
+}
+func (*UnimplementedAccountsServer) VoluntaryExit(ctx context.Context, req *VoluntaryExitRequest) (*VoluntaryExitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoluntaryExit not implemented")
+}
+func (*UnimplementedAccountsServer) ListValidatingKeys(ctx context.Context, req *empty.Empty) (*ListValidatingKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValidatingKeys not implemented")
+}
+func (*UnimplementedAccountsServer) SetValidatingKeyEnabled(ctx context.Context, req *SetValidatingKeyEnabledRequest) (*ValidatingKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatingKeyEnabled not implemented")
+}
+
+func RegisterAccountsServer(s *grpc.Server, srv AccountsServer) {
+	s.RegisterService(&_Accounts_serviceDesc, srv)
+}
+
+func _Accounts_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).CreateAccount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ImportKeystores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportKeystoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ImportKeystores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ImportKeystores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ImportKeystores(ctx, req.(*ImportKeystoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_DeleteAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).DeleteAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/DeleteAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).DeleteAccounts(ctx, req.(*DeleteAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_BackupAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).BackupAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/BackupAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).BackupAccounts(ctx, req.(*BackupAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_VoluntaryExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoluntaryExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).VoluntaryExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/VoluntaryExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).VoluntaryExit(ctx, req.(*VoluntaryExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ListValidatingKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListValidatingKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ListValidatingKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListValidatingKeys(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_SetValidatingKeyEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetValidatingKeyEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).SetValidatingKeyEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Accounts/SetValidatingKeyEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).SetValidatingKeyEnabled(ctx, req.(*SetValidatingKeyEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Accounts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Accounts",
+	HandlerType: (*AccountsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccount",
+			Handler:    _Accounts_CreateAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _Accounts_ListAccounts_Handler,
+		},
+		{
+			MethodName: "ImportKeystores",
+			Handler:    _Accounts_ImportKeystores_Handler,
+		},
+		{
+			MethodName: "DeleteAccounts",
+			Handler:    _Accounts_DeleteAccounts_Handler,
+		},
+		{
+			MethodName: "BackupAccounts",
+			Handler:    _Accounts_BackupAccounts_Handler,
+		},
+		{
+			MethodName: "VoluntaryExit",
+			Handler:    _Accounts_VoluntaryExit_Handler,
+		},
+		{
+			MethodName: "ListValidatingKeys",
+			Handler:    _Accounts_ListValidatingKeys_Handler,
+		},
+		{
+			MethodName: "SetValidatingKeyEnabled",
+			Handler:    _Accounts_SetValidatingKeyEnabled_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
+}
+
+// HealthClient is the client API for Health service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HealthClient interface {
+	GetBeaconNodeConnection(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error)
+}
+
+type healthClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHealthClient(cc grpc.ClientConnInterface) HealthClient {
+	return &healthClient{cc}
+}
+
+func (c *healthClient) GetBeaconNodeConnection(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error) {
+	out := new(NodeConnectionResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HealthServer is the server API for Health service.
+type HealthServer interface {
+	GetBeaconNodeConnection(context.Context, *empty.Empty) (*NodeConnectionResponse, error)
+}
+
+// UnimplementedHealthServer can be embedded to have forward compatible implementations.
+type UnimplementedHealthServer struct {
+}
+
+func (*UnimplementedHealthServer) GetBeaconNodeConnection(ctx context.Context, req *empty.Empty) (*NodeConnectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconNodeConnection not implemented")
+}
+
+func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
+	s.RegisterService(&_Health_serviceDesc, srv)
+}
+
+func _Health_GetBeaconNodeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthServer).GetBeaconNodeConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthServer).GetBeaconNodeConnection(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Health_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Health",
+	HandlerType: (*HealthServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBeaconNodeConnection",
+			Handler:    _Health_GetBeaconNodeConnection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/validator/accounts/v2/web_api.proto",
+}
+
+// AuthClient is the client API for Auth service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuthClient interface {
+	Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	ListUsers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*User, error)
+	CreateApiToken(ctx context.Context, in *CreateApiTokenRequest, opts ...grpc.CallOption) (*CreateApiTokenResponse, error)
+	ListApiTokens(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListApiTokensResponse, error)
+	RevokeApiToken(ctx context.Context, in *RevokeApiTokenRequest, opts ...grpc.CallOption) (*ApiToken, error)
+	ListAuthEvents(ctx context.Context, in *ListAuthEventsRequest, opts ...grpc.CallOption) (*ListAuthEventsResponse, error)
+}
+
+type authClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthClient(cc grpc.ClientConnInterface) AuthClient {
+	return &authClient{cc}
+}
+
+func (c *authClient) Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Login", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *walletClient) WalletConfig(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*WalletResponse, error) {
-	out := new(WalletResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/WalletConfig", in, out, opts...)
+func (c *authClient) Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Signup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *walletClient) GenerateMnemonic(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenerateMnemonicResponse, error) {
-	out := new(GenerateMnemonicResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic", in, out, opts...)
+func (c *authClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/ChangePassword", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// WalletServer is the server API for Wallet service.
-type WalletServer interface {
-	CreateWallet(context.Context, *CreateWalletRequest) (*WalletResponse, error)
-	EditConfig(context.Context, *EditWalletConfigRequest) (*WalletResponse, error)
-	WalletConfig(context.Context, *empty.Empty) (*WalletResponse, error)
-	GenerateMnemonic(context.Context, *empty.Empty) (*GenerateMnemonicResponse, error)
+func (c *authClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedWalletServer can be embedded to have forward compatible implementations.
-type UnimplementedWalletServer struct {
+func (c *authClient) ListUsers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedWalletServer) CreateWallet(ctx context.Context, req *CreateWalletRequest) (*WalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
+func (c *authClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedWalletServer) EditConfig(ctx context.Context, req *EditWalletConfigRequest) (*WalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditConfig not implemented")
+
+func (c *authClient) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/SetUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedWalletServer) WalletConfig(ctx context.Context, req *empty.Empty) (*WalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WalletConfig not implemented")
+
+func (c *authClient) CreateApiToken(ctx context.Context, in *CreateApiTokenRequest, opts ...grpc.CallOption) (*CreateApiTokenResponse, error) {
+	out := new(CreateApiTokenResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/CreateApiToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedWalletServer) GenerateMnemonic(ctx context.Context, req *empty.Empty) (*GenerateMnemonicResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateMnemonic not implemented")
+
+func (c *authClient) ListApiTokens(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListApiTokensResponse, error) {
+	out := new(ListApiTokensResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/ListApiTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterWalletServer(s *grpc.Server, srv WalletServer) {
-	s.RegisterService(&_Wallet_serviceDesc, srv)
+func (c *authClient) RevokeApiToken(ctx context.Context, in *RevokeApiTokenRequest, opts ...grpc.CallOption) (*ApiToken, error) {
+	out := new(ApiToken)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/RevokeApiToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _Wallet_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateWalletRequest)
+func (c *authClient) ListAuthEvents(ctx context.Context, in *ListAuthEventsRequest, opts ...grpc.CallOption) (*ListAuthEventsResponse, error) {
+	out := new(ListAuthEventsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/ListAuthEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthServer is the server API for Auth service.
+type AuthServer interface {
+	Login(context.Context, *AuthRequest) (*AuthResponse, error)
+	Signup(context.Context, *AuthRequest) (*AuthResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*empty.Empty, error)
+	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	ListUsers(context.Context, *empty.Empty) (*ListUsersResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*empty.Empty, error)
+	SetUserRole(context.Context, *SetUserRoleRequest) (*User, error)
+	CreateApiToken(context.Context, *CreateApiTokenRequest) (*CreateApiTokenResponse, error)
+	ListApiTokens(context.Context, *empty.Empty) (*ListApiTokensResponse, error)
+	RevokeApiToken(context.Context, *RevokeApiTokenRequest) (*ApiToken, error)
+	ListAuthEvents(context.Context, *ListAuthEventsRequest) (*ListAuthEventsResponse, error)
+}
+
+// UnimplementedAuthServer can be embedded to have forward compatible implementations.
+type UnimplementedAuthServer struct {
+}
+
+func (*UnimplementedAuthServer) Login(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedAuthServer) Signup(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Signup not implemented")
+}
+func (*UnimplementedAuthServer) ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedAuthServer) CreateUser(ctx context.Context, req *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (*UnimplementedAuthServer) ListUsers(ctx context.Context, req *empty.Empty) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedAuthServer) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (*UnimplementedAuthServer) SetUserRole(ctx context.Context, req *SetUserRoleRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (*UnimplementedAuthServer) CreateApiToken(ctx context.Context, req *CreateApiTokenRequest) (*CreateApiTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiToken not implemented")
+}
+func (*UnimplementedAuthServer) ListApiTokens(ctx context.Context, req *empty.Empty) (*ListApiTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiTokens not implemented")
+}
+func (*UnimplementedAuthServer) RevokeApiToken(ctx context.Context, req *RevokeApiTokenRequest) (*ApiToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiToken not implemented")
+}
+func (*UnimplementedAuthServer) ListAuthEvents(ctx context.Context, req *ListAuthEventsRequest) (*ListAuthEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuthEvents not implemented")
+}
+
+func RegisterAuthServer(s *grpc.Server, srv AuthServer) {
+	s.RegisterService(&_Auth_serviceDesc, srv)
+}
+
+func _Auth_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServer).CreateWallet(ctx, in)
+		return srv.(AuthServer).Login(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/CreateWallet",
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/Login",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+		return srv.(AuthServer).Login(ctx, req.(*AuthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Wallet_EditConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EditWalletConfigRequest)
+func _Auth_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServer).EditConfig(ctx, in)
+		return srv.(AuthServer).Signup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/EditConfig",
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/Signup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).EditConfig(ctx, req.(*EditWalletConfigRequest))
+		return srv.(AuthServer).Signup(ctx, req.(*AuthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Wallet_WalletConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _Auth_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServer).WalletConfig(ctx, in)
+		return srv.(AuthServer).ChangePassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/WalletConfig",
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/ChangePassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).WalletConfig(ctx, req.(*empty.Empty))
+		return srv.(AuthServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Wallet_GenerateMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _Auth_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServer).GenerateMnemonic(ctx, in)
+		return srv.(AuthServer).CreateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Wallet/GenerateMnemonic",
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/CreateUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServer).GenerateMnemonic(ctx, req.(*empty.Empty))
+		return srv.(AuthServer).CreateUser(ctx, req.(*CreateUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Wallet_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Wallet",
-	HandlerType: (*WalletServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateWallet",
-			Handler:    _Wallet_CreateWallet_Handler,
-		},
-		{
-			MethodName: "EditConfig",
-			Handler:    _Wallet_EditConfig_Handler,
-		},
-		{
-			MethodName: "WalletConfig",
-			Handler:    _Wallet_WalletConfig_Handler,
-		},
-		{
-			MethodName: "GenerateMnemonic",
-			Handler:    _Wallet_GenerateMnemonic_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/validator/accounts/v2/web_api.proto",
-}
-
-// AccountsClient is the client API for Accounts service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AccountsClient interface {
-	CreateAccount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CreateAccountResponse, error)
-	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
-}
-
-type accountsClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewAccountsClient(cc grpc.ClientConnInterface) AccountsClient {
-	return &accountsClient{cc}
-}
-
-func (c *accountsClient) CreateAccount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
-	out := new(CreateAccountResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/CreateAccount", in, out, opts...)
-	if err != nil {
+func _Auth_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AuthServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ListUsers(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *accountsClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
-	out := new(ListAccountsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Accounts/ListAccounts", in, out, opts...)
-	if err != nil {
+func _Auth_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// AccountsServer is the server API for Accounts service.
-type AccountsServer interface {
-	CreateAccount(context.Context, *empty.Empty) (*CreateAccountResponse, error)
-	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	if interceptor == nil {
+		return srv.(AuthServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedAccountsServer can be embedded to have forward compatible implementations.
-type UnimplementedAccountsServer struct {
+func _Auth_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).SetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/SetUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).SetUserRole(ctx, req.(*SetUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedAccountsServer) CreateAccount(ctx context.Context, req *empty.Empty) (*CreateAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
-}
-func (*UnimplementedAccountsServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+func _Auth_CreateApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CreateApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/CreateApiToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CreateApiToken(ctx, req.(*CreateApiTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAccountsServer(s *grpc.Server, srv AccountsServer) {
-	s.RegisterService(&_Accounts_serviceDesc, srv)
+func _Auth_ListApiTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ListApiTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/ListApiTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ListApiTokens(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Accounts_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _Auth_RevokeApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiTokenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServer).CreateAccount(ctx, in)
+		return srv.(AuthServer).RevokeApiToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Accounts/CreateAccount",
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/RevokeApiToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServer).CreateAccount(ctx, req.(*empty.Empty))
+		return srv.(AuthServer).RevokeApiToken(ctx, req.(*RevokeApiTokenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Accounts_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAccountsRequest)
+func _Auth_ListAuthEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuthEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AccountsServer).ListAccounts(ctx, in)
+		return srv.(AuthServer).ListAuthEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Accounts/ListAccounts",
+		FullMethod: "/ethereum.validator.accounts.v2.Auth/ListAuthEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AccountsServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+		return srv.(AuthServer).ListAuthEvents(ctx, req.(*ListAuthEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Accounts_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Accounts",
-	HandlerType: (*AccountsServer)(nil),
+var _Auth_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Auth",
+	HandlerType: (*AuthServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateAccount",
-			Handler:    _Accounts_CreateAccount_Handler,
+			MethodName: "Login",
+			Handler:    _Auth_Login_Handler,
 		},
 		{
-			MethodName: "ListAccounts",
-			Handler:    _Accounts_ListAccounts_Handler,
+			MethodName: "Signup",
+			Handler:    _Auth_Signup_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _Auth_ChangePassword_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _Auth_CreateUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _Auth_ListUsers_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _Auth_DeleteUser_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _Auth_SetUserRole_Handler,
+		},
+		{
+			MethodName: "CreateApiToken",
+			Handler:    _Auth_CreateApiToken_Handler,
+		},
+		{
+			MethodName: "ListApiTokens",
+			Handler:    _Auth_ListApiTokens_Handler,
+		},
+		{
+			MethodName: "RevokeApiToken",
+			Handler:    _Auth_RevokeApiToken_Handler,
+		},
+		{
+			MethodName: "ListAuthEvents",
+			Handler:    _Auth_ListAuthEvents_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
-// HealthClient is the client API for Health service.
+// ValidatorClient is the client API for Validator service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type HealthClient interface {
-	GetBeaconNodeConnection(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error)
+type ValidatorClient interface {
+	StreamValidatorEvents(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Validator_StreamValidatorEventsClient, error)
 }
 
-type healthClient struct {
+type validatorClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewHealthClient(cc grpc.ClientConnInterface) HealthClient {
-	return &healthClient{cc}
+func NewValidatorClient(cc grpc.ClientConnInterface) ValidatorClient {
+	return &validatorClient{cc}
 }
 
-func (c *healthClient) GetBeaconNodeConnection(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*NodeConnectionResponse, error) {
-	out := new(NodeConnectionResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection", in, out, opts...)
+func (c *validatorClient) StreamValidatorEvents(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (Validator_StreamValidatorEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Validator_serviceDesc.Streams[0], "/ethereum.validator.accounts.v2.Validator/StreamValidatorEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &validatorStreamValidatorEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// HealthServer is the server API for Health service.
-type HealthServer interface {
-	GetBeaconNodeConnection(context.Context, *empty.Empty) (*NodeConnectionResponse, error)
+type Validator_StreamValidatorEventsClient interface {
+	Recv() (*ValidatorEvent, error)
+	grpc.ClientStream
 }
 
-// UnimplementedHealthServer can be embedded to have forward compatible implementations.
-type UnimplementedHealthServer struct {
+type validatorStreamValidatorEventsClient struct {
+	grpc.ClientStream
 }
 
-func (*UnimplementedHealthServer) GetBeaconNodeConnection(ctx context.Context, req *empty.Empty) (*NodeConnectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBeaconNodeConnection not implemented")
+func (x *validatorStreamValidatorEventsClient) Recv() (*ValidatorEvent, error) {
+	m := new(ValidatorEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func RegisterHealthServer(s *grpc.Server, srv HealthServer) {
-	s.RegisterService(&_Health_serviceDesc, srv)
+// ValidatorServer is the server API for Validator service.
+type ValidatorServer interface {
+	StreamValidatorEvents(*empty.Empty, Validator_StreamValidatorEventsServer) error
 }
 
-func _Health_GetBeaconNodeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(HealthServer).GetBeaconNodeConnection(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthServer).GetBeaconNodeConnection(ctx, req.(*empty.Empty))
+// UnimplementedValidatorServer can be embedded to have forward compatible implementations.
+type UnimplementedValidatorServer struct {
+}
+
+func (*UnimplementedValidatorServer) StreamValidatorEvents(req *empty.Empty, srv Validator_StreamValidatorEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamValidatorEvents not implemented")
+}
+
+func RegisterValidatorServer(s *grpc.Server, srv ValidatorServer) {
+	s.RegisterService(&_Validator_serviceDesc, srv)
+}
+
+func _Validator_StreamValidatorEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(ValidatorServer).StreamValidatorEvents(m, &validatorStreamValidatorEventsServer{stream})
 }
 
-var _Health_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Health",
-	HandlerType: (*HealthServer)(nil),
-	Methods: []grpc.MethodDesc{
+type Validator_StreamValidatorEventsServer interface {
+	Send(*ValidatorEvent) error
+	grpc.ServerStream
+}
+
+type validatorStreamValidatorEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *validatorStreamValidatorEventsServer) Send(m *ValidatorEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Validator_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Validator",
+	HandlerType: (*ValidatorServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
 		{
-			MethodName: "GetBeaconNodeConnection",
-			Handler:    _Health_GetBeaconNodeConnection_Handler,
+			StreamName:    "StreamValidatorEvents",
+			Handler:       _Validator_StreamValidatorEvents_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
 
-// AuthClient is the client API for Auth service.
+// LogsClient is the client API for Logs service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AuthClient interface {
-	Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
-	Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+type LogsClient interface {
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (Logs_StreamLogsClient, error)
 }
 
-type authClient struct {
+type logsClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewAuthClient(cc grpc.ClientConnInterface) AuthClient {
-	return &authClient{cc}
+func NewLogsClient(cc grpc.ClientConnInterface) LogsClient {
+	return &logsClient{cc}
 }
 
-func (c *authClient) Login(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
-	out := new(AuthResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Login", in, out, opts...)
+func (c *logsClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (Logs_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Logs_serviceDesc.Streams[0], "/ethereum.validator.accounts.v2.Logs/StreamLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &logsStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *authClient) Signup(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
-	out := new(AuthResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.validator.accounts.v2.Auth/Signup", in, out, opts...)
-	if err != nil {
+type Logs_StreamLogsClient interface {
+	Recv() (*LogEntry, error)
+	grpc.ClientStream
+}
+
+type logsStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *logsStreamLogsClient) Recv() (*LogEntry, error) {
+	m := new(LogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
-// AuthServer is the server API for Auth service.
-type AuthServer interface {
-	Login(context.Context, *AuthRequest) (*AuthResponse, error)
-	Signup(context.Context, *AuthRequest) (*AuthResponse, error)
+// LogsServer is the server API for Logs service.
+type LogsServer interface {
+	StreamLogs(*StreamLogsRequest, Logs_StreamLogsServer) error
 }
 
-// UnimplementedAuthServer can be embedded to have forward compatible implementations.
-type UnimplementedAuthServer struct {
+// UnimplementedLogsServer can be embedded to have forward compatible implementations.
+type UnimplementedLogsServer struct {
 }
 
-func (*UnimplementedAuthServer) Login(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
-}
-func (*UnimplementedAuthServer) Signup(ctx context.Context, req *AuthRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Signup not implemented")
+func (*UnimplementedLogsServer) StreamLogs(req *StreamLogsRequest, srv Logs_StreamLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
 }
 
-func RegisterAuthServer(s *grpc.Server, srv AuthServer) {
-	s.RegisterService(&_Auth_serviceDesc, srv)
+func RegisterLogsServer(s *grpc.Server, srv LogsServer) {
+	s.RegisterService(&_Logs_serviceDesc, srv)
 }
 
-func _Auth_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AuthServer).Login(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Auth/Login",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).Login(ctx, req.(*AuthRequest))
+func _Logs_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(LogsServer).StreamLogs(m, &logsStreamLogsServer{stream})
 }
 
-func _Auth_Signup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AuthServer).Signup(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.validator.accounts.v2.Auth/Signup",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AuthServer).Signup(ctx, req.(*AuthRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type Logs_StreamLogsServer interface {
+	Send(*LogEntry) error
+	grpc.ServerStream
 }
 
-var _Auth_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.validator.accounts.v2.Auth",
-	HandlerType: (*AuthServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Login",
-			Handler:    _Auth_Login_Handler,
-		},
+type logsStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *logsStreamLogsServer) Send(m *LogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Logs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.validator.accounts.v2.Logs",
+	HandlerType: (*LogsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
 		{
-			MethodName: "Signup",
-			Handler:    _Auth_Signup_Handler,
+			StreamName:    "StreamLogs",
+			Handler:       _Logs_StreamLogs_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/validator/accounts/v2/web_api.proto",
 }
@@ -42,3 +42,15 @@ func NewAggregateSignature() iface.Signature {
 func RandKey() iface.SecretKey {
 	return herumi.RandKey()
 }
+
+// SplitSecretKey splits a secret key into shares for the given non-zero share IDs, the signatures
+// of any threshold of which recover the signature of the secret key.
+func SplitSecretKey(secretKey SecretKey, threshold uint64, ids []uint64) (map[uint64]SecretKey, error) {
+	return herumi.SplitSecretKey(secretKey, threshold, ids)
+}
+
+// RecoverSignature recovers the signature of a split secret key from the signatures of at
+// least a threshold of its shares, keyed by share ID.
+func RecoverSignature(sigs map[uint64]Signature) (Signature, error) {
+	return herumi.RecoverSignature(sigs)
+}
@@ -0,0 +1,79 @@
+package herumi
+
+import (
+	"strconv"
+
+	bls12 "github.com/herumi/bls-eth-go-binary/bls"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls/iface"
+)
+
+// SplitSecretKey splits a secret key into shares for the given non-zero share IDs, the signatures
+// of any threshold of which recover the signature of the secret key with RecoverSignature.
+func SplitSecretKey(secretKey iface.SecretKey, threshold uint64, ids []uint64) (map[uint64]iface.SecretKey, error) {
+	sk, ok := secretKey.(*bls12SecretKey)
+	if !ok {
+		return nil, errors.New("unsupported secret key")
+	}
+	if threshold == 0 || threshold > uint64(len(ids)) {
+		return nil, errors.Errorf("threshold must be between 1 and the number of shares %d, got %d", len(ids), threshold)
+	}
+	// The secret key is the constant term of a random polynomial of degree threshold - 1,
+	// which is evaluated at each share ID.
+	msk := sk.p.GetMasterSecretKey(int(threshold))
+	shares := make(map[uint64]iface.SecretKey, len(ids))
+	for _, id := range ids {
+		if _, ok := shares[id]; ok {
+			return nil, errors.Errorf("duplicate share ID %d", id)
+		}
+		blsID, err := shareID(id)
+		if err != nil {
+			return nil, err
+		}
+		share := &bls12.SecretKey{}
+		if err := share.Set(msk, blsID); err != nil {
+			return nil, errors.Wrapf(err, "could not compute share %d", id)
+		}
+		shares[id] = &bls12SecretKey{p: share}
+	}
+	return shares, nil
+}
+
+// RecoverSignature recovers the signature of a secret key split with SplitSecretKey from the
+// signatures of at least a threshold of its shares, keyed by share ID, by Lagrange interpolation.
+func RecoverSignature(sigs map[uint64]iface.Signature) (iface.Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signature to recover from")
+	}
+	sigVec := make([]bls12.Sign, 0, len(sigs))
+	idVec := make([]bls12.ID, 0, len(sigs))
+	for id, sig := range sigs {
+		s, ok := sig.(*Signature)
+		if !ok || s.s == nil {
+			return nil, errors.Errorf("unsupported signature of share %d", id)
+		}
+		blsID, err := shareID(id)
+		if err != nil {
+			return nil, err
+		}
+		sigVec = append(sigVec, *s.s)
+		idVec = append(idVec, *blsID)
+	}
+	recovered := &bls12.Sign{}
+	if err := recovered.Recover(sigVec, idVec); err != nil {
+		return nil, errors.Wrap(err, "could not recover signature")
+	}
+	return &Signature{s: recovered}, nil
+}
+
+func shareID(id uint64) (*bls12.ID, error) {
+	// The secret key itself is the evaluation of the polynomial at 0.
+	if id == 0 {
+		return nil, errors.New("share ID must not be 0")
+	}
+	blsID := &bls12.ID{}
+	if err := blsID.SetDecString(strconv.FormatUint(id, 10)); err != nil {
+		return nil, errors.Wrapf(err, "could not set share ID %d", id)
+	}
+	return blsID, nil
+}
@@ -0,0 +1,48 @@
+package herumi
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls/iface"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSplitSecretKey_RecoverSignature(t *testing.T) {
+	sk := RandKey()
+	shares, err := SplitSecretKey(sk, 3, []uint64{1, 2, 3, 4, 5})
+	require.NoError(t, err)
+	require.Equal(t, 5, len(shares))
+	msg := []byte("hello")
+
+	for _, ids := range [][]uint64{{1, 2, 3}, {2, 4, 5}, {1, 3, 4, 5}} {
+		sigs := make(map[uint64]iface.Signature, len(ids))
+		for _, id := range ids {
+			sigs[id] = shares[id].Sign(msg)
+		}
+		sig, err := RecoverSignature(sigs)
+		require.NoError(t, err)
+		assert.DeepEqual(t, sk.Sign(msg).Marshal(), sig.Marshal())
+		assert.Equal(t, true, sig.Verify(sk.PublicKey(), msg))
+	}
+
+	// Fewer shares than the threshold do not recover the signature.
+	sig, err := RecoverSignature(map[uint64]iface.Signature{
+		1: shares[1].Sign(msg),
+		2: shares[2].Sign(msg),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, false, sig.Verify(sk.PublicKey(), msg))
+}
+
+func TestSplitSecretKey_InvalidParameters(t *testing.T) {
+	sk := RandKey()
+	_, err := SplitSecretKey(sk, 0, []uint64{1, 2})
+	assert.ErrorContains(t, "threshold must be between", err)
+	_, err = SplitSecretKey(sk, 3, []uint64{1, 2})
+	assert.ErrorContains(t, "threshold must be between", err)
+	_, err = SplitSecretKey(sk, 2, []uint64{0, 1})
+	assert.ErrorContains(t, "must not be 0", err)
+	_, err = SplitSecretKey(sk, 2, []uint64{1, 1})
+	assert.ErrorContains(t, "duplicate share ID", err)
+}
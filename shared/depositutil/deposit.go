@@ -15,7 +15,6 @@ import (
 	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
-	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
@@ -80,8 +79,7 @@ func DepositInput(
 //   withdrawal_credentials[1:] == hash(withdrawal_pubkey)[1:]
 // where withdrawal_credentials is of type bytes32.
 func WithdrawalCredentialsHash(withdrawalKey bls.SecretKey) []byte {
-	h := hashutil.Hash(withdrawalKey.PublicKey().Marshal())
-	return append([]byte{params.BeaconConfig().BLSWithdrawalPrefixByte}, h[1:]...)[:32]
+	return WithdrawalCredentialsFromPublicKey(withdrawalKey.PublicKey().Marshal())
 }
 
 // VerifyDepositSignature verifies the correctness of Eth1 deposit BLS signature
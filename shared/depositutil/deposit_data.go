@@ -0,0 +1,159 @@
+package depositutil
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	p2ppb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// DepositDataJSON is a deposit in the deposit_data-*.json files used by the eth2 launchpad
+// and the eth2 deposit CLI. Byte fields are hex encoded without a 0x prefix.
+type DepositDataJSON struct {
+	PubKey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+}
+
+// WithdrawalCredentialsFromPublicKey forms the withdrawal credentials of a BLS withdrawal
+// public key, the same way as WithdrawalCredentialsHash.
+func WithdrawalCredentialsFromPublicKey(withdrawalPubKey []byte) []byte {
+	h := hashutil.Hash(withdrawalPubKey)
+	return append([]byte{params.BeaconConfig().BLSWithdrawalPrefixByte}, h[1:]...)[:32]
+}
+
+// DepositSigningRoot returns the root to sign for a deposit, with the deposit domain of the
+// genesis fork version of the network config.
+func DepositSigningRoot(dd *ethpb.Deposit_Data) ([32]byte, error) {
+	domain, err := DepositDomain()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	messageRoot, err := ssz.SigningRoot(dd)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not compute deposit message root")
+	}
+	return (&p2ppb.SigningData{ObjectRoot: messageRoot[:], Domain: domain}).HashTreeRoot()
+}
+
+// DepositDataToJSON converts a signed deposit to the launchpad deposit data format.
+func DepositDataToJSON(dd *ethpb.Deposit_Data) (*DepositDataJSON, error) {
+	messageRoot, err := ssz.SigningRoot(dd)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposit message root")
+	}
+	dataRoot, err := dd.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposit data root")
+	}
+	return &DepositDataJSON{
+		PubKey:                hex.EncodeToString(dd.PublicKey),
+		WithdrawalCredentials: hex.EncodeToString(dd.WithdrawalCredentials),
+		Amount:                dd.Amount,
+		Signature:             hex.EncodeToString(dd.Signature),
+		DepositMessageRoot:    hex.EncodeToString(messageRoot[:]),
+		DepositDataRoot:       hex.EncodeToString(dataRoot[:]),
+		ForkVersion:           hex.EncodeToString(params.BeaconConfig().GenesisForkVersion),
+	}, nil
+}
+
+// DepositData decodes the deposit of launchpad deposit data, without verifying it.
+func (d *DepositDataJSON) DepositData() (*ethpb.Deposit_Data, error) {
+	pubKey, err := decodeHexField("pubkey", d.PubKey, params.BeaconConfig().BLSPubkeyLength)
+	if err != nil {
+		return nil, err
+	}
+	withdrawalCredentials, err := decodeHexField("withdrawal_credentials", d.WithdrawalCredentials, 32)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := decodeHexField("signature", d.Signature, params.BeaconConfig().BLSSignatureLength)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.Deposit_Data{
+		PublicKey:             pubKey,
+		WithdrawalCredentials: withdrawalCredentials,
+		Amount:                d.Amount,
+		Signature:             signature,
+	}, nil
+}
+
+// VerifyDepositDataJSON checks that launchpad deposit data is for the network of the
+// config in params, that its amount is valid, and that its roots and signature match.
+func VerifyDepositDataJSON(d *DepositDataJSON) error {
+	forkVersion, err := decodeHexField("fork_version", d.ForkVersion, 4)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(forkVersion, params.BeaconConfig().GenesisForkVersion) {
+		return fmt.Errorf(
+			"fork version %#x does not match the genesis fork version %#x of the network",
+			forkVersion,
+			params.BeaconConfig().GenesisForkVersion,
+		)
+	}
+	if d.Amount < params.BeaconConfig().MinDepositAmount || d.Amount > params.BeaconConfig().MaxEffectiveBalance {
+		return fmt.Errorf(
+			"amount %d must be between %d and %d gwei",
+			d.Amount,
+			params.BeaconConfig().MinDepositAmount,
+			params.BeaconConfig().MaxEffectiveBalance,
+		)
+	}
+	dd, err := d.DepositData()
+	if err != nil {
+		return err
+	}
+	expected, err := DepositDataToJSON(dd)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(strings.TrimPrefix(d.DepositMessageRoot, "0x"), expected.DepositMessageRoot) {
+		return fmt.Errorf("deposit message root %s does not match the deposit, expected %s", d.DepositMessageRoot, expected.DepositMessageRoot)
+	}
+	if !strings.EqualFold(strings.TrimPrefix(d.DepositDataRoot, "0x"), expected.DepositDataRoot) {
+		return fmt.Errorf("deposit data root %s does not match the deposit, expected %s", d.DepositDataRoot, expected.DepositDataRoot)
+	}
+	domain, err := DepositDomain()
+	if err != nil {
+		return err
+	}
+	if err := VerifyDepositSignature(dd, domain); err != nil {
+		return errors.Wrap(err, "invalid deposit signature")
+	}
+	return nil
+}
+
+// DepositDomain returns the domain of deposit signatures, for the genesis fork version of the
+// network config.
+func DepositDomain() ([]byte, error) {
+	return helpers.ComputeDomain(
+		params.BeaconConfig().DomainDeposit,
+		params.BeaconConfig().GenesisForkVersion,
+		params.BeaconConfig().ZeroHash[:],
+	)
+}
+
+func decodeHexField(name string, value string, length int) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decode %s as hex", name)
+	}
+	if len(b) != length {
+		return nil, fmt.Errorf("%s must be %d bytes, received %d", name, length, len(b))
+	}
+	return b, nil
+}
@@ -0,0 +1,86 @@
+package depositutil_test
+
+import (
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func signedDepositDataJSON(t *testing.T) *depositutil.DepositDataJSON {
+	depositKey := bls.RandKey()
+	withdrawalKey := bls.RandKey()
+	dd := &ethpb.Deposit_Data{
+		PublicKey:             depositKey.PublicKey().Marshal(),
+		WithdrawalCredentials: depositutil.WithdrawalCredentialsHash(withdrawalKey),
+		Amount:                params.BeaconConfig().MaxEffectiveBalance,
+	}
+	root, err := depositutil.DepositSigningRoot(dd)
+	require.NoError(t, err)
+	dd.Signature = depositKey.Sign(root[:]).Marshal()
+	d, err := depositutil.DepositDataToJSON(dd)
+	require.NoError(t, err)
+	return d
+}
+
+func TestDepositDataToJSON_MatchesDepositInput(t *testing.T) {
+	depositKey := bls.RandKey()
+	withdrawalKey := bls.RandKey()
+	dd, dataRoot, err := depositutil.DepositInput(depositKey, withdrawalKey, params.BeaconConfig().MaxEffectiveBalance)
+	require.NoError(t, err)
+	d, err := depositutil.DepositDataToJSON(dd)
+	require.NoError(t, err)
+	decoded, err := d.DepositData()
+	require.NoError(t, err)
+	assert.DeepEqual(t, dd, decoded)
+	assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, d.Amount)
+	assert.Equal(t, 64, len(d.DepositDataRoot))
+	root, err := decoded.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, dataRoot, root)
+	require.NoError(t, depositutil.VerifyDepositDataJSON(d))
+}
+
+func TestWithdrawalCredentialsFromPublicKey(t *testing.T) {
+	withdrawalKey := bls.RandKey()
+	assert.DeepEqual(
+		t,
+		depositutil.WithdrawalCredentialsHash(withdrawalKey),
+		depositutil.WithdrawalCredentialsFromPublicKey(withdrawalKey.PublicKey().Marshal()),
+	)
+}
+
+func TestVerifyDepositDataJSON(t *testing.T) {
+	d := signedDepositDataJSON(t)
+	require.NoError(t, depositutil.VerifyDepositDataJSON(d))
+
+	wrongFork := *d
+	wrongFork.ForkVersion = "ffffffff"
+	assert.ErrorContains(t, "does not match the genesis fork version", depositutil.VerifyDepositDataJSON(&wrongFork))
+
+	wrongAmount := *d
+	wrongAmount.Amount = params.BeaconConfig().MaxEffectiveBalance + 1
+	assert.ErrorContains(t, "amount", depositutil.VerifyDepositDataJSON(&wrongAmount))
+
+	wrongMessageRoot := *d
+	wrongMessageRoot.DepositMessageRoot = d.DepositDataRoot
+	assert.ErrorContains(t, "deposit message root", depositutil.VerifyDepositDataJSON(&wrongMessageRoot))
+
+	// A signature of another deposit message is refused, even if the roots are recomputed.
+	other := signedDepositDataJSON(t)
+	wrongSignature := *d
+	wrongSignature.Signature = other.Signature
+	dd, err := wrongSignature.DepositData()
+	require.NoError(t, err)
+	recomputed, err := depositutil.DepositDataToJSON(dd)
+	require.NoError(t, err)
+	assert.ErrorContains(t, "invalid deposit signature", depositutil.VerifyDepositDataJSON(recomputed))
+
+	badPubKey := *d
+	badPubKey.PubKey = "00"
+	assert.ErrorContains(t, "pubkey must be 48 bytes", depositutil.VerifyDepositDataJSON(&badPubKey))
+}
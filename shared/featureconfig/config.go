@@ -62,6 +62,7 @@ type Flags struct {
 	EnableAttBroadcastDiscoveryAttempts        bool // EnableAttBroadcastDiscoveryAttempts allows the p2p service to attempt to ensure a subnet peer is present before broadcasting an attestation.
 	EnablePeerScorer                           bool // EnablePeerScorer enables experimental peer scoring in p2p.
 	EnableRoughtime                            bool // EnableRoughtime is an opt-in flag for enabling hourly syncing with roughtime. Default is to not sync.
+	AttestTimely                               bool // AttestTimely has the validator client attest as soon as the block of the slot is processed, instead of waiting until one third of the slot.
 
 	// DisableForkChoice disables using LMD-GHOST fork choice to update
 	// the head of the chain based on attestations and instead accepts any valid received block
@@ -318,6 +319,10 @@ func ConfigureValidator(ctx *cli.Context) {
 		log.Warn("Disabled domain data cache.")
 		cfg.EnableDomainDataCache = false
 	}
+	if ctx.Bool(attestTimely.Name) {
+		log.Warn("Enabled attesting as soon as the block of the slot is processed.")
+		cfg.AttestTimely = true
+	}
 	Init(cfg)
 }
 
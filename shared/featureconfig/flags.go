@@ -169,6 +169,11 @@ var (
 		Name:  "use-check-point-cache",
 		Usage: "Enables check point info caching",
 	}
+	attestTimely = &cli.BoolFlag{
+		Name: "attest-timely",
+		Usage: "Attest as soon as the block of the slot is processed by the beacon node, with one third " +
+			"of the slot as the deadline. Aggregates are still broadcast two thirds through the slot",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -637,6 +642,7 @@ var ValidatorFlags = append(deprecatedFlags, []cli.Flag{
 	AltonaTestnet,
 	OnyxTestnet,
 	disableAccountsV2,
+	attestTimely,
 }...)
 
 // SlasherFlags contains a list of all the feature flags that apply to the slasher client.
@@ -95,3 +95,42 @@ func CopyFile(src, dst string) error {
 	}
 	return nil
 }
+
+// WriteFileAtomically writes data to a file by writing it to a temporary file in the same
+// directory first, which is then renamed to the destination. The file thus either has its
+// previous or its new contents, even if writing it is interrupted.
+func WriteFileAtomically(filename string, data []byte, perm os.FileMode) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(filename), "."+filepath.Base(filename)+".tmp")
+	if err != nil {
+		return errors.Wrapf(err, "could not create temporary file for %s", filename)
+	}
+	tmpName := tmp.Name()
+	cleanup := func() {
+		if err := os.Remove(tmpName); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Errorf("Could not remove temporary file %s", tmpName)
+		}
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		cleanup()
+		return errors.Wrapf(err, "could not write %s", tmpName)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		cleanup()
+		return errors.Wrapf(err, "could not sync %s", tmpName)
+	}
+	if err := tmp.Close(); err != nil {
+		cleanup()
+		return errors.Wrapf(err, "could not close %s", tmpName)
+	}
+	if err := os.Chmod(tmpName, perm); err != nil {
+		cleanup()
+		return errors.Wrapf(err, "could not set permissions of %s", tmpName)
+	}
+	if err := os.Rename(tmpName, filename); err != nil {
+		cleanup()
+		return errors.Wrapf(err, "could not rename %s to %s", tmpName, filename)
+	}
+	return nil
+}
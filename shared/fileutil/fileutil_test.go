@@ -21,6 +21,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/user"
+	"path/filepath"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
@@ -64,6 +65,29 @@ func TestCopyFile(t *testing.T) {
 	assert.Equal(t, true, deepCompare(t, fName, fName+"copy"))
 }
 
+func TestWriteFileAtomically(t *testing.T) {
+	dir, err := ioutil.TempDir(testutil.TempDir(), "atomic")
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, os.RemoveAll(dir))
+	}()
+	fName := filepath.Join(dir, "testfile")
+	require.NoError(t, ioutil.WriteFile(fName, []byte{1, 2, 3}, params.BeaconIoConfig().ReadWritePermissions))
+
+	require.NoError(t, fileutil.WriteFileAtomically(fName, []byte{4, 5}, params.BeaconIoConfig().ReadWritePermissions))
+	data, err := ioutil.ReadFile(fName)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{4, 5}, data)
+	info, err := os.Stat(fName)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconIoConfig().ReadWritePermissions, info.Mode().Perm())
+
+	// No temporary file is left behind.
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+}
+
 func deepCompare(t *testing.T, file1, file2 string) bool {
 	sf, err := os.Open(file1)
 	assert.NoError(t, err)
@@ -0,0 +1,138 @@
+package logutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Entry of a log, as kept by a stream hook.
+type Entry struct {
+	Time    time.Time
+	Level   logrus.Level
+	Message string
+	Fields  logrus.Fields
+}
+
+// StreamHook is a logrus hook keeping the recent entries of the log, and sending every
+// new entry to its subscribers. It never blocks the logging path: entries are dropped for
+// the subscribers which do not receive them fast enough.
+type StreamHook struct {
+	lock    sync.Mutex
+	entries []*Entry
+	next    int
+	full    bool
+	subs    map[*Subscription]bool
+}
+
+// Subscription to the new entries of a stream hook.
+type Subscription struct {
+	hook    *StreamHook
+	entries chan *Entry
+	dropped uint64
+}
+
+// NewStreamHook keeping the given number of recent entries of the log.
+func NewStreamHook(size int) *StreamHook {
+	if size < 1 {
+		size = 1
+	}
+	return &StreamHook{
+		entries: make([]*Entry, size),
+		subs:    make(map[*Subscription]bool),
+	}
+}
+
+// Levels of the entries the hook is fired for.
+func (h *StreamHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire keeps an entry of the log and sends it to the subscribers of the hook.
+func (h *StreamHook) Fire(e *logrus.Entry) error {
+	fields := make(logrus.Fields, len(e.Data))
+	for k, v := range e.Data {
+		fields[k] = v
+	}
+	entry := &Entry{
+		Time:    e.Time,
+		Level:   e.Level,
+		Message: e.Message,
+		Fields:  fields,
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.entries[h.next] = entry
+	h.next = (h.next + 1) % len(h.entries)
+	if h.next == 0 {
+		h.full = true
+	}
+	for sub := range h.subs {
+		select {
+		case sub.entries <- entry:
+		default:
+			sub.dropped++
+		}
+	}
+	return nil
+}
+
+// Subscribe to the new entries of the log, which are buffered up to the given number of
+// entries. It returns the recent entries of the log, up to the given number of entries,
+// along with the subscription, so that no entry is missed in between.
+func (h *StreamHook) Subscribe(recent int, bufferSize int) ([]*Entry, *Subscription) {
+	sub := &Subscription{
+		hook:    h,
+		entries: make(chan *Entry, bufferSize),
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.subs[sub] = true
+	return h.recent(recent), sub
+}
+
+// recent entries of the log, from the oldest to the newest.
+func (h *StreamHook) recent(n int) []*Entry {
+	count := h.next
+	if h.full {
+		count = len(h.entries)
+	}
+	if n > count {
+		n = count
+	}
+	if n <= 0 {
+		return nil
+	}
+	res := make([]*Entry, 0, n)
+	start := h.next - n
+	if start < 0 {
+		start += len(h.entries)
+	}
+	for i := 0; i < n; i++ {
+		res = append(res, h.entries[(start+i)%len(h.entries)])
+	}
+	return res
+}
+
+// Entries sent to the subscription.
+func (s *Subscription) Entries() <-chan *Entry {
+	return s.entries
+}
+
+// Dropped returns the number of entries dropped because the subscription did not receive
+// them fast enough.
+func (s *Subscription) Dropped() uint64 {
+	s.hook.lock.Lock()
+	defer s.hook.lock.Unlock()
+	return s.dropped
+}
+
+// Unsubscribe from the entries of the log.
+func (s *Subscription) Unsubscribe() {
+	s.hook.lock.Lock()
+	defer s.hook.lock.Unlock()
+	delete(s.hook.subs, s)
+}
@@ -0,0 +1,74 @@
+package logutil
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+)
+
+func newStreamLogger(hook *StreamHook) *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	logger.SetLevel(logrus.DebugLevel)
+	logger.AddHook(hook)
+	return logger
+}
+
+func TestStreamHook_Recent(t *testing.T) {
+	hook := NewStreamHook(3)
+	logger := newStreamLogger(hook)
+
+	recent, sub := hook.Subscribe(10, 1)
+	sub.Unsubscribe()
+	assert.Equal(t, 0, len(recent))
+
+	logger.WithField("prefix", "test").Info("first")
+	recent, sub = hook.Subscribe(10, 1)
+	sub.Unsubscribe()
+	require.Equal(t, 1, len(recent))
+	assert.Equal(t, "first", recent[0].Message)
+	assert.Equal(t, logrus.InfoLevel, recent[0].Level)
+	assert.Equal(t, "test", recent[0].Fields["prefix"])
+
+	for i := 0; i < 5; i++ {
+		logger.Debug(fmt.Sprintf("entry %d", i))
+	}
+	// Only the last 3 entries are kept, from the oldest to the newest.
+	recent, sub = hook.Subscribe(10, 1)
+	sub.Unsubscribe()
+	require.Equal(t, 3, len(recent))
+	for i, entry := range recent {
+		assert.Equal(t, fmt.Sprintf("entry %d", i+2), entry.Message)
+	}
+	recent, sub = hook.Subscribe(2, 1)
+	sub.Unsubscribe()
+	require.Equal(t, 2, len(recent))
+	assert.Equal(t, "entry 3", recent[0].Message)
+	assert.Equal(t, "entry 4", recent[1].Message)
+}
+
+func TestStreamHook_Subscribe(t *testing.T) {
+	hook := NewStreamHook(10)
+	logger := newStreamLogger(hook)
+
+	_, sub := hook.Subscribe(0, 2)
+	logger.Info("a")
+	logger.Warn("b")
+	// The subscription buffer is full, the entry is dropped instead of blocking the log.
+	logger.Error("c")
+	assert.Equal(t, "a", (<-sub.Entries()).Message)
+	assert.Equal(t, "b", (<-sub.Entries()).Message)
+	assert.Equal(t, uint64(1), sub.Dropped())
+
+	sub.Unsubscribe()
+	logger.Info("d")
+	select {
+	case entry := <-sub.Entries():
+		t.Fatalf("Received entry %q after unsubscribing", entry.Message)
+	default:
+	}
+}
@@ -3,6 +3,7 @@ package roughtime
 
 import (
 	"math"
+	"sync/atomic"
 	"time"
 
 	rt "github.com/cloudflare/roughtime"
@@ -20,6 +21,10 @@ const RecalibrationInterval = time.Hour
 // the roughtime server
 var offset time.Duration
 
+// pinnedTime is the time in unix nanoseconds returned by Now instead of the clock, or 0 if
+// the clock is used.
+var pinnedTime int64
+
 var log = logrus.WithField("prefix", "roughtime")
 
 var offsetHistogram = promauto.NewHistogram(prometheus.HistogramOpts{
@@ -110,8 +115,23 @@ func Until(t time.Time) time.Duration {
 	return t.Sub(Now())
 }
 
-// Now returns the current local time given the roughtime offset.
+// Pin makes Now return the given time instead of reading the clock, so that messages
+// recorded in the past are processed as they were when they were received. A zero
+// time goes back to the clock.
+func Pin(t time.Time) {
+	var nanos int64
+	if !t.IsZero() {
+		nanos = t.UnixNano()
+	}
+	atomic.StoreInt64(&pinnedTime, nanos)
+}
+
+// Now returns the current local time given the roughtime offset, or the pinned time
+// if any.
 func Now() time.Time {
+	if nanos := atomic.LoadInt64(&pinnedTime); nanos != 0 {
+		return time.Unix(0, nanos)
+	}
 	if featureconfig.Get().EnableRoughtime {
 		return time.Now().Add(offset)
 	}
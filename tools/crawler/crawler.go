@@ -0,0 +1,323 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/helpers"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/sirupsen/logrus"
+)
+
+// Response code of a successful rpc response chunk.
+const responseCodeSuccess = byte(0x00)
+
+// crawler walks the discv5 table and performs the eth2 rpc handshakes with every
+// discovered peer, recording what the peer reports into the store.
+type crawler struct {
+	listener        *discover.UDPv5
+	host            host.Host
+	store           *store
+	encoding        encoder.NetworkEncoding
+	recrawlInterval time.Duration
+	timeout         time.Duration
+	dials           chan struct{}
+	inFlightLock    sync.Mutex
+	inFlight        map[peer.ID]bool
+}
+
+func newCrawler(listener *discover.UDPv5, h host.Host, s *store, recrawl, timeout time.Duration, maxDials int) *crawler {
+	c := &crawler{
+		listener:        listener,
+		host:            h,
+		store:           s,
+		encoding:        &encoder.SszNetworkEncoder{},
+		recrawlInterval: recrawl,
+		timeout:         timeout,
+		dials:           make(chan struct{}, maxDials),
+		inFlight:        make(map[peer.ID]bool),
+	}
+	// Answer the requests peers send us while we are connected, so that they do not
+	// drop the connection before our own handshake completes.
+	h.SetStreamHandler(c.protocolID(p2p.RPCStatusTopic), c.statusHandler)
+	h.SetStreamHandler(c.protocolID(p2p.RPCPingTopic), c.pingHandler)
+	h.SetStreamHandler(c.protocolID(p2p.RPCMetaDataTopic), c.metadataHandler)
+	return c
+}
+
+// run walks discv5 until the context is canceled.
+func (c *crawler) run(ctx context.Context) {
+	iterator := c.listener.RandomNodes()
+	go func() {
+		<-ctx.Done()
+		iterator.Close()
+	}()
+	for iterator.Next() {
+		discoveredNodesCounter.Inc()
+		c.handleNode(ctx, iterator.Node())
+	}
+}
+
+// handleNode records the content of a discovered node's ENR, then handshakes with
+// the node unless it was crawled recently.
+func (c *crawler) handleNode(ctx context.Context, node *enode.Node) {
+	info, err := p2p.PeerInfoFromNode(node)
+	if err != nil {
+		log.WithError(err).Debug("Could not convert node to peer info")
+		return
+	}
+	enrString, err := p2p.SerializeENR(node.Record())
+	if err != nil {
+		log.WithError(err).Debug("Could not serialize ENR")
+		return
+	}
+	var forkDigest []byte
+	forkEntry, err := p2p.ForkEntryFromRecord(node.Record())
+	if err != nil {
+		log.WithError(err).WithField("peer", info.ID).Debug("Could not retrieve fork entry")
+	} else {
+		forkDigest = forkEntry.CurrentForkDigest
+	}
+	attnets, err := p2p.AttSubnetsFromRecord(node.Record())
+	if err != nil {
+		log.WithError(err).WithField("peer", info.ID).Debug("Could not retrieve attestation subnets")
+	}
+
+	now := roughtime.Now()
+	var lastCrawled time.Time
+	c.store.update(info.ID.String(), func(r *peerRecord) {
+		if r.FirstSeen.IsZero() {
+			r.FirstSeen = now
+		}
+		r.LastSeen = now
+		r.ENR = "enr:" + enrString
+		if len(info.Addrs) > 0 {
+			r.Address = info.Addrs[0].String()
+		}
+		if forkEntry != nil {
+			r.ForkDigest = hex.EncodeToString(forkEntry.CurrentForkDigest)
+			r.NextForkVersion = hex.EncodeToString(forkEntry.NextForkVersion)
+			r.NextForkEpoch = forkEntry.NextForkEpoch
+		}
+		if attnets != nil {
+			r.Attnets = hex.EncodeToString(attnets.Bytes())
+		}
+		lastCrawled = r.LastCrawled
+	})
+	if forkDigest == nil || now.Sub(lastCrawled) < c.recrawlInterval || !c.markInFlight(info.ID) {
+		return
+	}
+
+	select {
+	case c.dials <- struct{}{}:
+	case <-ctx.Done():
+		c.unmarkInFlight(info.ID)
+		return
+	}
+	go func() {
+		defer func() {
+			<-c.dials
+			c.unmarkInFlight(info.ID)
+		}()
+		c.visit(ctx, *info, forkDigest)
+	}()
+}
+
+// visit dials a peer and runs the status, metadata and ping handshakes with it.
+func (c *crawler) visit(ctx context.Context, info peer.AddrInfo, forkDigest []byte) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	pid := info.ID
+	fail := func(step string, err error) {
+		handshakeFailuresCounter.WithLabelValues(step).Inc()
+		log.WithError(err).WithField("peer", pid).Debugf("Could not complete %s with peer", step)
+		c.store.update(pid.String(), func(r *peerRecord) {
+			r.LastCrawled = roughtime.Now()
+			r.LastError = step + ": " + err.Error()
+		})
+	}
+
+	if err := c.host.Connect(ctx, info); err != nil {
+		fail("dial", err)
+		return
+	}
+	defer func() {
+		if err := c.host.Network().ClosePeer(pid); err != nil {
+			log.WithError(err).Debug("Could not close connection")
+		}
+	}()
+
+	// Claim the genesis checkpoint as our finalized and head block, which every peer
+	// on the fork accepts.
+	status := &pb.Status{
+		ForkDigest:     forkDigest,
+		FinalizedRoot:  make([]byte, 32),
+		FinalizedEpoch: 0,
+		HeadRoot:       make([]byte, 32),
+		HeadSlot:       0,
+	}
+	peerStatus := &pb.Status{}
+	if err := c.request(ctx, pid, p2p.RPCStatusTopic, status, peerStatus); err != nil {
+		fail("status", err)
+		return
+	}
+	metadata := &pb.MetaData{}
+	if err := c.request(ctx, pid, p2p.RPCMetaDataTopic, nil, metadata); err != nil {
+		fail("metadata", err)
+		return
+	}
+	seq := uint64(0)
+	peerSeq := new(uint64)
+	if err := c.request(ctx, pid, p2p.RPCPingTopic, &seq, peerSeq); err != nil {
+		fail("ping", err)
+		return
+	}
+
+	var agent string
+	if v, err := c.host.Peerstore().Get(pid, "AgentVersion"); err == nil {
+		agent, _ = v.(string)
+	}
+	c.store.update(pid.String(), func(r *peerRecord) {
+		r.LastCrawled = roughtime.Now()
+		r.LastError = ""
+		r.AgentVersion = agent
+		r.Client = clientFromAgent(agent)
+		r.ForkDigest = hex.EncodeToString(peerStatus.ForkDigest)
+		r.HeadSlot = peerStatus.HeadSlot
+		r.HeadRoot = hex.EncodeToString(peerStatus.HeadRoot)
+		r.FinalizedEpoch = peerStatus.FinalizedEpoch
+		r.FinalizedRoot = hex.EncodeToString(peerStatus.FinalizedRoot)
+		r.Attnets = hex.EncodeToString(metadata.Attnets.Bytes())
+		r.MetadataSeq = *peerSeq
+	})
+	log.WithFields(logrus.Fields{
+		"peer":           pid,
+		"agent":          agent,
+		"headSlot":       peerStatus.HeadSlot,
+		"finalizedEpoch": peerStatus.FinalizedEpoch,
+	}).Debug("Crawled peer")
+}
+
+// request sends an rpc request to a peer and decodes the first response chunk. A
+// nil request sends no payload.
+func (c *crawler) request(ctx context.Context, pid peer.ID, topic string, req interface{}, resp interface{}) error {
+	stream, err := c.host.NewStream(ctx, pid, c.protocolID(topic))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := helpers.FullClose(stream); err != nil {
+			log.WithError(err).Trace("Could not close stream")
+		}
+	}()
+	if err := stream.SetDeadline(roughtime.Now().Add(c.timeout)); err != nil {
+		return err
+	}
+	if req != nil {
+		if _, err := c.encoding.EncodeWithMaxLength(stream, req); err != nil {
+			return err
+		}
+	}
+	// Close the stream for writing, to signal the end of the request.
+	if err := stream.Close(); err != nil {
+		return err
+	}
+	code := make([]byte, 1)
+	if _, err := stream.Read(code); err != nil {
+		return err
+	}
+	if code[0] != responseCodeSuccess {
+		errMsg := &pb.ErrorResponse{}
+		if err := c.encoding.DecodeWithMaxLength(stream, errMsg); err != nil {
+			return err
+		}
+		return errors.Errorf("peer responded with code %d: %s", code[0], errMsg.Message)
+	}
+	return c.encoding.DecodeWithMaxLength(stream, resp)
+}
+
+// statusHandler answers a peer's status request with the peer's own status.
+func (c *crawler) statusHandler(stream network.Stream) {
+	msg := &pb.Status{}
+	c.respond(stream, msg, func() interface{} {
+		return msg
+	})
+}
+
+// pingHandler answers a ping with our metadata sequence number, which never changes.
+func (c *crawler) pingHandler(stream network.Stream) {
+	c.respond(stream, new(uint64), func() interface{} {
+		seq := uint64(0)
+		return &seq
+	})
+}
+
+// metadataHandler answers a metadata request with empty attestation subnets.
+func (c *crawler) metadataHandler(stream network.Stream) {
+	c.respond(stream, nil, func() interface{} {
+		return &pb.MetaData{
+			SeqNumber: 0,
+			Attnets:   bitfield.NewBitvector64(),
+		}
+	})
+}
+
+// respond decodes a request into msg, unless it is nil, and writes back a single
+// successful response chunk.
+func (c *crawler) respond(stream network.Stream, msg interface{}, resp func() interface{}) {
+	defer func() {
+		if err := helpers.FullClose(stream); err != nil {
+			log.WithError(err).Trace("Could not close stream")
+		}
+	}()
+	if err := stream.SetDeadline(roughtime.Now().Add(c.timeout)); err != nil {
+		log.WithError(err).Debug("Could not set stream deadline")
+		return
+	}
+	if msg != nil {
+		if err := c.encoding.DecodeWithMaxLength(stream, msg); err != nil {
+			log.WithError(err).Debug("Could not decode request")
+			return
+		}
+	}
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		log.WithError(err).Debug("Could not write response code")
+		return
+	}
+	if _, err := c.encoding.EncodeWithMaxLength(stream, resp()); err != nil {
+		log.WithError(err).Debug("Could not write response")
+	}
+}
+
+func (c *crawler) protocolID(topic string) protocol.ID {
+	return protocol.ID(topic + c.encoding.ProtocolSuffix())
+}
+
+func (c *crawler) markInFlight(pid peer.ID) bool {
+	c.inFlightLock.Lock()
+	defer c.inFlightLock.Unlock()
+	if c.inFlight[pid] {
+		return false
+	}
+	c.inFlight[pid] = true
+	return true
+}
+
+func (c *crawler) unmarkInFlight(pid peer.ID) {
+	c.inFlightLock.Lock()
+	defer c.inFlightLock.Unlock()
+	delete(c.inFlight, pid)
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var testForkDigest = []byte{'a', 'b', 'c', 'd'}
+
+// discoveredNode returns a node as discovered through discv5, with a fork entry if a
+// fork digest is given.
+func discoveredNode(t *testing.T, forkDigest []byte) *enode.Node {
+	key, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	localNode := enode.NewLocalNode(db, key)
+	localNode.Set(enr.IP(net.ParseIP("127.0.0.1")))
+	localNode.Set(enr.TCP(13000))
+	localNode.Set(enr.UDP(12000))
+	if forkDigest != nil {
+		enc, err := (&pb.ENRForkID{
+			CurrentForkDigest: forkDigest,
+			NextForkVersion:   params.BeaconConfig().GenesisForkVersion,
+			NextForkEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}).MarshalSSZ()
+		require.NoError(t, err)
+		localNode.Set(enr.WithEntry(params.BeaconNetworkConfig().ETH2Key, enc))
+	}
+	return localNode.Node()
+}
+
+// dialsNode returns whether the crawler tries to dial a discovered node. The dial slots
+// of the crawler must all be taken, so that a dial blocks instead of taking place.
+func dialsNode(c *crawler, node *enode.Node) bool {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		c.handleNode(ctx, node)
+		close(done)
+	}()
+	select {
+	case <-done:
+		return false
+	case <-time.After(100 * time.Millisecond):
+	}
+	cancel()
+	<-done
+	return true
+}
+
+func TestCrawler_HandleNode(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	c := newCrawler(nil, p.BHost, newStore(), time.Hour, time.Second, 1)
+	// Take the only dial slot.
+	c.dials <- struct{}{}
+
+	withoutFork := discoveredNode(t, nil)
+	assert.Equal(t, false, dialsNode(c, withoutFork), "Dialed a node without fork entry")
+
+	node := discoveredNode(t, testForkDigest)
+	assert.Equal(t, true, dialsNode(c, node), "Did not dial a discovered node")
+	records := c.store.records()
+	require.Equal(t, 2, len(records))
+	var record peerRecord
+	for _, r := range records {
+		if r.ForkDigest != "" {
+			record = r
+		}
+	}
+	assert.Equal(t, hex.EncodeToString(testForkDigest), record.ForkDigest)
+	assert.Equal(t, true, strings.HasPrefix(record.ENR, "enr:"))
+	assert.Equal(t, false, record.FirstSeen.IsZero())
+	assert.Equal(t, true, record.LastCrawled.IsZero())
+}
+
+func TestCrawler_HandleNode_Dedup(t *testing.T) {
+	p := p2ptest.NewTestP2P(t)
+	c := newCrawler(nil, p.BHost, newStore(), time.Hour, time.Second, 1)
+	c.dials <- struct{}{}
+
+	// A peer crawled within the recrawl interval is not dialed again.
+	node := discoveredNode(t, testForkDigest)
+	require.Equal(t, true, dialsNode(c, node))
+	for _, r := range c.store.records() {
+		c.store.update(r.PeerID, func(r *peerRecord) {
+			r.LastCrawled = roughtime.Now()
+		})
+	}
+	assert.Equal(t, false, dialsNode(c, node), "Dialed a recently crawled node")
+
+	// A peer which is being crawled is not dialed twice at the same time.
+	other := discoveredNode(t, testForkDigest)
+	info, err := p2p.PeerInfoFromNode(other)
+	require.NoError(t, err)
+	require.Equal(t, true, c.markInFlight(info.ID))
+	assert.Equal(t, false, dialsNode(c, other), "Dialed a node which is being crawled")
+	c.unmarkInFlight(info.ID)
+	assert.Equal(t, true, dialsNode(c, other))
+}
+
+func TestCrawler_Visit(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	c := newCrawler(nil, p1.BHost, newStore(), time.Hour, 5*time.Second, 1)
+	// The other crawler answers the handshakes like a beacon node at genesis.
+	newCrawler(nil, p2.BHost, newStore(), time.Hour, 5*time.Second, 1)
+
+	c.visit(context.Background(), peer.AddrInfo{ID: p2.BHost.ID(), Addrs: p2.BHost.Addrs()}, testForkDigest)
+
+	record, ok := c.store.get(p2.BHost.ID().String())
+	require.Equal(t, true, ok)
+	assert.Equal(t, "", record.LastError)
+	assert.Equal(t, true, record.reachable())
+	assert.Equal(t, hex.EncodeToString(testForkDigest), record.ForkDigest)
+	assert.Equal(t, uint64(0), record.HeadSlot)
+	assert.Equal(t, "0000000000000000", record.Attnets)
+	assert.Equal(t, 0, len(p1.BHost.Network().ConnsToPeer(p2.BHost.ID())), "Connection was not closed")
+}
+
+func TestCrawler_Visit_HandshakeFails(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	c := newCrawler(nil, p1.BHost, newStore(), time.Hour, 5*time.Second, 1)
+
+	// The peer does not speak the eth2 rpc protocols.
+	c.visit(context.Background(), peer.AddrInfo{ID: p2.BHost.ID(), Addrs: p2.BHost.Addrs()}, testForkDigest)
+
+	record, ok := c.store.get(p2.BHost.ID().String())
+	require.Equal(t, true, ok)
+	assert.Equal(t, false, record.reachable())
+	assert.Equal(t, true, strings.HasPrefix(record.LastError, "status: "), "Unexpected error %s", record.LastError)
+}
@@ -0,0 +1,173 @@
+/**
+ * Crawler
+ *
+ * A tool which continuously walks the discv5 network, dials every discovered
+ * peer and performs the status, metadata and ping handshakes with it. It records
+ * the fork digest, head slot, finalized epoch, attestation subnets, agent version
+ * and client type of every peer into a JSON store, optionally exported as CSV,
+ * and serves aggregate Prometheus metrics about network health and client
+ * diversity.
+ *
+ * Usage: Run crawler --help for flag options.
+ */
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
+	noise "github.com/libp2p/go-libp2p-noise"
+	secio "github.com/libp2p/go-libp2p-secio"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/shared/iputils"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	_ "github.com/prysmaticlabs/prysm/shared/maxprocs"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	debug           = flag.Bool("debug", false, "Enable debug logging")
+	logFileName     = flag.String("log-file", "", "Specify log filename, relative or absolute")
+	privateKey      = flag.String("private", "", "Private key to use for peer ID")
+	bootstrapNodes  = flag.String("bootstrap-node", "", "Comma separated list of discv5 bootstrap ENRs to start the walk from")
+	discv5Port      = flag.Int("discv5-port", 12000, "Port to listen for discv5 connections")
+	tcpPort         = flag.Int("tcp-port", 13000, "Port to listen for libp2p connections")
+	metricsPort     = flag.Int("metrics-port", 5000, "Port to serve Prometheus metrics on")
+	storePath       = flag.String("store", "crawler.json", "Path of the JSON store of crawled peers, reloaded on start")
+	csvPath         = flag.String("csv", "", "If set, also export the crawled peers as CSV to this path")
+	reportInterval  = flag.Duration("report-interval", time.Minute, "Interval at which the store, report and metrics are updated")
+	recrawlInterval = flag.Duration("recrawl-interval", 10*time.Minute, "Minimum interval between two handshakes with the same peer")
+	dialTimeout     = flag.Duration("dial-timeout", 10*time.Second, "Timeout to dial and handshake with a peer")
+	maxDials        = flag.Int("max-concurrent-dials", 16, "Maximum number of peers dialed at the same time")
+	log             = logrus.WithField("prefix", "crawler")
+)
+
+func main() {
+	flag.Parse()
+
+	if *logFileName != "" {
+		if err := logutil.ConfigurePersistentLogging(*logFileName); err != nil {
+			log.WithError(err).Error("Failed to configuring logging to disk.")
+		}
+	}
+	if *debug {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+	fmt.Printf("Starting crawler. Version: %s\n", version.GetVersion())
+
+	if *bootstrapNodes == "" {
+		log.Fatal("At least one bootstrap node must be provided with --bootstrap-node")
+	}
+	var bootnodes []string
+	for _, addr := range strings.Split(*bootstrapNodes, ",") {
+		bootnodes = append(bootnodes, strings.TrimSpace(addr))
+	}
+
+	s, err := loadStore(*storePath)
+	if err != nil {
+		log.WithError(err).Fatal("Could not load store")
+	}
+	privKey := extractPrivateKey()
+	ipAddr, err := iputils.ExternalIPv4()
+	if err != nil {
+		log.WithError(err).Fatal("Could not get external ip")
+	}
+	listener, err := p2p.ListenDiscoveryV5(net.ParseIP(ipAddr), *discv5Port, privKey, bootnodes)
+	if err != nil {
+		log.WithError(err).Fatal("Could not start discv5")
+	}
+	defer listener.Close()
+	log.WithField("ENR", listener.Self().String()).Info("Started discovery v5")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h, err := createHost(ctx, privKey, *tcpPort)
+	if err != nil {
+		log.WithError(err).Fatal("Could not create libp2p host")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		if err := http.ListenAndServe(fmt.Sprintf(":%d", *metricsPort), mux); err != nil {
+			log.WithError(err).Fatal("Failed to start metrics server")
+		}
+	}()
+
+	runutil.RunEvery(ctx, *reportInterval, func() {
+		report(s)
+	})
+	go newCrawler(listener, h, s, *recrawlInterval, *dialTimeout, *maxDials).run(ctx)
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	<-sigc
+	log.Info("Shutting down")
+	cancel()
+	if err := h.Close(); err != nil {
+		log.WithError(err).Error("Could not close libp2p host")
+	}
+	report(s)
+}
+
+// report saves the store and the CSV export, and refreshes metrics.
+func report(s *store) {
+	updateMetrics(s)
+	if err := s.save(*storePath, formatJSON); err != nil {
+		log.WithError(err).Error("Could not save store")
+	}
+	if *csvPath != "" {
+		if err := s.save(*csvPath, formatCSV); err != nil {
+			log.WithError(err).Error("Could not save CSV report")
+		}
+	}
+	log.WithField("peers", len(s.records())).Info("Saved crawler report")
+}
+
+func createHost(ctx context.Context, privKey *ecdsa.PrivateKey, port int) (host.Host, error) {
+	return libp2p.New(
+		ctx,
+		libp2p.Identity((*crypto.Secp256k1PrivateKey)((*btcec.PrivateKey)(privKey))),
+		libp2p.ListenAddrStrings(fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", port)),
+		libp2p.UserAgent(version.GetBuildData()),
+		libp2p.Security(noise.ID, noise.New),
+		libp2p.Security(secio.ID, secio.New),
+	)
+}
+
+func extractPrivateKey() *ecdsa.PrivateKey {
+	if *privateKey != "" {
+		dst, err := hex.DecodeString(*privateKey)
+		if err != nil {
+			panic(err)
+		}
+		unmarshalledKey, err := crypto.UnmarshalSecp256k1PrivateKey(dst)
+		if err != nil {
+			panic(err)
+		}
+		return (*ecdsa.PrivateKey)((*btcec.PrivateKey)(unmarshalledKey.(*crypto.Secp256k1PrivateKey)))
+	}
+	privInterfaceKey, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	log.Warning("No private key was provided. Using default/random private key")
+	return (*ecdsa.PrivateKey)((*btcec.PrivateKey)(privInterfaceKey.(*crypto.Secp256k1PrivateKey)))
+}
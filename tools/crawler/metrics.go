@@ -0,0 +1,63 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	discoveredNodesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "crawler_discovered_nodes_total",
+		Help: "The number of nodes returned by the discv5 random walk",
+	})
+	handshakeFailuresCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "crawler_handshake_failures_total",
+		Help: "The number of failed dials and handshakes, by step",
+	}, []string{"step"})
+	knownPeersGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "crawler_known_peers",
+		Help: "The number of peers in the crawler store",
+	})
+	reachablePeersGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "crawler_reachable_peers",
+		Help: "The number of peers which completed the last handshake, by client and fork digest",
+	}, []string{"client", "fork_digest"})
+	maxFinalizedEpochGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "crawler_max_finalized_epoch",
+		Help: "The highest finalized epoch reported by reachable peers, by fork digest",
+	}, []string{"fork_digest"})
+	maxHeadSlotGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "crawler_max_head_slot",
+		Help: "The highest head slot reported by reachable peers, by fork digest",
+	}, []string{"fork_digest"})
+)
+
+// updateMetrics refreshes the gauges from the current content of the store.
+func updateMetrics(s *store) {
+	records := s.records()
+	knownPeersGauge.Set(float64(len(records)))
+	reachablePeersGauge.Reset()
+	maxFinalizedEpochGauge.Reset()
+	maxHeadSlotGauge.Reset()
+
+	maxFinalized := make(map[string]uint64)
+	maxHead := make(map[string]uint64)
+	for _, r := range records {
+		if !r.reachable() {
+			continue
+		}
+		reachablePeersGauge.WithLabelValues(r.Client, r.ForkDigest).Inc()
+		if r.FinalizedEpoch > maxFinalized[r.ForkDigest] {
+			maxFinalized[r.ForkDigest] = r.FinalizedEpoch
+		}
+		if r.HeadSlot > maxHead[r.ForkDigest] {
+			maxHead[r.ForkDigest] = r.HeadSlot
+		}
+	}
+	for digest, epoch := range maxFinalized {
+		maxFinalizedEpochGauge.WithLabelValues(digest).Set(float64(epoch))
+	}
+	for digest, slot := range maxHead {
+		maxHeadSlotGauge.WithLabelValues(digest).Set(float64(slot))
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Supported report formats.
+const (
+	formatJSON = "json"
+	formatCSV  = "csv"
+)
+
+// Client types, derived from the agent version advertised through libp2p identify.
+const (
+	clientPrysm      = "prysm"
+	clientLighthouse = "lighthouse"
+	clientTeku       = "teku"
+	clientNimbus     = "nimbus"
+	clientLodestar   = "lodestar"
+	clientUnknown    = "unknown"
+)
+
+var csvHeader = []string{
+	"peer_id", "address", "enr", "client", "agent_version", "fork_digest", "next_fork_version",
+	"next_fork_epoch", "head_slot", "head_root", "finalized_epoch", "finalized_root", "attnets",
+	"metadata_seq", "first_seen", "last_seen", "last_crawled", "last_error",
+}
+
+// peerRecord is everything the crawler learned about a single peer.
+type peerRecord struct {
+	PeerID          string    `json:"peer_id"`
+	Address         string    `json:"address"`
+	ENR             string    `json:"enr"`
+	Client          string    `json:"client"`
+	AgentVersion    string    `json:"agent_version"`
+	ForkDigest      string    `json:"fork_digest"`
+	NextForkVersion string    `json:"next_fork_version"`
+	NextForkEpoch   uint64    `json:"next_fork_epoch"`
+	HeadSlot        uint64    `json:"head_slot"`
+	HeadRoot        string    `json:"head_root"`
+	FinalizedEpoch  uint64    `json:"finalized_epoch"`
+	FinalizedRoot   string    `json:"finalized_root"`
+	Attnets         string    `json:"attnets"`
+	MetadataSeq     uint64    `json:"metadata_seq"`
+	FirstSeen       time.Time `json:"first_seen"`
+	LastSeen        time.Time `json:"last_seen"`
+	LastCrawled     time.Time `json:"last_crawled"`
+	LastError       string    `json:"last_error,omitempty"`
+}
+
+// reachable returns whether the last handshake with the peer succeeded.
+func (r *peerRecord) reachable() bool {
+	return !r.LastCrawled.IsZero() && r.LastError == ""
+}
+
+func (r *peerRecord) csvRow() []string {
+	return []string{
+		r.PeerID,
+		r.Address,
+		r.ENR,
+		r.Client,
+		r.AgentVersion,
+		r.ForkDigest,
+		r.NextForkVersion,
+		strconv.FormatUint(r.NextForkEpoch, 10),
+		strconv.FormatUint(r.HeadSlot, 10),
+		r.HeadRoot,
+		strconv.FormatUint(r.FinalizedEpoch, 10),
+		r.FinalizedRoot,
+		r.Attnets,
+		strconv.FormatUint(r.MetadataSeq, 10),
+		formatTime(r.FirstSeen),
+		formatTime(r.LastSeen),
+		formatTime(r.LastCrawled),
+		r.LastError,
+	}
+}
+
+// store keeps the records of all crawled peers, keyed by peer id. It can be saved
+// to and reloaded from a JSON report, so that the crawler keeps its history across
+// restarts.
+type store struct {
+	lock  sync.RWMutex
+	peers map[string]*peerRecord
+}
+
+func newStore() *store {
+	return &store{
+		peers: make(map[string]*peerRecord),
+	}
+}
+
+// loadStore reads a store from a JSON report. A missing file results in an empty store.
+func loadStore(path string) (*store, error) {
+	s := newStore()
+	enc, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read report")
+	}
+	var records []*peerRecord
+	if err := json.Unmarshal(enc, &records); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal report")
+	}
+	for _, r := range records {
+		s.peers[r.PeerID] = r
+	}
+	return s, nil
+}
+
+// update applies a change to the record of a peer, creating it if needed.
+func (s *store) update(pid string, f func(r *peerRecord)) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	r, ok := s.peers[pid]
+	if !ok {
+		r = &peerRecord{PeerID: pid}
+		s.peers[pid] = r
+	}
+	f(r)
+}
+
+// get returns a copy of the record of a peer.
+func (s *store) get(pid string) (peerRecord, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	r, ok := s.peers[pid]
+	if !ok {
+		return peerRecord{}, false
+	}
+	return *r, true
+}
+
+// records returns a copy of all records, sorted by peer id.
+func (s *store) records() []peerRecord {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	records := make([]peerRecord, 0, len(s.peers))
+	for _, r := range s.peers {
+		records = append(records, *r)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].PeerID < records[j].PeerID
+	})
+	return records
+}
+
+// writeJSON writes all records as a JSON array.
+func (s *store) writeJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s.records())
+}
+
+// writeCSV writes all records as CSV, with a header row.
+func (s *store) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, r := range s.records() {
+		if err := cw.Write(r.csvRow()); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// save writes the report in the given format. The file is replaced atomically so
+// that readers never observe a partial report.
+func (s *store) save(path string, format string) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "could not create report file")
+	}
+	switch format {
+	case formatJSON:
+		err = s.writeJSON(tmp)
+	case formatCSV:
+		err = s.writeCSV(tmp)
+	default:
+		err = fmt.Errorf("unknown report format %q", format)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if rmErr := os.Remove(tmp.Name()); rmErr != nil {
+			log.WithError(rmErr).Error("Could not remove temporary report file")
+		}
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// clientFromAgent derives the client type from a libp2p agent version.
+func clientFromAgent(agent string) string {
+	agent = strings.ToLower(agent)
+	for _, client := range []string{clientPrysm, clientLighthouse, clientTeku, clientNimbus, clientLodestar} {
+		if strings.Contains(agent, client) {
+			return client
+		}
+	}
+	return clientUnknown
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
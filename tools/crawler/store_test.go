@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_SaveAndLoad(t *testing.T) {
+	dir := path.Join(testutil.TempDir(), fmt.Sprintf("crawler-%d", rand.NewGenerator().Int()))
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	storeFile := path.Join(dir, "crawler.json")
+
+	s, err := loadStore(storeFile)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(s.records()))
+
+	crawled := time.Unix(1600000000, 0).UTC()
+	s.update("peerB", func(r *peerRecord) {
+		r.HeadSlot = 10
+		r.LastCrawled = crawled
+	})
+	s.update("peerA", func(r *peerRecord) {
+		r.ForkDigest = "e7a75d5a"
+		r.LastError = "dial: timeout"
+	})
+	require.NoError(t, s.save(storeFile, formatJSON))
+
+	loaded, err := loadStore(storeFile)
+	require.NoError(t, err)
+	records := loaded.records()
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, "peerA", records[0].PeerID)
+	assert.Equal(t, "e7a75d5a", records[0].ForkDigest)
+	assert.Equal(t, false, records[0].reachable())
+	r, ok := loaded.get("peerB")
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(10), r.HeadSlot)
+	assert.Equal(t, true, r.LastCrawled.Equal(crawled))
+	assert.Equal(t, true, r.reachable())
+
+	assert.ErrorContains(t, "unknown report format", s.save(storeFile, "xml"))
+}
+
+func TestStore_WriteCSV(t *testing.T) {
+	s := newStore()
+	s.update("peerA", func(r *peerRecord) {
+		r.Client = clientLighthouse
+		r.FinalizedEpoch = 7
+	})
+	buf := new(bytes.Buffer)
+	require.NoError(t, s.writeCSV(buf))
+
+	rows, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rows))
+	assert.DeepEqual(t, csvHeader, rows[0])
+	assert.Equal(t, len(csvHeader), len(rows[1]))
+	assert.Equal(t, "peerA", rows[1][0])
+	assert.Equal(t, clientLighthouse, rows[1][3])
+	assert.Equal(t, "7", rows[1][10])
+}
+
+func TestClientFromAgent(t *testing.T) {
+	tests := []struct {
+		agent string
+		want  string
+	}{
+		{agent: "Prysm/v1.0.0-alpha.25/abcdef", want: clientPrysm},
+		{agent: "Lighthouse/v0.2.9/linux-x86_64", want: clientLighthouse},
+		{agent: "teku/v0.12.7/linux-x86_64/oracle_openjdk-java-14", want: clientTeku},
+		{agent: "nimbus", want: clientNimbus},
+		{agent: "js-libp2p/0.28.10 lodestar", want: clientLodestar},
+		{agent: "", want: clientUnknown},
+		{agent: "rust-libp2p/0.23.0", want: clientUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.agent, func(t *testing.T) {
+			assert.Equal(t, tt.want, clientFromAgent(tt.agent))
+		})
+	}
+}
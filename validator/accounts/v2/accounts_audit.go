@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/audit"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// AuditAccountsCli verifies the hash chain of the audit log of the validator client, and
+// summarizes the messages it signed.
+func AuditAccountsCli(cliCtx *cli.Context) error {
+	dir := cliCtx.String(flags.AuditLogDirFlag.Name)
+	if dir == "" {
+		return errors.Errorf("--%s is required", flags.AuditLogDirFlag.Name)
+	}
+	summary, err := audit.Verify(dir)
+	if summary != nil && summary.Entries > 0 {
+		printAuditSummary(summary)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not verify audit log")
+	}
+	fmt.Printf(
+		"%s %d entries in %d files, last hash %s\n",
+		au.BrightGreen("Audit log verified:").Bold(), summary.Entries, summary.Files, summary.LastHash,
+	)
+	return nil
+}
+
+func printAuditSummary(summary *audit.Summary) {
+	fmt.Printf("%s %s to %s\n", au.BrightBlue("[period]").Bold(), summary.FirstTime, summary.LastTime)
+	fmt.Printf(
+		"%s %d signed, %d refused by slashing protection, %d failed\n",
+		au.BrightBlue("[entries]").Bold(), summary.Signed, summary.Rejected, summary.Failed,
+	)
+	types := make([]string, 0, len(summary.ByType))
+	for typ := range summary.ByType {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	for _, typ := range types {
+		fmt.Printf("  %-20s %d\n", typ, summary.ByType[typ])
+	}
+	pubKeys := make([]string, 0, len(summary.PublicKeys))
+	for pubKey := range summary.PublicKeys {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	sort.Strings(pubKeys)
+	fmt.Printf("%s %d\n", au.BrightBlue("[validating public keys]").Bold(), len(pubKeys))
+	for _, pubKey := range pubKeys {
+		fmt.Printf("  %s %d\n", pubKey, summary.PublicKeys[pubKey])
+	}
+}
@@ -2,8 +2,10 @@ package v2
 
 import (
 	"archive/zip"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -30,6 +32,14 @@ const (
 	backupPromptText = "Enter the directory where your backup.zip file will be written to"
 )
 
+// BackupAccountsConfig defines values to run the backup accounts function.
+type BackupAccountsConfig struct {
+	Wallet         *Wallet
+	Keymanager     v2keymanager.IKeymanager
+	PublicKeys     []bls.PublicKey
+	BackupPassword string
+}
+
 // BackupAccountsCli allows users to select validator accounts from their wallet
 // and export them as a backup.zip file containing the keys as EIP-2335 compliant
 // keystore.json files, which are compatible with importing in other eth2 clients.
@@ -42,10 +52,15 @@ func BackupAccountsCli(cliCtx *cli.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "could not initialize wallet")
 	}
-	if wallet.KeymanagerKind() == v2keymanager.Remote {
+	switch wallet.KeymanagerKind() {
+	case v2keymanager.Remote, v2keymanager.RemoteHTTP:
 		return errors.New(
 			"remote wallets cannot backup accounts",
 		)
+	case v2keymanager.Threshold:
+		return errors.New(
+			"threshold wallets cannot backup accounts",
+		)
 	}
 	keymanager, err := wallet.InitializeKeymanager(cliCtx.Context, true /* skip mnemonic confirm */)
 	if err != nil {
@@ -87,33 +102,57 @@ func BackupAccountsCli(cliCtx *cli.Context) error {
 		return errors.Wrap(err, "could not determine password for backed up accounts")
 	}
 
-	var keystoresToBackup []*v2keymanager.Keystore
-	switch wallet.KeymanagerKind() {
+	keystoresToBackup, err := extractKeystores(cliCtx.Context, &BackupAccountsConfig{
+		Wallet:         wallet,
+		Keymanager:     keymanager,
+		PublicKeys:     filteredPubKeys,
+		BackupPassword: backupsPassword,
+	})
+	if err != nil {
+		return err
+	}
+	return zipKeystoresToOutputDir(keystoresToBackup, backupDir)
+}
+
+// BackupAccounts exports accounts of a wallet as EIP-2335 compliant keystore.json files,
+// encrypted with the backup password, and writes them to w as a zip file.
+func BackupAccounts(ctx context.Context, cfg *BackupAccountsConfig, w io.Writer) error {
+	keystoresToBackup, err := extractKeystores(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	return zipKeystores(keystoresToBackup, w)
+}
+
+func extractKeystores(ctx context.Context, cfg *BackupAccountsConfig) ([]*v2keymanager.Keystore, error) {
+	switch cfg.Wallet.KeymanagerKind() {
 	case v2keymanager.Direct:
-		km, ok := keymanager.(*direct.Keymanager)
+		km, ok := cfg.Keymanager.(*direct.Keymanager)
 		if !ok {
-			return errors.New("could not assert keymanager interface to concrete type")
+			return nil, errors.New("could not assert keymanager interface to concrete type")
 		}
-		keystoresToBackup, err = km.ExtractKeystores(cliCtx.Context, filteredPubKeys, backupsPassword)
+		keystores, err := km.ExtractKeystores(ctx, cfg.PublicKeys, cfg.BackupPassword)
 		if err != nil {
-			return errors.Wrap(err, "could not backup accounts for direct keymanager")
+			return nil, errors.Wrap(err, "could not backup accounts for direct keymanager")
 		}
+		return keystores, nil
 	case v2keymanager.Derived:
-		km, ok := keymanager.(*derived.Keymanager)
+		km, ok := cfg.Keymanager.(*derived.Keymanager)
 		if !ok {
-			return errors.New("could not assert keymanager interface to concrete type")
+			return nil, errors.New("could not assert keymanager interface to concrete type")
 		}
-		keystoresToBackup, err = km.ExtractKeystores(cliCtx.Context, filteredPubKeys, backupsPassword)
+		keystores, err := km.ExtractKeystores(ctx, cfg.PublicKeys, cfg.BackupPassword)
 		if err != nil {
-			return errors.Wrap(err, "could not backup accounts for derived keymanager")
+			return nil, errors.Wrap(err, "could not backup accounts for derived keymanager")
 		}
-		return nil
-	case v2keymanager.Remote:
-		return errors.New("backing up keys is not supported for a remote keymanager")
+		return keystores, nil
+	case v2keymanager.Remote, v2keymanager.RemoteHTTP:
+		return nil, errors.New("backing up keys is not supported for a remote keymanager")
+	case v2keymanager.Threshold:
+		return nil, errors.New("backing up keys is not supported for a threshold keymanager")
 	default:
-		return errors.New("keymanager kind not supported")
+		return nil, errors.New("keymanager kind not supported")
 	}
-	return zipKeystoresToOutputDir(keystoresToBackup, backupDir)
 }
 
 // Ask user to select accounts via an interactive prompt.
@@ -217,15 +256,24 @@ func zipKeystoresToOutputDir(keystoresToBackup []*v2keymanager.Keystore, outputD
 			log.WithError(err).Error("Could not close zipfile")
 		}
 	}()
-	// Using this zip file, we create a new zip writer which we write
-	// files to directly from our marshaled keystores.
-	writer := zip.NewWriter(zipfile)
-	defer func() {
-		// We close the zip writer when done.
-		if err := writer.Close(); err != nil {
-			log.WithError(err).Error("Could not close zip file after writing")
-		}
-	}()
+	if err := zipKeystores(keystoresToBackup, zipfile); err != nil {
+		return err
+	}
+	log.WithField(
+		"backup-path", archivePath,
+	).Infof("Successfully backed up %d accounts", len(keystoresToBackup))
+	return nil
+}
+
+// Zips a list of keystores into respective EIP-2335 keystore.json files,
+// written to w.
+func zipKeystores(keystoresToBackup []*v2keymanager.Keystore, w io.Writer) error {
+	if len(keystoresToBackup) == 0 {
+		return errors.New("nothing to backup")
+	}
+	// We create a new zip writer which we write files to
+	// directly from our marshaled keystores.
+	writer := zip.NewWriter(w)
 	for i, k := range keystoresToBackup {
 		encodedFile, err := json.MarshalIndent(k, "", "\t")
 		if err != nil {
@@ -239,8 +287,9 @@ func zipKeystoresToOutputDir(keystoresToBackup []*v2keymanager.Keystore, outputD
 			return errors.Wrap(err, "could not write keystore file contents")
 		}
 	}
-	log.WithField(
-		"backup-path", archivePath,
-	).Infof("Successfully backed up %d accounts", len(keystoresToBackup))
+	// We close the zip writer when done, which writes its central directory.
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "could not close zip file after writing")
+	}
 	return nil
 }
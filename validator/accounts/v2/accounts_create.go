@@ -49,8 +49,10 @@ func CreateAccount(ctx context.Context, cfg *CreateAccountConfig) error {
 		return errors.Wrap(err, "could not initialize keymanager")
 	}
 	switch cfg.Wallet.KeymanagerKind() {
-	case v2keymanager.Remote:
+	case v2keymanager.Remote, v2keymanager.RemoteHTTP:
 		return errors.New("cannot create a new account for a remote keymanager")
+	case v2keymanager.Threshold:
+		return errors.New("cannot create a new account for a threshold keymanager, create a new threshold wallet instead")
 	case v2keymanager.Direct:
 		km, ok := keymanager.(*direct.Keymanager)
 		if !ok {
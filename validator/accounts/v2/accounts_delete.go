@@ -105,8 +105,10 @@ func DeleteAccountCli(cliCtx *cli.Context) error {
 // DeleteAccount deletes the accounts that the user requests to be deleted from the wallet.
 func DeleteAccount(ctx context.Context, cfg *DeleteAccountConfig) error {
 	switch cfg.Wallet.KeymanagerKind() {
-	case v2keymanager.Remote:
+	case v2keymanager.Remote, v2keymanager.RemoteHTTP:
 		return errors.New("cannot delete accounts for a remote keymanager")
+	case v2keymanager.Threshold:
+		return errors.New("cannot delete accounts for a threshold keymanager")
 	case v2keymanager.Direct:
 		km, ok := cfg.Keymanager.(*direct.Keymanager)
 		if !ok {
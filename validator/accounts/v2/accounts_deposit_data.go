@@ -0,0 +1,270 @@
+package v2
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/threshold"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// DepositDataConfig defines values to run the deposit data function.
+type DepositDataConfig struct {
+	Keymanager v2keymanager.IKeymanager
+	PublicKeys [][]byte
+	// WithdrawalPublicKeys maps each validating public key to the BLS public key its
+	// withdrawal credentials are derived from.
+	WithdrawalPublicKeys map[[48]byte][]byte
+	// Amount of each deposit in Gwei.
+	Amount uint64
+}
+
+// DepositDataCli writes the deposit data of the selected accounts of a wallet to a
+// deposit_data-*.json file, in the format of the eth2 launchpad.
+func DepositDataCli(cliCtx *cli.Context) error {
+	wallet, err := OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*Wallet, error) {
+		return nil, errors.New(
+			"no wallet found, nothing to generate deposit data for",
+		)
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	if wallet.KeymanagerKind() == v2keymanager.Threshold {
+		return errors.New("cannot generate deposit data for a threshold wallet, as its co-signers do not sign deposits")
+	}
+	keymanager, err := wallet.InitializeKeymanager(cliCtx.Context, true /* skip mnemonic confirm */)
+	if err != nil && strings.Contains(err.Error(), "invalid checksum") {
+		return errors.New("wrong wallet password entered")
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	validatingPublicKeys, err := keymanager.FetchValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(validatingPublicKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to generate deposit data for")
+	}
+	rawPublicKeys := make([][]byte, 0, len(validatingPublicKeys))
+	if cliCtx.Bool(flags.DepositAllAccountsFlag.Name) {
+		for _, pk := range validatingPublicKeys {
+			rawPublicKeys = append(rawPublicKeys, bytesutil.SafeCopyBytes(pk[:]))
+		}
+	} else {
+		filteredPubKeys, err := filterPublicKeysFromUserInput(
+			cliCtx,
+			flags.DepositPublicKeysFlag,
+			validatingPublicKeys,
+			selectAccountsDepositDataPromptText,
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not filter validating public keys for deposit data")
+		}
+		for _, pk := range filteredPubKeys {
+			rawPublicKeys = append(rawPublicKeys, pk.Marshal())
+		}
+	}
+	withdrawalPublicKeys, err := withdrawalPublicKeysFromCli(cliCtx, keymanager, validatingPublicKeys)
+	if err != nil {
+		return err
+	}
+	dir, err := inputDirectory(cliCtx, depositDataDirPromptText, flags.DepositDataDirFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not parse deposit data directory")
+	}
+	deposits, err := DepositData(cliCtx.Context, &DepositDataConfig{
+		Keymanager:           keymanager,
+		PublicKeys:           rawPublicKeys,
+		WithdrawalPublicKeys: withdrawalPublicKeys,
+		Amount:               params.BeaconConfig().MaxEffectiveBalance,
+	})
+	if err != nil {
+		return err
+	}
+	filePath, err := WriteDepositDataFile(dir, deposits)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"path":     filePath,
+		"deposits": len(deposits),
+	}).Info("Wrote deposit data file, which can be uploaded to the eth2 launchpad")
+	return nil
+}
+
+// VerifyDepositDataCli checks the signatures and roots of the deposits in a deposit_data-*.json
+// file against the network config.
+func VerifyDepositDataCli(cliCtx *cli.Context) error {
+	filePath := cliCtx.String(flags.DepositDataFileFlag.Name)
+	if filePath == "" {
+		return fmt.Errorf("a deposit data file must be given with --%s", flags.DepositDataFileFlag.Name)
+	}
+	filePath, err := fileutil.ExpandPath(filePath)
+	if err != nil {
+		return errors.Wrap(err, "could not expand deposit data file path")
+	}
+	return VerifyDepositDataFile(filePath)
+}
+
+// DepositData signs a deposit for each of the given public keys with the keymanager, and
+// returns them in the format of the eth2 launchpad. The keymanager signs the signing root of
+// each deposit message, which a threshold keymanager cannot do, as its co-signers only sign
+// the beacon chain objects they can check.
+func DepositData(ctx context.Context, cfg *DepositDataConfig) ([]*depositutil.DepositDataJSON, error) {
+	if _, ok := cfg.Keymanager.(*threshold.Keymanager); ok {
+		return nil, errors.New("cannot sign deposits with a threshold keymanager")
+	}
+	domain, err := depositutil.DepositDomain()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute deposit domain")
+	}
+	deposits := make([]*depositutil.DepositDataJSON, len(cfg.PublicKeys))
+	for i, pubKey := range cfg.PublicKeys {
+		withdrawalPubKey, ok := cfg.WithdrawalPublicKeys[bytesutil.ToBytes48(pubKey)]
+		if !ok {
+			return nil, fmt.Errorf("no withdrawal public key for validating public key %#x", pubKey)
+		}
+		dd := &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: depositutil.WithdrawalCredentialsFromPublicKey(withdrawalPubKey),
+			Amount:                cfg.Amount,
+		}
+		root, err := depositutil.DepositSigningRoot(dd)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute signing root of deposit for %#x", pubKey)
+		}
+		sig, err := cfg.Keymanager.Sign(ctx, &validatorpb.SignRequest{
+			PublicKey:       pubKey,
+			SigningRoot:     root[:],
+			SignatureDomain: domain,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not sign deposit for %#x", pubKey)
+		}
+		dd.Signature = sig.Marshal()
+		deposits[i], err = depositutil.DepositDataToJSON(dd)
+		if err != nil {
+			return nil, err
+		}
+		// The deposit is checked as the launchpad would, so that a wrong signature
+		// cannot lose the funds of the deposit.
+		if err := depositutil.VerifyDepositDataJSON(deposits[i]); err != nil {
+			return nil, errors.Wrapf(err, "could not verify deposit for %#x", pubKey)
+		}
+	}
+	return deposits, nil
+}
+
+// WriteDepositDataFile writes deposits to a deposit_data-<timestamp>.json file in a directory,
+// and returns the path of the file.
+func WriteDepositDataFile(dir string, deposits []*depositutil.DepositDataJSON) (string, error) {
+	if err := os.MkdirAll(dir, DirectoryPermissions); err != nil {
+		return "", errors.Wrapf(err, "could not create directory %s", dir)
+	}
+	enc, err := json.Marshal(deposits)
+	if err != nil {
+		return "", errors.Wrap(err, "could not encode deposit data")
+	}
+	filePath := filepath.Join(dir, fmt.Sprintf("deposit_data-%d.json", time.Now().Unix()))
+	if err := ioutil.WriteFile(filePath, enc, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return "", errors.Wrapf(err, "could not write deposit data file %s", filePath)
+	}
+	return filePath, nil
+}
+
+// VerifyDepositDataFile verifies each deposit of a deposit_data-*.json file, logging the
+// result of each, and returns an error if any of them is invalid.
+func VerifyDepositDataFile(filePath string) error {
+	enc, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return errors.Wrapf(err, "could not read deposit data file %s", filePath)
+	}
+	var deposits []*depositutil.DepositDataJSON
+	if err := json.Unmarshal(enc, &deposits); err != nil {
+		return errors.Wrapf(err, "could not decode deposit data file %s", filePath)
+	}
+	if len(deposits) == 0 {
+		return fmt.Errorf("deposit data file %s has no deposits", filePath)
+	}
+	invalid := 0
+	for i, d := range deposits {
+		entry := log.WithFields(logrus.Fields{
+			"index":  i,
+			"pubkey": d.PubKey,
+		})
+		if err := depositutil.VerifyDepositDataJSON(d); err != nil {
+			entry.WithError(err).Error("Invalid deposit")
+			invalid++
+			continue
+		}
+		entry.Info("Valid deposit")
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d deposits are invalid", invalid, len(deposits))
+	}
+	log.WithField("network", params.BeaconConfig().NetworkName).Infof("All %d deposits are valid", len(deposits))
+	return nil
+}
+
+// withdrawalPublicKeysFromCli returns the withdrawal public key of each validating public key,
+// which is either given by a flag or derived by the keymanager of a derived wallet.
+func withdrawalPublicKeysFromCli(
+	cliCtx *cli.Context,
+	keymanager v2keymanager.IKeymanager,
+	validatingPublicKeys [][48]byte,
+) (map[[48]byte][]byte, error) {
+	withdrawalPublicKeys := make(map[[48]byte][]byte, len(validatingPublicKeys))
+	if cliCtx.IsSet(flags.WithdrawalPublicKeyFlag.Name) {
+		withdrawalPubKey, err := hex.DecodeString(strings.TrimPrefix(cliCtx.String(flags.WithdrawalPublicKeyFlag.Name), "0x"))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode withdrawal public key as hex")
+		}
+		if _, err := bls.PublicKeyFromBytes(withdrawalPubKey); err != nil {
+			return nil, errors.Wrapf(err, "%#x is not a valid BLS public key", withdrawalPubKey)
+		}
+		for _, pk := range validatingPublicKeys {
+			withdrawalPublicKeys[pk] = withdrawalPubKey
+		}
+		return withdrawalPublicKeys, nil
+	}
+	km, ok := keymanager.(*derived.Keymanager)
+	if !ok {
+		return nil, fmt.Errorf(
+			"the wallet cannot derive withdrawal keys, a withdrawal public key must be given with --%s",
+			flags.WithdrawalPublicKeyFlag.Name,
+		)
+	}
+	// The withdrawal keys of a derived wallet are in the same order as its validating keys.
+	derivedPublicKeys, err := km.FetchWithdrawalPublicKeys(cliCtx.Context)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch withdrawal public keys")
+	}
+	if len(derivedPublicKeys) != len(validatingPublicKeys) {
+		return nil, errors.New("wallet has a different number of withdrawal and validating keys")
+	}
+	for i, pk := range validatingPublicKeys {
+		withdrawalPublicKeys[pk] = bytesutil.SafeCopyBytes(derivedPublicKeys[i][:])
+	}
+	return withdrawalPublicKeys, nil
+}
@@ -0,0 +1,111 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/threshold"
+	"github.com/urfave/cli/v2"
+)
+
+func setupDerivedWallet(t *testing.T, numAccounts int) (*cli.Context, *Wallet, *derived.Keymanager) {
+	walletDir, passwordsDir, passwordFilePath := setupWalletAndPasswordsDir(t)
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:          walletDir,
+		passwordsDir:       passwordsDir,
+		keymanagerKind:     v2keymanager.Derived,
+		walletPasswordFile: passwordFilePath,
+	})
+	wallet, err := CreateWalletWithKeymanager(cliCtx.Context, &CreateWalletConfig{
+		WalletCfg: &WalletConfig{
+			WalletDir:      walletDir,
+			KeymanagerKind: v2keymanager.Derived,
+			WalletPassword: "Passwordz0320$",
+		},
+	})
+	require.NoError(t, err)
+	keymanager, err := derived.NewKeymanager(
+		cliCtx.Context,
+		&derived.SetupConfig{
+			Opts:                derived.DefaultKeymanagerOpts(),
+			Wallet:              wallet,
+			SkipMnemonicConfirm: true,
+		},
+	)
+	require.NoError(t, err)
+	for i := 0; i < numAccounts; i++ {
+		_, err := keymanager.CreateAccount(cliCtx.Context, false /*logAccountInfo*/)
+		require.NoError(t, err)
+	}
+	return cliCtx, wallet, keymanager
+}
+
+func TestDepositData_DerivedKeymanager(t *testing.T) {
+	numAccounts := 2
+	cliCtx, wallet, keymanager := setupDerivedWallet(t, numAccounts)
+	validatingPublicKeys, err := keymanager.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	withdrawalPublicKeys, err := withdrawalPublicKeysFromCli(cliCtx, keymanager, validatingPublicKeys)
+	require.NoError(t, err)
+	publicKeys := make([][]byte, len(validatingPublicKeys))
+	for i := range validatingPublicKeys {
+		publicKeys[i] = validatingPublicKeys[i][:]
+	}
+
+	deposits, err := DepositData(cliCtx.Context, &DepositDataConfig{
+		Keymanager:           keymanager,
+		PublicKeys:           publicKeys,
+		WithdrawalPublicKeys: withdrawalPublicKeys,
+		Amount:               params.BeaconConfig().MaxEffectiveBalance,
+	})
+	require.NoError(t, err)
+	require.Equal(t, numAccounts, len(deposits))
+
+	// The withdrawal credentials are derived from the withdrawal key of each account.
+	derivedWithdrawalKeys, err := keymanager.FetchWithdrawalPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+	for i, d := range deposits {
+		dd, err := d.DepositData()
+		require.NoError(t, err)
+		assert.DeepEqual(t, validatingPublicKeys[i][:], dd.PublicKey)
+		assert.DeepEqual(t, depositutil.WithdrawalCredentialsFromPublicKey(derivedWithdrawalKeys[i][:]), dd.WithdrawalCredentials)
+	}
+
+	filePath, err := WriteDepositDataFile(wallet.walletDir, deposits)
+	require.NoError(t, err)
+	require.NoError(t, VerifyDepositDataFile(filePath))
+
+	// A tampered deposit is refused.
+	deposits[1].Amount = params.BeaconConfig().MinDepositAmount
+	enc, err := json.Marshal(deposits)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filePath, enc, params.BeaconIoConfig().ReadWritePermissions))
+	assert.ErrorContains(t, "1 of 2 deposits are invalid", VerifyDepositDataFile(filePath))
+}
+
+func TestDepositData_MissingWithdrawalPublicKey(t *testing.T) {
+	_, err := DepositData(context.Background(), &DepositDataConfig{
+		PublicKeys:           [][]byte{make([]byte, 48)},
+		WithdrawalPublicKeys: map[[48]byte][]byte{},
+		Amount:               params.BeaconConfig().MaxEffectiveBalance,
+	})
+	assert.ErrorContains(t, "no withdrawal public key", err)
+}
+
+func TestDepositData_ThresholdKeymanager(t *testing.T) {
+	_, err := DepositData(context.Background(), &DepositDataConfig{
+		Keymanager:           &threshold.Keymanager{},
+		PublicKeys:           [][]byte{make([]byte, 48)},
+		WithdrawalPublicKeys: map[[48]byte][]byte{{}: make([]byte, 48)},
+		Amount:               params.BeaconConfig().MaxEffectiveBalance,
+	})
+	assert.ErrorContains(t, "cannot sign deposits with a threshold keymanager", err)
+}
@@ -2,24 +2,36 @@ package v2
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"strings"
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/audit"
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	v2 "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	"github.com/urfave/cli/v2"
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// ExitAccountsConfig defines values to run the exit accounts function.
+type ExitAccountsConfig struct {
+	ValidatorClient ethpb.BeaconNodeValidatorClient
+	NodeClient      ethpb.NodeClient
+	Keymanager      v2.IKeymanager
+	AuditLog        *audit.Log
+	PublicKeys      [][]byte
+}
+
 type performExitCfg struct {
 	validatorClient  ethpb.BeaconNodeValidatorClient
 	nodeClient       ethpb.NodeClient
@@ -48,6 +60,21 @@ func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
 	if err != nil {
 		return err
 	}
+	if dir := cliCtx.String(flags.AuditLogDirFlag.Name); dir != "" {
+		auditLog, err := audit.NewLog(dir, cliCtx.Int64(flags.AuditLogMaxSizeFlag.Name)*1024*1024)
+		if err != nil {
+			return errors.Wrap(err, "could not open audit log")
+		}
+		defer func() {
+			if err := auditLog.Close(); err != nil {
+				log.WithError(err).Error("Could not close audit log")
+			}
+		}()
+		keymanager = &auditedKeymanager{
+			IKeymanager: keymanager,
+			sign:        auditLog.Signer(keymanager.Sign),
+		}
+	}
 	cfg := performExitCfg{
 		*validatorClient,
 		*nodeClient,
@@ -71,6 +98,17 @@ func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
 	return nil
 }
 
+// auditedKeymanager records the messages signed by a keymanager in an audit log.
+type auditedKeymanager struct {
+	v2.IKeymanager
+	sign func(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
+}
+
+// Sign a message with the keymanager, recording it in the audit log.
+func (km *auditedKeymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	return km.sign(ctx, req)
+}
+
 // ExitAccountsUnimplemented is a stub for ExitAccounts until the latter is fully implemented.
 func ExitAccountsUnimplemented(cliCtx *cli.Context, r io.Reader) error {
 	return status.Errorf(codes.Unimplemented, "method ExitAccounts not implemented")
@@ -171,47 +209,71 @@ func interact(cliCtx *cli.Context, r io.Reader, validatingPublicKeys [][48]byte)
 }
 
 func prepareClients(cliCtx *cli.Context) (*ethpb.BeaconNodeValidatorClient, *ethpb.NodeClient, error) {
+	conns, err := DialBeaconNodes(cliCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &conns.ValidatorClient, &conns.NodeClient, nil
+}
+
+// DialBeaconNodes connects to the beacon nodes given by the beacon RPC provider flag, with
+// clients which fail over to the next beacon node when one is unavailable.
+func DialBeaconNodes(cliCtx *cli.Context) (*client.BeaconNodeConns, error) {
 	dialOpts := client.ConstructDialOptions(
-		cmd.GrpcMaxCallRecvMsgSizeFlag.Value,
-		flags.CertFlag.Value,
-		strings.Split(flags.GrpcHeadersFlag.Value, ","),
-		flags.GrpcRetriesFlag.Value,
-		flags.GrpcRetryDelayFlag.Value,
+		cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		cliCtx.String(flags.CertFlag.Name),
+		strings.Split(cliCtx.String(flags.GrpcHeadersFlag.Name), ","),
+		cliCtx.Uint(flags.GrpcRetriesFlag.Name),
+		cliCtx.Duration(flags.GrpcRetryDelayFlag.Name),
 	)
 	if dialOpts == nil {
-		return nil, nil, errors.New("failed to construct dial options")
+		return nil, errors.New("failed to construct dial options")
 	}
-	conn, err := grpc.DialContext(cliCtx.Context, cliCtx.String(flags.BeaconRPCProviderFlag.Name), dialOpts...)
+	conns, err := client.DialBeaconNodes(cliCtx.Context, cliCtx.String(flags.BeaconRPCProviderFlag.Name), dialOpts...)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "could not dial endpoint %s", flags.BeaconRPCProviderFlag.Name)
+		return nil, errors.Wrapf(err, "could not dial %s", flags.BeaconRPCProviderFlag.Name)
 	}
-	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
-	nodeClient := ethpb.NewNodeClient(conn)
-
-	return &validatorClient, &nodeClient, nil
+	return conns, nil
 }
 
 func performExit(cliCtx *cli.Context, cfg performExitCfg) ([]string, error) {
-	var rawNotExitedKeys [][]byte
-	for i, key := range cfg.rawPubKeys {
-		if err := client.ProposeExit(cliCtx.Context, cfg.validatorClient, cfg.nodeClient, cfg.keymanager.Sign, key); err != nil {
-			rawNotExitedKeys = append(rawNotExitedKeys, key)
-			log.WithError(err).Errorf("voluntary exit failed for account %s", cfg.formattedPubKeys[i])
-		}
+	rawExitedKeys, err := ExitAccounts(cliCtx.Context, &ExitAccountsConfig{
+		ValidatorClient: cfg.validatorClient,
+		NodeClient:      cfg.nodeClient,
+		Keymanager:      cfg.keymanager,
+		PublicKeys:      cfg.rawPubKeys,
+	})
+	if err != nil {
+		return nil, err
 	}
 	var formattedExitedKeys []string
 	for i, key := range cfg.rawPubKeys {
-		found := false
-		for _, notExited := range rawNotExitedKeys {
-			if bytes.Equal(notExited, key) {
-				found = true
+		for _, exited := range rawExitedKeys {
+			if bytes.Equal(exited, key) {
+				formattedExitedKeys = append(formattedExitedKeys, cfg.formattedPubKeys[i])
 				break
 			}
 		}
-		if !found {
-			formattedExitedKeys = append(formattedExitedKeys, cfg.formattedPubKeys[i])
-		}
 	}
 
 	return formattedExitedKeys, nil
 }
+
+// ExitAccounts performs a voluntary exit on accounts, and returns the public keys of the
+// accounts for which it succeeded. Exits signed by the keymanager are recorded in the
+// audit log, if any.
+func ExitAccounts(ctx context.Context, cfg *ExitAccountsConfig) ([][]byte, error) {
+	sign := cfg.Keymanager.Sign
+	if cfg.AuditLog != nil {
+		sign = cfg.AuditLog.Signer(sign)
+	}
+	var rawExitedKeys [][]byte
+	for _, key := range cfg.PublicKeys {
+		if err := client.ProposeExit(ctx, cfg.ValidatorClient, cfg.NodeClient, sign, key); err != nil {
+			log.WithError(err).Errorf("voluntary exit failed for account %#x", bytesutil.Trunc(key))
+			continue
+		}
+		rawExitedKeys = append(rawExitedKeys, key)
+	}
+	return rawExitedKeys, nil
+}
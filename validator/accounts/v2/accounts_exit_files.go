@@ -0,0 +1,486 @@
+package v2
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	v2 "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The stages of the exit of a validator, until its balance can be withdrawn.
+const (
+	ExitStageNotExited    = "not exited"
+	ExitStageExiting      = "exiting"
+	ExitStageExited       = "exited"
+	ExitStageWithdrawable = "withdrawable"
+	ExitStageUnknown      = "unknown validator"
+)
+
+// SignedExitJSON is a signed voluntary exit stored in a file, in the format of the beacon
+// node API, along with the public key of the validator to track its exit.
+type SignedExitJSON struct {
+	Message   *ExitMessageJSON `json:"message"`
+	Signature string           `json:"signature"`
+	PublicKey string           `json:"pubkey"`
+}
+
+// ExitMessageJSON is the voluntary exit of a SignedExitJSON.
+type ExitMessageJSON struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+// SignExitsConfig defines values to run the sign exits function.
+type SignExitsConfig struct {
+	ValidatorClient ethpb.BeaconNodeValidatorClient
+	Keymanager      v2.IKeymanager
+	PublicKeys      [][]byte
+	// Epoch from which the exits are valid, which can be in the future.
+	Epoch uint64
+}
+
+// SubmitExitsConfig defines values to run the submit exits function.
+type SubmitExitsConfig struct {
+	ValidatorClient ethpb.BeaconNodeValidatorClient
+	BeaconClient    ethpb.BeaconChainClient
+	Exits           []*SignedExitJSON
+	// MaxPerEpoch caps the number of exits submitted per epoch below the churn limit if set.
+	MaxPerEpoch uint64
+	// WaitForNextEpoch waits between the batches of exits, for an epoch if nil.
+	WaitForNextEpoch func(ctx context.Context) error
+}
+
+// ExitStatus is the stage of the exit of a validator.
+type ExitStatus struct {
+	PublicKey         []byte
+	Stage             string
+	ExitEpoch         uint64
+	WithdrawableEpoch uint64
+}
+
+// SignExitsCli pre-signs voluntary exits of accounts of a wallet for an epoch, and writes them
+// to files which can be kept offline and submitted later without the keys.
+func SignExitsCli(cliCtx *cli.Context) error {
+	validatingPublicKeys, keymanager, err := prepareWallet(cliCtx)
+	if err != nil {
+		return err
+	}
+	filteredPubKeys, err := filterPublicKeysFromUserInput(
+		cliCtx,
+		flags.VoluntaryExitPublicKeysFlag,
+		validatingPublicKeys,
+		selectAccountsSignExitsPromptText,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not filter public keys for voluntary exits")
+	}
+	rawPubKeys := make([][]byte, len(filteredPubKeys))
+	for i, pk := range filteredPubKeys {
+		rawPubKeys[i] = pk.Marshal()
+	}
+	dir, err := inputDirectory(cliCtx, exitsDirPromptText, flags.ExitsDirFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not parse exits directory")
+	}
+	conns, err := DialBeaconNodes(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer conns.Close()
+	epoch := cliCtx.Uint64(flags.ExitEpochFlag.Name)
+	if !cliCtx.IsSet(flags.ExitEpochFlag.Name) {
+		head, err := conns.BeaconClient.GetChainHead(cliCtx.Context, &ptypes.Empty{})
+		if err != nil {
+			return errors.Wrap(err, "could not get chain head")
+		}
+		epoch = head.HeadEpoch
+	}
+	exits, err := SignExits(cliCtx.Context, &SignExitsConfig{
+		ValidatorClient: conns.ValidatorClient,
+		Keymanager:      keymanager,
+		PublicKeys:      rawPubKeys,
+		Epoch:           epoch,
+	})
+	if err != nil {
+		return err
+	}
+	paths, err := WriteExitFiles(dir, exits)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"dir":   dir,
+		"exits": len(paths),
+		"epoch": epoch,
+	}).Info("Wrote signed voluntary exits, which can be submitted with accounts-v2 submit-exits from their epoch")
+	return nil
+}
+
+// SubmitExitsCli submits voluntary exits stored in files to a beacon node, at most as many
+// per epoch as the churn limit allows, and fails if any of them could not be submitted.
+func SubmitExitsCli(cliCtx *cli.Context) error {
+	exits, err := exitsFromCli(cliCtx)
+	if err != nil {
+		return err
+	}
+	conns, err := DialBeaconNodes(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer conns.Close()
+	submitted, err := SubmitExits(cliCtx.Context, &SubmitExitsConfig{
+		ValidatorClient: conns.ValidatorClient,
+		BeaconClient:    conns.BeaconClient,
+		Exits:           exits,
+		MaxPerEpoch:     cliCtx.Uint64(flags.ExitsPerEpochFlag.Name),
+	})
+	log.WithField("submitted", len(submitted)).Infof("Submitted %d of %d voluntary exits", len(submitted), len(exits))
+	return err
+}
+
+// ExitStatusCli prints the stage of the exit of validators, given by public keys or by exit
+// files, and optionally keeps tracking them every epoch until they are all withdrawable.
+func ExitStatusCli(cliCtx *cli.Context) error {
+	var pubKeys [][]byte
+	if cliCtx.IsSet(flags.ExitsPathFlag.Name) {
+		exits, err := exitsFromCli(cliCtx)
+		if err != nil {
+			return err
+		}
+		for _, exit := range exits {
+			pubKey, err := hex.DecodeString(strings.TrimPrefix(exit.PublicKey, "0x"))
+			if err != nil {
+				return errors.Wrapf(err, "could not decode public key of exit of validator %s", exit.Message.ValidatorIndex)
+			}
+			pubKeys = append(pubKeys, pubKey)
+		}
+	} else if cliCtx.IsSet(flags.VoluntaryExitPublicKeysFlag.Name) {
+		filteredPubKeys, err := filterPublicKeysFromUserInput(cliCtx, flags.VoluntaryExitPublicKeysFlag, nil, "")
+		if err != nil {
+			return errors.Wrap(err, "could not parse public keys")
+		}
+		for _, pk := range filteredPubKeys {
+			pubKeys = append(pubKeys, pk.Marshal())
+		}
+	}
+	if len(pubKeys) == 0 {
+		return fmt.Errorf("no validators to track, set --%s or --%s", flags.VoluntaryExitPublicKeysFlag.Name, flags.ExitsPathFlag.Name)
+	}
+	conns, err := DialBeaconNodes(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer conns.Close()
+	beaconClient := conns.BeaconClient
+	for {
+		statuses, err := ExitStatuses(cliCtx.Context, beaconClient, pubKeys)
+		if err != nil {
+			return err
+		}
+		withdrawable := 0
+		for _, s := range statuses {
+			log.WithFields(logrus.Fields{
+				"pubKey":            fmt.Sprintf("%#x", bytesutil.Trunc(s.PublicKey)),
+				"exitEpoch":         s.ExitEpoch,
+				"withdrawableEpoch": s.WithdrawableEpoch,
+			}).Infof("Validator %s", s.Stage)
+			if s.Stage == ExitStageWithdrawable {
+				withdrawable++
+			}
+		}
+		if !cliCtx.Bool(flags.WatchExitsFlag.Name) || withdrawable == len(statuses) {
+			return nil
+		}
+		if err := waitForEpoch(cliCtx.Context); err != nil {
+			return err
+		}
+	}
+}
+
+// SignExits signs a voluntary exit of each of the given accounts for an epoch.
+func SignExits(ctx context.Context, cfg *SignExitsConfig) ([]*SignedExitJSON, error) {
+	exits := make([]*SignedExitJSON, len(cfg.PublicKeys))
+	for i, pubKey := range cfg.PublicKeys {
+		indexResponse, err := cfg.ValidatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get index of validator %#x", bytesutil.Trunc(pubKey))
+		}
+		signedExit, err := client.SignExit(ctx, cfg.ValidatorClient, cfg.Keymanager.Sign, pubKey, indexResponse.Index, cfg.Epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not sign exit of validator %#x", bytesutil.Trunc(pubKey))
+		}
+		exits[i] = signedExitToJSON(pubKey, signedExit)
+	}
+	return exits, nil
+}
+
+// WriteExitFiles writes signed voluntary exits to exit-<validator index>-<epoch>.json files in
+// a directory, and returns their paths.
+func WriteExitFiles(dir string, exits []*SignedExitJSON) ([]string, error) {
+	if err := os.MkdirAll(dir, DirectoryPermissions); err != nil {
+		return nil, errors.Wrapf(err, "could not create directory %s", dir)
+	}
+	paths := make([]string, len(exits))
+	for i, exit := range exits {
+		enc, err := json.MarshalIndent(exit, "", "\t")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not encode exit")
+		}
+		paths[i] = filepath.Join(dir, fmt.Sprintf("exit-%s-%s.json", exit.Message.ValidatorIndex, exit.Message.Epoch))
+		if err := ioutil.WriteFile(paths[i], enc, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+			return nil, errors.Wrapf(err, "could not write exit file %s", paths[i])
+		}
+	}
+	return paths, nil
+}
+
+// ReadExitFiles reads a signed voluntary exit file, or the exit-*.json files of a directory.
+func ReadExitFiles(path string) ([]*SignedExitJSON, error) {
+	isDir, err := fileutil.HasDir(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine if path is a directory")
+	}
+	paths := []string{path}
+	if isDir {
+		paths, err = filepath.Glob(filepath.Join(path, "exit-*.json"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no exit files found at %s", path)
+	}
+	exits := make([]*SignedExitJSON, len(paths))
+	for i, p := range paths {
+		enc, err := ioutil.ReadFile(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read exit file %s", p)
+		}
+		exits[i] = &SignedExitJSON{}
+		if err := json.Unmarshal(enc, exits[i]); err != nil {
+			return nil, errors.Wrapf(err, "could not decode exit file %s", p)
+		}
+		if _, err := exits[i].SignedVoluntaryExit(); err != nil {
+			return nil, errors.Wrapf(err, "invalid exit file %s", p)
+		}
+	}
+	return exits, nil
+}
+
+// SubmitExits proposes signed voluntary exits to a beacon node, and returns the ones it
+// accepted. Exits are submitted in batches, one batch per epoch, as more exits than the churn
+// limit would only wait in the exit queue. The exits the exit queue already holds take up the
+// churn limit of the first epochs, and the exits of validators among them are not submitted
+// again. Exits for a future epoch are submitted once that epoch is reached. Returns an error
+// if any exit could not be submitted, along with the ones which were.
+func SubmitExits(ctx context.Context, cfg *SubmitExitsConfig) ([]*SignedExitJSON, error) {
+	head, err := cfg.BeaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain head")
+	}
+	queue, err := cfg.BeaconClient.GetValidatorQueue(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator queue")
+	}
+	churnLimit := queue.ChurnLimit
+	if churnLimit == 0 {
+		churnLimit = 1
+	}
+	perEpoch := churnLimit
+	if cfg.MaxPerEpoch != 0 && cfg.MaxPerEpoch < perEpoch {
+		perEpoch = cfg.MaxPerEpoch
+	}
+	waitForNextEpoch := cfg.WaitForNextEpoch
+	if waitForNextEpoch == nil {
+		waitForNextEpoch = waitForEpoch
+	}
+	queued := make(map[string]bool, len(queue.ExitPubkeys))
+	for _, pubKey := range queue.ExitPubkeys {
+		queued[fmt.Sprintf("%#x", pubKey)] = true
+	}
+
+	var valid []*ethpb.SignedVoluntaryExit
+	var validJSON []*SignedExitJSON
+	for _, exit := range cfg.Exits {
+		signedExit, err := exit.SignedVoluntaryExit()
+		if err != nil {
+			return nil, err
+		}
+		if queued[strings.ToLower(exit.PublicKey)] {
+			log.WithField("validatorIndex", signedExit.Exit.ValidatorIndex).Info("Validator is already in the exit queue")
+			continue
+		}
+		valid = append(valid, signedExit)
+		validJSON = append(validJSON, exit)
+	}
+	order := make([]int, len(valid))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return valid[order[i]].Exit.Epoch < valid[order[j]].Exit.Epoch
+	})
+
+	epoch := head.HeadEpoch
+	// The exits of the queue are processed first, at the churn limit per epoch.
+	backlog := uint64(len(queue.ExitPubkeys))
+	var inEpoch uint64
+	room := func() uint64 {
+		ahead := backlog
+		if ahead > churnLimit {
+			ahead = churnLimit
+		}
+		r := churnLimit - ahead
+		if r > perEpoch {
+			r = perEpoch
+		}
+		return r
+	}
+	var submitted []*SignedExitJSON
+	for _, i := range order {
+		for valid[i].Exit.Epoch > epoch || inEpoch >= room() {
+			log.WithFields(logrus.Fields{
+				"epoch":         epoch,
+				"nextExitEpoch": valid[i].Exit.Epoch,
+				"perEpoch":      perEpoch,
+				"queued":        backlog,
+			}).Info("Waiting for the next epoch to respect the churn limit and the epoch of the exits")
+			if err := waitForNextEpoch(ctx); err != nil {
+				return submitted, err
+			}
+			epoch++
+			inEpoch = 0
+			if backlog > churnLimit {
+				backlog -= churnLimit
+			} else {
+				backlog = 0
+			}
+		}
+		inEpoch++
+		if _, err := cfg.ValidatorClient.ProposeExit(ctx, valid[i]); err != nil {
+			log.WithError(err).WithField("validatorIndex", valid[i].Exit.ValidatorIndex).Error("Could not submit voluntary exit")
+			continue
+		}
+		submitted = append(submitted, validJSON[i])
+	}
+	if len(submitted) < len(valid) {
+		return submitted, fmt.Errorf("could not submit %d of %d voluntary exits", len(valid)-len(submitted), len(valid))
+	}
+	return submitted, nil
+}
+
+// ExitStatuses returns the stage of the exit of each validator.
+func ExitStatuses(ctx context.Context, beaconClient ethpb.BeaconChainClient, pubKeys [][]byte) ([]*ExitStatus, error) {
+	head, err := beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain head")
+	}
+	statuses := make([]*ExitStatus, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		statuses[i] = &ExitStatus{PublicKey: pubKey}
+		validator, err := beaconClient.GetValidator(ctx, &ethpb.GetValidatorRequest{
+			QueryFilter: &ethpb.GetValidatorRequest_PublicKey{PublicKey: pubKey},
+		})
+		if status.Code(err) == codes.NotFound {
+			statuses[i].Stage = ExitStageUnknown
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get validator %#x", bytesutil.Trunc(pubKey))
+		}
+		statuses[i].ExitEpoch = validator.ExitEpoch
+		statuses[i].WithdrawableEpoch = validator.WithdrawableEpoch
+		switch {
+		case validator.ExitEpoch == params.BeaconConfig().FarFutureEpoch:
+			statuses[i].Stage = ExitStageNotExited
+		case head.HeadEpoch < validator.ExitEpoch:
+			statuses[i].Stage = ExitStageExiting
+		case head.HeadEpoch < validator.WithdrawableEpoch:
+			statuses[i].Stage = ExitStageExited
+		default:
+			statuses[i].Stage = ExitStageWithdrawable
+		}
+	}
+	return statuses, nil
+}
+
+// SignedVoluntaryExit decodes the signed voluntary exit of an exit file.
+func (e *SignedExitJSON) SignedVoluntaryExit() (*ethpb.SignedVoluntaryExit, error) {
+	if e.Message == nil {
+		return nil, errors.New("exit has no message")
+	}
+	epoch, err := strconv.ParseUint(e.Message.Epoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse exit epoch")
+	}
+	validatorIndex, err := strconv.ParseUint(e.Message.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse exit validator index")
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(e.Signature, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode exit signature")
+	}
+	if len(sig) != params.BeaconConfig().BLSSignatureLength {
+		return nil, fmt.Errorf("exit signature must be %d bytes, received %d", params.BeaconConfig().BLSSignatureLength, len(sig))
+	}
+	return &ethpb.SignedVoluntaryExit{
+		Exit:      &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: validatorIndex},
+		Signature: sig,
+	}, nil
+}
+
+func signedExitToJSON(pubKey []byte, exit *ethpb.SignedVoluntaryExit) *SignedExitJSON {
+	return &SignedExitJSON{
+		Message: &ExitMessageJSON{
+			Epoch:          strconv.FormatUint(exit.Exit.Epoch, 10),
+			ValidatorIndex: strconv.FormatUint(exit.Exit.ValidatorIndex, 10),
+		},
+		Signature: fmt.Sprintf("%#x", exit.Signature),
+		PublicKey: fmt.Sprintf("%#x", pubKey),
+	}
+}
+
+func exitsFromCli(cliCtx *cli.Context) ([]*SignedExitJSON, error) {
+	path := cliCtx.String(flags.ExitsPathFlag.Name)
+	if path == "" {
+		return nil, fmt.Errorf("an exit file or directory must be given with --%s", flags.ExitsPathFlag.Name)
+	}
+	path, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not expand exits path")
+	}
+	return ReadExitFiles(path)
+}
+
+// waitForEpoch waits for the duration of an epoch, or until the context is done.
+func waitForEpoch(ctx context.Context) error {
+	epochDuration := time.Duration(params.BeaconConfig().SecondsPerSlot*params.BeaconConfig().SlotsPerEpoch) * time.Second
+	select {
+	case <-time.After(epochDuration):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
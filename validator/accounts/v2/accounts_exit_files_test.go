@@ -0,0 +1,210 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSignExits_WriteAndReadFiles(t *testing.T) {
+	cliCtx, wallet, keymanager := setupDerivedWallet(t, 2)
+	pubKeys, err := keymanager.FetchValidatingPublicKeys(cliCtx.Context)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	for i, pk := range pubKeys {
+		validatorClient.EXPECT().
+			ValidatorIndex(gomock.Any(), &ethpb.ValidatorIndexRequest{PublicKey: pk[:]}).
+			Return(&ethpb.ValidatorIndexResponse{Index: uint64(10 + i)}, nil)
+	}
+	validatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).
+		Times(len(pubKeys))
+
+	exits, err := SignExits(cliCtx.Context, &SignExitsConfig{
+		ValidatorClient: validatorClient,
+		Keymanager:      keymanager,
+		PublicKeys:      [][]byte{pubKeys[0][:], pubKeys[1][:]},
+		Epoch:           500,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(exits))
+
+	dir := filepath.Join(filepath.Dir(wallet.walletDir), "exits")
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	paths, err := WriteExitFiles(dir, exits)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "exit-10-500.json"), paths[0])
+
+	read, err := ReadExitFiles(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(read))
+	for i, exit := range read {
+		signedExit, err := exit.SignedVoluntaryExit()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(500), signedExit.Exit.Epoch)
+		assert.Equal(t, uint64(10+i), signedExit.Exit.ValidatorIndex)
+		assert.Equal(t, fmt.Sprintf("%#x", pubKeys[i]), exit.PublicKey)
+	}
+
+	read, err = ReadExitFiles(paths[1])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(read))
+	assert.Equal(t, "11", read[0].Message.ValidatorIndex)
+}
+
+func TestSubmitExits_ChurnLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+
+	// Five exits are valid, and one is not valid before a future epoch.
+	var exits []*SignedExitJSON
+	var pubKeys [][]byte
+	for i := uint64(0); i < 6; i++ {
+		epoch := 10 - i
+		if i == 5 {
+			epoch = 20
+		}
+		pubKeys = append(pubKeys, bls.RandKey().PublicKey().Marshal())
+		exits = append(exits, signedExitToJSON(pubKeys[i], &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: i},
+			Signature: make([]byte, params.BeaconConfig().BLSSignatureLength),
+		}))
+	}
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 10}, nil)
+	// The exit queue holds the first validator and two others, which leaves room for a
+	// single exit in the current epoch.
+	beaconClient.EXPECT().GetValidatorQueue(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorQueue{
+		ChurnLimit: 4,
+		ExitPubkeys: [][]byte{
+			pubKeys[0],
+			bls.RandKey().PublicKey().Marshal(),
+			bls.RandKey().PublicKey().Marshal(),
+		},
+	}, nil)
+	var proposed []uint64
+	validatorClient.EXPECT().
+		ProposeExit(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, exit *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error) {
+			proposed = append(proposed, exit.Exit.ValidatorIndex)
+			return &ethpb.ProposeExitResponse{}, nil
+		}).
+		Times(5)
+
+	waits := 0
+	submitted, err := SubmitExits(context.Background(), &SubmitExitsConfig{
+		ValidatorClient: validatorClient,
+		BeaconClient:    beaconClient,
+		Exits:           exits,
+		// The cap is lower than the churn limit.
+		MaxPerEpoch: 2,
+		WaitForNextEpoch: func(ctx context.Context) error {
+			waits++
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 5, len(submitted))
+	// One exit in epoch 10, two in epoch 11, one in epoch 12, and the last one in epoch 20.
+	assert.Equal(t, 10, waits)
+	// The exits are submitted by epoch, without the one already queued.
+	assert.DeepEqual(t, []uint64{4, 3, 2, 1, 5}, proposed)
+}
+
+func TestSubmitExits_ProposeFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 10}, nil)
+	beaconClient.EXPECT().GetValidatorQueue(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorQueue{ChurnLimit: 4}, nil)
+
+	var exits []*SignedExitJSON
+	for i := uint64(0); i < 2; i++ {
+		exits = append(exits, signedExitToJSON(bls.RandKey().PublicKey().Marshal(), &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: i},
+			Signature: make([]byte, params.BeaconConfig().BLSSignatureLength),
+		}))
+	}
+	validatorClient.EXPECT().
+		ProposeExit(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, exit *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error) {
+			if exit.Exit.ValidatorIndex == 0 {
+				return nil, errors.New("bad exit")
+			}
+			return &ethpb.ProposeExitResponse{}, nil
+		}).
+		Times(2)
+
+	submitted, err := SubmitExits(context.Background(), &SubmitExitsConfig{
+		ValidatorClient: validatorClient,
+		BeaconClient:    beaconClient,
+		Exits:           exits,
+	})
+	assert.ErrorContains(t, "could not submit 1 of 2 voluntary exits", err)
+	require.Equal(t, 1, len(submitted))
+	assert.Equal(t, exits[1], submitted[0])
+}
+
+func TestExitStatuses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 100}, nil)
+
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	validators := []*ethpb.Validator{
+		{ExitEpoch: farFuture, WithdrawableEpoch: farFuture},
+		{ExitEpoch: 120, WithdrawableEpoch: 376},
+		{ExitEpoch: 90, WithdrawableEpoch: 346},
+		{ExitEpoch: 10, WithdrawableEpoch: 50},
+		nil,
+	}
+	pubKeys := make([][]byte, len(validators))
+	for i, v := range validators {
+		pubKeys[i] = bls.RandKey().PublicKey().Marshal()
+		req := &ethpb.GetValidatorRequest{
+			QueryFilter: &ethpb.GetValidatorRequest_PublicKey{PublicKey: pubKeys[i]},
+		}
+		if v == nil {
+			beaconClient.EXPECT().GetValidator(gomock.Any(), req).Return(nil, status.Error(codes.NotFound, "not found"))
+			continue
+		}
+		beaconClient.EXPECT().GetValidator(gomock.Any(), req).Return(v, nil)
+	}
+
+	statuses, err := ExitStatuses(context.Background(), beaconClient, pubKeys)
+	require.NoError(t, err)
+	stages := make([]string, len(statuses))
+	for i, s := range statuses {
+		stages[i] = s.Stage
+	}
+	assert.DeepEqual(t, []string{
+		ExitStageNotExited,
+		ExitStageExiting,
+		ExitStageExited,
+		ExitStageWithdrawable,
+		ExitStageUnknown,
+	}, stages)
+	assert.Equal(t, uint64(346), statuses[2].WithdrawableEpoch)
+}
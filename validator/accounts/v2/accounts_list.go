@@ -14,6 +14,8 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/threshold"
 	"github.com/urfave/cli/v2"
 )
 
@@ -60,6 +62,22 @@ func ListAccountsCli(cliCtx *cli.Context) error {
 		if err := listRemoteKeymanagerAccounts(cliCtx.Context, wallet, km, km.KeymanagerOpts()); err != nil {
 			return errors.Wrap(err, "could not list validator accounts with remote keymanager")
 		}
+	case v2keymanager.RemoteHTTP:
+		km, ok := keymanager.(*remotehttp.Keymanager)
+		if !ok {
+			return errors.New("could not assert keymanager interface to concrete type")
+		}
+		if err := listRemoteKeymanagerAccounts(cliCtx.Context, wallet, km, km.KeymanagerOpts()); err != nil {
+			return errors.Wrap(err, "could not list validator accounts with remote HTTP keymanager")
+		}
+	case v2keymanager.Threshold:
+		km, ok := keymanager.(*threshold.Keymanager)
+		if !ok {
+			return errors.New("could not assert keymanager interface to concrete type")
+		}
+		if err := listRemoteKeymanagerAccounts(cliCtx.Context, wallet, km, km.KeymanagerOpts()); err != nil {
+			return errors.Wrap(err, "could not list validator accounts with threshold keymanager")
+		}
 	default:
 		return fmt.Errorf("keymanager kind %s not yet supported", wallet.KeymanagerKind().String())
 	}
@@ -181,7 +199,7 @@ func listRemoteKeymanagerAccounts(
 	ctx context.Context,
 	wallet *Wallet,
 	keymanager v2keymanager.IKeymanager,
-	opts *remote.KeymanagerOpts,
+	opts fmt.Stringer,
 ) error {
 	au := aurora.NewAurora(true)
 	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("remote signer").Bold())
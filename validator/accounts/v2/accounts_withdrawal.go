@@ -0,0 +1,316 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/depositutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/derived"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithdrawalAccount is an account of a derived wallet along with its withdrawal key.
+type WithdrawalAccount struct {
+	Index                 uint64
+	ValidatingPublicKey   []byte
+	WithdrawalPublicKey   []byte
+	WithdrawalCredentials []byte
+}
+
+// WithdrawalCredentialsCheck compares the withdrawal credentials of a validator in the beacon
+// state with the ones derived by the wallet.
+type WithdrawalCredentialsCheck struct {
+	Account *WithdrawalAccount
+	// Found is false if the beacon node does not know the validator yet.
+	Found bool
+	// Actual are the withdrawal credentials of the validator in the beacon state.
+	Actual []byte
+}
+
+// Matches returns whether the validator was found with the withdrawal credentials of the wallet.
+func (c *WithdrawalCredentialsCheck) Matches() bool {
+	return c.Found && bytes.Equal(c.Actual, c.Account.WithdrawalCredentials)
+}
+
+// ExportWithdrawalKeysCli exports the withdrawal keys of accounts of a derived wallet as
+// EIP-2335 keystores, to a directory outside of the wallet.
+func ExportWithdrawalKeysCli(cliCtx *cli.Context) error {
+	wallet, km, err := derivedKeymanagerFromCli(cliCtx)
+	if err != nil {
+		return err
+	}
+	accounts, err := selectedWithdrawalAccounts(cliCtx, km)
+	if err != nil {
+		return err
+	}
+	dir, err := inputDirectory(cliCtx, withdrawalKeysDirPromptText, flags.WithdrawalKeysDirFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not parse withdrawal keys directory")
+	}
+	password, err := promptutil.InputPassword(
+		cliCtx,
+		flags.WithdrawalKeysPasswordFileFlag,
+		"Enter a new password for the withdrawal keystores",
+		"Confirm new password",
+		promptutil.ConfirmPass,
+		promptutil.ValidatePasswordInput,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not determine password for withdrawal keystores")
+	}
+	indices := make([]uint64, len(accounts))
+	for i, account := range accounts {
+		indices[i] = account.Index
+	}
+	paths, err := ExportWithdrawalKeystores(cliCtx.Context, wallet, km, indices, password, dir)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"dir":       dir,
+		"keystores": len(paths),
+	}).Info("Exported withdrawal keystores, which can now be stored offline")
+	return nil
+}
+
+// ListWithdrawalCredentialsCli prints the withdrawal credentials of the accounts of a derived wallet.
+func ListWithdrawalCredentialsCli(cliCtx *cli.Context) error {
+	_, km, err := derivedKeymanagerFromCli(cliCtx)
+	if err != nil {
+		return err
+	}
+	accounts, err := selectedWithdrawalAccounts(cliCtx, km)
+	if err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		fmt.Println("")
+		fmt.Printf("%s\n", au.BrightBlue(fmt.Sprintf("Account %d", account.Index)).Bold())
+		fmt.Printf("%s %#x\n", au.BrightCyan("[validating public key]").Bold(), account.ValidatingPublicKey)
+		fmt.Printf("%s %#x\n", au.BrightMagenta("[withdrawal public key]").Bold(), account.WithdrawalPublicKey)
+		fmt.Printf("%s %s\n", au.BrightMagenta("[derivation path]").Bold(), fmt.Sprintf(derived.WithdrawalKeyDerivationPathTemplate, account.Index))
+		fmt.Printf("%s %#x\n", au.BrightGreen("[withdrawal credentials]").Bold(), account.WithdrawalCredentials)
+	}
+	return nil
+}
+
+// CheckWithdrawalCredentialsCli checks that the withdrawal credentials of each validator of a
+// derived wallet in the beacon state are the ones derived by the wallet, and prints a checklist.
+func CheckWithdrawalCredentialsCli(cliCtx *cli.Context) error {
+	_, km, err := derivedKeymanagerFromCli(cliCtx)
+	if err != nil {
+		return err
+	}
+	accounts, err := selectedWithdrawalAccounts(cliCtx, km)
+	if err != nil {
+		return err
+	}
+	conns, err := DialBeaconNodes(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer conns.Close()
+	checks, err := CheckWithdrawalCredentials(cliCtx.Context, conns.BeaconClient, accounts)
+	if err != nil {
+		return err
+	}
+	mismatches := 0
+	for _, check := range checks {
+		var result string
+		switch {
+		case check.Matches():
+			result = au.BrightGreen("[ok]").Bold().String()
+		case !check.Found:
+			result = au.BrightYellow("[not deposited]").Bold().String()
+		default:
+			result = au.BrightRed(fmt.Sprintf("[mismatch, beacon state has %#x]", check.Actual)).Bold().String()
+			mismatches++
+		}
+		fmt.Printf(
+			"Account %d | %#x | %#x %s\n",
+			check.Account.Index,
+			check.Account.ValidatingPublicKey,
+			check.Account.WithdrawalCredentials,
+			result,
+		)
+	}
+	if mismatches > 0 {
+		return fmt.Errorf("withdrawal credentials of %d validators do not match the wallet", mismatches)
+	}
+	return nil
+}
+
+// WithdrawalAccounts returns the accounts of a derived wallet along with their withdrawal key.
+func WithdrawalAccounts(ctx context.Context, km *derived.Keymanager) ([]*WithdrawalAccount, error) {
+	validatingPublicKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validating public keys")
+	}
+	withdrawalPublicKeys, err := km.FetchWithdrawalPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch withdrawal public keys")
+	}
+	if len(withdrawalPublicKeys) != len(validatingPublicKeys) {
+		return nil, errors.New("wallet has a different number of withdrawal and validating keys")
+	}
+	accounts := make([]*WithdrawalAccount, len(validatingPublicKeys))
+	for i := range validatingPublicKeys {
+		withdrawalPublicKey := withdrawalPublicKeys[i]
+		accounts[i] = &WithdrawalAccount{
+			Index:                 uint64(i),
+			ValidatingPublicKey:   validatingPublicKeys[i][:],
+			WithdrawalPublicKey:   withdrawalPublicKey[:],
+			WithdrawalCredentials: depositutil.WithdrawalCredentialsFromPublicKey(withdrawalPublicKey[:]),
+		}
+	}
+	return accounts, nil
+}
+
+// ExportWithdrawalKeystores writes the withdrawal keys of accounts of a derived wallet to
+// EIP-2335 keystore files in a directory, and returns their paths. The directory cannot be
+// in the wallet, as withdrawal keys are meant to be kept apart from the validating keys.
+func ExportWithdrawalKeystores(
+	ctx context.Context,
+	wallet *Wallet,
+	km *derived.Keymanager,
+	accountIndices []uint64,
+	password string,
+	dir string,
+) ([]string, error) {
+	inWallet, err := isSubdirectory(wallet.walletDir, dir)
+	if err != nil {
+		return nil, err
+	}
+	if inWallet {
+		return nil, fmt.Errorf("withdrawal keys cannot be exported in the wallet directory %s", wallet.walletDir)
+	}
+	keystores, err := km.ExtractWithdrawalKeystores(ctx, accountIndices, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not extract withdrawal keystores")
+	}
+	if err := os.MkdirAll(dir, DirectoryPermissions); err != nil {
+		return nil, errors.Wrapf(err, "could not create directory %s", dir)
+	}
+	timestamp := time.Now().Unix()
+	paths := make([]string, len(keystores))
+	for i, keystore := range keystores {
+		enc, err := json.MarshalIndent(keystore, "", "\t")
+		if err != nil {
+			return nil, errors.Wrap(err, "could not encode withdrawal keystore")
+		}
+		paths[i] = filepath.Join(dir, fmt.Sprintf("keystore-withdrawal-%d-%d.json", accountIndices[i], timestamp))
+		if err := ioutil.WriteFile(paths[i], enc, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+			return nil, errors.Wrapf(err, "could not write withdrawal keystore %s", paths[i])
+		}
+	}
+	return paths, nil
+}
+
+// CheckWithdrawalCredentials retrieves each validator from the beacon state to compare its
+// withdrawal credentials with the ones of the wallet.
+func CheckWithdrawalCredentials(
+	ctx context.Context,
+	beaconClient ethpb.BeaconChainClient,
+	accounts []*WithdrawalAccount,
+) ([]*WithdrawalCredentialsCheck, error) {
+	checks := make([]*WithdrawalCredentialsCheck, len(accounts))
+	for i, account := range accounts {
+		checks[i] = &WithdrawalCredentialsCheck{Account: account}
+		validator, err := beaconClient.GetValidator(ctx, &ethpb.GetValidatorRequest{
+			QueryFilter: &ethpb.GetValidatorRequest_PublicKey{
+				PublicKey: account.ValidatingPublicKey,
+			},
+		})
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get validator %#x", account.ValidatingPublicKey)
+		}
+		checks[i].Found = true
+		checks[i].Actual = validator.WithdrawalCredentials
+	}
+	return checks, nil
+}
+
+func derivedKeymanagerFromCli(cliCtx *cli.Context) (*Wallet, *derived.Keymanager, error) {
+	wallet, err := OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*Wallet, error) {
+		return nil, errors.New("no wallet found, create a new one with validator wallet-v2 create")
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not open wallet")
+	}
+	if wallet.KeymanagerKind() != v2keymanager.Derived {
+		return nil, nil, errors.New("only derived wallets have withdrawal keys")
+	}
+	keymanager, err := wallet.InitializeKeymanager(cliCtx.Context, true /* skip mnemonic confirm */)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not initialize keymanager")
+	}
+	km, ok := keymanager.(*derived.Keymanager)
+	if !ok {
+		return nil, nil, errors.New("could not assert keymanager interface to concrete type")
+	}
+	return wallet, km, nil
+}
+
+// selectedWithdrawalAccounts returns the accounts given by the account indices flag, or all
+// the accounts of the wallet.
+func selectedWithdrawalAccounts(cliCtx *cli.Context, km *derived.Keymanager) ([]*WithdrawalAccount, error) {
+	accounts, err := WithdrawalAccounts(cliCtx.Context, km)
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) == 0 {
+		return nil, errors.New("wallet is empty, no withdrawal keys")
+	}
+	if !cliCtx.IsSet(flags.WithdrawalAccountIndicesFlag.Name) {
+		return accounts, nil
+	}
+	var selected []*WithdrawalAccount
+	for _, str := range strings.Split(cliCtx.String(flags.WithdrawalAccountIndicesFlag.Name), ",") {
+		index, err := strconv.ParseUint(strings.TrimSpace(str), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse account index %s", str)
+		}
+		if index >= uint64(len(accounts)) {
+			return nil, fmt.Errorf("account %d does not exist, the wallet has %d accounts", index, len(accounts))
+		}
+		selected = append(selected, accounts[index])
+	}
+	return selected, nil
+}
+
+// isSubdirectory returns whether a path is a directory or a subdirectory of another.
+func isSubdirectory(parent string, path string) (bool, error) {
+	absParent, err := filepath.Abs(parent)
+	if err != nil {
+		return false, err
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false, err
+	}
+	rel, err := filepath.Rel(absParent, absPath)
+	if err != nil {
+		return false, nil
+	}
+	return rel == "." || !strings.HasPrefix(rel, ".."), nil
+}
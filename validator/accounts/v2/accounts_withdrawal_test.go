@@ -0,0 +1,101 @@
+package v2
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExportWithdrawalKeystores(t *testing.T) {
+	cliCtx, wallet, keymanager := setupDerivedWallet(t, 3)
+	accounts, err := WithdrawalAccounts(cliCtx.Context, keymanager)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(accounts))
+
+	// Withdrawal keys cannot be exported in the wallet.
+	_, err = ExportWithdrawalKeystores(cliCtx.Context, wallet, keymanager, []uint64{0}, password, filepath.Join(wallet.walletDir, "withdrawal"))
+	assert.ErrorContains(t, "cannot be exported in the wallet directory", err)
+
+	dir := filepath.Join(filepath.Dir(wallet.walletDir), "withdrawal")
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	paths, err := ExportWithdrawalKeystores(cliCtx.Context, wallet, keymanager, []uint64{0, 2}, password, dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(paths))
+	for i, accountIndex := range []uint64{0, 2} {
+		enc, err := ioutil.ReadFile(paths[i])
+		require.NoError(t, err)
+		keystore := &v2keymanager.Keystore{}
+		require.NoError(t, json.Unmarshal(enc, keystore))
+		secretKeyBytes, err := keystorev4.New().Decrypt(keystore.Crypto, password)
+		require.NoError(t, err)
+		secretKey, err := bls.SecretKeyFromBytes(secretKeyBytes)
+		require.NoError(t, err)
+		assert.DeepEqual(t, accounts[accountIndex].WithdrawalPublicKey, secretKey.PublicKey().Marshal())
+		assert.Equal(t, hex.EncodeToString(accounts[accountIndex].WithdrawalPublicKey), keystore.Pubkey)
+	}
+}
+
+func TestCheckWithdrawalCredentials(t *testing.T) {
+	cliCtx, _, keymanager := setupDerivedWallet(t, 3)
+	accounts, err := WithdrawalAccounts(cliCtx.Context, keymanager)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	// The first validator has the withdrawal credentials of the wallet, the second one other
+	// withdrawal credentials, and the third one was not deposited yet.
+	beaconClient.EXPECT().GetValidator(gomock.Any(), &ethpb.GetValidatorRequest{
+		QueryFilter: &ethpb.GetValidatorRequest_PublicKey{PublicKey: accounts[0].ValidatingPublicKey},
+	}).Return(&ethpb.Validator{WithdrawalCredentials: accounts[0].WithdrawalCredentials}, nil)
+	beaconClient.EXPECT().GetValidator(gomock.Any(), &ethpb.GetValidatorRequest{
+		QueryFilter: &ethpb.GetValidatorRequest_PublicKey{PublicKey: accounts[1].ValidatingPublicKey},
+	}).Return(&ethpb.Validator{WithdrawalCredentials: accounts[2].WithdrawalCredentials}, nil)
+	beaconClient.EXPECT().GetValidator(gomock.Any(), &ethpb.GetValidatorRequest{
+		QueryFilter: &ethpb.GetValidatorRequest_PublicKey{PublicKey: accounts[2].ValidatingPublicKey},
+	}).Return(nil, status.Error(codes.NotFound, "Could not find validator"))
+
+	checks, err := CheckWithdrawalCredentials(cliCtx.Context, beaconClient, accounts)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(checks))
+	assert.Equal(t, true, checks[0].Matches())
+	assert.Equal(t, true, checks[1].Found)
+	assert.Equal(t, false, checks[1].Matches())
+	assert.Equal(t, false, checks[2].Found)
+	assert.Equal(t, false, checks[2].Matches())
+}
+
+func TestIsSubdirectory(t *testing.T) {
+	tests := []struct {
+		parent string
+		path   string
+		want   bool
+	}{
+		{parent: "/wallet", path: "/wallet", want: true},
+		{parent: "/wallet", path: "/wallet/keys", want: true},
+		{parent: "/wallet", path: "/wallet/../keys", want: false},
+		{parent: "/wallet", path: "/walletkeys", want: false},
+		{parent: "/wallet/keys", path: "/wallet", want: false},
+	}
+	for _, tt := range tests {
+		got, err := isSubdirectory(tt.parent, tt.path)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "isSubdirectory(%s, %s)", tt.parent, tt.path)
+	}
+}
@@ -120,6 +120,41 @@ this command outputs a deposit data string which is required to become a validat
 				return nil
 			},
 		},
+		{
+			Name: "change-password",
+			Description: "decrypts EIP-2335 keystore.json files with their password and encrypts them again with " +
+				"a new password, after backing them up. Accounts in a wallet are changed with wallet-v2 change-password",
+			Flags: []cli.Flag{
+				flags.KeystoresPathFlag,
+				flags.AccountPasswordFileFlag,
+				flags.NewAccountPasswordFileFlag,
+				flags.KDFFlag,
+				flags.KDFScryptNFlag,
+				flags.KDFScryptRFlag,
+				flags.KDFScryptPFlag,
+				flags.KDFPBKDF2CFlag,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := ChangeKeystoresPasswordCli(cliCtx); err != nil {
+					log.Fatalf("Could not change keystores password: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "audit",
+			Description: "Verifies the hash chain of the audit log of the messages signed by the validator client, " +
+				"and summarizes them",
+			Flags: []cli.Flag{
+				flags.AuditLogDirFlag,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := AuditAccountsCli(cliCtx); err != nil {
+					log.Fatalf("Could not audit signed messages: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "voluntary-exit",
 			Description: "Performs a voluntary exit on selected accounts",
@@ -134,6 +169,8 @@ this command outputs a deposit data string which is required to become a validat
 				flags.GrpcHeadersFlag,
 				flags.GrpcRetriesFlag,
 				flags.GrpcRetryDelayFlag,
+				flags.AuditLogDirFlag,
+				flags.AuditLogMaxSizeFlag,
 				featureconfig.AltonaTestnet,
 				featureconfig.OnyxTestnet,
 			},
@@ -145,6 +182,83 @@ this command outputs a deposit data string which is required to become a validat
 				return nil
 			},
 		},
+		{
+			Name: "sign-exits",
+			Description: "Pre-signs voluntary exits of the selected accounts for an epoch, which can be in the future, " +
+				"and writes them to files which can be kept offline and submitted later with submit-exits",
+			Flags: []cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.AccountPasswordFileFlag,
+				flags.VoluntaryExitPublicKeysFlag,
+				flags.ExitEpochFlag,
+				flags.ExitsDirFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.AltonaTestnet,
+				featureconfig.OnyxTestnet,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := SignExitsCli(cliCtx); err != nil {
+					log.Fatalf("Could not sign voluntary exits: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "submit-exits",
+			Description: "Submits pre-signed voluntary exits to a beacon node, without needing the keys. Many exits are " +
+				"submitted in batches of at most the churn limit per epoch",
+			Flags: []cli.Flag{
+				flags.ExitsPathFlag,
+				flags.ExitsPerEpochFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.AltonaTestnet,
+				featureconfig.OnyxTestnet,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := SubmitExitsCli(cliCtx); err != nil {
+					log.Fatalf("Could not submit voluntary exits: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "exit-status",
+			Description: "Shows whether validators are exiting, exited or withdrawable, given by their public keys " +
+				"or by their pre-signed voluntary exit files",
+			Flags: []cli.Flag{
+				flags.VoluntaryExitPublicKeysFlag,
+				flags.ExitsPathFlag,
+				flags.WatchExitsFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.AltonaTestnet,
+				featureconfig.OnyxTestnet,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := ExitStatusCli(cliCtx); err != nil {
+					log.Fatalf("Could not get exit status: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name: "deposit",
 			Description: "Submits a deposit to the eth2 deposit contract for a validator key by connecting " +
@@ -170,5 +284,115 @@ this command outputs a deposit data string which is required to become a validat
 				return nil
 			},
 		},
+		{
+			Name: "deposit-data",
+			Description: "Writes a deposit_data-*.json file in the format of the eth2 launchpad for the selected " +
+				"accounts. The withdrawal keys of derived wallets are used unless a --withdrawal-public-key is given. " +
+				"Threshold wallets cannot sign deposits",
+			Flags: []cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.DepositPublicKeysFlag,
+				flags.DepositAllAccountsFlag,
+				flags.WithdrawalPublicKeyFlag,
+				flags.DepositDataDirFlag,
+				featureconfig.AltonaTestnet,
+				featureconfig.OnyxTestnet,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := DepositDataCli(cliCtx); err != nil {
+					log.Fatalf("Could not generate deposit data: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "withdrawal-keys",
+			Description: "Manages the withdrawal keys of the accounts of a derived wallet",
+			Subcommands: []*cli.Command{
+				{
+					Name: "export",
+					Description: "Exports the withdrawal keys of accounts as EIP-2335 keystore.json files to a directory " +
+						"outside of the wallet, so that they can be kept offline",
+					Flags: []cli.Flag{
+						flags.WalletDirFlag,
+						flags.WalletPasswordFileFlag,
+						flags.WithdrawalAccountIndicesFlag,
+						flags.WithdrawalKeysDirFlag,
+						flags.WithdrawalKeysPasswordFileFlag,
+						featureconfig.AltonaTestnet,
+						featureconfig.OnyxTestnet,
+					},
+					Action: func(cliCtx *cli.Context) error {
+						featureconfig.ConfigureValidator(cliCtx)
+						if err := ExportWithdrawalKeysCli(cliCtx); err != nil {
+							log.Fatalf("Could not export withdrawal keys: %v", err)
+						}
+						return nil
+					},
+				},
+				{
+					Name:        "credentials",
+					Description: "Prints the withdrawal public keys and withdrawal credentials of accounts",
+					Flags: []cli.Flag{
+						flags.WalletDirFlag,
+						flags.WalletPasswordFileFlag,
+						flags.WithdrawalAccountIndicesFlag,
+						featureconfig.AltonaTestnet,
+						featureconfig.OnyxTestnet,
+					},
+					Action: func(cliCtx *cli.Context) error {
+						featureconfig.ConfigureValidator(cliCtx)
+						if err := ListWithdrawalCredentialsCli(cliCtx); err != nil {
+							log.Fatalf("Could not list withdrawal credentials: %v", err)
+						}
+						return nil
+					},
+				},
+				{
+					Name: "check",
+					Description: "Checks that the withdrawal credentials of the validators in the beacon state are " +
+						"the ones derived by the wallet",
+					Flags: []cli.Flag{
+						flags.WalletDirFlag,
+						flags.WalletPasswordFileFlag,
+						flags.WithdrawalAccountIndicesFlag,
+						flags.BeaconRPCProviderFlag,
+						cmd.GrpcMaxCallRecvMsgSizeFlag,
+						flags.CertFlag,
+						flags.GrpcHeadersFlag,
+						flags.GrpcRetriesFlag,
+						flags.GrpcRetryDelayFlag,
+						featureconfig.AltonaTestnet,
+						featureconfig.OnyxTestnet,
+					},
+					Action: func(cliCtx *cli.Context) error {
+						featureconfig.ConfigureValidator(cliCtx)
+						if err := CheckWithdrawalCredentialsCli(cliCtx); err != nil {
+							log.Fatalf("Could not check withdrawal credentials: %v", err)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		{
+			Name: "verify-deposit-data",
+			Description: "Verifies the signatures and roots of the deposits in a deposit_data-*.json file against " +
+				"the config of the network",
+			Flags: []cli.Flag{
+				flags.DepositDataFileFlag,
+				featureconfig.AltonaTestnet,
+				featureconfig.OnyxTestnet,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := VerifyDepositDataCli(cliCtx); err != nil {
+					log.Fatalf("Could not verify deposit data: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
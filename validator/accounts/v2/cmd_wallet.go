@@ -1,6 +1,7 @@
 package v2
 
 import (
+	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/urfave/cli/v2"
@@ -23,6 +24,20 @@ var WalletCommands = &cli.Command{
 				flags.RemoteSignerCertPathFlag,
 				flags.RemoteSignerKeyPathFlag,
 				flags.RemoteSignerCACertPathFlag,
+				flags.RemoteSignerURLFlag,
+				flags.RemoteSignerTokenFileFlag,
+				flags.ThresholdFlag,
+				flags.ThresholdShareIDFlag,
+				flags.ThresholdCosignersFlag,
+				flags.ThresholdListenAddressFlag,
+				flags.ThresholdTokenFileFlag,
+				flags.ThresholdTLSCertPathFlag,
+				flags.ThresholdTLSKeyPathFlag,
+				flags.ThresholdTLSCACertPathFlag,
+				flags.ThresholdSharesDirFlag,
+				flags.ThresholdShareFileFlag,
+				flags.ThresholdSharePasswordFileFlag,
+				flags.NumAccountsFlag,
 				flags.WalletPasswordFileFlag,
 				featureconfig.AltonaTestnet,
 				featureconfig.OnyxTestnet,
@@ -44,6 +59,16 @@ var WalletCommands = &cli.Command{
 				flags.RemoteSignerCertPathFlag,
 				flags.RemoteSignerKeyPathFlag,
 				flags.RemoteSignerCACertPathFlag,
+				flags.RemoteSignerURLFlag,
+				flags.RemoteSignerTokenFileFlag,
+				flags.ThresholdFlag,
+				flags.ThresholdShareIDFlag,
+				flags.ThresholdCosignersFlag,
+				flags.ThresholdListenAddressFlag,
+				flags.ThresholdTokenFileFlag,
+				flags.ThresholdTLSCertPathFlag,
+				flags.ThresholdTLSKeyPathFlag,
+				flags.ThresholdTLSCACertPathFlag,
 				featureconfig.AltonaTestnet,
 				featureconfig.OnyxTestnet,
 				flags.DeprecatedPasswordsDirFlag,
@@ -74,5 +99,30 @@ var WalletCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "change-password",
+			Usage: "encrypts a direct or derived wallet again with a new password, after backing it up. " +
+				"The password of the validator web API in the database of --datadir is changed along with it. " +
+				"The validator client using the wallet must be stopped",
+			Flags: []cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				flags.NewWalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.KDFFlag,
+				flags.KDFScryptNFlag,
+				flags.KDFScryptRFlag,
+				flags.KDFScryptPFlag,
+				flags.KDFPBKDF2CFlag,
+				featureconfig.AltonaTestnet,
+				featureconfig.OnyxTestnet,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := ChangeWalletPasswordCli(cliCtx); err != nil {
+					log.Fatalf("Could not change wallet password: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/logrusorgru/aurora"
@@ -13,16 +14,25 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/threshold"
 	"github.com/urfave/cli/v2"
 )
 
 const (
 	importKeysDirPromptText               = "Enter the directory or filepath where your keystores to import are located"
 	walletDirPromptText                   = "Enter a wallet directory"
+	thresholdSharesDirPromptText          = "Enter a directory to write the shares of the other share-holders to"
+	thresholdSharePasswordPromptText      = "Enter the password of the shares file"
 	selectAccountsDeletePromptText        = "Select the account(s) you would like to delete"
 	selectAccountsBackupPromptText        = "Select the account(s) you wish to backup"
 	selectAccountsDepositPromptText       = "Select the validating public keys you wish to submit deposits for"
 	selectAccountsVoluntaryExitPromptText = "Select the account(s) on which you wish to perform a voluntary exit"
+	selectAccountsDepositDataPromptText   = "Select the validating public keys you wish to generate deposit data for"
+	depositDataDirPromptText              = "Enter the directory to write the deposit data file to"
+	withdrawalKeysDirPromptText           = "Enter a directory outside of the wallet to export the withdrawal keystores to"
+	selectAccountsSignExitsPromptText     = "Select the account(s) you wish to pre-sign a voluntary exit for"
+	exitsDirPromptText                    = "Enter the directory to write the signed voluntary exits to"
 )
 
 var au = aurora.NewAurora(true)
@@ -141,6 +151,158 @@ func inputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+func inputRemoteHTTPKeymanagerConfig(cliCtx *cli.Context) (*remotehttp.KeymanagerOpts, error) {
+	url := cliCtx.String(flags.RemoteSignerURLFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if url == "" {
+		url, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Remote signer URL (such as https://host.example.com:9000)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	newCfg := &remotehttp.KeymanagerOpts{
+		URL: strings.TrimRight(url, "\r\n"),
+	}
+	// The bearer token and TLS files are optional, as a remote signer may be reached
+	// over a trusted network.
+	paths := []struct {
+		flag *cli.StringFlag
+		path *string
+	}{
+		{flags.RemoteSignerTokenFileFlag, &newCfg.BearerTokenPath},
+		{flags.RemoteSignerCACertPathFlag, &newCfg.CACertPath},
+		{flags.RemoteSignerCertPathFlag, &newCfg.ClientCertPath},
+		{flags.RemoteSignerKeyPathFlag, &newCfg.ClientKeyPath},
+	}
+	for _, p := range paths {
+		input := cliCtx.String(p.flag.Name)
+		if input == "" {
+			continue
+		}
+		*p.path, err = fileutil.ExpandPath(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not determine absolute path for %s", input)
+		}
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
+func inputThresholdKeymanagerConfig(cliCtx *cli.Context) (*threshold.KeymanagerOpts, error) {
+	log.Info("Input desired configuration")
+	thresholdCount, err := inputUint64(cliCtx, flags.ThresholdFlag, "Number of share-holders required to sign")
+	if err != nil {
+		return nil, err
+	}
+	shareID, err := inputUint64(cliCtx, flags.ThresholdShareIDFlag, "Share ID of this share-holder (such as 1)")
+	if err != nil {
+		return nil, err
+	}
+	cosigners := cliCtx.String(flags.ThresholdCosignersFlag.Name)
+	if cosigners == "" {
+		cosigners, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Share IDs and URLs of the other share-holders (such as 2=https://10.0.0.2:7600,3=https://10.0.0.3:7600)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	cosignerOpts, err := parseCosigners(cosigners)
+	if err != nil {
+		return nil, err
+	}
+	token := cliCtx.String(flags.ThresholdTokenFileFlag.Name)
+	if token == "" {
+		token, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Path to the bearer token shared by the share-holders (such as /path/to/token)",
+			validateFilePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	newCfg := &threshold.KeymanagerOpts{
+		Threshold:  thresholdCount,
+		ShareID:    shareID,
+		ListenAddr: cliCtx.String(flags.ThresholdListenAddressFlag.Name),
+		Cosigners:  cosignerOpts,
+	}
+	paths := []struct {
+		input string
+		path  *string
+	}{
+		{token, &newCfg.BearerTokenPath},
+		{cliCtx.String(flags.ThresholdTLSCACertPathFlag.Name), &newCfg.CACertPath},
+		{cliCtx.String(flags.ThresholdTLSCertPathFlag.Name), &newCfg.CertPath},
+		{cliCtx.String(flags.ThresholdTLSKeyPathFlag.Name), &newCfg.KeyPath},
+	}
+	for _, p := range paths {
+		if p.input == "" {
+			continue
+		}
+		*p.path, err = fileutil.ExpandPath(strings.TrimRight(p.input, "\r\n"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not determine absolute path for %s", p.input)
+		}
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
+func inputUint64(cliCtx *cli.Context, flag *cli.Uint64Flag, promptText string) (uint64, error) {
+	if cliCtx.IsSet(flag.Name) {
+		return cliCtx.Uint64(flag.Name), nil
+	}
+	input, err := promptutil.ValidatePrompt(os.Stdin, promptText, promptutil.ValidateNumber)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(input, 10, 64)
+}
+
+// parseCosigners parses comma-separated share IDs and URLs of co-signers, such as
+// 2=https://10.0.0.2:7600,3=https://10.0.0.3:7600.
+func parseCosigners(input string) ([]*threshold.CosignerOpts, error) {
+	var cosigners []*threshold.CosignerOpts
+	for _, cosigner := range strings.Split(input, ",") {
+		cosigner = strings.TrimSpace(cosigner)
+		if cosigner == "" {
+			continue
+		}
+		parts := strings.SplitN(cosigner, "=", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("co-signer %q is not formatted as share-id=url", cosigner)
+		}
+		shareID, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid share ID of co-signer %q", cosigner)
+		}
+		cosigners = append(cosigners, &threshold.CosignerOpts{
+			ShareID: shareID,
+			URL:     parts[1],
+		})
+	}
+	if len(cosigners) == 0 {
+		return nil, errors.New("at least one co-signer is required")
+	}
+	return cosigners, nil
+}
+
+func validateFilePath(input string) error {
+	if input == "" {
+		return errors.New("path cannot be empty")
+	}
+	if !fileutil.FileExists(input) {
+		return fmt.Errorf("no file found at path: %s", input)
+	}
+	return nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
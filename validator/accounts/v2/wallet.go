@@ -20,6 +20,8 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/threshold"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -44,9 +46,11 @@ var (
 		"edit your wallet configuration by running ./prysm.sh validator wallet-v2 edit-config",
 	)
 	keymanagerKindSelections = map[v2keymanager.Kind]string{
-		v2keymanager.Derived: "HD Wallet (Recommended)",
-		v2keymanager.Direct:  "Non-HD Wallet (Most Basic)",
-		v2keymanager.Remote:  "Remote Signing Wallet (Advanced)",
+		v2keymanager.Derived:    "HD Wallet (Recommended)",
+		v2keymanager.Direct:     "Non-HD Wallet (Most Basic)",
+		v2keymanager.Remote:     "Remote Signing Wallet (Advanced)",
+		v2keymanager.RemoteHTTP: "Remote HTTP Signing Wallet (Advanced)",
+		v2keymanager.Threshold:  "Threshold Signing Wallet (Advanced)",
 	}
 	validateExistingPass = func(input string) error {
 		if input == "" {
@@ -202,6 +206,7 @@ func (w *Wallet) InitializeKeymanager(
 			Opts:                opts,
 			Wallet:              w,
 			SkipMnemonicConfirm: skipMnemonicConfirm,
+			WalletPassword:      w.walletPassword,
 		})
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize derived keymanager")
@@ -218,6 +223,29 @@ func (w *Wallet) InitializeKeymanager(
 		if err != nil {
 			return nil, errors.Wrap(err, "could not initialize remote keymanager")
 		}
+	case v2keymanager.RemoteHTTP:
+		opts, err := remotehttp.UnmarshalOptionsFile(configFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal keymanager config file")
+		}
+		keymanager, err = remotehttp.NewKeymanager(ctx, &remotehttp.SetupConfig{
+			Opts: opts,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize remote HTTP keymanager")
+		}
+	case v2keymanager.Threshold:
+		opts, err := threshold.UnmarshalOptionsFile(configFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal keymanager config file")
+		}
+		keymanager, err = threshold.NewKeymanager(ctx, &threshold.SetupConfig{
+			Wallet: w,
+			Opts:   opts,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not initialize threshold keymanager")
+		}
 	default:
 		return nil, fmt.Errorf("keymanager kind not supported: %s", w.keymanagerKind)
 	}
@@ -231,7 +259,7 @@ func (w *Wallet) WriteFileAtPath(ctx context.Context, filePath string, fileName
 		return errors.Wrapf(err, "could not create path: %s", accountPath)
 	}
 	fullPath := filepath.Join(accountPath, fileName)
-	if err := ioutil.WriteFile(fullPath, data, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+	if err := fileutil.WriteFileAtomically(fullPath, data, params.BeaconIoConfig().ReadWritePermissions); err != nil {
 		return errors.Wrapf(err, "could not write %s", filePath)
 	}
 	log.WithFields(logrus.Fields{
@@ -344,7 +372,7 @@ func (w *Wallet) ReadEncryptedSeedFromDisk(ctx context.Context) (io.ReadCloser,
 func (w *Wallet) WriteEncryptedSeedToDisk(ctx context.Context, encoded []byte) error {
 	seedFilePath := filepath.Join(w.accountsPath, derived.EncryptedSeedFileName)
 	// Write the config file to disk.
-	if err := ioutil.WriteFile(seedFilePath, encoded, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+	if err := fileutil.WriteFileAtomically(seedFilePath, encoded, params.BeaconIoConfig().ReadWritePermissions); err != nil {
 		return errors.Wrapf(err, "could not write %s", seedFilePath)
 	}
 	log.WithField("seedFilePath", seedFilePath).Debug("Wrote wallet encrypted seed file to disk")
@@ -2,25 +2,50 @@ package v2
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/manifoldco/promptui"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/threshold"
 	"github.com/urfave/cli/v2"
 )
 
 // CreateWalletConfig defines the parameters needed to call the create wallet functions.
 type CreateWalletConfig struct {
-	WalletCfg            *WalletConfig
-	RemoteKeymanagerOpts *remote.KeymanagerOpts
-	SkipMnemonicConfirm  bool
+	WalletCfg                *WalletConfig
+	RemoteKeymanagerOpts     *remote.KeymanagerOpts
+	RemoteHTTPKeymanagerOpts *remotehttp.KeymanagerOpts
+	ThresholdKeymanagerOpts  *threshold.KeymanagerOpts
+	// ThresholdShareFile is the encrypted shares file of the share-holder, generated by
+	// another share-holder. Shares are generated when it is empty.
+	ThresholdShareFile string
+	// ThresholdSharePassword is the password the shares file of the share-holder is
+	// encrypted with.
+	ThresholdSharePassword string
+	// ThresholdSharesDir is where the encrypted shares of the other share-holders are
+	// written to when generating shares.
+	ThresholdSharesDir string
+	// ThresholdSharePasswords are the passwords the shares of each of the other
+	// share-holders are encrypted with when generating shares, by share ID.
+	ThresholdSharePasswords map[uint64]string
+	ThresholdNumKeys        uint64
+	SkipMnemonicConfirm     bool
 }
 
 // CreateAndSaveWalletCli from user input with a desired keymanager. If a
@@ -35,7 +60,16 @@ func CreateAndSaveWalletCli(cliCtx *cli.Context) (*Wallet, error) {
 	if err != nil {
 		return nil, err
 	}
-	return CreateWalletWithKeymanager(cliCtx.Context, createWalletConfig)
+	w, err := CreateWalletWithKeymanager(cliCtx.Context, createWalletConfig)
+	if err != nil {
+		return nil, err
+	}
+	if createWalletConfig.ThresholdSharesDir != "" {
+		if err := distributeThresholdShares(createWalletConfig); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
 }
 
 // CreateWalletWithKeymanager specified by configuration options.
@@ -77,6 +111,20 @@ func CreateWalletWithKeymanager(ctx context.Context, cfg *CreateWalletConfig) (*
 		log.WithField("--wallet-dir", w.walletDir).Info(
 			"Successfully created wallet with remote keymanager configuration",
 		)
+	case v2keymanager.RemoteHTTP:
+		if err = createRemoteHTTPKeymanagerWallet(ctx, w, cfg.RemoteHTTPKeymanagerOpts); err != nil {
+			return nil, errors.Wrap(err, "could not initialize wallet with remote HTTP keymanager")
+		}
+		log.WithField("--wallet-dir", w.walletDir).Info(
+			"Successfully created wallet with remote HTTP keymanager configuration",
+		)
+	case v2keymanager.Threshold:
+		if err = createThresholdKeymanagerWallet(ctx, w, cfg); err != nil {
+			return nil, errors.Wrap(err, "could not initialize wallet with threshold keymanager")
+		}
+		log.WithField("--wallet-dir", w.walletDir).Info(
+			"Successfully created wallet with threshold keymanager configuration",
+		)
 	default:
 		return nil, errors.Wrapf(err, "keymanager type %s is not supported", w.KeymanagerKind())
 	}
@@ -118,6 +166,59 @@ func extractWalletCreationConfigFromCli(cliCtx *cli.Context, keymanagerKind v2ke
 		}
 		createWalletConfig.RemoteKeymanagerOpts = opts
 	}
+	if keymanagerKind == v2keymanager.RemoteHTTP {
+		opts, err := inputRemoteHTTPKeymanagerConfig(cliCtx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not input remote HTTP keymanager config")
+		}
+		createWalletConfig.RemoteHTTPKeymanagerOpts = opts
+	}
+	if keymanagerKind == v2keymanager.Threshold {
+		opts, err := inputThresholdKeymanagerConfig(cliCtx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not input threshold keymanager config")
+		}
+		createWalletConfig.ThresholdKeymanagerOpts = opts
+		if cliCtx.IsSet(flags.ThresholdShareFileFlag.Name) {
+			createWalletConfig.ThresholdShareFile, err = fileutil.ExpandPath(cliCtx.String(flags.ThresholdShareFileFlag.Name))
+			if err != nil {
+				return nil, err
+			}
+			createWalletConfig.ThresholdSharePassword, err = inputPassword(
+				cliCtx,
+				flags.ThresholdSharePasswordFileFlag,
+				thresholdSharePasswordPromptText,
+				false, /* Do not confirm password */
+				promptutil.NotEmpty,
+			)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			numKeys := cliCtx.Int64(flags.NumAccountsFlag.Name)
+			if numKeys <= 0 {
+				return nil, errors.New("number of accounts must be greater than 0")
+			}
+			createWalletConfig.ThresholdNumKeys = uint64(numKeys)
+			createWalletConfig.ThresholdSharesDir, err = inputDirectory(cliCtx, thresholdSharesDirPromptText, flags.ThresholdSharesDirFlag)
+			if err != nil {
+				return nil, err
+			}
+			// Every share is encrypted with its own password, so that no share-holder can
+			// decrypt the shares of another one.
+			createWalletConfig.ThresholdSharePasswords = make(map[uint64]string)
+			for _, shareID := range opts.ShareIDs() {
+				if shareID == opts.ShareID {
+					continue
+				}
+				password := make([]byte, 16)
+				if _, err := rand.Read(password); err != nil {
+					return nil, errors.Wrap(err, "could not generate shares password")
+				}
+				createWalletConfig.ThresholdSharePasswords[shareID] = hex.EncodeToString(password)
+			}
+		}
+	}
 	return createWalletConfig, nil
 }
 
@@ -171,6 +272,155 @@ func createRemoteKeymanagerWallet(ctx context.Context, wallet *Wallet, opts *rem
 	return nil
 }
 
+func createRemoteHTTPKeymanagerWallet(ctx context.Context, wallet *Wallet, opts *remotehttp.KeymanagerOpts) error {
+	keymanagerConfig, err := remotehttp.MarshalOptionsFile(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal config file")
+	}
+	if err := wallet.SaveWallet(); err != nil {
+		return errors.Wrap(err, "could not save wallet to disk")
+	}
+	if err := wallet.WriteKeymanagerConfigToDisk(ctx, keymanagerConfig); err != nil {
+		return errors.Wrap(err, "could not write keymanager config to disk")
+	}
+	return nil
+}
+
+// createThresholdKeymanagerWallet creates a threshold wallet either from the shares file
+// generated by another share-holder, or by generating new validator keys whose shares of
+// the other share-holders are written to files, each encrypted with its own password.
+func createThresholdKeymanagerWallet(ctx context.Context, wallet *Wallet, cfg *CreateWalletConfig) error {
+	opts := cfg.ThresholdKeymanagerOpts
+	var store *threshold.ShareStore
+	if cfg.ThresholdShareFile != "" {
+		enc, err := ioutil.ReadFile(cfg.ThresholdShareFile)
+		if err != nil {
+			return errors.Wrap(err, "could not read shares file")
+		}
+		store, err = threshold.DecryptShareStore(enc, cfg.ThresholdSharePassword)
+		if err != nil {
+			return errors.Wrap(err, "could not decrypt shares file with the shares password")
+		}
+	} else {
+		stores, err := threshold.GenerateShares(cfg.ThresholdNumKeys, opts.Threshold, opts.ShareIDs())
+		if err != nil {
+			return errors.Wrap(err, "could not generate shares")
+		}
+		if err := os.MkdirAll(cfg.ThresholdSharesDir, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+			return errors.Wrap(err, "could not create shares directory")
+		}
+		for shareID, s := range stores {
+			if shareID == opts.ShareID {
+				store = s
+				continue
+			}
+			password, ok := cfg.ThresholdSharePasswords[shareID]
+			if !ok || password == "" {
+				return fmt.Errorf("no password to encrypt the shares of share ID %d with", shareID)
+			}
+			enc, err := threshold.EncryptShareStore(s, password)
+			if err != nil {
+				return err
+			}
+			sharesFile := thresholdSharesFile(cfg.ThresholdSharesDir, shareID)
+			if err := ioutil.WriteFile(sharesFile, enc, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+				return errors.Wrapf(err, "could not write shares of share ID %d", shareID)
+			}
+		}
+	}
+	if store.ShareID != opts.ShareID || store.Threshold != opts.Threshold {
+		return fmt.Errorf(
+			"shares are for share ID %d and a threshold of %d, not share ID %d and a threshold of %d",
+			store.ShareID, store.Threshold, opts.ShareID, opts.Threshold,
+		)
+	}
+	keymanagerConfig, err := threshold.MarshalOptionsFile(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal config file")
+	}
+	if err := wallet.SaveWallet(); err != nil {
+		return errors.Wrap(err, "could not save wallet to disk")
+	}
+	if err := wallet.WriteKeymanagerConfigToDisk(ctx, keymanagerConfig); err != nil {
+		return errors.Wrap(err, "could not write keymanager config to disk")
+	}
+	if err := threshold.SaveShareStore(ctx, wallet, store); err != nil {
+		return errors.Wrap(err, "could not write shares to disk")
+	}
+	return nil
+}
+
+// thresholdSharesFile is the path of the shares file of a share ID in a shares directory.
+func thresholdSharesFile(dir string, shareID uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("share-%d.keystore.json", shareID))
+}
+
+// distributeThresholdShares prints the passwords of the shares generated for the other
+// share-holders, and deletes their shares files once they are distributed: anyone holding
+// as many shares as the threshold can sign with the validator keys.
+func distributeThresholdShares(cfg *CreateWalletConfig) error {
+	log.Warn(
+		"The shares of the other share-holders were written unprotected by any share-holder's slashing " +
+			"protection. Give each share-holder only its own shares file and password, over separate channels, " +
+			"and never keep the shares of others: anyone holding enough shares can sign with the validator keys",
+	)
+	shareIDs := make([]uint64, 0, len(cfg.ThresholdSharePasswords))
+	for shareID := range cfg.ThresholdSharePasswords {
+		shareIDs = append(shareIDs, shareID)
+	}
+	sort.Slice(shareIDs, func(i, j int) bool { return shareIDs[i] < shareIDs[j] })
+	for _, shareID := range shareIDs {
+		fmt.Printf(
+			"Shares of share ID %d: %s, password: %s\n",
+			shareID, thresholdSharesFile(cfg.ThresholdSharesDir, shareID), cfg.ThresholdSharePasswords[shareID],
+		)
+	}
+	resp, err := promptutil.ValidatePrompt(
+		os.Stdin, "Delete the shares files once distributed to their share-holders? (Y/N)", promptutil.ValidateYesOrNo,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not confirm the shares were distributed")
+	}
+	if strings.ToLower(resp) != "y" {
+		log.WithField("path", cfg.ThresholdSharesDir).Warn(
+			"The shares files were not deleted, securely delete them as soon as they are distributed",
+		)
+		return nil
+	}
+	for _, shareID := range shareIDs {
+		if err := shredFile(thresholdSharesFile(cfg.ThresholdSharesDir, shareID)); err != nil {
+			return errors.Wrapf(err, "could not delete the shares of share ID %d", shareID)
+		}
+	}
+	log.WithField("path", cfg.ThresholdSharesDir).Info("Deleted the shares files of the other share-holders")
+	return nil
+}
+
+// shredFile overwrites a file with zeros before removing it, so that its content does not
+// linger on disk.
+func shredFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(make([]byte, info.Size())); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
 func inputKeymanagerKind(cliCtx *cli.Context) (v2keymanager.Kind, error) {
 	if cliCtx.IsSet(flags.KeymanagerKindFlag.Name) {
 		return v2keymanager.ParseKind(cliCtx.String(flags.KeymanagerKindFlag.Name))
@@ -181,6 +431,8 @@ func inputKeymanagerKind(cliCtx *cli.Context) (v2keymanager.Kind, error) {
 			keymanagerKindSelections[v2keymanager.Derived],
 			keymanagerKindSelections[v2keymanager.Direct],
 			keymanagerKindSelections[v2keymanager.Remote],
+			keymanagerKindSelections[v2keymanager.RemoteHTTP],
+			keymanagerKindSelections[v2keymanager.Threshold],
 		},
 	}
 	selection, _, err := promptSelect.Run()
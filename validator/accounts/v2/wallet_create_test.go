@@ -3,6 +3,8 @@ package v2
 import (
 	"context"
 	"flag"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/threshold"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
 )
@@ -166,3 +169,110 @@ func TestCreateWallet_Remote(t *testing.T) {
 	// We assert the created configuration was as desired.
 	assert.DeepEqual(t, wantCfg, cfg)
 }
+
+func TestCreateWallet_Threshold(t *testing.T) {
+	ctx := context.Background()
+	sharePassword := "share-2-password"
+	walletDir, sharesDir, tokenFile := setupWalletAndPasswordsDir(t)
+	opts := &threshold.KeymanagerOpts{
+		Threshold:       2,
+		ShareID:         1,
+		ListenAddr:      "127.0.0.1:7600",
+		Cosigners:       []*threshold.CosignerOpts{{ShareID: 2, URL: "http://127.0.0.1:7601"}},
+		BearerTokenPath: tokenFile,
+	}
+	wallet, err := CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &WalletConfig{
+			WalletDir:      walletDir,
+			KeymanagerKind: v2keymanager.Threshold,
+			WalletPassword: password,
+		},
+		ThresholdKeymanagerOpts: opts,
+		ThresholdSharesDir:      sharesDir,
+		ThresholdSharePasswords: map[uint64]string{2: sharePassword},
+		ThresholdNumKeys:        2,
+	})
+	require.NoError(t, err)
+	km, err := wallet.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pubKeys))
+
+	// The other share-holder creates its wallet from the shares distributed to it.
+	otherWalletDir, _, _ := setupWalletAndPasswordsDir(t)
+	otherWallet, err := CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &WalletConfig{
+			WalletDir:      otherWalletDir,
+			KeymanagerKind: v2keymanager.Threshold,
+			WalletPassword: password,
+		},
+		ThresholdKeymanagerOpts: &threshold.KeymanagerOpts{
+			Threshold:       2,
+			ShareID:         2,
+			ListenAddr:      "127.0.0.1:7601",
+			Cosigners:       []*threshold.CosignerOpts{{ShareID: 1, URL: "http://127.0.0.1:7600"}},
+			BearerTokenPath: tokenFile,
+		},
+		ThresholdShareFile:     filepath.Join(sharesDir, "share-2.keystore.json"),
+		ThresholdSharePassword: sharePassword,
+	})
+	require.NoError(t, err)
+	otherKm, err := otherWallet.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	otherPubKeys, err := otherKm.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKeys, otherPubKeys)
+
+	// Shares are only valid for their own share ID.
+	thirdWalletDir, _, _ := setupWalletAndPasswordsDir(t)
+	_, err = CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &WalletConfig{
+			WalletDir:      thirdWalletDir,
+			KeymanagerKind: v2keymanager.Threshold,
+			WalletPassword: password,
+		},
+		ThresholdKeymanagerOpts: opts,
+		ThresholdShareFile:      filepath.Join(sharesDir, "share-2.keystore.json"),
+		ThresholdSharePassword:  sharePassword,
+	})
+	assert.ErrorContains(t, "shares are for share ID 2", err)
+
+	// The shares of another share-holder are not encrypted with the wallet password.
+	_, err = CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &WalletConfig{
+			WalletDir:      thirdWalletDir,
+			KeymanagerKind: v2keymanager.Threshold,
+			WalletPassword: password,
+		},
+		ThresholdKeymanagerOpts: opts,
+		ThresholdShareFile:      filepath.Join(sharesDir, "share-2.keystore.json"),
+		ThresholdSharePassword:  password,
+	})
+	assert.ErrorContains(t, "could not decrypt shares file", err)
+}
+
+func TestShredFile(t *testing.T) {
+	_, sharesDir, _ := setupWalletAndPasswordsDir(t)
+	require.NoError(t, os.MkdirAll(sharesDir, 0700))
+	path := thresholdSharesFile(sharesDir, 2)
+	require.NoError(t, ioutil.WriteFile(path, []byte("secret shares"), 0600))
+	require.NoError(t, shredFile(path))
+	_, err := os.Stat(path)
+	assert.Equal(t, true, os.IsNotExist(err))
+	assert.NotNil(t, shredFile(path))
+}
+
+func TestParseCosigners(t *testing.T) {
+	cosigners, err := parseCosigners("2=https://10.0.0.2:7600, 3=http://10.0.0.3:7600,")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*threshold.CosignerOpts{
+		{ShareID: 2, URL: "https://10.0.0.2:7600"},
+		{ShareID: 3, URL: "http://10.0.0.3:7600"},
+	}, cosigners)
+
+	_, err = parseCosigners("https://10.0.0.2:7600")
+	assert.ErrorContains(t, "not formatted as share-id=url", err)
+	_, err = parseCosigners("")
+	assert.ErrorContains(t, "at least one co-signer", err)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/pkg/errors"
 	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/threshold"
 	"github.com/urfave/cli/v2"
 )
 
@@ -49,6 +51,56 @@ func EditWalletConfigurationCli(cliCtx *cli.Context) error {
 		if err := wallet.WriteKeymanagerConfigToDisk(cliCtx.Context, encodedCfg); err != nil {
 			return errors.Wrap(err, "could not write config to disk")
 		}
+	case v2keymanager.RemoteHTTP:
+		enc, err := wallet.ReadKeymanagerConfigFromDisk(cliCtx.Context)
+		if err != nil {
+			return errors.Wrap(err, "could not read config")
+		}
+		opts, err := remotehttp.UnmarshalOptionsFile(enc)
+		if err != nil {
+			return errors.Wrap(err, "could not unmarshal config")
+		}
+		log.Info("Current configuration")
+		// Prints the current configuration to stdout.
+		fmt.Println(opts)
+		newCfg, err := inputRemoteHTTPKeymanagerConfig(cliCtx)
+		if err != nil {
+			return errors.Wrap(err, "could not get keymanager config")
+		}
+		encodedCfg, err := remotehttp.MarshalOptionsFile(cliCtx.Context, newCfg)
+		if err != nil {
+			return errors.Wrap(err, "could not marshal config file")
+		}
+		if err := wallet.WriteKeymanagerConfigToDisk(cliCtx.Context, encodedCfg); err != nil {
+			return errors.Wrap(err, "could not write config to disk")
+		}
+	case v2keymanager.Threshold:
+		enc, err := wallet.ReadKeymanagerConfigFromDisk(cliCtx.Context)
+		if err != nil {
+			return errors.Wrap(err, "could not read config")
+		}
+		opts, err := threshold.UnmarshalOptionsFile(enc)
+		if err != nil {
+			return errors.Wrap(err, "could not unmarshal config")
+		}
+		log.Info("Current configuration")
+		// Prints the current configuration to stdout.
+		fmt.Println(opts)
+		newCfg, err := inputThresholdKeymanagerConfig(cliCtx)
+		if err != nil {
+			return errors.Wrap(err, "could not get keymanager config")
+		}
+		// The shares in the wallet are only valid for their share ID and threshold.
+		if newCfg.ShareID != opts.ShareID || newCfg.Threshold != opts.Threshold {
+			return errors.New("the share ID and threshold of a threshold wallet cannot be changed")
+		}
+		encodedCfg, err := threshold.MarshalOptionsFile(cliCtx.Context, newCfg)
+		if err != nil {
+			return errors.Wrap(err, "could not marshal config file")
+		}
+		if err := wallet.WriteKeymanagerConfigToDisk(cliCtx.Context, encodedCfg); err != nil {
+			return errors.Wrap(err, "could not write config to disk")
+		}
 	default:
 		return fmt.Errorf("keymanager type %s is not supported", wallet.KeymanagerKind())
 	}
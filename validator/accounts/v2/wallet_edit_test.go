@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
 	"github.com/urfave/cli/v2"
 )
 
@@ -70,3 +71,48 @@ func TestEditWalletConfiguration(t *testing.T) {
 	assert.NoError(t, err)
 	assert.DeepEqual(t, wantCfg, cfg)
 }
+
+func TestEditWalletConfiguration_RemoteHTTP(t *testing.T) {
+	walletDir, _, _ := setupWalletAndPasswordsDir(t)
+	cliCtx := setupWalletCtx(t, &testWalletConfig{
+		walletDir:      walletDir,
+		keymanagerKind: v2keymanager.RemoteHTTP,
+	})
+	wallet, err := CreateWalletWithKeymanager(cliCtx.Context, &CreateWalletConfig{
+		WalletCfg: &WalletConfig{
+			WalletDir:      walletDir,
+			KeymanagerKind: v2keymanager.RemoteHTTP,
+			WalletPassword: "Passwordz0320$",
+		},
+		RemoteHTTPKeymanagerOpts: &remotehttp.KeymanagerOpts{
+			URL: "http://my.server.com:9000",
+		},
+	})
+	require.NoError(t, err)
+
+	wantCfg := &remotehttp.KeymanagerOpts{
+		URL:             "https://host.example.com:9000",
+		BearerTokenPath: "/tmp/token",
+		CACertPath:      "/tmp/ca.crt",
+	}
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.WalletDirFlag.Name, walletDir, "")
+	set.String(flags.RemoteSignerURLFlag.Name, wantCfg.URL, "")
+	set.String(flags.RemoteSignerTokenFileFlag.Name, wantCfg.BearerTokenPath, "")
+	set.String(flags.RemoteSignerCACertPathFlag.Name, wantCfg.CACertPath, "")
+	assert.NoError(t, set.Set(flags.WalletDirFlag.Name, walletDir))
+	assert.NoError(t, set.Set(flags.RemoteSignerURLFlag.Name, wantCfg.URL))
+	assert.NoError(t, set.Set(flags.RemoteSignerTokenFileFlag.Name, wantCfg.BearerTokenPath))
+	assert.NoError(t, set.Set(flags.RemoteSignerCACertPathFlag.Name, wantCfg.CACertPath))
+	cliCtx = cli.NewContext(&app, set, nil)
+
+	err = EditWalletConfigurationCli(cliCtx)
+	require.NoError(t, err)
+	encoded, err := wallet.ReadKeymanagerConfigFromDisk(cliCtx.Context)
+	require.NoError(t, err)
+
+	cfg, err := remotehttp.UnmarshalOptionsFile(encoded)
+	assert.NoError(t, err)
+	assert.DeepEqual(t, wantCfg, cfg)
+}
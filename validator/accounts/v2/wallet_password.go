@@ -0,0 +1,351 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	keystoresPasswordPromptText       = "Current password of the keystores"
+	newKeystoresPasswordPromptText    = "New password of the keystores"
+	changePasswordKeystoresPromptText = "Enter the directory or filepath of the keystores to change the password of"
+	walletBackupsDirName              = "backups"
+)
+
+// WebPasswordHashCost is the bcrypt cost of the hashed passwords of the validator web API.
+const WebPasswordHashCost = 8
+
+// ChangePasswordConfig defines values to run the change wallet password function.
+type ChangePasswordConfig struct {
+	Wallet      *Wallet
+	Keymanager  v2keymanager.IKeymanager
+	NewPassword string
+	// KDF used to encrypt the wallet, the default one of the keystores if nil.
+	KDF *v2keymanager.KDFConfig
+	// ValDB is the validator database whose web API password is changed along with the
+	// wallet password, if not nil.
+	ValDB db.Database
+}
+
+// ChangeKeystoresPasswordConfig defines values to run the change keystores password function.
+type ChangeKeystoresPasswordConfig struct {
+	// KeystorePaths are the paths of EIP-2335 keystore files.
+	KeystorePaths []string
+	Password      string
+	NewPassword   string
+	// KDF used to encrypt the keystores, the default one of the keystores if nil.
+	KDF *v2keymanager.KDFConfig
+}
+
+// ChangeWalletPasswordCli changes the password of a direct or derived wallet, which
+// must not be used by a running validator client.
+func ChangeWalletPasswordCli(cliCtx *cli.Context) error {
+	wallet, err := OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*Wallet, error) {
+		return nil, errors.New("no wallet found, create a new one with validator wallet-v2 create")
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	keymanager, err := wallet.InitializeKeymanager(cliCtx.Context, true /* skip mnemonic confirm */)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	if err := wallet.LockWalletConfigFile(cliCtx.Context); err != nil {
+		return errors.Wrap(err, "could not lock the wallet, stop the validator client using it first")
+	}
+	defer func() {
+		if err := wallet.UnlockWalletConfigFile(); err != nil {
+			log.WithError(err).Error("Could not unlock wallet config file")
+		}
+	}()
+	newPassword, err := inputPassword(
+		cliCtx,
+		flags.NewWalletPasswordFileFlag,
+		newWalletPasswordPromptText,
+		true, /* Confirm password */
+		promptutil.ValidatePasswordInput,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not input new wallet password")
+	}
+	kdf, err := kdfConfigFromCli(cliCtx)
+	if err != nil {
+		return err
+	}
+	// The password of the wallet is also the password of the validator web API, which is
+	// changed along with it if the validator database has one.
+	var valDB db.Database
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if fileutil.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)) {
+		valDB, err = kv.NewKVStore(dataDir, nil)
+		if err != nil {
+			return errors.Wrap(err, "could not open validator database")
+		}
+		defer func() {
+			if err := valDB.Close(); err != nil {
+				log.WithError(err).Error("Could not close validator database")
+			}
+		}()
+	}
+	return ChangeWalletPassword(cliCtx.Context, &ChangePasswordConfig{
+		Wallet:      wallet,
+		Keymanager:  keymanager,
+		NewPassword: newPassword,
+		KDF:         kdf,
+		ValDB:       valDB,
+	})
+}
+
+// ChangeKeystoresPasswordCli changes the password of EIP-2335 keystore files, such as
+// keystores to import in a wallet.
+func ChangeKeystoresPasswordCli(cliCtx *cli.Context) error {
+	keystoresPath, err := inputDirectory(cliCtx, changePasswordKeystoresPromptText, flags.KeystoresPathFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not parse keystores path")
+	}
+	keystorePaths, err := keystoreFilesAtPath(keystoresPath)
+	if err != nil {
+		return err
+	}
+	password, err := inputWeakPassword(cliCtx, flags.AccountPasswordFileFlag, keystoresPasswordPromptText)
+	if err != nil {
+		return errors.Wrap(err, "could not input keystores password")
+	}
+	newPassword, err := inputPassword(
+		cliCtx,
+		flags.NewAccountPasswordFileFlag,
+		newKeystoresPasswordPromptText,
+		true, /* Confirm password */
+		promptutil.ValidatePasswordInput,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not input new keystores password")
+	}
+	kdf, err := kdfConfigFromCli(cliCtx)
+	if err != nil {
+		return err
+	}
+	return ChangeKeystoresPassword(&ChangeKeystoresPasswordConfig{
+		KeystorePaths: keystorePaths,
+		Password:      password,
+		NewPassword:   newPassword,
+		KDF:           kdf,
+	})
+}
+
+// ChangeWalletPassword encrypts the keys of a direct or derived wallet again with a new
+// password. The files of the keymanager are first backed up in the wallet directory, and
+// the wallet keeps its previous password if they could not be written. The password of the
+// validator web API in the validator database, if any, is changed along with it.
+func ChangeWalletPassword(ctx context.Context, cfg *ChangePasswordConfig) error {
+	var reencrypt func(context.Context, *v2keymanager.KDFConfig) error
+	switch cfg.Wallet.KeymanagerKind() {
+	case v2keymanager.Direct:
+		km, ok := cfg.Keymanager.(*direct.Keymanager)
+		if !ok {
+			return errors.New("not a direct keymanager")
+		}
+		reencrypt = km.ReencryptAccounts
+	case v2keymanager.Derived:
+		km, ok := cfg.Keymanager.(*derived.Keymanager)
+		if !ok {
+			return errors.New("not a derived keymanager")
+		}
+		reencrypt = km.ReencryptSeed
+	case v2keymanager.Remote, v2keymanager.RemoteHTTP:
+		return errors.New("cannot change the password of a remote keymanager")
+	case v2keymanager.Threshold:
+		return errors.New("cannot change the password of a threshold keymanager")
+	default:
+		return errors.Errorf("keymanager kind %s not supported", cfg.Wallet.KeymanagerKind())
+	}
+	backupDir := filepath.Join(
+		cfg.Wallet.walletDir,
+		walletBackupsDirName,
+		fmt.Sprintf("%s-%d", cfg.Wallet.KeymanagerKind(), time.Now().Unix()),
+	)
+	if err := backupFiles(cfg.Wallet.accountsPath, backupDir); err != nil {
+		return errors.Wrap(err, "could not back up wallet")
+	}
+	// The web API password is saved first, and restored if the wallet could not be encrypted,
+	// so that it never lags behind the password of the wallet.
+	var previousHashedPassword []byte
+	webPasswordSaved := false
+	if cfg.ValDB != nil {
+		var err error
+		previousHashedPassword, err = cfg.ValDB.HashedPasswordForAPI(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve password of the validator web API")
+		}
+		webPasswordSaved, err = SaveWebPassword(ctx, cfg.ValDB, cfg.NewPassword)
+		if err != nil {
+			return errors.Wrap(err, "could not save new password of the validator web API")
+		}
+	}
+	previousPassword := cfg.Wallet.walletPassword
+	cfg.Wallet.walletPassword = cfg.NewPassword
+	if err := reencrypt(ctx, cfg.KDF); err != nil {
+		cfg.Wallet.walletPassword = previousPassword
+		if webPasswordSaved {
+			if _, err := cfg.ValDB.SaveWalletOwnerPassword(ctx, previousHashedPassword); err != nil {
+				log.WithError(err).Error("Could not restore previous password of the validator web API")
+			}
+		}
+		return errors.Wrap(err, "could not encrypt wallet with new password")
+	}
+	log.WithFields(logrus.Fields{
+		"wallet-path":       cfg.Wallet.walletDir,
+		"backup-path":       backupDir,
+		"changed-web-login": webPasswordSaved,
+	}).Info("Changed wallet password")
+	return nil
+}
+
+// SaveWebPassword hashes the password of a wallet and saves it as the password of the
+// validator web API and of the users owning the wallet. Returns whether it was saved, which
+// it is not if the validator web API has no password yet.
+func SaveWebPassword(ctx context.Context, valDB db.Database, password string) (bool, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), WebPasswordHashCost)
+	if err != nil {
+		return false, errors.Wrap(err, "could not generate hashed password")
+	}
+	return valDB.SaveWalletOwnerPassword(ctx, hashedPassword)
+}
+
+// ChangeKeystoresPassword decrypts EIP-2335 keystore files with their password, and
+// encrypts them again with a new password. The other fields of the keystores are kept.
+// Nothing is written unless all the keystores could be decrypted, and the previous files
+// are backed up next to the keystores.
+func ChangeKeystoresPassword(cfg *ChangeKeystoresPasswordConfig) error {
+	if len(cfg.KeystorePaths) == 0 {
+		return errors.New("no keystores to change the password of")
+	}
+	decryptor := keystorev4.New()
+	encoded := make([][]byte, len(cfg.KeystorePaths))
+	for i, path := range cfg.KeystorePaths {
+		keystoreBytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			return errors.Wrapf(err, "could not read keystore %s", path)
+		}
+		keystore := make(map[string]interface{})
+		if err := json.Unmarshal(keystoreBytes, &keystore); err != nil {
+			return errors.Wrapf(err, "could not decode keystore %s", path)
+		}
+		cryptoFields, ok := keystore["crypto"].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("keystore %s has no crypto fields", path)
+		}
+		secret, err := decryptor.Decrypt(cryptoFields, cfg.Password)
+		if err != nil && strings.Contains(err.Error(), "invalid checksum") {
+			return fmt.Errorf("incorrect password for keystore %s", path)
+		} else if err != nil {
+			return errors.Wrapf(err, "could not decrypt keystore %s", path)
+		}
+		keystore["crypto"], err = v2keymanager.EncryptKeystore(secret, cfg.NewPassword, cfg.KDF)
+		if err != nil {
+			return errors.Wrapf(err, "could not encrypt keystore %s", path)
+		}
+		encoded[i], err = json.MarshalIndent(keystore, "", "\t")
+		if err != nil {
+			return err
+		}
+	}
+	backupTime := time.Now().Unix()
+	for i, path := range cfg.KeystorePaths {
+		backupDir := filepath.Join(filepath.Dir(path), fmt.Sprintf("backup-%d", backupTime))
+		if err := os.MkdirAll(backupDir, DirectoryPermissions); err != nil {
+			return errors.Wrapf(err, "could not create backup directory %s", backupDir)
+		}
+		if err := fileutil.CopyFile(path, filepath.Join(backupDir, filepath.Base(path))); err != nil {
+			return errors.Wrapf(err, "could not back up keystore %s", path)
+		}
+		if err := fileutil.WriteFileAtomically(path, encoded[i], params.BeaconIoConfig().ReadWritePermissions); err != nil {
+			return errors.Wrapf(err, "could not write keystore %s", path)
+		}
+		log.WithFields(logrus.Fields{
+			"keystore":    path,
+			"backup-path": backupDir,
+		}).Info("Changed keystore password")
+	}
+	return nil
+}
+
+// keystoreFilesAtPath returns the path of a keystore file, or the paths of the keystore
+// files in a directory.
+func keystoreFilesAtPath(path string) ([]string, error) {
+	isDir, err := fileutil.HasDir(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not determine if path is a directory")
+	}
+	if !isDir {
+		return []string{path}, nil
+	}
+	files, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read dir")
+	}
+	var paths []string
+	for _, f := range files {
+		if f.IsDir() || !strings.Contains(f.Name(), "keystore") || filepath.Ext(f.Name()) != ".json" {
+			continue
+		}
+		paths = append(paths, filepath.Join(path, f.Name()))
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("directory %s has no keystore files", path)
+	}
+	return paths, nil
+}
+
+// backupFiles copies the files of a directory and of its subdirectories to a backup directory.
+func backupFiles(dir string, backupDir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(backupDir, rel), DirectoryPermissions)
+		}
+		return fileutil.CopyFile(path, filepath.Join(backupDir, rel))
+	})
+}
+
+func kdfConfigFromCli(cliCtx *cli.Context) (*v2keymanager.KDFConfig, error) {
+	kdf, err := v2keymanager.NewKDFConfig(
+		cliCtx.String(flags.KDFFlag.Name),
+		cliCtx.Int(flags.KDFScryptNFlag.Name),
+		cliCtx.Int(flags.KDFScryptRFlag.Name),
+		cliCtx.Int(flags.KDFScryptPFlag.Name),
+		cliCtx.Int(flags.KDFPBKDF2CFlag.Name),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid key derivation function")
+	}
+	return kdf, nil
+}
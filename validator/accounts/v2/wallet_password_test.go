@@ -0,0 +1,310 @@
+package v2
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const newPassword = "NewPassw0rdz4938%%"
+
+func TestChangeWalletPassword_Direct(t *testing.T) {
+	ctx := context.Background()
+	walletDir, _, _ := setupWalletAndPasswordsDir(t)
+	wallet, err := CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &WalletConfig{
+			WalletDir:      walletDir,
+			KeymanagerKind: v2keymanager.Direct,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+	km, err := wallet.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	_, err = km.(*direct.Keymanager).CreateAccount(ctx)
+	require.NoError(t, err)
+	wantKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, ChangeWalletPassword(ctx, &ChangePasswordConfig{
+		Wallet:      wallet,
+		Keymanager:  km,
+		NewPassword: newPassword,
+	}))
+	assert.Equal(t, newPassword, wallet.Password())
+
+	reopened, err := OpenWallet(ctx, &WalletConfig{
+		WalletDir:      walletDir,
+		WalletPassword: newPassword,
+	})
+	require.NoError(t, err)
+	km, err = reopened.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantKeys, keys)
+}
+
+func TestChangeWalletPassword_Derived(t *testing.T) {
+	ctx := context.Background()
+	walletDir, _, _ := setupWalletAndPasswordsDir(t)
+	wallet, err := RecoverWallet(ctx, &RecoverWalletConfig{
+		WalletDir:      walletDir,
+		WalletPassword: password,
+		Mnemonic:       mnemonic,
+		NumAccounts:    2,
+	})
+	require.NoError(t, err)
+	km, err := wallet.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	wantKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, ChangeWalletPassword(ctx, &ChangePasswordConfig{
+		Wallet:      wallet,
+		Keymanager:  km,
+		NewPassword: newPassword,
+	}))
+
+	stale, err := OpenWallet(ctx, &WalletConfig{
+		WalletDir:      walletDir,
+		WalletPassword: password,
+	})
+	require.NoError(t, err)
+	_, err = stale.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	assert.ErrorContains(t, "could not decrypt seed", err)
+	reopened, err := OpenWallet(ctx, &WalletConfig{
+		WalletDir:      walletDir,
+		WalletPassword: newPassword,
+	})
+	require.NoError(t, err)
+	km, err = reopened.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantKeys, keys)
+}
+
+func TestChangeWalletPassword_Remote(t *testing.T) {
+	wallet := &Wallet{
+		keymanagerKind: v2keymanager.Remote,
+		walletPassword: password,
+	}
+	err := ChangeWalletPassword(context.Background(), &ChangePasswordConfig{
+		Wallet:      wallet,
+		NewPassword: newPassword,
+	})
+	assert.ErrorContains(t, "cannot change the password of a remote keymanager", err)
+	assert.Equal(t, password, wallet.Password())
+}
+
+func TestChangeWalletPassword_KDFAndBackup(t *testing.T) {
+	ctx := context.Background()
+	walletDir, _, _ := setupWalletAndPasswordsDir(t)
+	wallet, err := CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &WalletConfig{
+			WalletDir:      walletDir,
+			KeymanagerKind: v2keymanager.Direct,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+	km, err := wallet.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	_, err = km.(*direct.Keymanager).CreateAccount(ctx)
+	require.NoError(t, err)
+	keystorePath := filepath.Join(walletDir, v2keymanager.Direct.String(), direct.AccountsPath, "all-accounts.keystore.json")
+	previous, err := ioutil.ReadFile(keystorePath)
+	require.NoError(t, err)
+
+	kdf, err := v2keymanager.NewKDFConfig(v2keymanager.PBKDF2KDF, 0, 0, 0, 1000)
+	require.NoError(t, err)
+	require.NoError(t, ChangeWalletPassword(ctx, &ChangePasswordConfig{
+		Wallet:      wallet,
+		Keymanager:  km,
+		NewPassword: newPassword,
+		KDF:         kdf,
+	}))
+
+	keystore := &v2keymanager.Keystore{}
+	encoded, err := ioutil.ReadFile(keystorePath)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(encoded, keystore))
+	kdfFields, ok := keystore.Crypto["kdf"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	assert.Equal(t, v2keymanager.PBKDF2KDF, kdfFields["function"])
+	_, err = keystorev4.New().Decrypt(keystore.Crypto, newPassword)
+	require.NoError(t, err)
+
+	// The previous keystore is backed up in the wallet.
+	backups, err := ioutil.ReadDir(filepath.Join(walletDir, walletBackupsDirName))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(backups))
+	backup, err := ioutil.ReadFile(filepath.Join(
+		walletDir, walletBackupsDirName, backups[0].Name(), direct.AccountsPath, "all-accounts.keystore.json",
+	))
+	require.NoError(t, err)
+	assert.DeepEqual(t, previous, backup)
+}
+
+func TestChangeKeystoresPassword(t *testing.T) {
+	_, keysDir, _ := setupWalletAndPasswordsDir(t)
+	require.NoError(t, os.MkdirAll(keysDir, os.ModePerm))
+	privKey := bls.RandKey()
+	keystore, err := createKeystoreFromPrivateKey(privKey, password)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(keystore)
+	require.NoError(t, err)
+	fields := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(encoded, &fields))
+	fields["path"] = "m/12381/3600/0/0/0"
+	encoded, err = json.Marshal(fields)
+	require.NoError(t, err)
+	keystorePath := filepath.Join(keysDir, "keystore-m_12381_3600_0_0_0.json")
+	require.NoError(t, ioutil.WriteFile(keystorePath, encoded, os.ModePerm))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(keysDir, "deposit_data.json"), []byte("[]"), os.ModePerm))
+
+	paths, err := keystoreFilesAtPath(keysDir)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{keystorePath}, paths)
+	kdf, err := v2keymanager.NewKDFConfig(v2keymanager.ScryptKDF, 1024, 0, 0, 0)
+	require.NoError(t, err)
+
+	// Nothing is written with an incorrect password.
+	err = ChangeKeystoresPassword(&ChangeKeystoresPasswordConfig{
+		KeystorePaths: paths,
+		Password:      "wrong",
+		NewPassword:   newPassword,
+		KDF:           kdf,
+	})
+	require.ErrorContains(t, "incorrect password for keystore", err)
+	unchanged, err := ioutil.ReadFile(keystorePath)
+	require.NoError(t, err)
+	assert.DeepEqual(t, encoded, unchanged)
+
+	require.NoError(t, ChangeKeystoresPassword(&ChangeKeystoresPasswordConfig{
+		KeystorePaths: paths,
+		Password:      password,
+		NewPassword:   newPassword,
+		KDF:           kdf,
+	}))
+	changed, err := ioutil.ReadFile(keystorePath)
+	require.NoError(t, err)
+	fields = make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(changed, &fields))
+	assert.Equal(t, "m/12381/3600/0/0/0", fields["path"])
+	assert.Equal(t, keystore.Pubkey, fields["pubkey"])
+	cryptoFields, ok := fields["crypto"].(map[string]interface{})
+	require.Equal(t, true, ok)
+	secret, err := keystorev4.New().Decrypt(cryptoFields, newPassword)
+	require.NoError(t, err)
+	assert.DeepEqual(t, privKey.Marshal(), secret)
+
+	// The previous keystore is backed up next to it, and is not a keystore to change.
+	backups, err := filepath.Glob(filepath.Join(keysDir, "backup-*", "keystore-m_12381_3600_0_0_0.json"))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(backups))
+	backup, err := ioutil.ReadFile(backups[0])
+	require.NoError(t, err)
+	assert.DeepEqual(t, encoded, backup)
+	paths, err = keystoreFilesAtPath(keysDir)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(paths))
+}
+
+func TestSaveWebPassword(t *testing.T) {
+	ctx := context.Background()
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	saved, err := SaveWebPassword(ctx, valDB, newPassword)
+	require.NoError(t, err)
+	assert.Equal(t, false, saved)
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), WebPasswordHashCost)
+	require.NoError(t, err)
+	require.NoError(t, valDB.SaveHashedPasswordForAPI(ctx, hashedPassword))
+	require.NoError(t, valDB.SaveAPIUser(ctx, &kv.APIUser{
+		Username:       "admin",
+		HashedPassword: hashedPassword,
+		Role:           kv.AdminRole,
+		WalletOwner:    true,
+	}))
+	saved, err = SaveWebPassword(ctx, valDB, newPassword)
+	require.NoError(t, err)
+	assert.Equal(t, true, saved)
+	hashedPassword, err = valDB.HashedPasswordForAPI(ctx)
+	require.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword(hashedPassword, []byte(newPassword)))
+	user, err := valDB.APIUser(ctx, "admin")
+	require.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword(user.HashedPassword, []byte(newPassword)))
+}
+
+func TestChangeWalletPassword_WebPassword(t *testing.T) {
+	ctx := context.Background()
+	walletDir, _, _ := setupWalletAndPasswordsDir(t)
+	wallet, err := CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &WalletConfig{
+			WalletDir:      walletDir,
+			KeymanagerKind: v2keymanager.Direct,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+	km, err := wallet.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	_, err = km.(*direct.Keymanager).CreateAccount(ctx)
+	require.NoError(t, err)
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), WebPasswordHashCost)
+	require.NoError(t, err)
+	require.NoError(t, valDB.SaveHashedPasswordForAPI(ctx, hashedPassword))
+	require.NoError(t, valDB.SaveAPIUser(ctx, &kv.APIUser{
+		Username:       "admin",
+		HashedPassword: hashedPassword,
+		Role:           kv.AdminRole,
+		WalletOwner:    true,
+	}))
+
+	// The web API password is restored if the wallet could not be encrypted.
+	err = ChangeWalletPassword(ctx, &ChangePasswordConfig{
+		Wallet:      wallet,
+		Keymanager:  km,
+		NewPassword: newPassword,
+		KDF:         &v2keymanager.KDFConfig{Function: v2keymanager.PBKDF2KDF},
+		ValDB:       valDB,
+	})
+	assert.ErrorContains(t, "could not encrypt wallet with new password", err)
+	assert.Equal(t, password, wallet.Password())
+	hashedPassword, err = valDB.HashedPasswordForAPI(ctx)
+	require.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword(hashedPassword, []byte(password)))
+	user, err := valDB.APIUser(ctx, "admin")
+	require.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword(user.HashedPassword, []byte(password)))
+
+	require.NoError(t, ChangeWalletPassword(ctx, &ChangePasswordConfig{
+		Wallet:      wallet,
+		Keymanager:  km,
+		NewPassword: newPassword,
+		ValDB:       valDB,
+	}))
+	hashedPassword, err = valDB.HashedPasswordForAPI(ctx)
+	require.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword(hashedPassword, []byte(newPassword)))
+	user, err = valDB.APIUser(ctx, "admin")
+	require.NoError(t, err)
+	assert.NoError(t, bcrypt.CompareHashAndPassword(user.HashedPassword, []byte(newPassword)))
+}
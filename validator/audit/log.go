@@ -0,0 +1,303 @@
+// Package audit defines an append-only log of everything the validator client signs, for
+// operators who need a durable record of the signatures of their validators. Every entry
+// includes the hash of the previous one, so that a tampered log can be detected.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gofrs/flock"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "audit")
+
+const (
+	// CurrentFileName is the name of the file entries are appended to, until it is rotated.
+	CurrentFileName = "audit.jsonl"
+	// rotatedFilePrefix prefixes the names of the rotated files, which are followed by the
+	// time of their rotation so that they sort in the order of the log.
+	rotatedFilePrefix = "audit-"
+	fileExtension     = ".jsonl"
+	// maxEntrySize bounds the size of a single entry read from the log.
+	maxEntrySize = 1 << 20
+	// lockFileName is the name of the file locked by the process appending to the log.
+	lockFileName = "audit.lock"
+)
+
+// The types of messages signed by the validator client.
+const (
+	BlockType             = "block"
+	AttestationType       = "attestation"
+	AggregateAndProofType = "aggregate_and_proof"
+	SelectionProofType    = "selection_proof"
+	RandaoRevealType      = "randao_reveal"
+	VoluntaryExitType     = "voluntary_exit"
+)
+
+// The results of the slashing protection of signed messages.
+const (
+	// ProtectionAllowed is the result of a message checked by slashing protection, and signed.
+	ProtectionAllowed = "allowed"
+	// ProtectionRejected is the result of a message refused by slashing protection, and not signed.
+	ProtectionRejected = "rejected"
+	// ProtectionNotApplicable is the result of a message which cannot be slashable.
+	ProtectionNotApplicable = "not_applicable"
+)
+
+// genesisHash is the previous hash of the first entry of a log.
+var genesisHash = fmt.Sprintf("%#x", params.BeaconConfig().ZeroHash)
+
+// Entry is a message the validator client signed, or refused to sign.
+type Entry struct {
+	Time        string `json:"time"`
+	Type        string `json:"type"`
+	PublicKey   string `json:"pubkey"`
+	Slot        uint64 `json:"slot"`
+	Epoch       uint64 `json:"epoch"`
+	SigningRoot string `json:"signing_root"`
+	Domain      string `json:"domain"`
+	Protection  string `json:"slashing_protection"`
+	Signature   string `json:"signature,omitempty"`
+	Error       string `json:"error,omitempty"`
+	PrevHash    string `json:"prev_hash"`
+	Hash        string `json:"hash"`
+}
+
+// computeHash returns the hash of an entry, which covers all of its fields but the hash
+// itself, including the hash of the previous entry.
+func (e *Entry) computeHash() (string, error) {
+	unhashed := *e
+	unhashed.Hash = ""
+	enc, err := json.Marshal(&unhashed)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%#x", hashutil.Hash(enc)), nil
+}
+
+// Log appends the entries of the audit log to a file of a directory, which is rotated to a
+// new file once it grows past a maximum size. The hash chain continues across files.
+type Log struct {
+	dir      string
+	maxSize  int64
+	lock     sync.Mutex
+	dirLock  *flock.Flock
+	file     *os.File
+	size     int64
+	lastHash string
+}
+
+// NewLog opens the audit log of a directory, continuing the hash chain of its last entry.
+// The directory is locked until the log is closed, as the entries of processes appending
+// to the same log at once would break its hash chain: it fails if another process holds it.
+func NewLog(dir string, maxSize int64) (*Log, error) {
+	if maxSize <= 0 {
+		return nil, errors.New("maximum size of the audit log files must be positive")
+	}
+	if err := os.MkdirAll(dir, params.BeaconIoConfig().ReadWriteExecutePermissions); err != nil {
+		return nil, errors.Wrap(err, "could not create audit log directory")
+	}
+	dirLock := flock.New(filepath.Join(dir, lockFileName))
+	locked, err := dirLock.TryLock()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not lock audit log directory")
+	}
+	if !locked {
+		return nil, fmt.Errorf("audit log %s is in use by another process, such as a running validator client", dir)
+	}
+	l, err := openLog(dir, maxSize, dirLock)
+	if err != nil {
+		if err := dirLock.Unlock(); err != nil {
+			log.WithError(err).Error("Could not unlock audit log directory")
+		}
+		return nil, err
+	}
+	log.WithField("path", filepath.Join(dir, CurrentFileName)).Info("Recording signed messages in the audit log")
+	return l, nil
+}
+
+func openLog(dir string, maxSize int64, dirLock *flock.Flock) (*Log, error) {
+	files, err := logFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	lastHash := genesisHash
+	// The current file may be empty if it was just rotated, in which case the last entry
+	// is in the last rotated file.
+	for i := len(files) - 1; i >= 0; i-- {
+		entry, err := lastEntry(files[i])
+		if err != nil {
+			return nil, err
+		}
+		if entry != nil {
+			lastHash = entry.Hash
+			break
+		}
+	}
+	l := &Log{
+		dir:      dir,
+		maxSize:  maxSize,
+		dirLock:  dirLock,
+		lastHash: lastHash,
+	}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Record appends an entry to the audit log, chained to the previous one, and syncs it to disk.
+func (l *Log) Record(entry *Entry) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.file == nil {
+		return errors.New("audit log is closed")
+	}
+	entry.Time = roughtime.Now().UTC().Format("2006-01-02T15:04:05.000000000Z")
+	entry.PrevHash = l.lastHash
+	hash, err := entry.computeHash()
+	if err != nil {
+		return errors.Wrap(err, "could not hash audit log entry")
+	}
+	entry.Hash = hash
+	enc, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal audit log entry")
+	}
+	enc = append(enc, '\n')
+	if l.size > 0 && l.size+int64(len(enc)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return errors.Wrap(err, "could not rotate audit log")
+		}
+	}
+	n, err := l.file.Write(enc)
+	l.size += int64(n)
+	if err != nil {
+		return errors.Wrap(err, "could not write audit log entry")
+	}
+	if err := l.file.Sync(); err != nil {
+		return errors.Wrap(err, "could not sync audit log")
+	}
+	l.lastHash = hash
+	return nil
+}
+
+// Close the audit log, and unlock its directory.
+func (l *Log) Close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	if unlockErr := l.dirLock.Unlock(); unlockErr != nil && err == nil {
+		err = errors.Wrap(unlockErr, "could not unlock audit log directory")
+	}
+	return err
+}
+
+func (l *Log) open() error {
+	f, err := os.OpenFile(
+		filepath.Join(l.dir, CurrentFileName),
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		params.BeaconIoConfig().ReadWritePermissions,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not open audit log")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrap(err, "could not stat audit log")
+	}
+	l.file = f
+	l.size = info.Size()
+	return nil
+}
+
+// rotate moves the current file aside and starts a new one.
+func (l *Log) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	l.file = nil
+	rotated := filepath.Join(l.dir, fmt.Sprintf("%s%020d%s", rotatedFilePrefix, roughtime.Now().UnixNano(), fileExtension))
+	if err := os.Rename(filepath.Join(l.dir, CurrentFileName), rotated); err != nil {
+		return err
+	}
+	return l.open()
+}
+
+// logFiles returns the files of the audit log of a directory, in the order of the log.
+func logFiles(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read audit log directory")
+	}
+	var files []string
+	current := false
+	for _, info := range infos {
+		name := info.Name()
+		switch {
+		case info.IsDir():
+		case name == CurrentFileName:
+			current = true
+		case strings.HasPrefix(name, rotatedFilePrefix) && strings.HasSuffix(name, fileExtension):
+			files = append(files, filepath.Join(dir, name))
+		}
+	}
+	sort.Strings(files)
+	if current {
+		files = append(files, filepath.Join(dir, CurrentFileName))
+	}
+	return files, nil
+}
+
+// readEntries calls a function on every entry of a file of the audit log, in order.
+func readEntries(path string, f func(line int, entry *Entry) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "could not open audit log file")
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.WithError(err).Error("Could not close audit log file")
+		}
+	}()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 4096), maxEntrySize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		entry := &Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			return errors.Wrapf(err, "line %d of %s is not an audit log entry", line, path)
+		}
+		if err := f(line, entry); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func lastEntry(path string) (*Entry, error) {
+	var last *Entry
+	err := readEntries(path, func(_ int, entry *Entry) error {
+		last = entry
+		return nil
+	})
+	return last, err
+}
@@ -0,0 +1,222 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func setupDir(t *testing.T) string {
+	randPath, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	require.NoError(t, err, "Could not generate random file path")
+	dir := filepath.Join(testutil.TempDir(), fmt.Sprintf("audit-%d", randPath))
+	require.NoError(t, os.RemoveAll(dir))
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir), "Failed to remove directory")
+	})
+	return dir
+}
+
+func testEntry(slot uint64) *Entry {
+	return &Entry{
+		Type:        AttestationType,
+		PublicKey:   fmt.Sprintf("%#x", make([]byte, 48)),
+		Slot:        slot,
+		Epoch:       slot / 32,
+		SigningRoot: fmt.Sprintf("%#x", [32]byte{byte(slot)}),
+		Domain:      fmt.Sprintf("%#x", make([]byte, 32)),
+		Protection:  ProtectionAllowed,
+		Signature:   "0x01",
+	}
+}
+
+func TestLog_RecordAndVerify(t *testing.T) {
+	dir := setupDir(t)
+	l, err := NewLog(dir, 1<<20)
+	require.NoError(t, err)
+	for slot := uint64(1); slot <= 3; slot++ {
+		require.NoError(t, l.Record(testEntry(slot)))
+	}
+	rejected := testEntry(4)
+	rejected.Protection = ProtectionRejected
+	rejected.Signature = ""
+	rejected.Error = "slashable"
+	require.NoError(t, l.Record(rejected))
+	require.NoError(t, l.Close())
+
+	summary, err := Verify(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), summary.Entries)
+	assert.Equal(t, uint64(3), summary.Signed)
+	assert.Equal(t, uint64(1), summary.Rejected)
+	assert.Equal(t, uint64(4), summary.ByType[AttestationType])
+	assert.Equal(t, 1, len(summary.PublicKeys))
+}
+
+func TestLog_ContinuesChainWhenReopened(t *testing.T) {
+	dir := setupDir(t)
+	l, err := NewLog(dir, 1<<20)
+	require.NoError(t, err)
+	require.NoError(t, l.Record(testEntry(1)))
+	require.NoError(t, l.Close())
+	assert.ErrorContains(t, "closed", l.Record(testEntry(2)))
+
+	l, err = NewLog(dir, 1<<20)
+	require.NoError(t, err)
+	require.NoError(t, l.Record(testEntry(2)))
+	require.NoError(t, l.Close())
+
+	summary, err := Verify(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), summary.Entries)
+}
+
+func TestLog_LocksDirectory(t *testing.T) {
+	dir := setupDir(t)
+	l, err := NewLog(dir, 1<<20)
+	require.NoError(t, err)
+	_, err = NewLog(dir, 1<<20)
+	assert.ErrorContains(t, "in use by another process", err)
+
+	// The log can be opened again once closed.
+	require.NoError(t, l.Close())
+	l, err = NewLog(dir, 1<<20)
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+}
+
+func TestLog_Rotates(t *testing.T) {
+	dir := setupDir(t)
+	// Small enough that every entry is written to a new file.
+	l, err := NewLog(dir, 100)
+	require.NoError(t, err)
+	for slot := uint64(1); slot <= 3; slot++ {
+		require.NoError(t, l.Record(testEntry(slot)))
+	}
+	require.NoError(t, l.Close())
+	files, err := logFiles(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(files))
+
+	// The chain continues across the rotated files when the log is opened again.
+	l, err = NewLog(dir, 100)
+	require.NoError(t, err)
+	require.NoError(t, l.Record(testEntry(4)))
+	require.NoError(t, l.Close())
+	summary, err := Verify(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 4, summary.Files)
+	assert.Equal(t, uint64(4), summary.Entries)
+}
+
+func TestVerify_DetectsTampering(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(lines []string) []string
+		err    string
+	}{
+		{
+			name: "modified entry",
+			tamper: func(lines []string) []string {
+				lines[1] = strings.Replace(lines[1], `"slot":2`, `"slot":5`, 1)
+				return lines
+			},
+			err: "line 2 of audit.jsonl does not match its hash",
+		},
+		{
+			name: "removed entry",
+			tamper: func(lines []string) []string {
+				return append(lines[:1], lines[2:]...)
+			},
+			err: "line 2 of audit.jsonl does not follow the previous entry",
+		},
+		{
+			name: "not an entry",
+			tamper: func(lines []string) []string {
+				lines[0] = "garbage"
+				return lines
+			},
+			err: "line 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := setupDir(t)
+			l, err := NewLog(dir, 1<<20)
+			require.NoError(t, err)
+			for slot := uint64(1); slot <= 3; slot++ {
+				require.NoError(t, l.Record(testEntry(slot)))
+			}
+			require.NoError(t, l.Close())
+
+			path := filepath.Join(dir, CurrentFileName)
+			enc, err := ioutil.ReadFile(path)
+			require.NoError(t, err)
+			lines := strings.Split(strings.TrimSpace(string(enc)), "\n")
+			lines = tt.tamper(lines)
+			require.NoError(t, ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0600))
+
+			summary, err := Verify(dir)
+			assert.ErrorContains(t, tt.err, err)
+			require.NotNil(t, summary)
+		})
+	}
+}
+
+func TestVerify_NoLog(t *testing.T) {
+	dir := setupDir(t)
+	l, err := NewLog(dir, 1<<20)
+	require.NoError(t, err)
+	require.NoError(t, l.Close())
+	summary, err := Verify(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), summary.Entries)
+
+	_, err = Verify(filepath.Join(setupDir(t), "missing"))
+	assert.ErrorContains(t, "could not read audit log directory", err)
+}
+
+func TestLog_Signer(t *testing.T) {
+	dir := setupDir(t)
+	l, err := NewLog(dir, 1<<20)
+	require.NoError(t, err)
+	secretKey := bls.RandKey()
+	sign := l.Signer(func(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		if req.SigningRoot == nil {
+			return nil, errors.New("no signing root")
+		}
+		return secretKey.Sign(req.SigningRoot), nil
+	})
+	_, err = sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   secretKey.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: 10}},
+	})
+	require.NoError(t, err)
+	_, err = sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey: secretKey.PublicKey().Marshal(),
+		Object:    &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: 11}},
+	})
+	assert.ErrorContains(t, "no signing root", err)
+	require.NoError(t, l.Close())
+
+	summary, err := Verify(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), summary.ByType[VoluntaryExitType])
+	assert.Equal(t, uint64(1), summary.Signed)
+	assert.Equal(t, uint64(1), summary.Failed)
+}
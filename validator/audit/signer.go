@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Signer records every request of a signing function in the audit log, for the messages
+// signed outside of the duties of the validator client, such as voluntary exits.
+func (l *Log) Signer(
+	sign func(context.Context, *validatorpb.SignRequest) (bls.Signature, error),
+) func(context.Context, *validatorpb.SignRequest) (bls.Signature, error) {
+	return func(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		sig, err := sign(ctx, req)
+		entry := &Entry{
+			PublicKey:   fmt.Sprintf("%#x", req.PublicKey),
+			SigningRoot: fmt.Sprintf("%#x", req.SigningRoot),
+			Domain:      fmt.Sprintf("%#x", req.SignatureDomain),
+			Protection:  ProtectionNotApplicable,
+		}
+		switch obj := req.Object.(type) {
+		case *validatorpb.SignRequest_Exit:
+			entry.Type = VoluntaryExitType
+			entry.Epoch = obj.Exit.Epoch
+		case *validatorpb.SignRequest_Epoch:
+			entry.Type = RandaoRevealType
+			entry.Epoch = obj.Epoch
+		case *validatorpb.SignRequest_Slot:
+			entry.Type = SelectionProofType
+			entry.Slot = obj.Slot
+		case *validatorpb.SignRequest_Block:
+			entry.Type = BlockType
+			entry.Slot = obj.Block.Slot
+		case *validatorpb.SignRequest_AttestationData:
+			entry.Type = AttestationType
+			entry.Slot = obj.AttestationData.Slot
+		case *validatorpb.SignRequest_AggregateAttestationAndProof:
+			entry.Type = AggregateAndProofType
+			entry.Slot = obj.AggregateAttestationAndProof.Aggregate.Data.Slot
+		}
+		if entry.Type != VoluntaryExitType && entry.Type != RandaoRevealType {
+			entry.Epoch = entry.Slot / params.BeaconConfig().SlotsPerEpoch
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		} else {
+			entry.Signature = fmt.Sprintf("%#x", sig.Marshal())
+		}
+		if recordErr := l.Record(entry); recordErr != nil {
+			log.WithError(recordErr).Error("Could not record signed message in the audit log")
+		}
+		return sig, err
+	}
+}
@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// ErrBrokenChain is returned when the hash chain of an audit log does not verify, which
+// means entries were modified, inserted or removed.
+var ErrBrokenChain = errors.New("audit log hash chain is broken")
+
+// Summary of a verified audit log.
+type Summary struct {
+	Files      int
+	Entries    uint64
+	Signed     uint64
+	Rejected   uint64
+	Failed     uint64
+	ByType     map[string]uint64
+	PublicKeys map[string]uint64
+	FirstTime  string
+	LastTime   string
+	LastHash   string
+}
+
+// Verify the hash chain of the audit log of a directory, from its first entry to its last,
+// and summarize its entries. The summary covers the entries verified before any error.
+func Verify(dir string) (*Summary, error) {
+	files, err := logFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	summary := &Summary{
+		Files:      len(files),
+		ByType:     make(map[string]uint64),
+		PublicKeys: make(map[string]uint64),
+		LastHash:   genesisHash,
+	}
+	if len(files) == 0 {
+		return summary, errors.Errorf("no audit log found in %s", dir)
+	}
+	for _, file := range files {
+		err := readEntries(file, func(line int, entry *Entry) error {
+			if entry.PrevHash != summary.LastHash {
+				return errors.Wrapf(ErrBrokenChain, "line %d of %s does not follow the previous entry", line, filepath.Base(file))
+			}
+			hash, err := entry.computeHash()
+			if err != nil {
+				return err
+			}
+			if entry.Hash != hash {
+				return errors.Wrapf(ErrBrokenChain, "line %d of %s does not match its hash", line, filepath.Base(file))
+			}
+			summary.add(entry)
+			return nil
+		})
+		if err != nil {
+			return summary, err
+		}
+	}
+	return summary, nil
+}
+
+func (s *Summary) add(entry *Entry) {
+	s.Entries++
+	switch {
+	case entry.Protection == ProtectionRejected:
+		s.Rejected++
+	case entry.Error != "":
+		s.Failed++
+	default:
+		s.Signed++
+	}
+	s.ByType[entry.Type]++
+	s.PublicKeys[entry.PublicKey]++
+	if s.FirstTime == "" {
+		s.FirstTime = entry.Time
+	}
+	s.LastTime = entry.Time
+	s.LastHash = entry.Hash
+}
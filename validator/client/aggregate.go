@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/audit"
 	"go.opencensus.io/trace"
 )
 
@@ -34,6 +35,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_AGGREGATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -42,6 +44,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 	v.aggregatedSlotCommitteeIDCacheLock.Lock()
 	if v.aggregatedSlotCommitteeIDCache.Contains(k) {
 		v.aggregatedSlotCommitteeIDCacheLock.Unlock()
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_AGGREGATION, validatorpb.DutyOutcome_SKIPPED, errors.New("committee already aggregated"))
 		return
 	}
 	v.aggregatedSlotCommitteeIDCache.Add(k, true)
@@ -53,12 +56,15 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_AGGREGATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
 	// As specified in spec, an aggregator should wait until two thirds of the way through slot
 	// to broadcast the best aggregate to the global aggregate channel.
 	// https://github.com/ethereum/eth2.0-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#broadcast-aggregate
+	// This holds when attesting timely too, as the attestations of the other validators of the
+	// committee are only due one third through the slot.
 	v.waitToSlotTwoThirds(ctx, slot)
 
 	res, err := v.validatorClient.SubmitAggregateSelectionProof(ctx, &ethpb.AggregateSelectionRequest{
@@ -72,12 +78,14 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_AGGREGATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
 	sig, err := v.aggregateAndProofSig(ctx, pubKey, res.AggregateAndProof)
 	if err != nil {
 		log.Errorf("Could not sign aggregate and proof: %v", err)
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_AGGREGATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 	_, err = v.validatorClient.SubmitSignedAggregateSelectionProof(ctx, &ethpb.SignedAggregateSubmitRequest{
@@ -91,6 +99,7 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_AGGREGATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -99,11 +108,13 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot uint64, pu
 		if v.emitAccountMetrics {
 			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_AGGREGATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 	if v.emitAccountMetrics {
 		ValidatorAggSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
+	v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_AGGREGATION, validatorpb.DutyOutcome_SUCCEEDED, nil)
 
 }
 
@@ -115,27 +126,32 @@ func (v *validator) signSlot(ctx context.Context, pubKey [48]byte, slot uint64)
 		return nil, err
 	}
 
+	root, err := helpers.ComputeSigningRoot(slot, domain.SignatureDomain)
+	if err != nil {
+		return nil, err
+	}
 	var sig bls.Signature
 	if featureconfig.Get().EnableAccountsV2 {
-		root, err := helpers.ComputeSigningRoot(slot, domain.SignatureDomain)
-		if err != nil {
-			return nil, err
-		}
 		sig, err = v.keyManagerV2.Sign(ctx, &validatorpb.SignRequest{
 			PublicKey:       pubKey[:],
 			SigningRoot:     root[:],
 			SignatureDomain: domain.SignatureDomain,
 			Object:          &validatorpb.SignRequest_Slot{Slot: slot},
 		})
-		if err != nil {
-			return nil, err
-		}
 	} else {
 		sig, err = v.signObject(ctx, pubKey, slot, domain.SignatureDomain)
 		if err != nil {
-			return nil, errors.Wrap(err, "Failed to sign slot")
+			err = errors.Wrap(err, "Failed to sign slot")
 		}
 	}
+	entry := newAuditEntry(
+		audit.SelectionProofType, pubKey, slot, helpers.SlotToEpoch(slot), root, domain.SignatureDomain, audit.ProtectionNotApplicable,
+	)
+	if err != nil {
+		v.recordSigning(entry, nil, err)
+		return nil, err
+	}
+	v.recordSigning(entry, sig.Marshal(), nil)
 
 	return sig.Marshal(), nil
 }
@@ -144,7 +160,7 @@ func (v *validator) signSlot(ctx context.Context, pubKey [48]byte, slot uint64)
 // such that any attestations from this slot have time to reach the beacon node
 // before creating the aggregated attestation.
 func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot uint64) {
-	_, span := trace.StartSpan(ctx, "validator.waitToSlotTwoThirds")
+	ctx, span := trace.StartSpan(ctx, "validator.waitToSlotTwoThirds")
 	defer span.End()
 
 	oneThird := slotutil.DivideSlotBy(3 /* one third of slot duration */)
@@ -153,7 +169,10 @@ func (v *validator) waitToSlotTwoThirds(ctx context.Context, slot uint64) {
 
 	startTime := slotutil.SlotStartTime(v.genesisTime, slot)
 	finalTime := startTime.Add(delay)
-	time.Sleep(roughtime.Until(finalTime))
+	select {
+	case <-ctx.Done():
+	case <-time.After(roughtime.Until(finalTime)):
+	}
 }
 
 // This returns the signature of validator signing over aggregate and
@@ -163,27 +182,33 @@ func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [48]byte, a
 	if err != nil {
 		return nil, err
 	}
+	root, err := helpers.ComputeSigningRoot(agg, d.SignatureDomain)
+	if err != nil {
+		return nil, err
+	}
 	var sig bls.Signature
 	if featureconfig.Get().EnableAccountsV2 {
-		root, err := helpers.ComputeSigningRoot(agg, d.SignatureDomain)
-		if err != nil {
-			return nil, err
-		}
 		sig, err = v.keyManagerV2.Sign(ctx, &validatorpb.SignRequest{
 			PublicKey:       pubKey[:],
 			SigningRoot:     root[:],
 			SignatureDomain: d.SignatureDomain,
 			Object:          &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: agg},
 		})
-		if err != nil {
-			return nil, err
-		}
 	} else {
 		sig, err = v.signObject(ctx, pubKey, agg, d.SignatureDomain)
 		if err != nil {
-			return nil, errors.Wrap(err, "Failed to sign slot")
+			err = errors.Wrap(err, "Failed to sign slot")
 		}
 	}
+	slot := agg.Aggregate.Data.Slot
+	entry := newAuditEntry(
+		audit.AggregateAndProofType, pubKey, slot, helpers.SlotToEpoch(slot), root, d.SignatureDomain, audit.ProtectionNotApplicable,
+	)
+	if err != nil {
+		v.recordSigning(entry, nil, err)
+		return nil, err
+	}
+	v.recordSigning(entry, sig.Marshal(), nil)
 
 	return sig.Marshal(), nil
 }
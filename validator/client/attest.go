@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/audit"
 	keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v1"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -40,14 +41,25 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 	if len(duty.Committee) == 0 {
 		log.Debug("Empty committee for validator duty, not attesting")
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_SKIPPED, errors.New("empty committee"))
 		return
 	}
 
-	v.waitToSlotOneThird(ctx, slot)
+	if featureconfig.Get().AttestTimely {
+		trigger := "deadline"
+		if _, arrived := v.waitForBlockOrSlotOneThird(ctx, slot); arrived {
+			trigger = "block"
+		}
+		attestationTriggerCount.WithLabelValues(trigger).Inc()
+	} else {
+		v.waitToSlotOneThird(ctx, slot)
+	}
+	attestationDelaySeconds.Observe(roughtime.Since(slotutil.SlotStartTime(v.genesisTime, slot)).Seconds())
 
 	req := &ethpb.AttestationDataRequest{
 		Slot:           slot,
@@ -59,6 +71,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -66,20 +79,38 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		AttestingIndices: []uint64{duty.ValidatorIndex},
 		Data:             data,
 	}
-	if err := v.preAttSignValidations(ctx, indexedAtt, pubKey); err != nil {
+	domain, signingRoot, err := v.getDomainAndSigningRoot(ctx, data)
+	if err != nil {
+		log.WithError(err).Error("Could not get attestation signing root")
+		if v.emitAccountMetrics {
+			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_FAILED, err)
+		return
+	}
+
+	if err := v.preAttSignValidations(ctx, indexedAtt, pubKey, signingRoot); err != nil {
 		log.WithFields(logrus.Fields{
 			"sourceEpoch": indexedAtt.Data.Source.Epoch,
 			"targetEpoch": indexedAtt.Data.Target.Epoch,
 		}).WithError(err).Error("Failed attestation safety check")
+		v.recordSigning(newAuditEntry(
+			audit.AttestationType, pubKey, data.Slot, data.Target.Epoch, signingRoot, domain.SignatureDomain, audit.ProtectionRejected,
+		), nil, err)
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_REJECTED_BY_PROTECTION, err)
 		return
 	}
 
-	sig, err := v.signAtt(ctx, pubKey, data)
+	sig, err := v.signAtt(ctx, pubKey, data, domain, signingRoot)
+	v.recordSigning(newAuditEntry(
+		audit.AttestationType, pubKey, data.Slot, data.Target.Epoch, signingRoot, domain.SignatureDomain, audit.ProtectionAllowed,
+	), sig, err)
 	if err != nil {
 		log.WithError(err).Error("Could not sign attestation")
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -93,10 +124,12 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		}
 	}
 	if !found {
-		log.Errorf("Validator ID %d not found in committee of %v", duty.ValidatorIndex, duty.Committee)
+		err := fmt.Errorf("validator ID %d not found in committee of %v", duty.ValidatorIndex, duty.Committee)
+		log.Error(err)
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -114,6 +147,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 			"sourceEpoch": indexedAtt.Data.Source.Epoch,
 			"targetEpoch": indexedAtt.Data.Target.Epoch,
 		}).WithError(err).Error("Failed post attestation signing updates")
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -123,6 +157,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -131,6 +166,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -147,6 +183,7 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot uint64, pubKey [
 	if v.emitAccountMetrics {
 		ValidatorAttestSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
+	v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_SUCCEEDED, nil)
 }
 
 // Given the validator public key, this gets the validator assignment.
@@ -164,18 +201,33 @@ func (v *validator) duty(pubKey [48]byte) (*ethpb.DutiesResponse_Duty, error) {
 	return nil, fmt.Errorf("pubkey %#x not in duties", bytesutil.Trunc(pubKey[:]))
 }
 
-// Given validator's public key, this returns the signature of an attestation data.
-func (v *validator) signAtt(ctx context.Context, pubKey [48]byte, data *ethpb.AttestationData) ([]byte, error) {
+// getDomainAndSigningRoot returns the attester domain and the signing root of an attestation data.
+func (v *validator) getDomainAndSigningRoot(ctx context.Context, data *ethpb.AttestationData) (*ethpb.DomainResponse, [32]byte, error) {
 	domain, err := v.domainData(ctx, data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester[:])
 	if err != nil {
-		return nil, err
+		return nil, [32]byte{}, err
+	}
+	if domain == nil {
+		return nil, [32]byte{}, errors.New(domainDataErr)
 	}
 
 	root, err := helpers.ComputeSigningRoot(data, domain.SignatureDomain)
 	if err != nil {
-		return nil, err
+		return nil, [32]byte{}, err
 	}
+	return domain, root, nil
+}
 
+// Given validator's public key, this returns the signature of an attestation data
+// with its previously computed domain and signing root.
+func (v *validator) signAtt(
+	ctx context.Context,
+	pubKey [48]byte,
+	data *ethpb.AttestationData,
+	domain *ethpb.DomainResponse,
+	root [32]byte,
+) ([]byte, error) {
+	var err error
 	var sig bls.Signature
 	if featureconfig.Get().EnableAccountsV2 {
 		sig, err = v.keyManagerV2.Sign(ctx, &validatorpb.SignRequest{
@@ -220,11 +272,14 @@ func (v *validator) saveAttesterIndexToData(data *ethpb.AttestationData, index u
 // waitToSlotOneThird waits until one third through the current slot period
 // such that head block for beacon node can get updated.
 func (v *validator) waitToSlotOneThird(ctx context.Context, slot uint64) {
-	_, span := trace.StartSpan(ctx, "validator.waitToSlotOneThird")
+	ctx, span := trace.StartSpan(ctx, "validator.waitToSlotOneThird")
 	defer span.End()
 
 	delay := slotutil.DivideSlotBy(3 /* a third of the slot duration */)
 	startTime := slotutil.SlotStartTime(v.genesisTime, slot)
 	finalTime := startTime.Add(delay)
-	time.Sleep(roughtime.Until(finalTime))
+	select {
+	case <-ctx.Done():
+	case <-time.After(roughtime.Until(finalTime)):
+	}
 }
@@ -176,10 +176,12 @@ func TestAttestToBlockHead_BlocksDoubleAtt(t *testing.T) {
 	targetRoot := bytesutil.ToBytes32([]byte("B"))
 	sourceRoot := bytesutil.ToBytes32([]byte("C"))
 
+	beaconBlockRoot2 := bytesutil.ToBytes32([]byte("D"))
+
 	m.validatorClient.EXPECT().GetAttestationData(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
-	).Times(2).Return(&ethpb.AttestationData{
+	).Return(&ethpb.AttestationData{
 		BeaconBlockRoot: beaconBlockRoot[:],
 		Target:          &ethpb.Checkpoint{Root: targetRoot[:], Epoch: 4},
 		Source:          &ethpb.Checkpoint{Root: sourceRoot[:], Epoch: 3},
@@ -187,7 +189,7 @@ func TestAttestToBlockHead_BlocksDoubleAtt(t *testing.T) {
 	m.validatorClient.EXPECT().DomainData(
 		gomock.Any(), // ctx
 		gomock.Any(), // epoch
-	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	).Times(2).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
 
 	m.validatorClient.EXPECT().ProposeAttestation(
 		gomock.Any(), // ctx
@@ -195,6 +197,17 @@ func TestAttestToBlockHead_BlocksDoubleAtt(t *testing.T) {
 	).Return(&ethpb.AttestResponse{AttestationDataRoot: make([]byte, 32)}, nil /* error */)
 
 	validator.SubmitAttestation(context.Background(), 30, validatorPubKey)
+	require.LogsDoNotContain(t, hook, failedPreAttSignLocalErr)
+
+	m.validatorClient.EXPECT().GetAttestationData(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+	).Return(&ethpb.AttestationData{
+		BeaconBlockRoot: beaconBlockRoot2[:],
+		Target:          &ethpb.Checkpoint{Root: targetRoot[:], Epoch: 4},
+		Source:          &ethpb.Checkpoint{Root: sourceRoot[:], Epoch: 3},
+	}, nil)
+
 	validator.SubmitAttestation(context.Background(), 30, validatorPubKey)
 	require.LogsContain(t, hook, failedPreAttSignLocalErr)
 }
@@ -225,7 +238,7 @@ func TestAttestToBlockHead_BlocksSurroundAtt(t *testing.T) {
 	m.validatorClient.EXPECT().GetAttestationData(
 		gomock.Any(), // ctx
 		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
-	).Times(2).Return(&ethpb.AttestationData{
+	).Return(&ethpb.AttestationData{
 		BeaconBlockRoot: beaconBlockRoot[:],
 		Target:          &ethpb.Checkpoint{Root: targetRoot[:], Epoch: 2},
 		Source:          &ethpb.Checkpoint{Root: sourceRoot[:], Epoch: 1},
@@ -234,7 +247,7 @@ func TestAttestToBlockHead_BlocksSurroundAtt(t *testing.T) {
 	m.validatorClient.EXPECT().DomainData(
 		gomock.Any(), // ctx
 		gomock.Any(), // epoch
-	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	).Times(2).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
 
 	m.validatorClient.EXPECT().ProposeAttestation(
 		gomock.Any(), // ctx
@@ -242,6 +255,17 @@ func TestAttestToBlockHead_BlocksSurroundAtt(t *testing.T) {
 	).Return(&ethpb.AttestResponse{}, nil /* error */)
 
 	validator.SubmitAttestation(context.Background(), 30, validatorPubKey)
+	require.LogsDoNotContain(t, hook, failedPreAttSignLocalErr)
+
+	m.validatorClient.EXPECT().GetAttestationData(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.AttestationDataRequest{}),
+	).Return(&ethpb.AttestationData{
+		BeaconBlockRoot: beaconBlockRoot[:],
+		Target:          &ethpb.Checkpoint{Root: targetRoot[:], Epoch: 3},
+		Source:          &ethpb.Checkpoint{Root: sourceRoot[:], Epoch: 0},
+	}, nil)
+
 	validator.SubmitAttestation(context.Background(), 30, validatorPubKey)
 	require.LogsContain(t, hook, failedPreAttSignLocalErr)
 }
@@ -281,7 +305,7 @@ func TestAttestToBlockHead_BlocksSurroundedAtt(t *testing.T) {
 	m.validatorClient.EXPECT().DomainData(
 		gomock.Any(), // ctx
 		gomock.Any(), // epoch
-	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	).Times(2).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
 
 	m.validatorClient.EXPECT().ProposeAttestation(
 		gomock.Any(), // ctx
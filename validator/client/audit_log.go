@@ -0,0 +1,44 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/validator/audit"
+)
+
+// newAuditEntry returns the audit log entry of a message the validator client signs.
+func newAuditEntry(
+	typ string,
+	pubKey [48]byte,
+	slot uint64,
+	epoch uint64,
+	signingRoot [32]byte,
+	domain []byte,
+	protection string,
+) *audit.Entry {
+	return &audit.Entry{
+		Type:        typ,
+		PublicKey:   fmt.Sprintf("%#x", pubKey),
+		Slot:        slot,
+		Epoch:       epoch,
+		SigningRoot: fmt.Sprintf("%#x", signingRoot),
+		Domain:      fmt.Sprintf("%#x", domain),
+		Protection:  protection,
+	}
+}
+
+// recordSigning records a message the validator client signed, or failed or refused to
+// sign, in its audit log if it has one.
+func (v *validator) recordSigning(entry *audit.Entry, sig []byte, err error) {
+	if v.auditLog == nil {
+		return
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Signature = fmt.Sprintf("%#x", sig)
+	}
+	if err := v.auditLog.Record(entry); err != nil {
+		log.WithError(err).WithField("type", entry.Type).Error("Could not record signed message in the audit log")
+	}
+}
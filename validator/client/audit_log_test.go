@@ -0,0 +1,43 @@
+package client
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/audit"
+)
+
+func TestRecordSigning(t *testing.T) {
+	dir := filepath.Join(testutil.TempDir(), "validator-client-audit")
+	require.NoError(t, os.RemoveAll(dir))
+	auditLog, err := audit.NewLog(dir, 1<<20)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, auditLog.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	v := &validator{auditLog: auditLog}
+
+	v.recordSigning(newAuditEntry(
+		audit.BlockType, validatorPubKey, 10, 0, [32]byte{1}, make([]byte, 32), audit.ProtectionAllowed,
+	), []byte{2}, nil)
+	v.recordSigning(newAuditEntry(
+		audit.BlockType, validatorPubKey, 10, 0, [32]byte{3}, make([]byte, 32), audit.ProtectionRejected,
+	), nil, errors.New("slashable proposal"))
+
+	summary, err := audit.Verify(dir)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), summary.Signed)
+	assert.Equal(t, uint64(1), summary.Rejected)
+	assert.Equal(t, uint64(2), summary.ByType[audit.BlockType])
+
+	// Validator clients without an audit log do not record anything.
+	(&validator{}).recordSigning(newAuditEntry(
+		audit.BlockType, validatorPubKey, 11, 0, [32]byte{4}, make([]byte, 32), audit.ProtectionAllowed,
+	), []byte{5}, nil)
+}
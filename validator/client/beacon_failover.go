@@ -0,0 +1,735 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxHeadSlotLag is how many slots a beacon node head may be behind the highest head
+	// of the healthy beacon nodes, before the next beacon node by order of preference is used.
+	maxHeadSlotLag = 2
+	// minHealthyPeers is the number of peers a beacon node needs to be considered healthy.
+	minHealthyPeers = 1
+	// healthCheckTimeout bounds the health check of a single beacon node.
+	healthCheckTimeout = 5 * time.Second
+)
+
+var (
+	beaconNodeHealthyGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_healthy",
+			Help:      "Whether a beacon node endpoint passed its last health check: 0 unhealthy, 1 healthy",
+		},
+		[]string{
+			"endpoint",
+		},
+	)
+	beaconNodeFailoverCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_node_failovers_total",
+			Help:      "Number of times the validator client switched to another beacon node",
+		},
+	)
+)
+
+// nodeHealth is the outcome of the health check of a beacon node.
+type nodeHealth struct {
+	reachable bool
+	syncing   bool
+	headSlot  uint64
+	peers     int
+}
+
+// healthy returns whether a beacon node can serve validator duties.
+func (h nodeHealth) healthy() bool {
+	return h.reachable && !h.syncing && h.peers >= minHealthyPeers
+}
+
+// beaconNode is a beacon node endpoint of the validator client, along with the
+// outcome of its last health check.
+type beaconNode struct {
+	endpoint        string
+	validatorClient ethpb.BeaconNodeValidatorClient
+	beaconClient    ethpb.BeaconChainClient
+	nodeClient      ethpb.NodeClient
+	health          nodeHealth
+}
+
+func newBeaconNode(endpoint string, conn *grpc.ClientConn) *beaconNode {
+	return &beaconNode{
+		endpoint:        endpoint,
+		validatorClient: ethpb.NewBeaconNodeValidatorClient(conn),
+		beaconClient:    ethpb.NewBeaconChainClient(conn),
+		nodeClient:      ethpb.NewNodeClient(conn),
+	}
+}
+
+// dialBeaconNodes connects to a comma-separated list of beacon node endpoints.
+func dialBeaconNodes(ctx context.Context, endpoints string, dialOpts []grpc.DialOption) ([]*grpc.ClientConn, []*beaconNode, error) {
+	var conns []*grpc.ClientConn
+	var nodes []*beaconNode
+	for _, endpoint := range splitEndpoints(endpoints) {
+		conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
+		if err != nil {
+			closeConns(conns)
+			return nil, nil, errors.Wrapf(err, "could not dial endpoint %s", endpoint)
+		}
+		conns = append(conns, conn)
+		nodes = append(nodes, newBeaconNode(endpoint, conn))
+	}
+	return conns, nodes, nil
+}
+
+func closeConns(conns []*grpc.ClientConn) {
+	for _, conn := range conns {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Error("Could not close connection to beacon node")
+		}
+	}
+}
+
+// BeaconNodeConns are the connections of a command of the validator client to beacon nodes,
+// with clients which route calls to the healthiest beacon node and fail over to the next ones.
+type BeaconNodeConns struct {
+	ValidatorClient ethpb.BeaconNodeValidatorClient
+	BeaconClient    ethpb.BeaconChainClient
+	NodeClient      ethpb.NodeClient
+	conns           []*grpc.ClientConn
+}
+
+// DialBeaconNodes connects to a comma-separated list of beacon node endpoints by order of
+// preference, and checks their health to route calls to the healthiest one.
+func DialBeaconNodes(ctx context.Context, endpoints string, dialOpts ...grpc.DialOption) (*BeaconNodeConns, error) {
+	conns, nodes, err := dialBeaconNodes(ctx, endpoints, dialOpts)
+	if err != nil {
+		return nil, err
+	}
+	f, err := newFailoverClient(nodes, false /* broadcast */)
+	if err != nil {
+		closeConns(conns)
+		return nil, err
+	}
+	f.checkHealth(ctx)
+	return &BeaconNodeConns{
+		ValidatorClient: f,
+		BeaconClient:    f.beaconChainClient(),
+		NodeClient:      f.nodeClient(),
+		conns:           conns,
+	}, nil
+}
+
+// Close the connections to the beacon nodes.
+func (c *BeaconNodeConns) Close() {
+	closeConns(c.conns)
+}
+
+// checkHealth queries the sync status, head slot and peer count of the beacon node.
+func (n *beaconNode) checkHealth(ctx context.Context) nodeHealth {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+	syncStatus, err := n.nodeClient.GetSyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not get beacon node sync status")
+		return nodeHealth{}
+	}
+	head, err := n.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not get beacon node chain head")
+		return nodeHealth{}
+	}
+	peers, err := n.nodeClient.ListPeers(ctx, &ptypes.Empty{})
+	if err != nil {
+		log.WithError(err).WithField("endpoint", n.endpoint).Debug("Could not list beacon node peers")
+		return nodeHealth{}
+	}
+	return nodeHealth{
+		reachable: true,
+		syncing:   syncStatus.Syncing,
+		headSlot:  head.HeadSlot,
+		peers:     len(peers.Peers),
+	}
+}
+
+// failoverClient is a BeaconNodeValidatorClient which routes every call to the healthiest
+// of an ordered list of beacon nodes, and fails over to the next healthy beacon node when
+// a beacon node is unavailable. Its beacon chain and node clients are routed alike, and
+// the streams of all three are opened again on the next beacon node. Failing over in the middle of an epoch cannot double sign,
+// as duties fetched again from another beacon node are checked against the local slashing
+// protection, which records every attestation and block before it is signed. Signed objects
+// are optionally broadcast to every healthy beacon node.
+type failoverClient struct {
+	nodes     []*beaconNode
+	broadcast bool
+	lock      sync.RWMutex
+	active    int
+}
+
+func newFailoverClient(nodes []*beaconNode, broadcast bool) (*failoverClient, error) {
+	if len(nodes) == 0 {
+		return nil, errors.New("no beacon node endpoint provided")
+	}
+	return &failoverClient{
+		nodes:     nodes,
+		broadcast: broadcast,
+	}, nil
+}
+
+// activeNode returns the beacon node calls are currently routed to.
+func (f *failoverClient) activeNode() *beaconNode {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.nodes[f.active]
+}
+
+// run checks the health of every beacon node once per slot, until the context is canceled.
+func (f *failoverClient) run(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			f.checkHealth(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkHealth checks the health of every beacon node concurrently, and routes the next
+// calls to the healthiest one.
+func (f *failoverClient) checkHealth(ctx context.Context) {
+	results := make([]nodeHealth, len(f.nodes))
+	var wg sync.WaitGroup
+	for i, n := range f.nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			results[i] = n.checkHealth(ctx)
+		}(i, n)
+	}
+	wg.Wait()
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for i, n := range f.nodes {
+		n.health = results[i]
+		healthy := float64(0)
+		if n.health.healthy() {
+			healthy = 1
+		}
+		beaconNodeHealthyGaugeVec.WithLabelValues(n.endpoint).Set(healthy)
+	}
+	f.selectActive()
+}
+
+// selectActive routes the next calls to the first healthy beacon node by order of
+// preference whose head is not too far behind the other healthy beacon nodes. The
+// active beacon node is kept if none is healthy. The lock must be held.
+func (f *failoverClient) selectActive() {
+	highestSlot := uint64(0)
+	for _, n := range f.nodes {
+		if n.health.healthy() && n.health.headSlot > highestSlot {
+			highestSlot = n.health.headSlot
+		}
+	}
+	for i, n := range f.nodes {
+		if !n.health.healthy() || n.health.headSlot+maxHeadSlotLag < highestSlot {
+			continue
+		}
+		f.setActive(i)
+		return
+	}
+}
+
+// setActive routes the next calls to the i-th beacon node. The lock must be held.
+func (f *failoverClient) setActive(i int) {
+	if i == f.active {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"previous": f.nodes[f.active].endpoint,
+		"endpoint": f.nodes[i].endpoint,
+		"headSlot": f.nodes[i].health.headSlot,
+	}).Warn("Switching to another beacon node")
+	beaconNodeFailoverCount.Inc()
+	f.active = i
+}
+
+// markUnavailable records that a call to a beacon node failed because it is unavailable.
+func (f *failoverClient) markUnavailable(i int, err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	log.WithError(err).WithField("endpoint", f.nodes[i].endpoint).Warn("Beacon node is unavailable")
+	f.nodes[i].health = nodeHealth{}
+	beaconNodeHealthyGaugeVec.WithLabelValues(f.nodes[i].endpoint).Set(0)
+	if i == f.active {
+		f.selectActive()
+	}
+}
+
+// failOverFrom routes the next calls to another beacon node than the i-th one, which is
+// unavailable, preferring the healthy beacon nodes by order of preference. Streams need
+// it as they can be opened on a beacon node which is unavailable, and only fail to
+// receive from it.
+func (f *failoverClient) failOverFrom(i int) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.active != i || len(f.nodes) == 1 {
+		return
+	}
+	next := (i + 1) % len(f.nodes)
+	for j, n := range f.nodes {
+		if j != i && n.health.healthy() {
+			next = j
+			break
+		}
+	}
+	f.setActive(next)
+}
+
+// candidates returns the indices of the beacon nodes to try a call against, the active
+// beacon node first, then the healthy and the unhealthy ones by order of preference.
+func (f *failoverClient) candidates() []int {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	indices := make([]int, 0, len(f.nodes))
+	indices = append(indices, f.active)
+	for i, n := range f.nodes {
+		if i != f.active && n.health.healthy() {
+			indices = append(indices, i)
+		}
+	}
+	for i, n := range f.nodes {
+		if i != f.active && !n.health.healthy() {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// call runs fn against the active beacon node, and against the next beacon nodes for as
+// long as they are unavailable. Any other error is returned as is, as the beacon node may
+// have processed the call.
+func (f *failoverClient) call(fn func(n *beaconNode) (interface{}, error)) (interface{}, error) {
+	var res interface{}
+	var err error
+	candidates := f.candidates()
+	for _, i := range candidates {
+		res, err = fn(f.nodes[i])
+		if status.Code(err) != codes.Unavailable {
+			if i != candidates[0] {
+				f.failedOver(i)
+			}
+			return res, err
+		}
+		f.markUnavailable(i, err)
+	}
+	return res, err
+}
+
+// failedOver routes the next calls to a beacon node which answered after the active one was
+// unavailable, unless the active beacon node was found healthy again meanwhile.
+func (f *failoverClient) failedOver(i int) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if !f.nodes[f.active].health.healthy() {
+		f.setActive(i)
+	}
+}
+
+// submit runs fn against the active beacon node like call does, or against the active and
+// every healthy beacon node concurrently if broadcasting is enabled. When broadcasting, the
+// response of the first beacon node by preference which succeeded is returned.
+func (f *failoverClient) submit(fn func(n *beaconNode) (interface{}, error)) (interface{}, error) {
+	if !f.broadcast {
+		return f.call(fn)
+	}
+	f.lock.RLock()
+	targets := make([]int, 0, len(f.nodes))
+	for i, n := range f.nodes {
+		if i == f.active || n.health.healthy() {
+			targets = append(targets, i)
+		}
+	}
+	f.lock.RUnlock()
+
+	responses := make([]interface{}, len(f.nodes))
+	errs := make([]error, len(f.nodes))
+	var wg sync.WaitGroup
+	for _, i := range targets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i], errs[i] = fn(f.nodes[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for _, i := range targets {
+		if errs[i] == nil {
+			return responses[i], nil
+		}
+	}
+	for _, i := range targets {
+		if status.Code(errs[i]) == codes.Unavailable {
+			f.markUnavailable(i, errs[i])
+		}
+	}
+	// Every beacon node broadcast to failed, try the remaining ones one by one.
+	return f.call(fn)
+}
+
+// failoverStream is a server stream of the active beacon node, which is opened again on the
+// next beacon node when the beacon node it is opened on is unavailable, and on the active
+// beacon node when calls are routed to another one. Messages may be received twice or be
+// missed when the stream is opened again, which the streams of the validator client allow
+// for, as they send the latest state of the chain rather than its changes.
+type failoverStream struct {
+	f      *failoverClient
+	ctx    context.Context
+	open   func(ctx context.Context, n *beaconNode) (grpc.ClientStream, error)
+	node   *beaconNode
+	stream grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+// openStream opens a server stream on the active beacon node, or on the next ones for as
+// long as they are unavailable.
+func (f *failoverClient) openStream(
+	ctx context.Context,
+	open func(ctx context.Context, n *beaconNode) (grpc.ClientStream, error),
+) (*failoverStream, error) {
+	s := &failoverStream{
+		f:    f,
+		ctx:  ctx,
+		open: open,
+	}
+	if err := s.reopen(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// reopen opens the stream again on the active beacon node, and then closes it on the
+// beacon node it was opened on.
+func (s *failoverStream) reopen() error {
+	ctx, cancel := context.WithCancel(s.ctx)
+	var node *beaconNode
+	res, err := s.f.call(func(n *beaconNode) (interface{}, error) {
+		node = n
+		return s.open(ctx, n)
+	})
+	if err != nil {
+		cancel()
+		return err
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.node, s.stream, s.cancel = node, res.(grpc.ClientStream), cancel
+	return nil
+}
+
+// RecvMsg receives a message from the stream, opening it again on the next beacon node if
+// the beacon node it is opened on is unavailable. Every beacon node is tried once before
+// the error is returned.
+func (s *failoverStream) RecvMsg(m interface{}) error {
+	for attempts := 0; ; attempts++ {
+		err := s.stream.RecvMsg(m)
+		if err == nil {
+			if s.f.activeNode() != s.node {
+				log.WithField("endpoint", s.node.endpoint).Debug("Opening stream on the active beacon node")
+				if err := s.reopen(); err != nil {
+					log.WithError(err).Debug("Could not open stream on the active beacon node")
+				}
+			}
+			return nil
+		}
+		if status.Code(err) != codes.Unavailable || s.ctx.Err() != nil || attempts >= len(s.f.nodes) {
+			return err
+		}
+		for i, n := range s.f.nodes {
+			if n == s.node {
+				s.f.markUnavailable(i, err)
+				s.f.failOverFrom(i)
+			}
+		}
+		if err := s.reopen(); err != nil {
+			return err
+		}
+	}
+}
+
+// Header of the stream on the beacon node it is opened on.
+func (s *failoverStream) Header() (metadata.MD, error) {
+	return s.stream.Header()
+}
+
+// Trailer of the stream on the beacon node it is opened on.
+func (s *failoverStream) Trailer() metadata.MD {
+	return s.stream.Trailer()
+}
+
+// CloseSend closes the sending side of the stream.
+func (s *failoverStream) CloseSend() error {
+	return s.stream.CloseSend()
+}
+
+// Context of the stream.
+func (s *failoverStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg is not supported, as messages sent could not be sent again on the next beacon node.
+func (s *failoverStream) SendMsg(m interface{}) error {
+	return errors.New("cannot send messages on a server stream")
+}
+
+type failoverStreamDutiesClient struct{ *failoverStream }
+
+func (s failoverStreamDutiesClient) Recv() (*ethpb.DutiesResponse, error) {
+	m := &ethpb.DutiesResponse{}
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type failoverWaitForChainStartClient struct{ *failoverStream }
+
+func (s failoverWaitForChainStartClient) Recv() (*ethpb.ChainStartResponse, error) {
+	m := &ethpb.ChainStartResponse{}
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type failoverWaitForSyncedClient struct{ *failoverStream }
+
+func (s failoverWaitForSyncedClient) Recv() (*ethpb.SyncedResponse, error) {
+	m := &ethpb.SyncedResponse{}
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type failoverWaitForActivationClient struct{ *failoverStream }
+
+func (s failoverWaitForActivationClient) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	m := &ethpb.ValidatorActivationResponse{}
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetDuties routes the call to the active beacon node.
+func (f *failoverClient) GetDuties(ctx context.Context, in *ethpb.DutiesRequest, opts ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	res, err := f.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.GetDuties(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.DutiesResponse), nil
+}
+
+// StreamDuties opens the stream on the active beacon node, and again on the next one if it
+// becomes unavailable.
+func (f *failoverClient) StreamDuties(ctx context.Context, in *ethpb.DutiesRequest, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	s, err := f.openStream(ctx, func(ctx context.Context, n *beaconNode) (grpc.ClientStream, error) {
+		return n.validatorClient.StreamDuties(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return failoverStreamDutiesClient{s}, nil
+}
+
+// DomainData routes the call to the active beacon node.
+func (f *failoverClient) DomainData(ctx context.Context, in *ethpb.DomainRequest, opts ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	res, err := f.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.DomainData(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.DomainResponse), nil
+}
+
+// WaitForChainStart opens the stream on the active beacon node, and again on the next one if it
+// becomes unavailable.
+func (f *failoverClient) WaitForChainStart(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	s, err := f.openStream(ctx, func(ctx context.Context, n *beaconNode) (grpc.ClientStream, error) {
+		return n.validatorClient.WaitForChainStart(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return failoverWaitForChainStartClient{s}, nil
+}
+
+// WaitForSynced opens the stream on the active beacon node, and again on the next one if it
+// becomes unavailable.
+func (f *failoverClient) WaitForSynced(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForSyncedClient, error) {
+	s, err := f.openStream(ctx, func(ctx context.Context, n *beaconNode) (grpc.ClientStream, error) {
+		return n.validatorClient.WaitForSynced(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return failoverWaitForSyncedClient{s}, nil
+}
+
+// WaitForActivation opens the stream on the active beacon node, and again on the next one if it
+// becomes unavailable.
+func (f *failoverClient) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	s, err := f.openStream(ctx, func(ctx context.Context, n *beaconNode) (grpc.ClientStream, error) {
+		return n.validatorClient.WaitForActivation(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return failoverWaitForActivationClient{s}, nil
+}
+
+// ValidatorIndex routes the call to the active beacon node.
+func (f *failoverClient) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, opts ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	res, err := f.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ValidatorIndex(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorIndexResponse), nil
+}
+
+// ValidatorStatus routes the call to the active beacon node.
+func (f *failoverClient) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest, opts ...grpc.CallOption) (*ethpb.ValidatorStatusResponse, error) {
+	res, err := f.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ValidatorStatus(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorStatusResponse), nil
+}
+
+// MultipleValidatorStatus routes the call to the active beacon node.
+func (f *failoverClient) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest, opts ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error) {
+	res, err := f.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.MultipleValidatorStatus(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.MultipleValidatorStatusResponse), nil
+}
+
+// GetBlock routes the call to the active beacon node.
+func (f *failoverClient) GetBlock(ctx context.Context, in *ethpb.BlockRequest, opts ...grpc.CallOption) (*ethpb.BeaconBlock, error) {
+	res, err := f.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.GetBlock(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.BeaconBlock), nil
+}
+
+// ProposeBlock submits the block to the active beacon node, or broadcasts it.
+func (f *failoverClient) ProposeBlock(ctx context.Context, in *ethpb.SignedBeaconBlock, opts ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	res, err := f.submit(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ProposeBlock(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ProposeResponse), nil
+}
+
+// GetAttestationData routes the call to the active beacon node.
+func (f *failoverClient) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, opts ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	res, err := f.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.GetAttestationData(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AttestationData), nil
+}
+
+// ProposeAttestation submits the attestation to the active beacon node, or broadcasts it.
+func (f *failoverClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, opts ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	res, err := f.submit(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ProposeAttestation(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AttestResponse), nil
+}
+
+// SubmitAggregateSelectionProof routes the call to the active beacon node.
+func (f *failoverClient) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, opts ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error) {
+	res, err := f.call(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.SubmitAggregateSelectionProof(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AggregateSelectionResponse), nil
+}
+
+// SubmitSignedAggregateSelectionProof submits the aggregate to the active beacon node, or
+// broadcasts it.
+func (f *failoverClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, opts ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error) {
+	res, err := f.submit(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.SubmitSignedAggregateSelectionProof(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.SignedAggregateSubmitResponse), nil
+}
+
+// ProposeExit submits the exit to the active beacon node, or broadcasts it.
+func (f *failoverClient) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, opts ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	res, err := f.submit(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ProposeExit(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ProposeExitResponse), nil
+}
+
+// SubscribeCommitteeSubnets subscribes the active beacon node, or every healthy beacon node
+// when broadcasting, so that any of them can later receive the attestations to aggregate.
+func (f *failoverClient) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption) (*ptypes.Empty, error) {
+	res, err := f.submit(func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.SubscribeCommitteeSubnets(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ptypes.Empty), nil
+}
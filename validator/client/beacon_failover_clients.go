@@ -0,0 +1,408 @@
+package client
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// failoverBeaconChainClient is a BeaconChainClient which routes every call to the active
+// beacon node of a failover client, and fails over to the next beacon node like the calls
+// of the validator client do.
+type failoverBeaconChainClient struct {
+	f *failoverClient
+}
+
+// failoverNodeClient is a NodeClient which routes every call to the active beacon node of a
+// failover client, and fails over to the next beacon node like the calls of the validator
+// client do.
+type failoverNodeClient struct {
+	f *failoverClient
+}
+
+// beaconChainClient returns the beacon chain client of the beacon nodes.
+func (f *failoverClient) beaconChainClient() ethpb.BeaconChainClient {
+	return &failoverBeaconChainClient{f: f}
+}
+
+// nodeClient returns the node client of the beacon nodes.
+func (f *failoverClient) nodeClient() ethpb.NodeClient {
+	return &failoverNodeClient{f: f}
+}
+
+// AttestationPool routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) AttestationPool(ctx context.Context, in *ethpb.AttestationPoolRequest, opts ...grpc.CallOption) (*ethpb.AttestationPoolResponse, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.AttestationPool(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AttestationPoolResponse), nil
+}
+
+// GetBeaconConfig routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) GetBeaconConfig(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.BeaconConfig, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetBeaconConfig(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.BeaconConfig), nil
+}
+
+// GetChainHead routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) GetChainHead(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetChainHead(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ChainHead), nil
+}
+
+// GetIndividualVotes routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) GetIndividualVotes(ctx context.Context, in *ethpb.IndividualVotesRequest, opts ...grpc.CallOption) (*ethpb.IndividualVotesRespond, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetIndividualVotes(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.IndividualVotesRespond), nil
+}
+
+// GetValidator routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) GetValidator(ctx context.Context, in *ethpb.GetValidatorRequest, opts ...grpc.CallOption) (*ethpb.Validator, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetValidator(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Validator), nil
+}
+
+// GetValidatorActiveSetChanges routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) GetValidatorActiveSetChanges(ctx context.Context, in *ethpb.GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ethpb.ActiveSetChanges, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetValidatorActiveSetChanges(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ActiveSetChanges), nil
+}
+
+// GetValidatorParticipation routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) GetValidatorParticipation(ctx context.Context, in *ethpb.GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ethpb.ValidatorParticipationResponse, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetValidatorParticipation(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorParticipationResponse), nil
+}
+
+// GetValidatorPerformance routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetValidatorPerformance(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorPerformanceResponse), nil
+}
+
+// GetValidatorQueue routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) GetValidatorQueue(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.ValidatorQueue, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.GetValidatorQueue(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorQueue), nil
+}
+
+// ListAttestations routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) ListAttestations(ctx context.Context, in *ethpb.ListAttestationsRequest, opts ...grpc.CallOption) (*ethpb.ListAttestationsResponse, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListAttestations(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ListAttestationsResponse), nil
+}
+
+// ListBeaconCommittees routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) ListBeaconCommittees(ctx context.Context, in *ethpb.ListCommitteesRequest, opts ...grpc.CallOption) (*ethpb.BeaconCommittees, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListBeaconCommittees(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.BeaconCommittees), nil
+}
+
+// ListBlocks routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) ListBlocks(ctx context.Context, in *ethpb.ListBlocksRequest, opts ...grpc.CallOption) (*ethpb.ListBlocksResponse, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListBlocks(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ListBlocksResponse), nil
+}
+
+// ListIndexedAttestations routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) ListIndexedAttestations(ctx context.Context, in *ethpb.ListIndexedAttestationsRequest, opts ...grpc.CallOption) (*ethpb.ListIndexedAttestationsResponse, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListIndexedAttestations(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ListIndexedAttestationsResponse), nil
+}
+
+// ListValidatorAssignments routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) ListValidatorAssignments(ctx context.Context, in *ethpb.ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ethpb.ValidatorAssignments, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListValidatorAssignments(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorAssignments), nil
+}
+
+// ListValidatorBalances routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) ListValidatorBalances(ctx context.Context, in *ethpb.ListValidatorBalancesRequest, opts ...grpc.CallOption) (*ethpb.ValidatorBalances, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListValidatorBalances(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ValidatorBalances), nil
+}
+
+// ListValidators routes the call to the active beacon node.
+func (c *failoverBeaconChainClient) ListValidators(ctx context.Context, in *ethpb.ListValidatorsRequest, opts ...grpc.CallOption) (*ethpb.Validators, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.ListValidators(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Validators), nil
+}
+
+// SubmitAttesterSlashing submits the slashing to the active beacon node, or broadcasts it.
+func (c *failoverBeaconChainClient) SubmitAttesterSlashing(ctx context.Context, in *ethpb.AttesterSlashing, opts ...grpc.CallOption) (*ethpb.SubmitSlashingResponse, error) {
+	res, err := c.f.submit(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.SubmitAttesterSlashing(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.SubmitSlashingResponse), nil
+}
+
+// SubmitProposerSlashing submits the slashing to the active beacon node, or broadcasts it.
+func (c *failoverBeaconChainClient) SubmitProposerSlashing(ctx context.Context, in *ethpb.ProposerSlashing, opts ...grpc.CallOption) (*ethpb.SubmitSlashingResponse, error) {
+	res, err := c.f.submit(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.SubmitProposerSlashing(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.SubmitSlashingResponse), nil
+}
+
+// StreamAttestations opens the stream on the active beacon node, and again on the next one if it
+// becomes unavailable.
+func (c *failoverBeaconChainClient) StreamAttestations(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamAttestationsClient, error) {
+	s, err := c.f.openStream(ctx, func(ctx context.Context, n *beaconNode) (grpc.ClientStream, error) {
+		return n.beaconClient.StreamAttestations(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return failoverStreamAttestationsClient{s}, nil
+}
+
+// StreamBlocks opens the stream on the active beacon node, and again on the next one if it
+// becomes unavailable.
+func (c *failoverBeaconChainClient) StreamBlocks(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamBlocksClient, error) {
+	s, err := c.f.openStream(ctx, func(ctx context.Context, n *beaconNode) (grpc.ClientStream, error) {
+		return n.beaconClient.StreamBlocks(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return failoverStreamBlocksClient{s}, nil
+}
+
+// StreamChainHead opens the stream on the active beacon node, and again on the next one if it
+// becomes unavailable.
+func (c *failoverBeaconChainClient) StreamChainHead(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamChainHeadClient, error) {
+	s, err := c.f.openStream(ctx, func(ctx context.Context, n *beaconNode) (grpc.ClientStream, error) {
+		return n.beaconClient.StreamChainHead(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return failoverStreamChainHeadClient{s}, nil
+}
+
+// StreamIndexedAttestations opens the stream on the active beacon node, and again on the next one if it
+// becomes unavailable.
+func (c *failoverBeaconChainClient) StreamIndexedAttestations(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamIndexedAttestationsClient, error) {
+	s, err := c.f.openStream(ctx, func(ctx context.Context, n *beaconNode) (grpc.ClientStream, error) {
+		return n.beaconClient.StreamIndexedAttestations(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return failoverStreamIndexedAttestationsClient{s}, nil
+}
+
+// StreamValidatorsInfo opens the stream on the active beacon node. As the client sends
+// messages on the stream, it is not opened again on the next beacon node.
+func (c *failoverBeaconChainClient) StreamValidatorsInfo(ctx context.Context, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamValidatorsInfoClient, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.beaconClient.StreamValidatorsInfo(ctx, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(ethpb.BeaconChain_StreamValidatorsInfoClient), nil
+}
+
+type failoverStreamAttestationsClient struct{ *failoverStream }
+
+func (s failoverStreamAttestationsClient) Recv() (*ethpb.Attestation, error) {
+	m := &ethpb.Attestation{}
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type failoverStreamBlocksClient struct{ *failoverStream }
+
+func (s failoverStreamBlocksClient) Recv() (*ethpb.SignedBeaconBlock, error) {
+	m := &ethpb.SignedBeaconBlock{}
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type failoverStreamChainHeadClient struct{ *failoverStream }
+
+func (s failoverStreamChainHeadClient) Recv() (*ethpb.ChainHead, error) {
+	m := &ethpb.ChainHead{}
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+type failoverStreamIndexedAttestationsClient struct{ *failoverStream }
+
+func (s failoverStreamIndexedAttestationsClient) Recv() (*ethpb.IndexedAttestation, error) {
+	m := &ethpb.IndexedAttestation{}
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetGenesis routes the call to the active beacon node.
+func (c *failoverNodeClient) GetGenesis(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.Genesis, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.GetGenesis(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Genesis), nil
+}
+
+// GetHost routes the call to the active beacon node.
+func (c *failoverNodeClient) GetHost(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.HostData, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.GetHost(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.HostData), nil
+}
+
+// GetPeer routes the call to the active beacon node.
+func (c *failoverNodeClient) GetPeer(ctx context.Context, in *ethpb.PeerRequest, opts ...grpc.CallOption) (*ethpb.Peer, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.GetPeer(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Peer), nil
+}
+
+// GetSyncStatus routes the call to the active beacon node.
+func (c *failoverNodeClient) GetSyncStatus(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.GetSyncStatus(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.SyncStatus), nil
+}
+
+// GetVersion routes the call to the active beacon node.
+func (c *failoverNodeClient) GetVersion(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.Version, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.GetVersion(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Version), nil
+}
+
+// ListImplementedServices routes the call to the active beacon node.
+func (c *failoverNodeClient) ListImplementedServices(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.ImplementedServices, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.ListImplementedServices(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ImplementedServices), nil
+}
+
+// ListPeers routes the call to the active beacon node.
+func (c *failoverNodeClient) ListPeers(ctx context.Context, in *ptypes.Empty, opts ...grpc.CallOption) (*ethpb.Peers, error) {
+	res, err := c.f.call(func(n *beaconNode) (interface{}, error) {
+		return n.nodeClient.ListPeers(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.Peers), nil
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = ethpb.BeaconChainClient(&failoverBeaconChainClient{})
+var _ = ethpb.NodeClient(&failoverNodeClient{})
+
+func TestFailoverBeaconChainClient_FailsOverWhenUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f, mocks := setupFailoverClient(t, ctrl, 2, false)
+
+	mocks[0].beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "down"))
+	mocks[1].beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{TotalSize: 1}, nil)
+	res, err := f.beaconChainClient().ListBlocks(context.Background(), &ethpb.ListBlocksRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), res.TotalSize)
+	assert.Equal(t, 1, f.active, "Expected the next calls to use the beacon node which answered")
+
+	// The node client is routed to the same beacon node.
+	mocks[1].nodeClient.EXPECT().GetGenesis(gomock.Any(), gomock.Any()).Return(&ethpb.Genesis{}, nil)
+	_, err = f.nodeClient().GetGenesis(context.Background(), nil)
+	require.NoError(t, err)
+}
+
+func TestFailoverStream_OpensAgainOnNextNodeWhenUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f, mocks := setupFailoverClient(t, ctrl, 2, false)
+
+	stream0 := mock.NewMockBeaconChain_StreamChainHeadClient(ctrl)
+	stream1 := mock.NewMockBeaconChain_StreamChainHeadClient(ctrl)
+	mocks[0].beaconClient.EXPECT().StreamChainHead(gomock.Any(), gomock.Any()).Return(stream0, nil)
+	stream0.EXPECT().RecvMsg(gomock.Any()).Return(status.Error(codes.Unavailable, "down"))
+	mocks[1].beaconClient.EXPECT().StreamChainHead(gomock.Any(), gomock.Any()).Return(stream1, nil)
+	stream1.EXPECT().RecvMsg(gomock.Any()).DoAndReturn(func(m interface{}) error {
+		m.(*ethpb.ChainHead).HeadSlot = 5
+		return nil
+	})
+
+	stream, err := f.beaconChainClient().StreamChainHead(context.Background(), nil)
+	require.NoError(t, err)
+	head, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), head.HeadSlot)
+	assert.Equal(t, 1, f.active)
+}
+
+func TestFailoverStream_OpensAgainOnActiveNode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f, mocks := setupFailoverClient(t, ctrl, 2, false)
+
+	stream0 := mock.NewMockBeaconNodeValidator_StreamDutiesClient(ctrl)
+	stream1 := mock.NewMockBeaconNodeValidator_StreamDutiesClient(ctrl)
+	mocks[0].validatorClient.EXPECT().StreamDuties(gomock.Any(), gomock.Any()).Return(stream0, nil)
+	stream, err := f.StreamDuties(context.Background(), &ethpb.DutiesRequest{})
+	require.NoError(t, err)
+
+	// Once calls are routed to another beacon node, the stream is opened on it after the
+	// message being received.
+	f.lock.Lock()
+	f.setActive(1)
+	f.lock.Unlock()
+	stream0.EXPECT().RecvMsg(gomock.Any()).Return(nil)
+	mocks[1].validatorClient.EXPECT().StreamDuties(gomock.Any(), gomock.Any()).Return(stream1, nil)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	stream1.EXPECT().RecvMsg(gomock.Any()).Return(status.Error(codes.Internal, "bad"))
+	_, err = stream.Recv()
+	assert.ErrorContains(t, "bad", err)
+}
+
+func TestFailoverStream_AllUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f, mocks := setupFailoverClient(t, ctrl, 2, false)
+
+	stream0 := mock.NewMockBeaconChain_StreamChainHeadClient(ctrl)
+	stream1 := mock.NewMockBeaconChain_StreamChainHeadClient(ctrl)
+	mocks[0].beaconClient.EXPECT().StreamChainHead(gomock.Any(), gomock.Any()).Return(stream0, nil).Times(2)
+	mocks[1].beaconClient.EXPECT().StreamChainHead(gomock.Any(), gomock.Any()).Return(stream1, nil)
+	stream0.EXPECT().RecvMsg(gomock.Any()).Return(status.Error(codes.Unavailable, "down")).Times(2)
+	stream1.EXPECT().RecvMsg(gomock.Any()).Return(status.Error(codes.Unavailable, "down"))
+
+	stream, err := f.beaconChainClient().StreamChainHead(context.Background(), nil)
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.ErrorContains(t, "down", err)
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = ethpb.BeaconNodeValidatorClient(&failoverClient{})
+
+type mockBeaconNode struct {
+	validatorClient *mock.MockBeaconNodeValidatorClient
+	beaconClient    *mock.MockBeaconChainClient
+	nodeClient      *mock.MockNodeClient
+}
+
+func setupFailoverClient(t *testing.T, ctrl *gomock.Controller, count int, broadcast bool) (*failoverClient, []*mockBeaconNode) {
+	mocks := make([]*mockBeaconNode, count)
+	nodes := make([]*beaconNode, count)
+	for i := 0; i < count; i++ {
+		mocks[i] = &mockBeaconNode{
+			validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+			beaconClient:    mock.NewMockBeaconChainClient(ctrl),
+			nodeClient:      mock.NewMockNodeClient(ctrl),
+		}
+		nodes[i] = &beaconNode{
+			endpoint:        string(rune('a' + i)),
+			validatorClient: mocks[i].validatorClient,
+			beaconClient:    mocks[i].beaconClient,
+			nodeClient:      mocks[i].nodeClient,
+		}
+	}
+	f, err := newFailoverClient(nodes, broadcast)
+	require.NoError(t, err)
+	return f, mocks
+}
+
+func (m *mockBeaconNode) expectHealthCheck(syncing bool, headSlot uint64, peers int) {
+	m.nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(&ethpb.SyncStatus{Syncing: syncing}, nil)
+	m.beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadSlot: headSlot}, nil)
+	m.nodeClient.EXPECT().ListPeers(gomock.Any(), gomock.Any()).Return(&ethpb.Peers{Peers: make([]*ethpb.Peer, peers)}, nil)
+}
+
+func TestNewFailoverClient_NoEndpoint(t *testing.T) {
+	_, err := newFailoverClient(nil, false)
+	assert.ErrorContains(t, "no beacon node endpoint", err)
+}
+
+func TestSplitEndpoints(t *testing.T) {
+	assert.DeepEqual(t, []string{"127.0.0.1:4000"}, splitEndpoints("127.0.0.1:4000"))
+	assert.DeepEqual(t, []string{"a:4000", "b:4000"}, splitEndpoints("a:4000, b:4000,"))
+}
+
+func TestFailoverClient_CheckHealth(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(mocks []*mockBeaconNode)
+		active int
+	}{
+		{
+			name: "prefers the first healthy beacon node",
+			setup: func(mocks []*mockBeaconNode) {
+				mocks[0].expectHealthCheck(false, 100, 5)
+				mocks[1].expectHealthCheck(false, 101, 50)
+				mocks[2].expectHealthCheck(false, 101, 50)
+			},
+			active: 0,
+		},
+		{
+			name: "skips syncing beacon nodes",
+			setup: func(mocks []*mockBeaconNode) {
+				mocks[0].expectHealthCheck(true, 100, 5)
+				mocks[1].expectHealthCheck(false, 100, 5)
+				mocks[2].expectHealthCheck(false, 100, 5)
+			},
+			active: 1,
+		},
+		{
+			name: "skips beacon nodes without peers",
+			setup: func(mocks []*mockBeaconNode) {
+				mocks[0].expectHealthCheck(false, 100, 0)
+				mocks[1].expectHealthCheck(false, 100, 0)
+				mocks[2].expectHealthCheck(false, 100, 5)
+			},
+			active: 2,
+		},
+		{
+			name: "skips beacon nodes behind the others",
+			setup: func(mocks []*mockBeaconNode) {
+				mocks[0].expectHealthCheck(false, 100, 5)
+				mocks[1].expectHealthCheck(false, 110, 5)
+				mocks[2].expectHealthCheck(false, 110, 5)
+			},
+			active: 1,
+		},
+		{
+			name: "skips unreachable beacon nodes",
+			setup: func(mocks []*mockBeaconNode) {
+				mocks[0].nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "down"))
+				mocks[1].nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "down"))
+				mocks[2].expectHealthCheck(false, 100, 5)
+			},
+			active: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			f, mocks := setupFailoverClient(t, ctrl, 3, false)
+			tt.setup(mocks)
+			f.checkHealth(context.Background())
+			assert.Equal(t, tt.active, f.active)
+		})
+	}
+}
+
+func TestFailoverClient_FailsOverWhenUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f, mocks := setupFailoverClient(t, ctrl, 2, false)
+
+	mocks[0].validatorClient.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "down"))
+	mocks[1].validatorClient.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(&ethpb.DutiesResponse{}, nil)
+	res, err := f.GetDuties(context.Background(), &ethpb.DutiesRequest{})
+	require.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, 1, f.active, "Expected the next calls to use the beacon node which answered")
+
+	mocks[1].validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(&ethpb.DomainResponse{}, nil)
+	_, err = f.DomainData(context.Background(), &ethpb.DomainRequest{})
+	require.NoError(t, err)
+}
+
+func TestFailoverClient_DoesNotFailOverOnOtherErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f, mocks := setupFailoverClient(t, ctrl, 2, false)
+
+	// The second beacon node must not be called, as the first one may have processed the call.
+	mocks[0].validatorClient.EXPECT().ProposeAttestation(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Internal, "bad"))
+	_, err := f.ProposeAttestation(context.Background(), &ethpb.Attestation{})
+	assert.ErrorContains(t, "bad", err)
+	assert.Equal(t, 0, f.active)
+}
+
+func TestFailoverClient_AllUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f, mocks := setupFailoverClient(t, ctrl, 2, false)
+
+	mocks[0].validatorClient.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "down"))
+	mocks[1].validatorClient.EXPECT().GetBlock(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "down"))
+	_, err := f.GetBlock(context.Background(), &ethpb.BlockRequest{})
+	assert.ErrorContains(t, "down", err)
+}
+
+func TestFailoverClient_BroadcastsToHealthyNodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	f, mocks := setupFailoverClient(t, ctrl, 3, true)
+	mocks[0].expectHealthCheck(false, 100, 5)
+	mocks[1].expectHealthCheck(false, 100, 5)
+	mocks[2].expectHealthCheck(true, 90, 5)
+	f.checkHealth(context.Background())
+
+	mocks[0].validatorClient.EXPECT().ProposeBlock(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Internal, "bad"))
+	mocks[1].validatorClient.EXPECT().ProposeBlock(gomock.Any(), gomock.Any()).Return(&ethpb.ProposeResponse{BlockRoot: []byte{1}}, nil)
+	res, err := f.ProposeBlock(context.Background(), &ethpb.SignedBeaconBlock{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1}, res.BlockRoot)
+
+	// Reads are not broadcast.
+	mocks[0].validatorClient.EXPECT().GetAttestationData(gomock.Any(), gomock.Any()).Return(&ethpb.AttestationData{Slot: 1}, nil)
+	data, err := f.GetAttestationData(context.Background(), &ethpb.AttestationDataRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), data.Slot)
+}
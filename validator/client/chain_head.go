@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// chainHeadStreamRetryDelay is how long to wait before opening the chain head stream again
+// after it broke.
+const chainHeadStreamRetryDelay = time.Second
+
+// headTracker records when the beacon node processed the blocks of recent slots, so that
+// duties depending on the head of the chain can be performed as soon as it is up to date.
+// It also records the root of the head, from which the blocks the duties depend on are
+// queried again when it changes.
+type headTracker struct {
+	lock     sync.RWMutex
+	headSlot uint64
+	headRoot [32]byte
+	arrivals map[uint64]time.Time
+	updated  chan struct{} // Closed and replaced on every head update.
+}
+
+func newHeadTracker() *headTracker {
+	return &headTracker{
+		arrivals: make(map[uint64]time.Time),
+		updated:  make(chan struct{}),
+	}
+}
+
+// update records a new head of the beacon node, processed at the given time.
+func (h *headTracker) update(headSlot uint64, headRoot [32]byte, processed time.Time) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if _, ok := h.arrivals[headSlot]; !ok {
+		h.arrivals[headSlot] = processed
+	}
+	if headSlot < h.headSlot || (headSlot == h.headSlot && headRoot != h.headRoot) {
+		// The head moved back to another branch. A reorg to a branch with a later head is
+		// only noticed when the blocks the duties depend on change.
+		log.WithFields(logrus.Fields{
+			"oldHeadSlot": h.headSlot,
+			"newHeadSlot": headSlot,
+		}).Debug("Beacon node head was reorganized")
+		headReorgsCount.Inc()
+	}
+	h.headSlot = headSlot
+	h.headRoot = headRoot
+	// Only the arrivals of the slots of the last epoch are of interest.
+	for slot := range h.arrivals {
+		if slot+params.BeaconConfig().SlotsPerEpoch < headSlot {
+			delete(h.arrivals, slot)
+		}
+	}
+	close(h.updated)
+	h.updated = make(chan struct{})
+}
+
+// head returns the slot and root of the head of the beacon node.
+func (h *headTracker) head() (uint64, [32]byte) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	return h.headSlot, h.headRoot
+}
+
+// waitForBlock waits until the beacon node processed the block of a slot, or a later block,
+// or until the deadline. It returns when the head was up to date, and whether it was up to
+// date before the deadline.
+func (h *headTracker) waitForBlock(ctx context.Context, slot uint64, deadline time.Time) (time.Time, bool) {
+	timer := time.NewTimer(roughtime.Until(deadline))
+	defer timer.Stop()
+	for {
+		h.lock.RLock()
+		arrival, ok := h.arrivals[slot]
+		headSlot := h.headSlot
+		updated := h.updated
+		h.lock.RUnlock()
+		if ok {
+			return arrival, true
+		}
+		if headSlot > slot {
+			// The head moved past the slot without its block, so there is nothing left to wait for.
+			return roughtime.Now(), true
+		}
+		select {
+		case <-updated:
+		case <-timer.C:
+			return deadline, false
+		case <-ctx.Done():
+			return deadline, false
+		}
+	}
+}
+
+// streamChainHead follows the head of the beacon node, recording when the block of each
+// slot is processed, until the context is canceled. The stream is opened again when it breaks.
+func (v *validator) streamChainHead(ctx context.Context) {
+	for {
+		if err := v.receiveChainHeads(ctx); err != nil && ctx.Err() == nil {
+			log.WithError(err).Debug("Chain head stream broke, duties will wait for their deadline until it is opened again")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(chainHeadStreamRetryDelay):
+		}
+	}
+}
+
+// receiveChainHeads receives the heads of the beacon node until the stream breaks. The chain
+// head stream is the head event stream of the beacon node, which sends the new head every time
+// a block is processed. The beacon chain client fails over, so the stream is opened again on
+// the next beacon node when the active one becomes unavailable.
+func (v *validator) receiveChainHeads(ctx context.Context) error {
+	stream, err := v.beaconClient.StreamChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not open chain head stream")
+	}
+	for {
+		head, err := stream.Recv()
+		if err != nil {
+			return errors.Wrap(err, "could not receive chain head")
+		}
+		v.head.update(head.HeadSlot, bytesutil.ToBytes32(head.HeadBlockRoot), roughtime.Now())
+	}
+}
+
+// waitForBlockOrSlotOneThird waits until the beacon node processed the block of the slot,
+// with one third through the slot as the deadline. It returns when the attestations of the
+// slot can be made, and whether the block was processed before the deadline.
+func (v *validator) waitForBlockOrSlotOneThird(ctx context.Context, slot uint64) (time.Time, bool) {
+	ctx, span := trace.StartSpan(ctx, "validator.waitForBlockOrSlotOneThird")
+	defer span.End()
+
+	deadline := slotutil.SlotStartTime(v.genesisTime, slot).Add(slotutil.DivideSlotBy(3 /* a third of the slot duration */))
+	if v.head == nil {
+		select {
+		case <-ctx.Done():
+		case <-time.After(roughtime.Until(deadline)):
+		}
+		return deadline, false
+	}
+	return v.head.waitForBlock(ctx, slot, deadline)
+}
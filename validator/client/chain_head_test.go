@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestHeadTracker_WaitForBlock(t *testing.T) {
+	h := newHeadTracker()
+	arrival := roughtime.Now()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		h.update(4, [32]byte{}, roughtime.Now())
+		h.update(5, [32]byte{}, arrival)
+	}()
+	attestTime, arrived := h.waitForBlock(context.Background(), 5, roughtime.Now().Add(time.Second))
+	assert.Equal(t, true, arrived)
+	assert.Equal(t, arrival, attestTime)
+}
+
+func TestHeadTracker_WaitForBlock_Deadline(t *testing.T) {
+	h := newHeadTracker()
+	h.update(4, [32]byte{}, roughtime.Now())
+	deadline := roughtime.Now().Add(50 * time.Millisecond)
+	attestTime, arrived := h.waitForBlock(context.Background(), 5, deadline)
+	assert.Equal(t, false, arrived)
+	assert.Equal(t, deadline, attestTime)
+}
+
+func TestHeadTracker_WaitForBlock_SkippedSlot(t *testing.T) {
+	h := newHeadTracker()
+	h.update(6, [32]byte{}, roughtime.Now())
+	_, arrived := h.waitForBlock(context.Background(), 5, roughtime.Now().Add(time.Second))
+	assert.Equal(t, true, arrived)
+}
+
+func TestHeadTracker_PrunesOldArrivals(t *testing.T) {
+	h := newHeadTracker()
+	h.update(1, [32]byte{}, roughtime.Now())
+	h.update(2+params.BeaconConfig().SlotsPerEpoch, [32]byte{}, roughtime.Now())
+	_, ok := h.arrivals[1]
+	assert.Equal(t, false, ok)
+	assert.Equal(t, 1, len(h.arrivals))
+}
+
+func TestHeadTracker_Head(t *testing.T) {
+	h := newHeadTracker()
+	h.update(5, [32]byte{5}, roughtime.Now())
+	h.update(6, [32]byte{6}, roughtime.Now())
+	slot, root := h.head()
+	assert.Equal(t, uint64(6), slot)
+	assert.Equal(t, [32]byte{6}, root)
+
+	// A reorg to an earlier head replaces it.
+	h.update(4, [32]byte{4}, roughtime.Now())
+	slot, root = h.head()
+	assert.Equal(t, uint64(4), slot)
+	assert.Equal(t, [32]byte{4}, root)
+}
+
+func TestStreamChainHead_RecordsHeads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	stream := mock.NewMockBeaconChain_StreamChainHeadClient(ctrl)
+	v := &validator{
+		beaconClient: beaconClient,
+		head:         newHeadTracker(),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	beaconClient.EXPECT().StreamChainHead(
+		gomock.Any(),
+		&ptypes.Empty{},
+	).Return(stream, nil)
+	stream.EXPECT().Recv().Return(&ethpb.ChainHead{HeadSlot: 7}, nil)
+	stream.EXPECT().Recv().DoAndReturn(func() (*ethpb.ChainHead, error) {
+		cancel()
+		return nil, errors.New("stream closed")
+	})
+
+	done := make(chan struct{})
+	go func() {
+		v.streamChainHead(ctx)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Chain head stream was not closed")
+	}
+	_, arrived := v.head.waitForBlock(context.Background(), 7, roughtime.Now())
+	assert.Equal(t, true, arrived)
+}
+
+func TestWaitForBlockOrSlotOneThird_BlockArrived(t *testing.T) {
+	currentTime := roughtime.Now()
+	numOfSlots := uint64(4)
+	v := &validator{
+		genesisTime: uint64(currentTime.Unix()) - (numOfSlots * params.BeaconConfig().SecondsPerSlot),
+		head:        newHeadTracker(),
+	}
+	slotStart := slotutil.SlotStartTime(v.genesisTime, numOfSlots)
+	v.head.update(numOfSlots, [32]byte{}, slotStart)
+
+	// The block of the slot arrived at its start, so the attestations are made then rather
+	// than one third into the slot.
+	attestTime, arrived := v.waitForBlockOrSlotOneThird(context.Background(), numOfSlots)
+	require.Equal(t, true, arrived)
+	assert.Equal(t, slotStart, attestTime)
+}
+
+func TestWaitForBlockOrSlotOneThird_ContextCanceled(t *testing.T) {
+	// Without the chain head, the wait lasts until one third into the slot.
+	v := &validator{genesisTime: uint64(roughtime.Now().Unix())}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		_, arrived := v.waitForBlockOrSlotOneThird(ctx, 1)
+		assert.Equal(t, false, arrived)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Wait did not return when the context was canceled")
+	}
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// doppelgangerPageSize is the page size of the chain queries made to detect doppelgangers.
+const doppelgangerPageSize = 250
+
+var (
+	// ValidatorDoppelgangerGaugeVec is set to 1 for the public keys found signing elsewhere.
+	ValidatorDoppelgangerGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "doppelganger_detected",
+			Help:      "Whether another validator client was found signing with a public key: 0 no, 1 yes",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	validatorDoppelgangerCheckedEpochs = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "doppelganger_checked_epochs_total",
+			Help:      "Number of epochs checked for doppelgangers before signing",
+		},
+	)
+)
+
+// doppelgangerWatch is what the doppelganger detection looks for in the chain: the
+// validator indices of the public keys, and what was signed before the validator
+// client started, which is not a doppelganger.
+type doppelgangerWatch struct {
+	indexToPubKey map[uint64][48]byte
+	watermarks    map[[48]byte]*kv.AttestationWatermarks
+}
+
+// CheckDoppelganger watches the chain for the configured number of epochs without signing,
+// and returns an error if any of the validating public keys attests or proposes during that
+// time, as it means another validator client is signing with the same keys. Attestations and
+// blocks recorded in the slashing protection database were signed by this validator client
+// before a restart, and are not mistaken for a doppelganger.
+func (v *validator) CheckDoppelganger(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelganger")
+	defer span.End()
+
+	if v.doppelgangerEpochs == 0 {
+		return nil
+	}
+	watch, err := v.doppelgangerWatch(ctx)
+	if err != nil {
+		return err
+	}
+	if len(watch.indexToPubKey) == 0 {
+		return nil
+	}
+	startEpoch := helpers.SlotToEpoch(helpers.CurrentSlot(v.genesisTime))
+	endEpoch := startEpoch + v.doppelgangerEpochs
+	log.WithFields(logrus.Fields{
+		"startEpoch": startEpoch,
+		"endEpoch":   endEpoch,
+	}).Info("Watching the chain for other validator clients using the same keys before signing")
+	for epoch := startEpoch; epoch < endEpoch; epoch++ {
+		// Wait for the end of the epoch, for its blocks to be known to the beacon node.
+		nextEpochStart := slotutil.SlotStartTime(v.genesisTime, (epoch+1)*params.BeaconConfig().SlotsPerEpoch)
+		select {
+		case <-time.After(time.Until(nextEpochStart)):
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "context canceled while checking for doppelgangers")
+		}
+		detected, err := v.detectDoppelgangers(ctx, epoch, watch)
+		if err != nil {
+			return errors.Wrapf(err, "could not check epoch %d for doppelgangers", epoch)
+		}
+		validatorDoppelgangerCheckedEpochs.Inc()
+		if len(detected) > 0 {
+			for pubKey := range detected {
+				ValidatorDoppelgangerGaugeVec.WithLabelValues(fmt.Sprintf("%#x", pubKey)).Set(1)
+				log.WithFields(logrus.Fields{
+					"pubKey": fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+					"epoch":  epoch,
+				}).Error("Another validator client is signing with this key, refusing to sign to avoid being slashed")
+			}
+			return errors.Errorf("%d validating keys are used by another validator client", len(detected))
+		}
+	}
+	log.Info("No other validator client found using the same keys, starting to sign")
+	return nil
+}
+
+// doppelgangerWatch fetches the validator indices of the validating public keys, along with
+// their attestation history.
+func (v *validator) doppelgangerWatch(ctx context.Context) (*doppelgangerWatch, error) {
+	validatingKeys, err := v.fetchValidatingKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := v.validatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validator indices")
+	}
+	nonexistentIndex := ^uint64(0)
+	indexToPubKey := make(map[uint64][48]byte, len(statuses.PublicKeys))
+	for i, pubKey := range statuses.PublicKeys {
+		if i < len(statuses.Indices) && statuses.Indices[i] != nonexistentIndex {
+			indexToPubKey[statuses.Indices[i]] = bytesutil.ToBytes48(pubKey)
+		}
+	}
+	watermarks, err := v.db.AttestationWatermarksForPubKeys(ctx, validatingKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch attestation history")
+	}
+	return &doppelgangerWatch{
+		indexToPubKey: indexToPubKey,
+		watermarks:    watermarks,
+	}, nil
+}
+
+// detectDoppelgangers returns the public keys which attested or proposed in the blocks of an
+// epoch, without the attestation or block being recorded in the slashing protection database.
+func (v *validator) detectDoppelgangers(ctx context.Context, epoch uint64, watch *doppelgangerWatch) (map[[48]byte]bool, error) {
+	detected := make(map[[48]byte]bool)
+	blocksReq := &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch},
+		PageSize:    doppelgangerPageSize,
+	}
+	for {
+		res, err := v.beaconClient.ListBlocks(ctx, blocksReq)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list blocks")
+		}
+		for _, ctr := range res.BlockContainers {
+			blk := ctr.Block.Block
+			pubKey, ok := watch.indexToPubKey[blk.ProposerIndex]
+			if !ok {
+				continue
+			}
+			slotBits, err := v.db.ProposalHistoryForEpoch(ctx, pubKey[:], helpers.SlotToEpoch(blk.Slot))
+			if err != nil {
+				return nil, errors.Wrap(err, "could not fetch proposal history")
+			}
+			if !slotBits.BitAt(blk.Slot % params.BeaconConfig().SlotsPerEpoch) {
+				detected[pubKey] = true
+			}
+		}
+		if res.NextPageToken == "" || len(res.BlockContainers) == 0 {
+			break
+		}
+		blocksReq.PageToken = res.NextPageToken
+	}
+
+	attsReq := &ethpb.ListIndexedAttestationsRequest{
+		QueryFilter: &ethpb.ListIndexedAttestationsRequest_Epoch{Epoch: epoch},
+		PageSize:    doppelgangerPageSize,
+	}
+	for {
+		res, err := v.beaconClient.ListIndexedAttestations(ctx, attsReq)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not list indexed attestations")
+		}
+		for _, att := range res.IndexedAttestations {
+			for _, idx := range att.AttestingIndices {
+				pubKey, ok := watch.indexToPubKey[idx]
+				if !ok {
+					continue
+				}
+				// Attestations up to the highest target epoch recorded may have been signed
+				// by this validator client before it restarted.
+				if w := watch.watermarks[pubKey]; w == nil || att.Data.Target.Epoch > w.HighestTargetEpoch {
+					detected[pubKey] = true
+				}
+			}
+		}
+		if res.NextPageToken == "" || len(res.IndexedAttestations) == 0 {
+			break
+		}
+		attsReq.PageToken = res.NextPageToken
+	}
+	return detected, nil
+}
@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestCheckDoppelganger_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	v := &validator{
+		validatorClient: mock.NewMockBeaconNodeValidatorClient(ctrl),
+		beaconClient:    mock.NewMockBeaconChainClient(ctrl),
+	}
+	require.NoError(t, v.CheckDoppelganger(context.Background()))
+}
+
+func TestDoppelgangerWatch_SkipsUnknownIndices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v := &validator{
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		db:              dbTest.SetupDB(t, [][48]byte{validatorPubKey}),
+	}
+	require.NoError(t, v.db.CheckAndSaveAttestation(context.Background(), validatorPubKey, [32]byte{1}, 3, 4))
+	client.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: [][]byte{validatorPubKey[:], {2}},
+		Indices:    []uint64{5, ^uint64(0)},
+	}, nil)
+
+	watch, err := v.doppelgangerWatch(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[uint64][48]byte{5: validatorPubKey}, watch.indexToPubKey)
+	require.NotNil(t, watch.watermarks[validatorPubKey])
+	assert.Equal(t, uint64(4), watch.watermarks[validatorPubKey].HighestTargetEpoch)
+}
+
+func TestDetectDoppelgangers(t *testing.T) {
+	ctx := context.Background()
+	ownKey := [48]byte{1}
+	otherKey := [48]byte{2}
+
+	tests := []struct {
+		name     string
+		blocks   []*ethpb.BeaconBlockContainer
+		atts     []*ethpb.IndexedAttestation
+		detected map[[48]byte]bool
+	}{
+		{
+			name: "ignores what was signed before a restart",
+			blocks: []*ethpb.BeaconBlockContainer{
+				blockContainer(1, 33),
+			},
+			atts: []*ethpb.IndexedAttestation{
+				indexedAttestation(5, 1, 9),
+			},
+			detected: map[[48]byte]bool{},
+		},
+		{
+			name: "detects attestations",
+			atts: []*ethpb.IndexedAttestation{
+				indexedAttestation(6, 1, 2, 9),
+			},
+			detected: map[[48]byte]bool{ownKey: true, otherKey: true},
+		},
+		{
+			name: "detects proposals",
+			blocks: []*ethpb.BeaconBlockContainer{
+				blockContainer(1, 34),
+				blockContainer(9, 35),
+			},
+			detected: map[[48]byte]bool{ownKey: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			beaconClient := mock.NewMockBeaconChainClient(ctrl)
+			v := &validator{
+				beaconClient: beaconClient,
+				db:           dbTest.SetupDB(t, [][48]byte{ownKey, otherKey}),
+			}
+			// Signed by this validator client before it restarted.
+			require.NoError(t, v.db.CheckAndSaveAttestation(ctx, ownKey, [32]byte{1}, 4, 5))
+			require.NoError(t, v.db.CheckAndSaveProposal(ctx, ownKey, [32]byte{1}, 33))
+			watermarks, err := v.db.AttestationWatermarksForPubKeys(ctx, [][48]byte{ownKey, otherKey})
+			require.NoError(t, err)
+			watch := &doppelgangerWatch{
+				indexToPubKey: map[uint64][48]byte{1: ownKey, 2: otherKey},
+				watermarks:    watermarks,
+			}
+
+			beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{
+				BlockContainers: tt.blocks,
+			}, nil)
+			beaconClient.EXPECT().ListIndexedAttestations(gomock.Any(), gomock.Any()).Return(&ethpb.ListIndexedAttestationsResponse{
+				IndexedAttestations: tt.atts,
+			}, nil)
+			detected, err := v.detectDoppelgangers(ctx, 1, watch)
+			require.NoError(t, err)
+			assert.DeepEqual(t, tt.detected, detected)
+		})
+	}
+}
+
+func TestDetectDoppelgangers_Paginates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	pubKey := [48]byte{1}
+	v := &validator{
+		beaconClient: beaconClient,
+		db:           dbTest.SetupDB(t, [][48]byte{pubKey}),
+	}
+	watch := &doppelgangerWatch{indexToPubKey: map[uint64][48]byte{1: pubKey}}
+
+	gomock.InOrder(
+		beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{
+			BlockContainers: []*ethpb.BeaconBlockContainer{blockContainer(2, 32)},
+			NextPageToken:   "1",
+		}, nil),
+		beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).Return(&ethpb.ListBlocksResponse{
+			BlockContainers: []*ethpb.BeaconBlockContainer{blockContainer(1, 40)},
+		}, nil),
+	)
+	// An empty result still has a next page token.
+	beaconClient.EXPECT().ListIndexedAttestations(gomock.Any(), gomock.Any()).Return(&ethpb.ListIndexedAttestationsResponse{
+		IndexedAttestations: []*ethpb.IndexedAttestation{},
+		NextPageToken:       "0",
+	}, nil)
+	detected, err := v.detectDoppelgangers(context.Background(), 1, watch)
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[[48]byte]bool{pubKey: true}, detected)
+}
+
+func blockContainer(proposerIndex uint64, slot uint64) *ethpb.BeaconBlockContainer {
+	return &ethpb.BeaconBlockContainer{
+		Block: &ethpb.SignedBeaconBlock{
+			Block: &ethpb.BeaconBlock{
+				Slot:          slot,
+				ProposerIndex: proposerIndex,
+			},
+		},
+	}
+}
+
+func indexedAttestation(targetEpoch uint64, indices ...uint64) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		AttestingIndices: indices,
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: targetEpoch - 1},
+			Target: &ethpb.Checkpoint{Epoch: targetEpoch},
+		},
+	}
+}
@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/sirupsen/logrus"
+)
+
+// Reasons for which the duties are fetched.
+const (
+	dutiesUpdateMissing   = "missing"
+	dutiesUpdateEpoch     = "epoch"
+	dutiesUpdateStale     = "stale"
+	dutiesUpdateKeyConfig = "key_config"
+	dutiesUpdateReorg     = "reorg"
+)
+
+// dutyDependentRoots are the roots of the blocks the shufflings of the duties of an epoch
+// depend on, or zero if they are not known.
+type dutyDependentRoots struct {
+	// current is the last block before the previous epoch, which the attester duties of the
+	// current epoch depend on.
+	current [32]byte
+	// next is the last block before the current epoch, which the proposer duties of the
+	// current epoch and the attester duties of the next epoch depend on.
+	next [32]byte
+}
+
+// blockRef is the slot and parent of a block, with which the chain of the head of the beacon
+// node is walked back.
+type blockRef struct {
+	slot   uint64
+	parent [32]byte
+}
+
+// subnetSubscription is a subscription of the beacon node to the subnet of a committee.
+type subnetSubscription struct {
+	slot       uint64
+	aggregator bool
+}
+
+// dutiesUpdateReason returns why the duties of an epoch need to be fetched, or an empty
+// string if the known duties are up to date.
+func (v *validator) dutiesUpdateReason(ctx context.Context, epoch uint64) string {
+	switch {
+	case v.duties == nil:
+		return dutiesUpdateMissing
+	case v.dutiesEpoch != epoch:
+		return dutiesUpdateEpoch
+	case v.dutiesStale:
+		return dutiesUpdateStale
+	case v.keyConfigChanged():
+		// Keys were enabled or disabled since the duties were fetched.
+		return dutiesUpdateKeyConfig
+	case v.dependentRootsChanged(ctx):
+		return dutiesUpdateReorg
+	default:
+		return ""
+	}
+}
+
+// dependentRoots returns the roots of the blocks the duties of an epoch depend on, in the
+// chain of the head of the beacon node. They are queried from the beacon node by walking its
+// chain back from the head, and are zero if they could not be.
+func (v *validator) dependentRoots(ctx context.Context, epoch uint64) dutyDependentRoots {
+	var roots dutyDependentRoots
+	if v.head == nil {
+		return roots
+	}
+	headSlot, headRoot := v.head.head()
+	if headRoot == [32]byte{} {
+		return roots
+	}
+	if epoch > 1 {
+		slot, err := helpers.StartSlot(epoch - 1)
+		if err == nil {
+			roots.current, err = v.canonicalRootAt(ctx, headRoot, slot-1)
+		}
+		if err != nil {
+			log.WithError(err).Debug("Could not get the block the attester duties depend on")
+		}
+	}
+	if epoch > 0 {
+		slot, err := helpers.StartSlot(epoch)
+		if err == nil {
+			roots.next, err = v.canonicalRootAt(ctx, headRoot, slot-1)
+		}
+		if err != nil {
+			log.WithError(err).Debug("Could not get the block the proposer duties depend on")
+		}
+	}
+	// Blocks older than the ones the duties of the previous epoch depended on are not
+	// walked through anymore.
+	for root, ref := range v.blockRefs {
+		if ref.slot+3*params.BeaconConfig().SlotsPerEpoch < headSlot {
+			delete(v.blockRefs, root)
+		}
+	}
+	return roots
+}
+
+// canonicalRootAt returns the root of the last block at or before a slot, in the chain of a
+// head block. The blocks are queried from the beacon node once, as their slot and parent
+// never change.
+func (v *validator) canonicalRootAt(ctx context.Context, root [32]byte, slot uint64) ([32]byte, error) {
+	if v.blockRefs == nil {
+		v.blockRefs = make(map[[32]byte]blockRef)
+	}
+	for {
+		ref, ok := v.blockRefs[root]
+		if !ok {
+			res, err := v.beaconClient.ListBlocks(ctx, &ethpb.ListBlocksRequest{
+				QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root[:]},
+			})
+			if err != nil {
+				return [32]byte{}, errors.Wrapf(err, "could not get block %#x", bytesutil.Trunc(root[:]))
+			}
+			if len(res.BlockContainers) == 0 || res.BlockContainers[0].Block == nil || res.BlockContainers[0].Block.Block == nil {
+				return [32]byte{}, fmt.Errorf("block %#x not found", bytesutil.Trunc(root[:]))
+			}
+			block := res.BlockContainers[0].Block.Block
+			ref = blockRef{slot: block.Slot, parent: bytesutil.ToBytes32(block.ParentRoot)}
+			v.blockRefs[root] = ref
+		}
+		if ref.slot <= slot || ref.parent == [32]byte{} {
+			return root, nil
+		}
+		root = ref.parent
+	}
+}
+
+// dependentRootsChanged returns whether a reorg changed the blocks the duties depend on
+// since they were fetched, in which case they are fetched again without waiting for the
+// next epoch.
+func (v *validator) dependentRootsChanged(ctx context.Context) bool {
+	roots := v.dependentRoots(ctx, v.dutiesEpoch)
+	changed := func(fetched, current [32]byte) bool {
+		return fetched != [32]byte{} && current != [32]byte{} && fetched != current
+	}
+	if !changed(v.dutiesDependentRoots.current, roots.current) && !changed(v.dutiesDependentRoots.next, roots.next) {
+		return false
+	}
+	log.WithFields(logrus.Fields{
+		"epoch":            v.dutiesEpoch,
+		"oldDependentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(v.dutiesDependentRoots.next[:])),
+		"newDependentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(roots.next[:])),
+		"oldPreviousRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(v.dutiesDependentRoots.current[:])),
+		"newPreviousRoot":  fmt.Sprintf("%#x", bytesutil.Trunc(roots.current[:])),
+	}).Info("Reorg changed the blocks the duties depend on, fetching them again")
+	return true
+}
+
+// attesterDuties returns copies of duties without their proposer slots.
+func attesterDuties(duties []*ethpb.DutiesResponse_Duty) []*ethpb.DutiesResponse_Duty {
+	res := make([]*ethpb.DutiesResponse_Duty, len(duties))
+	for i, duty := range duties {
+		res[i] = proto.Clone(duty).(*ethpb.DutiesResponse_Duty)
+		res[i].ProposerSlots = nil
+	}
+	return res
+}
+
+// subscribeToSubnets notifies the beacon node to subscribe to the attester and aggregator
+// subnets of the duties which it was not subscribed to yet.
+func (v *validator) subscribeToSubnets(ctx context.Context, epoch uint64, duties ...[]*ethpb.DutiesResponse_Duty) error {
+	if v.subscribedSubnets == nil {
+		v.subscribedSubnets = make(map[[64]byte]subnetSubscription)
+	}
+	// Forget the subscriptions of past epochs.
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return err
+	}
+	for key, sub := range v.subscribedSubnets {
+		if sub.slot < startSlot {
+			delete(v.subscribedSubnets, key)
+		}
+	}
+
+	var subscribeSlots, subscribeCommitteeIDs []uint64
+	var subscribeIsAggregator []bool
+	for _, epochDuties := range duties {
+		for _, duty := range epochDuties {
+			if duty.Status != ethpb.ValidatorStatus_ACTIVE && duty.Status != ethpb.ValidatorStatus_EXITING {
+				continue
+			}
+			key := validatorSubscribeKey(duty.AttesterSlot, duty.CommitteeIndex)
+			sub, ok := v.subscribedSubnets[key]
+			if ok && sub.aggregator {
+				continue
+			}
+			aggregator, err := v.isAggregator(ctx, duty.Committee, duty.AttesterSlot, bytesutil.ToBytes48(duty.PublicKey))
+			if err != nil {
+				return errors.Wrap(err, "could not check if a validator is an aggregator")
+			}
+			if ok && !aggregator {
+				continue
+			}
+			v.subscribedSubnets[key] = subnetSubscription{slot: duty.AttesterSlot, aggregator: aggregator}
+			subscribeSlots = append(subscribeSlots, duty.AttesterSlot)
+			subscribeCommitteeIDs = append(subscribeCommitteeIDs, duty.CommitteeIndex)
+			subscribeIsAggregator = append(subscribeIsAggregator, aggregator)
+		}
+	}
+	if len(subscribeSlots) == 0 {
+		return nil
+	}
+
+	if _, err := v.validatorClient.SubscribeCommitteeSubnets(ctx, &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        subscribeSlots,
+		CommitteeIds: subscribeCommitteeIDs,
+		IsAggregator: subscribeIsAggregator,
+	}); err != nil {
+		// Subscribe again the next time the duties are fetched.
+		for i, slot := range subscribeSlots {
+			delete(v.subscribedSubnets, validatorSubscribeKey(slot, subscribeCommitteeIDs[i]))
+		}
+		return err
+	}
+	return nil
+}
+
+// recordDutiesMetrics records the epoch, freshness and lookahead of the duties just fetched.
+func (v *validator) recordDutiesMetrics(epoch uint64, reason string) {
+	dutiesUpdatesCount.WithLabelValues(reason).Inc()
+	dutiesEpochGauge.Set(float64(epoch))
+	dutiesUpdatedTimestamp.Set(float64(roughtime.Now().Unix()))
+	for label, duties := range map[string][]*ethpb.DutiesResponse_Duty{
+		"current": v.duties.Duties,
+		"next":    v.duties.NextEpochDuties,
+	} {
+		proposals := 0
+		for _, duty := range duties {
+			proposals += len(duty.ProposerSlots)
+			if v.emitAccountMetrics && (duty.Status == ethpb.ValidatorStatus_ACTIVE || duty.Status == ethpb.ValidatorStatus_EXITING) {
+				fmtKey := fmt.Sprintf("%#x", duty.PublicKey)
+				ValidatorAttesterSlotGaugeVec.WithLabelValues(fmtKey, label).Set(float64(duty.AttesterSlot))
+			}
+		}
+		dutiesProposalsGaugeVec.WithLabelValues(label).Set(float64(proposals))
+	}
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v1"
+)
+
+func TestUpdateDuties_SubscribesToSubnetsAnEpochAhead(t *testing.T) {
+	v, m, finish := setup(t)
+	defer finish()
+	sk := bls.RandKey()
+	v.keyManager = keymanager.NewDirect([]bls.SecretKey{sk})
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	duty := func(attesterSlot uint64) *ethpb.DutiesResponse_Duty {
+		return &ethpb.DutiesResponse_Duty{
+			AttesterSlot:   attesterSlot,
+			CommitteeIndex: 3,
+			Committee:      []uint64{0, 1, 2, 3},
+			PublicKey:      sk.PublicKey().Marshal(),
+			Status:         ethpb.ValidatorStatus_ACTIVE,
+		}
+	}
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/).AnyTimes()
+	m.validatorClient.EXPECT().GetDuties(
+		gomock.Any(),
+		&ethpb.DutiesRequest{Epoch: 1, PublicKeys: [][]byte{sk.PublicKey().Marshal()}},
+	).Return(&ethpb.DutiesResponse{
+		Duties:          []*ethpb.DutiesResponse_Duty{duty(slotsPerEpoch + 1)},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{duty(2*slotsPerEpoch + 2)},
+	}, nil)
+	m.validatorClient.EXPECT().GetDuties(
+		gomock.Any(),
+		&ethpb.DutiesRequest{Epoch: 2, PublicKeys: [][]byte{sk.PublicKey().Marshal()}},
+	).Return(&ethpb.DutiesResponse{
+		Duties:          []*ethpb.DutiesResponse_Duty{duty(2*slotsPerEpoch + 2)},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{duty(3*slotsPerEpoch + 3)},
+	}, nil)
+	var subscribed [][]uint64
+	m.validatorClient.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *ethpb.CommitteeSubnetsSubscribeRequest) (*ptypes.Empty, error) {
+		subscribed = append(subscribed, req.Slots)
+		return nil, nil
+	}).Times(2)
+
+	// On start up, the beacon node subscribes to the subnets of the current and next epochs,
+	// and then only to the subnets of the next epoch, as the current ones were subscribed to
+	// an epoch ahead.
+	require.NoError(t, v.UpdateDuties(context.Background(), slotsPerEpoch))
+	require.NoError(t, v.UpdateDuties(context.Background(), slotsPerEpoch+1))
+	require.NoError(t, v.UpdateDuties(context.Background(), 2*slotsPerEpoch))
+	assert.DeepEqual(t, [][]uint64{
+		{slotsPerEpoch + 1, 2*slotsPerEpoch + 2},
+		{3*slotsPerEpoch + 3},
+	}, subscribed)
+	assert.Equal(t, 3*slotsPerEpoch+3, v.duties.NextEpochDuties[0].AttesterSlot)
+}
+
+func TestUpdateDuties_DependentRootChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	v := validator{
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		beaconClient:    beaconClient,
+		head:            newHeadTracker(),
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	// Each block is queried from the beacon node once.
+	block := func(root [32]byte, slot uint64, parent [32]byte) {
+		beaconClient.EXPECT().ListBlocks(
+			gomock.Any(),
+			&ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root[:]}},
+		).Return(&ethpb.ListBlocksResponse{
+			BlockContainers: []*ethpb.BeaconBlockContainer{{
+				Block: &ethpb.SignedBeaconBlock{
+					Block: &ethpb.BeaconBlock{Slot: slot, ParentRoot: parent[:]},
+				},
+			}},
+		}, nil)
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.DutiesResponse{}, nil).Times(2)
+
+	block([32]byte{'a'}, slotsPerEpoch-1, [32]byte{'z'})
+	v.head.update(slotsPerEpoch-1, [32]byte{'a'}, roughtime.Now())
+	require.NoError(t, v.UpdateDuties(context.Background(), slotsPerEpoch))
+	// Blocks which do not change the blocks the duties depend on do not fetch them again.
+	block([32]byte{'b'}, slotsPerEpoch, [32]byte{'a'})
+	v.head.update(slotsPerEpoch, [32]byte{'b'}, roughtime.Now())
+	require.NoError(t, v.UpdateDuties(context.Background(), slotsPerEpoch+1))
+	// A reorg to a branch with another last block of the previous epoch fetches them again,
+	// but only once.
+	block([32]byte{'d'}, slotsPerEpoch, [32]byte{'c'})
+	block([32]byte{'c'}, slotsPerEpoch-1, [32]byte{'z'})
+	v.head.update(slotsPerEpoch, [32]byte{'d'}, roughtime.Now())
+	require.NoError(t, v.UpdateDuties(context.Background(), slotsPerEpoch+2))
+	require.NoError(t, v.UpdateDuties(context.Background(), slotsPerEpoch+3))
+	assert.Equal(t, [32]byte{'c'}, v.dutiesDependentRoots.next)
+}
+
+func TestUpdateDuties_FallsBackToNextEpochDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	nextEpochDuties := []*ethpb.DutiesResponse_Duty{
+		{
+			AttesterSlot:   slotsPerEpoch + 5,
+			CommitteeIndex: 2,
+			ProposerSlots:  []uint64{slotsPerEpoch + 1},
+		},
+	}
+	v := validator{
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		duties: &ethpb.DutiesResponse{
+			Duties:          []*ethpb.DutiesResponse_Duty{{AttesterSlot: 5}},
+			NextEpochDuties: nextEpochDuties,
+		},
+	}
+	gomock.InOrder(
+		client.EXPECT().GetDuties(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(nil, errors.New("bad")),
+		client.EXPECT().GetDuties(
+			gomock.Any(),
+			gomock.Any(),
+		).Return(&ethpb.DutiesResponse{Duties: nextEpochDuties}, nil),
+	)
+
+	// The attester duties fetched during the previous epoch are used, and fetched again at the
+	// next slot.
+	require.NoError(t, v.UpdateDuties(context.Background(), slotsPerEpoch))
+	require.Equal(t, 1, len(v.duties.Duties))
+	assert.Equal(t, slotsPerEpoch+5, v.duties.Duties[0].AttesterSlot)
+	assert.Equal(t, 0, len(v.duties.Duties[0].ProposerSlots))
+	assert.Equal(t, 1, len(nextEpochDuties[0].ProposerSlots), "Expected the fetched duties to be left unchanged")
+	require.NoError(t, v.UpdateDuties(context.Background(), slotsPerEpoch+1))
+	assert.Equal(t, false, v.dutiesStale)
+	require.NoError(t, v.UpdateDuties(context.Background(), slotsPerEpoch+2))
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"sync"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+// EventSubscriber can subscribe to the events of the validator client, such as the duties
+// assigned to its validating keys, their outcome and the performance of the keys per epoch.
+type EventSubscriber interface {
+	SubscribeEvents(ch chan<- *validatorpb.ValidatorEvent) event.Subscription
+}
+
+// SubscribeEvents of the validator client. Events are sent to the channel until the
+// subscription is unsubscribed. The channel should be buffered: events are dropped for a
+// subscriber which does not receive them fast enough, so that it never delays the duties.
+func (v *ValidatorService) SubscribeEvents(ch chan<- *validatorpb.ValidatorEvent) event.Subscription {
+	return v.eventFeed.Subscribe(ch)
+}
+
+// eventFeed sends the events of the validator client to its subscribers. Unlike an
+// event.Feed, it never blocks the sender: an event is dropped for the subscribers whose
+// channel is full.
+type eventFeed struct {
+	lock sync.Mutex
+	subs map[*eventSubscription]bool
+}
+
+// eventSubscription to the events of an event feed.
+type eventSubscription struct {
+	feed    *eventFeed
+	ch      chan<- *validatorpb.ValidatorEvent
+	err     chan error
+	once    sync.Once
+	dropped uint64
+}
+
+func newEventFeed() *eventFeed {
+	return &eventFeed{subs: make(map[*eventSubscription]bool)}
+}
+
+// Subscribe to the events of the feed, which are sent to the channel until the subscription
+// is unsubscribed.
+func (f *eventFeed) Subscribe(ch chan<- *validatorpb.ValidatorEvent) event.Subscription {
+	sub := &eventSubscription{
+		feed: f,
+		ch:   ch,
+		err:  make(chan error),
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.subs[sub] = true
+	return sub
+}
+
+// send an event to the subscribers of the feed, dropping it for those which are not ready
+// to receive it.
+func (f *eventFeed) send(ev *validatorpb.ValidatorEvent) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for sub := range f.subs {
+		select {
+		case sub.ch <- ev:
+		default:
+			sub.dropped++
+			eventsDroppedCount.Inc()
+		}
+	}
+}
+
+// Err returns a channel which is closed when the subscription is unsubscribed.
+func (s *eventSubscription) Err() <-chan error {
+	return s.err
+}
+
+// Unsubscribe from the events of the feed.
+func (s *eventSubscription) Unsubscribe() {
+	s.feed.lock.Lock()
+	delete(s.feed.subs, s)
+	dropped := s.dropped
+	s.feed.lock.Unlock()
+	s.once.Do(func() {
+		if dropped > 0 {
+			log.WithField("dropped", dropped).Warn("Events were dropped for a slow subscriber of the validator client")
+		}
+		close(s.err)
+	})
+}
+
+// sendEvent to the subscribers of the event feed of the validator, if it has one.
+func (v *validator) sendEvent(ev *validatorpb.ValidatorEvent) {
+	if v.eventFeed == nil {
+		return
+	}
+	v.eventFeed.send(ev)
+}
+
+// sendDutyAssignments of the validating keys for an epoch.
+func (v *validator) sendDutyAssignments(epoch uint64, duties []*ethpb.DutiesResponse_Duty) {
+	for _, duty := range duties {
+		v.sendEvent(&validatorpb.ValidatorEvent{
+			Event: &validatorpb.ValidatorEvent_DutyAssignment{
+				DutyAssignment: &validatorpb.DutyAssignment{
+					PublicKey:      duty.PublicKey,
+					Epoch:          epoch,
+					ValidatorIndex: duty.ValidatorIndex,
+					AttesterSlot:   duty.AttesterSlot,
+					CommitteeIndex: duty.CommitteeIndex,
+					ProposerSlots:  duty.ProposerSlots,
+					Status:         duty.Status.String(),
+				},
+			},
+		})
+	}
+}
+
+// sendDutyOutcome of a duty of a validating key, with the error which made it fail, if any.
+func (v *validator) sendDutyOutcome(
+	pubKey [48]byte,
+	slot uint64,
+	duty validatorpb.DutyOutcome_Duty,
+	outcome validatorpb.DutyOutcome_Outcome,
+	err error,
+) {
+	if v.eventFeed == nil {
+		return
+	}
+	ev := &validatorpb.DutyOutcome{
+		PublicKey: pubKey[:],
+		Slot:      slot,
+		Duty:      duty,
+		Outcome:   outcome,
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	v.sendEvent(&validatorpb.ValidatorEvent{
+		Event: &validatorpb.ValidatorEvent_DutyOutcome{DutyOutcome: ev},
+	})
+}
+
+// sendEpochPerformance of the validating key at index i of a validator performance response.
+func (v *validator) sendEpochPerformance(epoch uint64, resp *ethpb.ValidatorPerformanceResponse, i int) {
+	if v.eventFeed == nil {
+		return
+	}
+	var effectiveness float64
+	if resp.InclusionSlots[i] != ^uint64(0) && resp.InclusionDistances[i] > 0 {
+		effectiveness = 1 / float64(resp.InclusionDistances[i])
+	}
+	v.sendEvent(&validatorpb.ValidatorEvent{
+		Event: &validatorpb.ValidatorEvent_EpochPerformance{
+			EpochPerformance: &validatorpb.EpochPerformance{
+				PublicKey:            resp.PublicKeys[i],
+				Epoch:                epoch,
+				BalanceBefore:        resp.BalancesBeforeEpochTransition[i],
+				BalanceAfter:         resp.BalancesAfterEpochTransition[i],
+				CorrectlyVotedSource: resp.CorrectlyVotedSource[i],
+				CorrectlyVotedTarget: resp.CorrectlyVotedTarget[i],
+				CorrectlyVotedHead:   resp.CorrectlyVotedHead[i],
+				InclusionSlot:        resp.InclusionSlots[i],
+				InclusionDistance:    resp.InclusionDistances[i],
+				Effectiveness:        effectiveness,
+			},
+		},
+	})
+}
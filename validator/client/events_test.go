@@ -0,0 +1,114 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSendDutyOutcome(t *testing.T) {
+	v := &validator{eventFeed: newEventFeed()}
+	events := make(chan *validatorpb.ValidatorEvent, 1)
+	sub := v.eventFeed.Subscribe(events)
+	defer sub.Unsubscribe()
+
+	pubKey := [48]byte{1, 2, 3}
+	v.sendDutyOutcome(pubKey, 10, validatorpb.DutyOutcome_ATTESTATION, validatorpb.DutyOutcome_REJECTED_BY_PROTECTION, errors.New("slashable"))
+	ev := (<-events).GetDutyOutcome()
+	require.NotNil(t, ev)
+	assert.DeepEqual(t, pubKey[:], ev.PublicKey)
+	assert.Equal(t, uint64(10), ev.Slot)
+	assert.Equal(t, validatorpb.DutyOutcome_ATTESTATION, ev.Duty)
+	assert.Equal(t, validatorpb.DutyOutcome_REJECTED_BY_PROTECTION, ev.Outcome)
+	assert.Equal(t, "slashable", ev.Error)
+}
+
+func TestSendDutyOutcome_NoFeed(t *testing.T) {
+	v := &validator{}
+	// Does not panic without subscribers of the validator client events.
+	v.sendDutyOutcome([48]byte{}, 10, validatorpb.DutyOutcome_PROPOSAL, validatorpb.DutyOutcome_SUCCEEDED, nil)
+	v.sendDutyAssignments(1, []*ethpb.DutiesResponse_Duty{{PublicKey: []byte{1}}})
+}
+
+func TestSendDutyAssignments(t *testing.T) {
+	v := &validator{eventFeed: newEventFeed()}
+	events := make(chan *validatorpb.ValidatorEvent, 1)
+	sub := v.eventFeed.Subscribe(events)
+	defer sub.Unsubscribe()
+
+	v.sendDutyAssignments(3, []*ethpb.DutiesResponse_Duty{
+		{
+			PublicKey:      []byte{1},
+			ValidatorIndex: 7,
+			AttesterSlot:   100,
+			CommitteeIndex: 2,
+			ProposerSlots:  []uint64{101},
+			Status:         ethpb.ValidatorStatus_ACTIVE,
+		},
+	})
+	ev := (<-events).GetDutyAssignment()
+	require.NotNil(t, ev)
+	assert.Equal(t, uint64(3), ev.Epoch)
+	assert.Equal(t, uint64(7), ev.ValidatorIndex)
+	assert.Equal(t, uint64(100), ev.AttesterSlot)
+	assert.Equal(t, uint64(2), ev.CommitteeIndex)
+	assert.DeepEqual(t, []uint64{101}, ev.ProposerSlots)
+	assert.Equal(t, "ACTIVE", ev.Status)
+}
+
+func TestSendEpochPerformance(t *testing.T) {
+	v := &validator{eventFeed: newEventFeed()}
+	events := make(chan *validatorpb.ValidatorEvent, 2)
+	sub := v.eventFeed.Subscribe(events)
+	defer sub.Unsubscribe()
+
+	resp := &ethpb.ValidatorPerformanceResponse{
+		PublicKeys:                    [][]byte{{1}, {2}},
+		BalancesBeforeEpochTransition: []uint64{32000000000, 32000000000},
+		BalancesAfterEpochTransition:  []uint64{32000010000, 31999990000},
+		CorrectlyVotedSource:          []bool{true, false},
+		CorrectlyVotedTarget:          []bool{true, false},
+		CorrectlyVotedHead:            []bool{false, false},
+		InclusionSlots:                []uint64{34, ^uint64(0)},
+		InclusionDistances:            []uint64{2, 0},
+	}
+	v.sendEpochPerformance(4, resp, 0)
+	v.sendEpochPerformance(4, resp, 1)
+
+	included := (<-events).GetEpochPerformance()
+	require.NotNil(t, included)
+	assert.Equal(t, uint64(4), included.Epoch)
+	assert.Equal(t, uint64(32000010000), included.BalanceAfter)
+	assert.Equal(t, true, included.CorrectlyVotedTarget)
+	assert.Equal(t, 0.5, included.Effectiveness)
+
+	missed := (<-events).GetEpochPerformance()
+	require.NotNil(t, missed)
+	assert.Equal(t, uint64(31999990000), missed.BalanceAfter)
+	assert.Equal(t, 0.0, missed.Effectiveness)
+}
+
+func TestSendEvent_DoesNotBlockOnSlowSubscriber(t *testing.T) {
+	v := &validator{eventFeed: newEventFeed()}
+	slow := make(chan *validatorpb.ValidatorEvent, 1)
+	slowSub := v.eventFeed.Subscribe(slow)
+	events := make(chan *validatorpb.ValidatorEvent, 3)
+	sub := v.eventFeed.Subscribe(events)
+	defer sub.Unsubscribe()
+
+	// The slow subscriber never receives, so its buffer is full after the first event.
+	v.sendDutyAssignments(1, []*ethpb.DutiesResponse_Duty{{PublicKey: []byte{1}}, {PublicKey: []byte{2}}, {PublicKey: []byte{3}}})
+	assert.Equal(t, 3, len(events))
+	assert.Equal(t, 1, len(slow))
+	assert.Equal(t, uint64(2), slowSub.(*eventSubscription).dropped)
+
+	slowSub.Unsubscribe()
+	_, ok := <-slowSub.Err()
+	assert.Equal(t, false, ok)
+	slowSub.Unsubscribe()
+	assert.Equal(t, 1, len(v.eventFeed.subs))
+}
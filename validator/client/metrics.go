@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -106,6 +107,36 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorCorrectlyVotedHeadRatio used to track the share of the included attestations of
+	// the previous epoch which voted for the correct head, by whether attesting timely is enabled.
+	ValidatorCorrectlyVotedHeadRatio = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "correctly_voted_head_ratio",
+			Help:      "Share of the included attestations of the previous epoch which voted for the correct head.",
+		},
+		[]string{
+			"attest_timely",
+		},
+	)
+	attestationTriggerCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "attestation_triggers_total",
+			Help:      "Count the attestations made as soon as the block of their slot was processed, or at their deadline.",
+		},
+		[]string{
+			"trigger",
+		},
+	)
+	attestationDelaySeconds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "validator",
+			Name:      "attestation_delay_seconds",
+			Help:      "Time into the slot at which the data of attestations is requested.",
+			Buckets:   []float64{0.5, 1, 2, 3, 4, 5, 6, 8, 12},
+		},
+	)
 	// ValidatorAttestFailVecSlasher used to count failed attestations by slashing protection.
 	ValidatorAttestFailVecSlasher = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -116,6 +147,67 @@ var (
 			"pubkey",
 		},
 	)
+	eventsDroppedCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "events_dropped_total",
+			Help:      "Count the events of the validator client dropped for subscribers which did not receive them fast enough.",
+		},
+	)
+	headReorgsCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "head_reorgs_total",
+			Help:      "Count the reorgs of the head of the beacon node noticed by the validator client.",
+		},
+	)
+	dutiesUpdatesCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "duties_updates_total",
+			Help:      "Count the updates of the duties, by why they were fetched.",
+		},
+		[]string{
+			"reason",
+		},
+	)
+	dutiesEpochGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "duties_epoch",
+			Help:      "Epoch of the current duties, whose next epoch duties are also known.",
+		},
+	)
+	dutiesUpdatedTimestamp = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "duties_updated_timestamp_seconds",
+			Help:      "Unix time at which the duties were last fetched.",
+		},
+	)
+	dutiesProposalsGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "duties_proposals",
+			Help:      "Number of blocks to propose, in the current and next epochs.",
+		},
+		[]string{
+			"epoch",
+		},
+	)
+	// ValidatorAttesterSlotGaugeVec used to track the attester slot of the current and next
+	// epoch duties by public key.
+	ValidatorAttesterSlotGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "attester_slot",
+			Help:      "Slot of the attestation duty, in the current and next epochs.",
+		},
+		[]string{
+			"pubkey",
+			"epoch",
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
@@ -203,6 +295,7 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 			}
 		}
 		v.prevBalance[pubKeyBytes] = resp.BalancesBeforeEpochTransition[i]
+		v.sendEpochPerformance(prevEpoch, resp, i)
 	}
 	v.prevBalanceLock.Unlock()
 
@@ -248,6 +341,11 @@ func (v *validator) UpdateLogAggregateStats(resp *ethpb.ValidatorPerformanceResp
 		"correctlyVotedTargetPct": fmt.Sprintf("%.0f%%", (float64(correctTarget)/float64(included))*100),
 		"correctlyVotedHeadPct":   fmt.Sprintf("%.0f%%", (float64(correctHead)/float64(included))*100),
 	}).Info("Previous epoch aggregated voting summary")
+	if included > 0 {
+		ValidatorCorrectlyVotedHeadRatio.WithLabelValues(
+			strconv.FormatBool(featureconfig.Get().AttestTimely),
+		).Set(float64(correctHead) / float64(included))
+	}
 
 	var totalStartBal, totalPrevBal uint64
 	for i, val := range v.startBalances {
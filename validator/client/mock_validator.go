@@ -15,6 +15,7 @@ type FakeValidator struct {
 	DoneCalled                        bool
 	WaitForWalletInitializationCalled bool
 	WaitForActivationCalled           bool
+	CheckDoppelgangerCalled           bool
 	WaitForChainStartCalled           bool
 	WaitForSyncCalled                 bool
 	WaitForSyncedCalled               bool
@@ -22,12 +23,10 @@ type FakeValidator struct {
 	NextSlotCalled                    bool
 	CanonicalHeadSlotCalled           bool
 	UpdateDutiesCalled                bool
-	UpdateProtectionsCalled           bool
 	RoleAtCalled                      bool
 	AttestToBlockHeadCalled           bool
 	ProposeBlockCalled                bool
 	LogValidatorGainsAndLossesCalled  bool
-	SaveProtectionsCalled             bool
 	SlotDeadlineCalled                bool
 	ProposeBlockArg1                  uint64
 	AttestToBlockHeadArg1             uint64
@@ -54,6 +53,12 @@ func (fv *FakeValidator) WaitForWalletInitialization(_ context.Context) error {
 	return nil
 }
 
+// CheckDoppelganger for mocking.
+func (fv *FakeValidator) CheckDoppelganger(_ context.Context) error {
+	fv.CheckDoppelgangerCalled = true
+	return nil
+}
+
 // WaitForChainStart for mocking.
 func (fv *FakeValidator) WaitForChainStart(_ context.Context) error {
 	fv.WaitForChainStartCalled = true
@@ -109,24 +114,12 @@ func (fv *FakeValidator) UpdateDuties(_ context.Context, slot uint64) error {
 	return fv.UpdateDutiesRet
 }
 
-// UpdateProtections for mocking.
-func (fv *FakeValidator) UpdateProtections(_ context.Context, slot uint64) error {
-	fv.UpdateProtectionsCalled = true
-	return nil
-}
-
 // LogValidatorGainsAndLosses for mocking.
 func (fv *FakeValidator) LogValidatorGainsAndLosses(_ context.Context, slot uint64) error {
 	fv.LogValidatorGainsAndLossesCalled = true
 	return nil
 }
 
-// SaveProtections for mocking.
-func (fv *FakeValidator) SaveProtections(_ context.Context) error {
-	fv.SaveProtectionsCalled = true
-	return nil
-}
-
 // RolesAt for mocking.
 func (fv *FakeValidator) RolesAt(_ context.Context, slot uint64) (map[[48]byte][]ValidatorRole, error) {
 	fv.RoleAtCalled = true
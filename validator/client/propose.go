@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/validator/audit"
 	km "github.com/prysmaticlabs/prysm/validator/keymanager/v1"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -34,6 +35,7 @@ const signExitErr = "could not sign voluntary exit proposal"
 func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]byte) {
 	if slot == 0 {
 		log.Debug("Assigned to genesis slot, skipping proposal")
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_PROPOSAL, validatorpb.DutyOutcome_SKIPPED, errors.New("genesis slot"))
 		return
 	}
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeBlock")
@@ -51,6 +53,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_PROPOSAL, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -58,28 +61,47 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	b, err := v.validatorClient.GetBlock(ctx, &ethpb.BlockRequest{
 		Slot:         slot,
 		RandaoReveal: randaoReveal,
-		Graffiti:     v.graffiti,
+		Graffiti:     v.graffitiFor(pubKey),
 	})
 	if err != nil {
 		log.WithField("blockSlot", slot).WithError(err).Error("Failed to request block from beacon node")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_PROPOSAL, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
-	if err := v.preBlockSignValidations(ctx, pubKey, b); err != nil {
+	domain, signingRoot, err := v.getBlockDomainAndSigningRoot(ctx, epoch, b)
+	if err != nil {
+		log.WithError(err).Error("Failed to compute block signing root")
+		if v.emitAccountMetrics {
+			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_PROPOSAL, validatorpb.DutyOutcome_FAILED, err)
+		return
+	}
+
+	if err := v.preBlockSignValidations(ctx, pubKey, b, signingRoot); err != nil {
 		log.WithField("slot", b.Slot).WithError(err).Error("Failed block safety check")
+		v.recordSigning(newAuditEntry(
+			audit.BlockType, pubKey, b.Slot, epoch, signingRoot, domain.SignatureDomain, audit.ProtectionRejected,
+		), nil, err)
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_PROPOSAL, validatorpb.DutyOutcome_REJECTED_BY_PROTECTION, err)
 		return
 	}
 
 	// Sign returned block from beacon node
-	sig, err := v.signBlock(ctx, pubKey, epoch, b)
+	sig, err := v.signBlock(ctx, pubKey, domain, signingRoot, b)
+	v.recordSigning(newAuditEntry(
+		audit.BlockType, pubKey, b.Slot, epoch, signingRoot, domain.SignatureDomain, audit.ProtectionAllowed,
+	), sig, err)
 	if err != nil {
 		log.WithError(err).Error("Failed to sign block")
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_PROPOSAL, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 	blk := &ethpb.SignedBeaconBlock{
@@ -89,6 +111,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 
 	if err := v.postBlockSignUpdate(ctx, pubKey, blk); err != nil {
 		log.WithField("slot", blk.Block.Slot).WithError(err).Error("Failed post block signing validations")
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_PROPOSAL, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -99,6 +122,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		if v.emitAccountMetrics {
 			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_PROPOSAL, validatorpb.DutyOutcome_FAILED, err)
 		return
 	}
 
@@ -120,6 +144,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	if v.emitAccountMetrics {
 		ValidatorProposeSuccessVec.WithLabelValues(fmtKey).Inc()
 	}
+	v.sendDutyOutcome(pubKey, slot, validatorpb.DutyOutcome_PROPOSAL, validatorpb.DutyOutcome_SUCCEEDED, nil)
 }
 
 // ProposeExit performs a voluntary exit on a validator.
@@ -145,13 +170,10 @@ func ProposeExit(
 	totalSecondsPassed := roughtime.Now().Unix() - genesisResponse.GenesisTime.Seconds
 	currentEpoch := uint64(totalSecondsPassed) / (params.BeaconConfig().SecondsPerSlot * params.BeaconConfig().SlotsPerEpoch)
 
-	exit := &ethpb.VoluntaryExit{Epoch: currentEpoch, ValidatorIndex: indexResponse.Index}
-	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit)
+	signedExit, err := SignExit(ctx, validatorClient, signer, pubKey, indexResponse.Index, currentEpoch)
 	if err != nil {
-		return errors.Wrap(err, "failed to sign voluntary exit")
+		return err
 	}
-
-	signedExit := &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}
 	exitResp, err := validatorClient.ProposeExit(ctx, signedExit)
 	if err != nil {
 		return errors.Wrap(err, "failed to propose voluntary exit")
@@ -164,6 +186,24 @@ func ProposeExit(
 	return nil
 }
 
+// SignExit signs a voluntary exit of a validator for an epoch, which can be in the future so
+// that the exit is stored and proposed later.
+func SignExit(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	signer signingFunc,
+	pubKey []byte,
+	validatorIndex uint64,
+	epoch uint64,
+) (*ethpb.SignedVoluntaryExit, error) {
+	exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: validatorIndex}
+	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign voluntary exit")
+	}
+	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}, nil
+}
+
 // Sign randao reveal with randao domain and private key.
 func (v *validator) signRandaoReveal(ctx context.Context, pubKey [48]byte, epoch uint64) ([]byte, error) {
 	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainRandao[:])
@@ -174,47 +214,61 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [48]byte, epoch
 		return nil, errors.New(domainDataErr)
 	}
 
+	root, err := helpers.ComputeSigningRoot(epoch, domain.SignatureDomain)
+	if err != nil {
+		return nil, err
+	}
 	var randaoReveal bls.Signature
 	if featureconfig.Get().EnableAccountsV2 {
-		root, err := helpers.ComputeSigningRoot(epoch, domain.SignatureDomain)
-		if err != nil {
-			return nil, err
-		}
 		randaoReveal, err = v.keyManagerV2.Sign(ctx, &validatorpb.SignRequest{
 			PublicKey:       pubKey[:],
 			SigningRoot:     root[:],
 			SignatureDomain: domain.SignatureDomain,
 			Object:          &validatorpb.SignRequest_Epoch{Epoch: epoch},
 		})
-		if err != nil {
-			return nil, err
-		}
 	} else {
 		randaoReveal, err = v.signObject(ctx, pubKey, epoch, domain.SignatureDomain)
 		if err != nil {
-			return nil, errors.Wrap(err, "could not sign reveal")
+			err = errors.Wrap(err, "could not sign reveal")
 		}
 	}
+	entry := newAuditEntry(audit.RandaoRevealType, pubKey, 0, epoch, root, domain.SignatureDomain, audit.ProtectionNotApplicable)
+	if err != nil {
+		v.recordSigning(entry, nil, err)
+		return nil, err
+	}
+	v.recordSigning(entry, randaoReveal.Marshal(), nil)
 
 	return randaoReveal.Marshal(), nil
 }
 
-// Sign block with proposer domain and private key.
-func (v *validator) signBlock(ctx context.Context, pubKey [48]byte, epoch uint64, b *ethpb.BeaconBlock) ([]byte, error) {
+// getBlockDomainAndSigningRoot returns the proposer domain and the signing root of a block.
+func (v *validator) getBlockDomainAndSigningRoot(ctx context.Context, epoch uint64, b *ethpb.BeaconBlock) (*ethpb.DomainResponse, [32]byte, error) {
 	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainBeaconProposer[:])
 	if err != nil {
-		return nil, errors.Wrap(err, domainDataErr)
+		return nil, [32]byte{}, errors.Wrap(err, domainDataErr)
 	}
 	if domain == nil {
-		return nil, errors.New(domainDataErr)
+		return nil, [32]byte{}, errors.New(domainDataErr)
+	}
+	blockRoot, err := helpers.ComputeSigningRoot(b, domain.SignatureDomain)
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, signingRootErr)
 	}
+	return domain, blockRoot, nil
+}
 
+// Sign block with proposer domain and private key, given its previously computed signing root.
+func (v *validator) signBlock(
+	ctx context.Context,
+	pubKey [48]byte,
+	domain *ethpb.DomainResponse,
+	blockRoot [32]byte,
+	b *ethpb.BeaconBlock,
+) ([]byte, error) {
 	var sig bls.Signature
+	var err error
 	if featureconfig.Get().EnableAccountsV2 {
-		blockRoot, err := helpers.ComputeSigningRoot(b, domain.SignatureDomain)
-		if err != nil {
-			return nil, errors.Wrap(err, signingRootErr)
-		}
 		sig, err = v.keyManagerV2.Sign(ctx, &validatorpb.SignRequest{
 			PublicKey:       pubKey[:],
 			SigningRoot:     blockRoot[:],
@@ -243,10 +297,6 @@ func (v *validator) signBlock(ctx context.Context, pubKey [48]byte, epoch uint64
 			return nil, errors.Wrap(err, "could not sign block proposal")
 		}
 	} else {
-		blockRoot, err := helpers.ComputeSigningRoot(b, domain.SignatureDomain)
-		if err != nil {
-			return nil, errors.Wrap(err, signingRootErr)
-		}
 		sig, err = v.keyManager.Sign(ctx, pubKey, blockRoot)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not sign block proposal")
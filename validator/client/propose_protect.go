@@ -6,34 +6,28 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/blockutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
-	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 )
 
 var failedPreBlockSignLocalErr = "attempted to sign a double proposal, block rejected by local protection"
 var failedPreBlockSignExternalErr = "attempted a double proposal, block rejected by remote slashing protection"
 var failedPostBlockSignErr = "made a double proposal, considered slashable by remote slashing protection"
 
-func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte, block *ethpb.BeaconBlock) error {
+// preBlockSignValidations checks the block is safe to sign. With local protection, the
+// proposal and its signing root are recorded in the database before the signature is made.
+func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte, block *ethpb.BeaconBlock, signingRoot [32]byte) error {
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
-	epoch := helpers.SlotToEpoch(block.Slot)
 	if featureconfig.Get().LocalProtection {
-		slotBits, err := v.db.ProposalHistoryForEpoch(ctx, pubKey[:], epoch)
-		if err != nil {
+		if err := v.db.CheckAndSaveProposal(ctx, pubKey, signingRoot, block.Slot); err != nil {
 			if v.emitAccountMetrics {
 				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 			}
-			return errors.Wrap(err, "failed to get proposal history")
-		}
-
-		// If the bit for the current slot is marked, do not propose.
-		if slotBits.BitAt(block.Slot % params.BeaconConfig().SlotsPerEpoch) {
-			if v.emitAccountMetrics {
-				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+			if err == kv.ErrSlashableProposal {
+				return errors.New(failedPreBlockSignLocalErr)
 			}
-			return errors.New(failedPreBlockSignLocalErr)
+			return errors.Wrap(err, "failed to record proposal in proposal history")
 		}
 	}
 
@@ -55,7 +49,6 @@ func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte
 
 func (v *validator) postBlockSignUpdate(ctx context.Context, pubKey [48]byte, block *ethpb.SignedBeaconBlock) error {
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
-	epoch := helpers.SlotToEpoch(block.Block.Slot)
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
 		sbh, err := blockutil.SignedBeaconBlockHeaderFromBlock(block)
 		if err != nil {
@@ -73,21 +66,5 @@ func (v *validator) postBlockSignUpdate(ctx context.Context, pubKey [48]byte, bl
 		}
 	}
 
-	if featureconfig.Get().LocalProtection {
-		slotBits, err := v.db.ProposalHistoryForEpoch(ctx, pubKey[:], epoch)
-		if err != nil {
-			if v.emitAccountMetrics {
-				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
-			}
-			return errors.Wrap(err, "failed to get proposal history")
-		}
-		slotBits.SetBitAt(block.Block.Slot%params.BeaconConfig().SlotsPerEpoch, true)
-		if err := v.db.SaveProposalHistoryForEpoch(ctx, pubKey[:], epoch, slotBits); err != nil {
-			if v.emitAccountMetrics {
-				ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
-			}
-			return errors.Wrap(err, "failed to save updated proposal history")
-		}
-	}
 	return nil
 }
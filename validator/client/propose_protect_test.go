@@ -26,10 +26,10 @@ func TestPreBlockSignValidation(t *testing.T) {
 	}
 	mockProtector := &mockSlasher.MockProtector{AllowBlock: false}
 	validator.protector = mockProtector
-	err := validator.preBlockSignValidations(context.Background(), validatorPubKey, block)
+	err := validator.preBlockSignValidations(context.Background(), validatorPubKey, block, [32]byte{1})
 	require.ErrorContains(t, failedPreBlockSignExternalErr, err)
 	mockProtector.AllowBlock = true
-	err = validator.preBlockSignValidations(context.Background(), validatorPubKey, block)
+	err = validator.preBlockSignValidations(context.Background(), validatorPubKey, block, [32]byte{1})
 	require.NoError(t, err, "Expected allowed attestation not to throw error")
 }
 
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,9 +11,9 @@ import (
 	"github.com/golang/mock/gomock"
 	lru "github.com/hashicorp/golang-lru"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -20,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -60,14 +62,6 @@ func setup(t *testing.T) (*validator, *mocks, func()) {
 
 	aggregatedSlotCommitteeIDCache, err := lru.New(int(params.BeaconConfig().MaxCommitteesPerSlot))
 	require.NoError(t, err)
-	cleanMap := make(map[uint64]uint64)
-	cleanMap[0] = params.BeaconConfig().FarFutureEpoch
-	clean := &slashpb.AttestationHistory{
-		TargetToSource: cleanMap,
-	}
-	attHistoryByPubKey := make(map[[48]byte]*slashpb.AttestationHistory)
-	attHistoryByPubKey[validatorPubKey] = clean
-
 	validator := &validator{
 		db:                             valDB,
 		validatorClient:                m.validatorClient,
@@ -75,7 +69,6 @@ func setup(t *testing.T) (*validator, *mocks, func()) {
 		graffiti:                       []byte{},
 		attLogs:                        make(map[[32]byte]*attSubmitted),
 		aggregatedSlotCommitteeIDCache: aggregatedSlotCommitteeIDCache,
-		attesterHistoryByPubKey:        attHistoryByPubKey,
 	}
 
 	return validator, m, ctrl.Finish
@@ -184,12 +177,19 @@ func TestProposeBlock_BlocksDoubleProposal(t *testing.T) {
 	m.validatorClient.EXPECT().GetBlock(
 		gomock.Any(), // ctx
 		gomock.Any(),
-	).Times(2).Return(testutil.NewBeaconBlock().Block, nil /*err*/)
+	).Return(testutil.NewBeaconBlock().Block, nil /*err*/)
+
+	secondBlock := testutil.NewBeaconBlock()
+	secondBlock.Block.Body.Graffiti = bytesutil.PadTo([]byte("other"), 32)
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(secondBlock.Block, nil /*err*/)
 
 	m.validatorClient.EXPECT().DomainData(
 		gomock.Any(), // ctx
 		gomock.Any(), //epoch
-	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	).Times(2).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
 
 	m.validatorClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
@@ -222,12 +222,19 @@ func TestProposeBlock_BlocksDoubleProposal_After54KEpochs(t *testing.T) {
 	m.validatorClient.EXPECT().GetBlock(
 		gomock.Any(), // ctx
 		gomock.Any(),
-	).Times(2).Return(testutil.NewBeaconBlock().Block, nil /*err*/)
+	).Return(testutil.NewBeaconBlock().Block, nil /*err*/)
+
+	secondBlock := testutil.NewBeaconBlock()
+	secondBlock.Block.Body.Graffiti = bytesutil.PadTo([]byte("other"), 32)
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).Return(secondBlock.Block, nil /*err*/)
 
 	m.validatorClient.EXPECT().DomainData(
 		gomock.Any(), // ctx
 		gomock.Any(), //epoch
-	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+	).Times(2).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
 
 	m.validatorClient.EXPECT().ProposeBlock(
 		gomock.Any(), // ctx
@@ -401,6 +408,48 @@ func TestProposeBlock_BroadcastsBlock_WithGraffiti(t *testing.T) {
 	assert.Equal(t, string(validator.graffiti), string(sentBlock.Block.Body.Graffiti))
 }
 
+func TestProposeBlock_BroadcastsBlock_WithKeyGraffiti(t *testing.T) {
+	validator, m, finish := setup(t)
+	defer finish()
+
+	validator.graffiti = []byte("flag")
+	keyGraffiti := "customer a"
+	config, err := keyconfig.NewStore(&keyconfig.File{
+		Validators: map[string]*keyconfig.Options{
+			fmt.Sprintf("%#x", validatorPubKey): {Graffiti: &keyGraffiti},
+		},
+	})
+	require.NoError(t, err)
+	validator.keyConfig = config
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	var req *ethpb.BlockRequest
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, r *ethpb.BlockRequest) (*ethpb.BeaconBlock, error) {
+		req = r
+		return testutil.NewBeaconBlock().Block, nil
+	})
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	m.validatorClient.EXPECT().ProposeBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.SignedBeaconBlock{}),
+	).Return(&ethpb.ProposeResponse{BlockRoot: make([]byte, 32)}, nil /*error*/)
+
+	validator.ProposeBlock(context.Background(), 1, validatorPubKey)
+	assert.Equal(t, keyGraffiti, string(req.Graffiti))
+}
+
 func TestProposeExit_ValidatorIndexFailed(t *testing.T) {
 	_, m, finish := setup(t)
 	defer finish()
@@ -547,3 +596,20 @@ func TestProposeExit_BroadcastsBlock(t *testing.T) {
 
 	assert.NoError(t, ProposeExit(context.Background(), m.validatorClient, m.nodeClient, m.signExitFunc, validatorPubKey[:]))
 }
+
+func TestSignExit_FutureEpoch(t *testing.T) {
+	_, m, finish := setup(t)
+	defer finish()
+
+	m.validatorClient.EXPECT().
+		DomainData(gomock.Any(), &ethpb.DomainRequest{
+			Epoch:  100,
+			Domain: params.BeaconConfig().DomainVoluntaryExit[:],
+		}).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
+
+	signedExit, err := SignExit(context.Background(), m.validatorClient, m.signExitFunc, validatorPubKey[:], 3, 100)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), signedExit.Exit.Epoch)
+	assert.Equal(t, uint64(3), signedExit.Exit.ValidatorIndex)
+}
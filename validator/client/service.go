@@ -24,7 +24,9 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/accounts/v2/iface"
+	"github.com/prysmaticlabs/prysm/validator/audit"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
 	keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v1"
 	v2 "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
@@ -50,17 +52,28 @@ type GenesisFetcher interface {
 	GenesisInfo(ctx context.Context) (*ethpb.Genesis, error)
 }
 
+// BeaconNodeFetcher can retrieve the clients of the beacon nodes the validator client is
+// connected to, to submit messages signed outside of its duties such as voluntary exits.
+type BeaconNodeFetcher interface {
+	BeaconNodeClients() (ethpb.BeaconNodeValidatorClient, ethpb.NodeClient, error)
+}
+
 // ValidatorService represents a service to manage the validator client
 // routine.
 type ValidatorService struct {
 	useWeb                bool
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
-	conn                  *grpc.ClientConn
+	doppelgangerEpochs    uint64
+	auditLog              *audit.Log
+	broadcast             bool
+	conns                 []*grpc.ClientConn
+	beaconNodes           *failoverClient
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
 	walletInitializedFeed *event.Feed
+	eventFeed             *eventFeed
 	cancel                context.CancelFunc
 	db                    db.Database
 	keyManager            keymanager.KeyManager
@@ -73,6 +86,7 @@ type ValidatorService struct {
 	keyManagerV2          v2.IKeymanager
 	grpcHeaders           []string
 	graffiti              []byte
+	keyConfig             *keyconfig.Store
 }
 
 // Config for the validator service.
@@ -86,11 +100,15 @@ type Config struct {
 	GrpcMaxCallRecvMsgSizeFlag int
 	Protector                  slashingprotection.Protector
 	Endpoint                   string
+	BroadcastToBeaconNodes     bool
+	DoppelgangerEpochs         uint64
+	AuditLog                   *audit.Log
 	Validator                  Validator
 	ValDB                      db.Database
 	KeyManagerV2               v2.IKeymanager
 	KeyManager                 keymanager.KeyManager
 	GraffitiFlag               string
+	KeyConfig                  *keyconfig.Store
 	CertFlag                   string
 	DataDir                    string
 	GrpcHeadersFlag            string
@@ -104,9 +122,13 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
+		broadcast:             cfg.BroadcastToBeaconNodes,
+		doppelgangerEpochs:    cfg.DoppelgangerEpochs,
+		auditLog:              cfg.AuditLog,
 		withCert:              cfg.CertFlag,
 		dataDir:               cfg.DataDir,
 		graffiti:              []byte(cfg.GraffitiFlag),
+		keyConfig:             cfg.KeyConfig,
 		keyManager:            cfg.KeyManager,
 		keyManagerV2:          cfg.KeyManagerV2,
 		logValidatorBalances:  cfg.LogValidatorBalances,
@@ -119,6 +141,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		validator:             cfg.Validator,
 		db:                    cfg.ValDB,
 		walletInitializedFeed: cfg.WalletInitializedFeed,
+		eventFeed:             newEventFeed(),
 		useWeb:                cfg.UseWeb,
 	}, nil
 }
@@ -142,16 +165,23 @@ func (v *ValidatorService) Start() {
 	if dialOpts == nil {
 		return
 	}
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+	conns, nodes, err := dialBeaconNodes(v.ctx, v.endpoint, dialOpts)
 	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+		log.Errorf("Could not dial beacon nodes: %v", err)
 		return
 	}
+	v.conns = conns
 	if v.withCert != "" {
 		log.Info("Established secure gRPC connection")
 	}
+	beaconNodes, err := newFailoverClient(nodes, v.broadcast)
+	if err != nil {
+		log.Errorf("Could not connect to beacon nodes: %v", err)
+		return
+	}
+	beaconNodes.checkHealth(v.ctx)
+	v.beaconNodes = beaconNodes
 
-	v.conn = conn
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -167,14 +197,15 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
-	v.validator = &validator{
+	val := &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
-		node:                           ethpb.NewNodeClient(v.conn),
+		validatorClient:                beaconNodes,
+		beaconClient:                   beaconNodes.beaconChainClient(),
+		node:                           beaconNodes.nodeClient(),
 		keyManager:                     v.keyManager,
 		keyManagerV2:                   v.keyManagerV2,
 		graffiti:                       v.graffiti,
+		keyConfig:                      v.keyConfig,
 		logValidatorBalances:           v.logValidatorBalances,
 		emitAccountMetrics:             v.emitAccountMetrics,
 		startBalances:                  make(map[[48]byte]uint64),
@@ -186,24 +217,36 @@ func (v *ValidatorService) Start() {
 		voteStats:                      voteStats{startEpoch: ^uint64(0)},
 		useWeb:                         v.useWeb,
 		walletInitializedFeed:          v.walletInitializedFeed,
+		eventFeed:                      v.eventFeed,
+		doppelgangerEpochs:             v.doppelgangerEpochs,
+		auditLog:                       v.auditLog,
 	}
+	// The head is followed whether attesting timely or not, as the duties are queried again
+	// when the blocks they depend on change.
+	val.head = newHeadTracker()
+	go val.streamChainHead(v.ctx)
+	v.validator = val
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
+	go beaconNodes.run(v.ctx)
 }
 
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
-	if v.conn != nil {
-		return v.conn.Close()
+	var closeErr error
+	for _, conn := range v.conns {
+		if err := conn.Close(); err != nil {
+			closeErr = err
+		}
 	}
-	return nil
+	return closeErr
 }
 
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
+	if len(v.conns) == 0 {
 		return errors.New("no connection to beacon RPC")
 	}
 	return nil
@@ -344,10 +387,9 @@ func ConstructDialOptions(
 	return dialOpts
 }
 
-// Syncing returns whether or not the beacon node is currently synchronizing the chain.
+// Syncing returns whether or not the active beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	resp, err := nc.GetSyncStatus(ctx, &ptypes.Empty{})
+	resp, err := v.beaconNodes.nodeClient().GetSyncStatus(ctx, &ptypes.Empty{})
 	if err != nil {
 		return false, err
 	}
@@ -357,8 +399,30 @@ func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
 // GenesisInfo queries the beacon node for the chain genesis info containing
 // the genesis time along with the validator deposit contract address.
 func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	return nc.GetGenesis(ctx, &ptypes.Empty{})
+	return v.beaconNodes.nodeClient().GetGenesis(ctx, &ptypes.Empty{})
+}
+
+// BeaconNodeClients returns the validator and node clients of the beacon nodes, which route
+// calls to the active beacon node and fail over to the next ones. The validator client
+// broadcasts signed objects to every healthy beacon node if enabled.
+func (v *ValidatorService) BeaconNodeClients() (ethpb.BeaconNodeValidatorClient, ethpb.NodeClient, error) {
+	if v.beaconNodes == nil {
+		return nil, nil, errors.New("no connection to beacon RPC")
+	}
+	return v.beaconNodes, v.beaconNodes.nodeClient(), nil
+}
+
+// splitEndpoints returns the beacon node endpoints of a comma-separated list, by order
+// of preference.
+func splitEndpoints(endpoints string) []string {
+	split := strings.Split(endpoints, ",")
+	res := make([]string, 0, len(split))
+	for _, endpoint := range split {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			res = append(res, endpoint)
+		}
+	}
+	return res
 }
 
 // to accounts changes in the keymanager, then updates those keys'
@@ -20,7 +20,6 @@ import (
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
-	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -28,7 +27,9 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/validator/accounts/v2/iface"
+	"github.com/prysmaticlabs/prysm/validator/audit"
 	vdb "github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
 	keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v1"
 	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
@@ -58,13 +59,12 @@ type validator struct {
 	attLogsLock                        sync.Mutex
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
 	prevBalanceLock                    sync.RWMutex
-	attesterHistoryByPubKeyLock        sync.RWMutex
 	walletInitializedFeed              *event.Feed
+	eventFeed                          *eventFeed
 	genesisTime                        uint64
 	domainDataCache                    *ristretto.Cache
 	aggregatedSlotCommitteeIDCache     *lru.Cache
 	ticker                             *slotutil.SlotTicker
-	attesterHistoryByPubKey            map[[48]byte]*slashpb.AttestationHistory
 	prevBalance                        map[[48]byte]uint64
 	duties                             *ethpb.DutiesResponse
 	startBalances                      map[[48]byte]uint64
@@ -77,7 +77,17 @@ type validator struct {
 	protector                          slashingprotection.Protector
 	db                                 vdb.Database
 	graffiti                           []byte
+	keyConfig                          *keyconfig.Store
+	dutiesKeyConfigGeneration          uint64
+	dutiesEpoch                        uint64
+	dutiesStale                        bool
+	dutiesDependentRoots               dutyDependentRoots
+	subscribedSubnets                  map[[64]byte]subnetSubscription
 	voteStats                          voteStats
+	doppelgangerEpochs                 uint64
+	head                               *headTracker
+	blockRefs                          map[[32]byte]blockRef
+	auditLog                           *audit.Log
 }
 
 // Done cleans up the validator.
@@ -141,7 +151,7 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 	// and begin a slot ticker used to track the current slot the beacon node is in.
 	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
 	log.WithField("genesisTime", time.Unix(int64(v.genesisTime), 0)).Info("Beacon chain started")
-	return nil
+	return v.saveGenesisValidatorsRoot(ctx)
 }
 
 // WaitForSync checks whether the beacon node has sync to the latest head.
@@ -202,6 +212,31 @@ func (v *validator) WaitForSynced(ctx context.Context) error {
 	// and begin a slot ticker used to track the current slot the beacon node is in.
 	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
 	log.WithField("genesisTime", time.Unix(int64(v.genesisTime), 0)).Info("Chain has started and the beacon node is synced")
+	return v.saveGenesisValidatorsRoot(ctx)
+}
+
+// saveGenesisValidatorsRoot records the genesis validators root of the beacon node's
+// network in the validator database, which refuses to be used on another network, so
+// that the slashing protection history is only ever applied to its own network. Keymanagers
+// signing remotely are given the root too, to identify the network to the remote signer.
+func (v *validator) saveGenesisValidatorsRoot(ctx context.Context) error {
+	setter, ok := v.keyManagerV2.(v2keymanager.GenesisValidatorsRootSetter)
+	if v.node == nil || (v.db == nil && !ok) {
+		return nil
+	}
+	genesis, err := v.node.GetGenesis(ctx, &ptypes.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis from beacon node")
+	}
+	if ok {
+		setter.SetGenesisValidatorsRoot(genesis.GenesisValidatorsRoot)
+	}
+	if v.db == nil {
+		return nil
+	}
+	if err := v.db.SaveGenesisValidatorsRoot(ctx, genesis.GenesisValidatorsRoot); err != nil {
+		return errors.Wrap(err, "validator database belongs to another network than the beacon node")
+	}
 	return nil
 }
 
@@ -243,15 +278,9 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "validator.WaitForActivation")
 	defer span.End()
 
-	var validatingKeys [][48]byte
-	var err error
-	if featureconfig.Get().EnableAccountsV2 {
-		validatingKeys, err = v.keyManagerV2.FetchValidatingPublicKeys(ctx)
-	} else {
-		validatingKeys, err = v.keyManager.FetchValidatingKeys()
-	}
+	validatingKeys, err := v.fetchValidatingKeys(ctx)
 	if err != nil {
-		return errors.Wrap(err, "could not fetch validating keys")
+		return err
 	}
 	req := &ethpb.ValidatorActivationRequest{
 		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
@@ -293,6 +322,21 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 	return nil
 }
 
+// fetchValidatingKeys returns the validating public keys of the keymanager in use.
+func (v *validator) fetchValidatingKeys(ctx context.Context) ([][48]byte, error) {
+	var validatingKeys [][48]byte
+	var err error
+	if featureconfig.Get().EnableAccountsV2 {
+		validatingKeys, err = v.keyManagerV2.FetchValidatingPublicKeys(ctx)
+	} else {
+		validatingKeys, err = v.keyManager.FetchValidatingKeys()
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validating keys")
+	}
+	return validatingKeys, nil
+}
+
 func (v *validator) checkAndLogValidatorStatus(validatorStatuses []*ethpb.ValidatorActivationResponse_Status) bool {
 	nonexistentIndex := ^uint64(0)
 	var validatorActivated bool
@@ -373,14 +417,17 @@ func (v *validator) SlotDeadline(slot uint64) time.Time {
 
 // UpdateDuties checks the slot number to determine if the validator's
 // list of upcoming assignments needs to be updated. For example, at the
-// beginning of a new epoch.
+// beginning of a new epoch. The duties of the next epoch are fetched along
+// with the current ones, so the beacon node can subscribe to the subnets of
+// the next epoch a full epoch ahead.
 func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
-	if slot%params.BeaconConfig().SlotsPerEpoch != 0 && v.duties != nil {
-		// Do nothing if not epoch start AND assignments already exist.
+	epoch := helpers.SlotToEpoch(slot)
+	reason := v.dutiesUpdateReason(ctx, epoch)
+	if reason == "" {
 		return nil
 	}
 	// Set deadline to end of epoch.
-	ss, err := helpers.StartSlot(helpers.SlotToEpoch(slot) + 1)
+	ss, err := helpers.StartSlot(epoch + 1)
 	if err != nil {
 		return err
 	}
@@ -389,6 +436,9 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 	ctx, span := trace.StartSpan(ctx, "validator.UpdateAssignments")
 	defer span.End()
 
+	if v.keyConfig != nil {
+		v.dutiesKeyConfigGeneration = v.keyConfig.Generation()
+	}
 	var validatingKeys [][48]byte
 	if featureconfig.Get().EnableAccountsV2 {
 		validatingKeys, err = v.keyManagerV2.FetchValidatingPublicKeys(ctx)
@@ -399,88 +449,51 @@ func (v *validator) UpdateDuties(ctx context.Context, slot uint64) error {
 		return err
 	}
 	req := &ethpb.DutiesRequest{
-		Epoch:      slot / params.BeaconConfig().SlotsPerEpoch,
+		Epoch:      epoch,
 		PublicKeys: bytesutil.FromBytes48Array(validatingKeys),
 	}
 
+	// The dependent roots are read before the duties are fetched, so that a reorg happening
+	// in between fetches them again.
+	roots := v.dependentRoots(ctx, epoch)
 	// If duties is nil it means we have had no prior duties and just started up.
 	resp, err := v.validatorClient.GetDuties(ctx, req)
 	if err != nil {
+		if reason == dutiesUpdateEpoch && v.duties != nil && v.dutiesEpoch+1 == epoch && len(v.duties.NextEpochDuties) > 0 {
+			// Use the attester duties of this epoch fetched during the previous epoch until they
+			// can be fetched again. The proposer duties are not known before the epoch starts.
+			log.WithError(err).Warn("Could not fetch duties, using the attester duties fetched during the previous epoch")
+			duties := attesterDuties(v.duties.NextEpochDuties)
+			v.duties = &ethpb.DutiesResponse{
+				Duties:             duties,
+				CurrentEpochDuties: duties,
+			}
+			v.dutiesEpoch = epoch
+			v.dutiesStale = true
+			v.logDuties(slot, v.duties.Duties)
+			v.sendDutyAssignments(epoch, v.duties.Duties)
+			return nil
+		}
 		v.duties = nil // Clear assignments so we know to retry the request.
 		log.Error(err)
 		return err
 	}
 
 	v.duties = resp
+	v.dutiesEpoch = epoch
+	v.dutiesStale = false
+	v.dutiesDependentRoots = roots
+	v.recordDutiesMetrics(epoch, reason)
 	v.logDuties(slot, v.duties.Duties)
-	subscribeSlots := make([]uint64, 0, len(validatingKeys))
-	subscribeCommitteeIDs := make([]uint64, 0, len(validatingKeys))
-	subscribeIsAggregator := make([]bool, 0, len(validatingKeys))
-	alreadySubscribed := make(map[[64]byte]bool)
-
-	for _, duty := range v.duties.Duties {
-		pk := bytesutil.ToBytes48(duty.PublicKey)
-		if duty.Status == ethpb.ValidatorStatus_ACTIVE || duty.Status == ethpb.ValidatorStatus_EXITING {
-			attesterSlot := duty.AttesterSlot
-			committeeIndex := duty.CommitteeIndex
-
-			alreadySubscribedKey := validatorSubscribeKey(attesterSlot, committeeIndex)
-			if _, ok := alreadySubscribed[alreadySubscribedKey]; ok {
-				continue
-			}
+	v.sendDutyAssignments(req.Epoch, v.duties.Duties)
 
-			aggregator, err := v.isAggregator(ctx, duty.Committee, attesterSlot, pk)
-			if err != nil {
-				return errors.Wrap(err, "could not check if a validator is an aggregator")
-			}
-			if aggregator {
-				alreadySubscribed[alreadySubscribedKey] = true
-			}
-
-			subscribeSlots = append(subscribeSlots, attesterSlot)
-			subscribeCommitteeIDs = append(subscribeCommitteeIDs, committeeIndex)
-			subscribeIsAggregator = append(subscribeIsAggregator, aggregator)
-		}
-	}
-
-	// Notify beacon node to subscribe to the attester and aggregator subnets for the next epoch.
-	req.Epoch++
-	dutiesNextEpoch, err := v.validatorClient.GetDuties(ctx, req)
-	if err != nil {
-		log.Error(err)
+	// Notify beacon node to subscribe to the attester and aggregator subnets of the duties, the
+	// ones of the current epoch being already subscribed to unless they just changed.
+	if err := v.subscribeToSubnets(ctx, epoch, v.duties.Duties, v.duties.NextEpochDuties); err != nil {
+		v.dutiesStale = true // Fetch the duties again at the next slot to subscribe again.
 		return err
 	}
-	for _, duty := range dutiesNextEpoch.Duties {
-		if duty.Status == ethpb.ValidatorStatus_ACTIVE || duty.Status == ethpb.ValidatorStatus_EXITING {
-			attesterSlot := duty.AttesterSlot
-			committeeIndex := duty.CommitteeIndex
-
-			alreadySubscribedKey := validatorSubscribeKey(attesterSlot, committeeIndex)
-			if _, ok := alreadySubscribed[alreadySubscribedKey]; ok {
-				continue
-			}
-
-			aggregator, err := v.isAggregator(ctx, duty.Committee, attesterSlot, bytesutil.ToBytes48(duty.PublicKey))
-			if err != nil {
-				return errors.Wrap(err, "could not check if a validator is an aggregator")
-			}
-			if aggregator {
-				alreadySubscribed[alreadySubscribedKey] = true
-			}
-
-			subscribeSlots = append(subscribeSlots, attesterSlot)
-			subscribeCommitteeIDs = append(subscribeCommitteeIDs, committeeIndex)
-			subscribeIsAggregator = append(subscribeIsAggregator, aggregator)
-		}
-	}
-
-	_, err = v.validatorClient.SubscribeCommitteeSubnets(ctx, &ethpb.CommitteeSubnetsSubscribeRequest{
-		Slots:        subscribeSlots,
-		CommitteeIds: subscribeCommitteeIDs,
-		IsAggregator: subscribeIsAggregator,
-	})
-
-	return err
+	return nil
 }
 
 // RolesAt slot returns the validator roles at the given slot. Returns nil if the
@@ -525,40 +538,6 @@ func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]Va
 	return rolesAt, nil
 }
 
-// UpdateProtections goes through the duties of the given slot and fetches the required validator history,
-// assigning it in validator.
-func (v *validator) UpdateProtections(ctx context.Context, slot uint64) error {
-	attestingPubKeys := make([][48]byte, 0, len(v.duties.CurrentEpochDuties))
-	for _, duty := range v.duties.CurrentEpochDuties {
-		if duty == nil || duty.AttesterSlot != slot {
-			continue
-		}
-		attestingPubKeys = append(attestingPubKeys, bytesutil.ToBytes48(duty.PublicKey))
-	}
-	attHistoryByPubKey, err := v.db.AttestationHistoryForPubKeys(ctx, attestingPubKeys)
-	if err != nil {
-		return errors.Wrap(err, "could not get attester history")
-	}
-	v.attesterHistoryByPubKeyLock.Lock()
-	v.attesterHistoryByPubKey = attHistoryByPubKey
-	v.attesterHistoryByPubKeyLock.Unlock()
-	return nil
-}
-
-// SaveProtections saves the attestation information currently in validator state.
-func (v *validator) SaveProtections(ctx context.Context) error {
-	v.attesterHistoryByPubKeyLock.RLock()
-	if err := v.db.SaveAttestationHistoryForPubKeys(ctx, v.attesterHistoryByPubKey); err != nil {
-		return errors.Wrap(err, "could not save attester history to DB")
-	}
-	v.attesterHistoryByPubKeyLock.RUnlock()
-	v.attesterHistoryByPubKeyLock.Lock()
-	v.attesterHistoryByPubKey = make(map[[48]byte]*slashpb.AttestationHistory)
-	v.attesterHistoryByPubKeyLock.Unlock()
-
-	return nil
-}
-
 // isAggregator checks if a validator is an aggregator of a given slot, it uses the selection algorithm outlined in:
 // https://github.com/ethereum/eth2.0-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#aggregation-selection
 func (v *validator) isAggregator(ctx context.Context, committee []uint64, slot uint64, pubKey [48]byte) (bool, error) {
@@ -696,3 +675,20 @@ type voteStats struct {
 	correctHeads          uint64
 	totalHeads            uint64
 }
+
+// keyConfigChanged returns whether validating keys were enabled or disabled since the
+// duties were last updated, in which case they are updated without waiting for the next epoch.
+func (v *validator) keyConfigChanged() bool {
+	return v.keyConfig != nil && v.keyConfig.Generation() != v.dutiesKeyConfigGeneration
+}
+
+// graffitiFor returns the graffiti of the blocks proposed by a validating key, which is
+// the graffiti of the key in the validating keys config if any, or else the --graffiti flag.
+func (v *validator) graffitiFor(pubKey [48]byte) []byte {
+	if v.keyConfig != nil {
+		if graffiti, ok := v.keyConfig.Graffiti(pubKey); ok {
+			return graffiti
+		}
+	}
+	return v.graffiti
+}
@@ -10,7 +10,6 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
-	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/mock"
@@ -18,7 +17,9 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
 	keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v1"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -82,6 +83,76 @@ func TestWaitForChainStart_SetsChainStartGenesisTime(t *testing.T) {
 	assert.NotNil(t, v.ticker, "Expected ticker to be set, received nil")
 }
 
+func TestWaitForChainStart_SavesGenesisValidatorsRoot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	node := mock.NewMockNodeClient(ctrl)
+	db := dbTest.SetupDB(t, [][48]byte{})
+
+	v := validator{
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		node:            node,
+		db:              db,
+	}
+	clientStream := mock.NewMockBeaconNodeValidator_WaitForChainStartClient(ctrl)
+	client.EXPECT().WaitForChainStart(
+		gomock.Any(),
+		&ptypes.Empty{},
+	).Return(clientStream, nil).Times(2)
+	clientStream.EXPECT().Recv().Return(
+		&ethpb.ChainStartResponse{
+			Started:     true,
+			GenesisTime: uint64(time.Unix(1, 0).Unix()),
+		},
+		nil,
+	).Times(2)
+	genValRoot := bytesutil.PadTo([]byte("root"), 32)
+	node.EXPECT().GetGenesis(
+		gomock.Any(),
+		&ptypes.Empty{},
+	).Return(&ethpb.Genesis{GenesisValidatorsRoot: genValRoot}, nil)
+	require.NoError(t, v.WaitForChainStart(context.Background()))
+	saved, err := db.GenesisValidatorsRoot(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, genValRoot, saved)
+
+	// A beacon node of another network is refused.
+	node.EXPECT().GetGenesis(
+		gomock.Any(),
+		&ptypes.Empty{},
+	).Return(&ethpb.Genesis{GenesisValidatorsRoot: make([]byte, 32)}, nil)
+	assert.ErrorContains(t, "belongs to another network", v.WaitForChainStart(context.Background()))
+}
+
+type genesisRootKeymanager struct {
+	v2keymanager.IKeymanager
+	genesisValidatorsRoot []byte
+}
+
+func (km *genesisRootKeymanager) SetGenesisValidatorsRoot(root []byte) {
+	km.genesisValidatorsRoot = root
+}
+
+func TestSaveGenesisValidatorsRoot_SetsKeymanagerRoot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	node := mock.NewMockNodeClient(ctrl)
+	km := &genesisRootKeymanager{}
+	v := validator{
+		node:         node,
+		keyManagerV2: km,
+	}
+	genValRoot := bytesutil.PadTo([]byte("root"), 32)
+	node.EXPECT().GetGenesis(
+		gomock.Any(),
+		&ptypes.Empty{},
+	).Return(&ethpb.Genesis{GenesisValidatorsRoot: genValRoot}, nil)
+	require.NoError(t, v.saveGenesisValidatorsRoot(context.Background()))
+	assert.DeepEqual(t, genValRoot, km.genesisValidatorsRoot)
+}
+
 func TestWaitForChainStart_ContextCanceled(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -525,6 +596,30 @@ func TestUpdateDuties_DoesNothingWhenNotEpochStart_AlreadyExistingAssignments(t
 	assert.NoError(t, v.UpdateDuties(context.Background(), slot), "Could not update assignments")
 }
 
+func TestUpdateDuties_KeyConfigChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+
+	config, err := keyconfig.NewStore(&keyconfig.File{})
+	require.NoError(t, err)
+	v := validator{
+		keyManager:      testKeyManager,
+		validatorClient: client,
+		keyConfig:       config,
+		duties:          &ethpb.DutiesResponse{},
+	}
+	client.EXPECT().GetDuties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(&ethpb.DutiesResponse{}, nil).Times(1) // Once for the disabled key.
+
+	// Duties are fetched again within the epoch once a key is disabled, but only once.
+	require.NoError(t, config.SetEnabled([48]byte{1}, false))
+	require.NoError(t, v.UpdateDuties(context.Background(), 1))
+	require.NoError(t, v.UpdateDuties(context.Background(), 2))
+}
+
 func TestUpdateDuties_ReturnsError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -554,9 +649,10 @@ func TestUpdateDuties_ReturnsError(t *testing.T) {
 }
 
 func TestUpdateDuties_OK(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	v, m, finish := setup(t)
+	defer finish()
+	sk := bls.RandKey()
+	v.keyManager = keymanager.NewDirect([]bls.SecretKey{sk})
 
 	slot := params.BeaconConfig().SlotsPerEpoch
 	resp := &ethpb.DutiesResponse{
@@ -566,28 +662,29 @@ func TestUpdateDuties_OK(t *testing.T) {
 				ValidatorIndex: 200,
 				CommitteeIndex: 100,
 				Committee:      []uint64{0, 1, 2, 3},
-				PublicKey:      []byte("testPubKey_1"),
+				PublicKey:      sk.PublicKey().Marshal(),
 				ProposerSlots:  []uint64{params.BeaconConfig().SlotsPerEpoch + 1},
+				Status:         ethpb.ValidatorStatus_ACTIVE,
 			},
 		},
 	}
-	v := validator{
-		keyManager:      testKeyManager,
-		validatorClient: client,
-	}
-	client.EXPECT().GetDuties(
+	m.validatorClient.EXPECT().GetDuties(
 		gomock.Any(),
 		gomock.Any(),
 	).Return(resp, nil)
 
-	client.EXPECT().GetDuties(
-		gomock.Any(),
-		gomock.Any(),
-	).Return(resp, nil)
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
 
-	client.EXPECT().SubscribeCommitteeSubnets(
-		gomock.Any(),
+	m.validatorClient.EXPECT().SubscribeCommitteeSubnets(
 		gomock.Any(),
+		&ethpb.CommitteeSubnetsSubscribeRequest{
+			Slots:        []uint64{params.BeaconConfig().SlotsPerEpoch},
+			CommitteeIds: []uint64{100},
+			IsAggregator: []bool{true},
+		},
 	).Return(nil, nil)
 
 	require.NoError(t, v.UpdateDuties(context.Background(), slot), "Could not update assignments")
@@ -597,104 +694,6 @@ func TestUpdateDuties_OK(t *testing.T) {
 	assert.Equal(t, resp.Duties[0].ValidatorIndex, v.duties.Duties[0].ValidatorIndex, "Unexpected validator assignments")
 }
 
-func TestUpdateProtections_OK(t *testing.T) {
-	pubKey1 := [48]byte{1}
-	pubKey2 := [48]byte{2}
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
-	db := dbTest.SetupDB(t, [][48]byte{pubKey1, pubKey2})
-
-	newMap := make(map[uint64]uint64)
-	newMap[0] = params.BeaconConfig().FarFutureEpoch
-	newMap[1] = 0
-	newMap[2] = 1
-	history := &slashpb.AttestationHistory{
-		TargetToSource:     newMap,
-		LatestEpochWritten: 2,
-	}
-
-	newMap2 := make(map[uint64]uint64)
-	newMap2[0] = params.BeaconConfig().FarFutureEpoch
-	newMap2[1] = params.BeaconConfig().FarFutureEpoch
-	newMap2[2] = params.BeaconConfig().FarFutureEpoch
-	newMap2[3] = 2
-	history2 := &slashpb.AttestationHistory{
-		TargetToSource:     newMap,
-		LatestEpochWritten: 3,
-	}
-
-	histories := make(map[[48]byte]*slashpb.AttestationHistory)
-	histories[pubKey1] = history
-	histories[pubKey2] = history2
-	require.NoError(t, db.SaveAttestationHistoryForPubKeys(context.Background(), histories))
-
-	slot := params.BeaconConfig().SlotsPerEpoch
-	duties := &ethpb.DutiesResponse{
-		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
-			{
-				AttesterSlot:   slot,
-				ValidatorIndex: 200,
-				CommitteeIndex: 100,
-				Committee:      []uint64{0, 1, 2, 3},
-				PublicKey:      pubKey1[:],
-			},
-			{
-				AttesterSlot:   slot,
-				ValidatorIndex: 201,
-				CommitteeIndex: 100,
-				Committee:      []uint64{0, 1, 2, 3},
-				PublicKey:      pubKey2[:],
-			},
-		},
-	}
-	v := validator{
-		db:              db,
-		keyManager:      testKeyManager,
-		validatorClient: client,
-		duties:          duties,
-	}
-
-	require.NoError(t, v.UpdateProtections(context.Background(), slot), "Could not update assignments")
-	require.DeepEqual(t, history, v.attesterHistoryByPubKey[pubKey1], "Unexpected retrieved history")
-	require.DeepEqual(t, history2, v.attesterHistoryByPubKey[pubKey2], "Unexpected retrieved history")
-}
-
-func TestSaveProtections_OK(t *testing.T) {
-	pubKey1 := [48]byte{1}
-	pubKey2 := [48]byte{2}
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-	client := mock.NewMockBeaconNodeValidatorClient(ctrl)
-	db := dbTest.SetupDB(t, [][48]byte{pubKey1, pubKey2})
-
-	cleanHistories, err := db.AttestationHistoryForPubKeys(context.Background(), [][48]byte{pubKey1, pubKey2})
-	require.NoError(t, err)
-	v := validator{
-		db:                      db,
-		keyManager:              testKeyManager,
-		validatorClient:         client,
-		attesterHistoryByPubKey: cleanHistories,
-	}
-
-	history1 := cleanHistories[pubKey1]
-	history1 = markAttestationForTargetEpoch(history1, 0, 1)
-
-	history2 := cleanHistories[pubKey1]
-	history2 = markAttestationForTargetEpoch(history1, 2, 3)
-
-	cleanHistories[pubKey1] = history1
-	cleanHistories[pubKey2] = history2
-
-	v.attesterHistoryByPubKey = cleanHistories
-	require.NoError(t, v.SaveProtections(context.Background()), "Could not update assignments")
-	savedHistories, err := db.AttestationHistoryForPubKeys(context.Background(), [][48]byte{pubKey1, pubKey2})
-	require.NoError(t, err)
-
-	require.DeepEqual(t, history1, savedHistories[pubKey1], "Unexpected retrieved history")
-	require.DeepEqual(t, history2, savedHistories[pubKey2], "Unexpected retrieved history")
-}
-
 func TestRolesAt_OK(t *testing.T) {
 	v, m, finish := setup(t)
 	defer finish()
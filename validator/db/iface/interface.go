@@ -6,7 +6,7 @@ import (
 	"io"
 
 	"github.com/prysmaticlabs/go-bitfield"
-	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 )
 
 // ValidatorDB defines the necessary methods for a Prysm validator DB.
@@ -15,13 +15,38 @@ type ValidatorDB interface {
 	DatabasePath() string
 	ClearDB() error
 	UpdatePublicKeysBuckets(publicKeys [][48]byte) error
+	ProtectedPublicKeys(ctx context.Context) ([][48]byte, error)
+	// Network related methods.
+	GenesisValidatorsRoot(ctx context.Context) ([]byte, error)
+	SaveGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error
 	// Proposer protection related methods.
 	ProposalHistoryForEpoch(ctx context.Context, publicKey []byte, epoch uint64) (bitfield.Bitlist, error)
 	SaveProposalHistoryForEpoch(ctx context.Context, publicKey []byte, epoch uint64, history bitfield.Bitlist) error
+	ProposedSlotsForPubKey(ctx context.Context, publicKey []byte) ([]uint64, error)
+	ProposalSigningRootsForPubKey(ctx context.Context, publicKey []byte) (map[uint64][32]byte, error)
+	CheckAndSaveProposal(ctx context.Context, pubKey [48]byte, signingRoot [32]byte, slot uint64) error
 	// Attester protection related methods.
-	AttestationHistoryForPubKeys(ctx context.Context, publicKeys [][48]byte) (map[[48]byte]*slashpb.AttestationHistory, error)
-	SaveAttestationHistoryForPubKeys(ctx context.Context, historyByPubKey map[[48]byte]*slashpb.AttestationHistory) error
+	AttestationWatermarksForPubKeys(ctx context.Context, publicKeys [][48]byte) (map[[48]byte]*kv.AttestationWatermarks, error)
+	MergeAttestationWatermarks(ctx context.Context, watermarksByPubKey map[[48]byte]*kv.AttestationWatermarks) error
+	CheckAndSaveAttestation(ctx context.Context, pubKey [48]byte, signingRoot [32]byte, sourceEpoch uint64, targetEpoch uint64) error
+	// Slashing protection interchange methods.
+	ImportSlashingProtection(
+		ctx context.Context,
+		genValRoot [32]byte,
+		proposalsByPubKey map[[48]byte]map[uint64][32]byte,
+		watermarksByPubKey map[[48]byte]*kv.AttestationWatermarks,
+	) error
 	// Validator RPC authentication methods.
 	SaveHashedPasswordForAPI(ctx context.Context, hashedPassword []byte) error
 	HashedPasswordForAPI(ctx context.Context) ([]byte, error)
+	SaveWalletOwnerPassword(ctx context.Context, hashedPassword []byte) (bool, error)
+	SaveAPIUser(ctx context.Context, user *kv.APIUser) error
+	APIUser(ctx context.Context, username string) (*kv.APIUser, error)
+	APIUsers(ctx context.Context) ([]*kv.APIUser, error)
+	DeleteAPIUser(ctx context.Context, username string) error
+	SaveAPIToken(ctx context.Context, token *kv.APIToken) error
+	APIToken(ctx context.Context, id string) (*kv.APIToken, error)
+	APITokens(ctx context.Context) ([]*kv.APIToken, error)
+	SaveAuthEvent(ctx context.Context, event *kv.AuthEvent) error
+	AuthEvents(ctx context.Context, limit int) ([]*kv.AuthEvent, error)
 }
@@ -0,0 +1,295 @@
+package kv
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// The roles of the users and API tokens of the validator API, from the least to the most
+// privileged.
+const (
+	// ReadOnlyRole can read the state of the validator client, but not change it.
+	ReadOnlyRole = "read-only"
+	// OperatorRole can also run the validator client, such as creating accounts and
+	// enabling or disabling validating keys.
+	OperatorRole = "operator"
+	// AdminRole can do anything, including managing the wallet, users and API tokens.
+	AdminRole = "admin"
+)
+
+// maxAuthEvents is the number of most recent auth events kept in the database.
+const maxAuthEvents = 10000
+
+// APIUser is a user of the validator API.
+type APIUser struct {
+	Username       string `json:"username"`
+	HashedPassword []byte `json:"hashed_password"`
+	Role           string `json:"role"`
+	// WalletOwner is set for the user whose password is also the password of the wallet.
+	WalletOwner bool      `json:"wallet_owner,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// APIToken is a long-lived token of the validator API, for automation. Only the hash of
+// its secret is kept.
+type APIToken struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Role         string    `json:"role"`
+	HashedSecret []byte    `json:"hashed_secret"`
+	CreatedBy    string    `json:"created_by"`
+	CreatedAt    time.Time `json:"created_at"`
+	// ExpiresAt is zero for tokens which do not expire.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	Revoked   bool      `json:"revoked,omitempty"`
+}
+
+// AuthEvent is an authentication or authorization event of the validator API, such as a
+// login or the creation of an API token.
+type AuthEvent struct {
+	Time     time.Time `json:"time"`
+	Type     string    `json:"type"`
+	Username string    `json:"username,omitempty"`
+	TokenID  string    `json:"token_id,omitempty"`
+	Method   string    `json:"method,omitempty"`
+	Details  string    `json:"details,omitempty"`
+}
+
+// SaveAPIUser creates or replaces a user of the validator API.
+func (store *Store) SaveAPIUser(ctx context.Context, user *APIUser) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveAPIUser")
+	defer span.End()
+
+	if user.Username == "" {
+		return errors.New("user without a username")
+	}
+	enc, err := json.Marshal(user)
+	if err != nil {
+		return errors.Wrap(err, "could not encode API user")
+	}
+	return store.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(apiUsersBucket).Put([]byte(user.Username), enc)
+	})
+}
+
+// APIUser retrieves a user of the validator API by username. Returns nil if there is none.
+func (store *Store) APIUser(ctx context.Context, username string) (*APIUser, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.APIUser")
+	defer span.End()
+
+	var user *APIUser
+	err := store.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(apiUsersBucket).Get([]byte(username))
+		if enc == nil {
+			return nil
+		}
+		user = &APIUser{}
+		return json.Unmarshal(enc, user)
+	})
+	return user, err
+}
+
+// APIUsers retrieves the users of the validator API, sorted by username.
+func (store *Store) APIUsers(ctx context.Context) ([]*APIUser, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.APIUsers")
+	defer span.End()
+
+	var users []*APIUser
+	err := store.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(apiUsersBucket).ForEach(func(_, enc []byte) error {
+			user := &APIUser{}
+			if err := json.Unmarshal(enc, user); err != nil {
+				return err
+			}
+			users = append(users, user)
+			return nil
+		})
+	})
+	return users, err
+}
+
+// SaveWalletOwnerPassword replaces the hashed password of the validator API and of the users
+// owning the wallet in a single transaction, as they must match the password of the wallet.
+// Nothing is saved if the validator API has no password yet, so that it can still be signed
+// up to. Returns whether the password was saved.
+func (store *Store) SaveWalletOwnerPassword(ctx context.Context, hashedPassword []byte) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveWalletOwnerPassword")
+	defer span.End()
+
+	saved := false
+	err := store.update(func(tx *bolt.Tx) error {
+		users := tx.Bucket(apiUsersBucket)
+		owners := make(map[string]*APIUser)
+		if err := users.ForEach(func(username, enc []byte) error {
+			user := &APIUser{}
+			if err := json.Unmarshal(enc, user); err != nil {
+				return err
+			}
+			if user.WalletOwner {
+				owners[string(username)] = user
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		apiBucket := tx.Bucket(validatorAPIBucket)
+		if len(apiBucket.Get(apiHashedPasswordKey)) == 0 && len(owners) == 0 {
+			return nil
+		}
+		if err := apiBucket.Put(apiHashedPasswordKey, hashedPassword); err != nil {
+			return err
+		}
+		for username, user := range owners {
+			user.HashedPassword = hashedPassword
+			enc, err := json.Marshal(user)
+			if err != nil {
+				return errors.Wrap(err, "could not encode API user")
+			}
+			if err := users.Put([]byte(username), enc); err != nil {
+				return err
+			}
+		}
+		saved = true
+		return nil
+	})
+	return saved, err
+}
+
+// DeleteAPIUser deletes a user of the validator API, along with the API tokens it created.
+func (store *Store) DeleteAPIUser(ctx context.Context, username string) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.DeleteAPIUser")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		tokens := tx.Bucket(apiTokensBucket)
+		var revoked [][]byte
+		if err := tokens.ForEach(func(id, enc []byte) error {
+			token := &APIToken{}
+			if err := json.Unmarshal(enc, token); err != nil {
+				return err
+			}
+			if token.CreatedBy == username {
+				revoked = append(revoked, id)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, id := range revoked {
+			if err := tokens.Delete(id); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(apiUsersBucket).Delete([]byte(username))
+	})
+}
+
+// SaveAPIToken creates or replaces an API token of the validator API.
+func (store *Store) SaveAPIToken(ctx context.Context, token *APIToken) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveAPIToken")
+	defer span.End()
+
+	if token.ID == "" {
+		return errors.New("API token without an ID")
+	}
+	enc, err := json.Marshal(token)
+	if err != nil {
+		return errors.Wrap(err, "could not encode API token")
+	}
+	return store.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(apiTokensBucket).Put([]byte(token.ID), enc)
+	})
+}
+
+// APIToken retrieves an API token of the validator API by ID. Returns nil if there is none.
+func (store *Store) APIToken(ctx context.Context, id string) (*APIToken, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.APIToken")
+	defer span.End()
+
+	var token *APIToken
+	err := store.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(apiTokensBucket).Get([]byte(id))
+		if enc == nil {
+			return nil
+		}
+		token = &APIToken{}
+		return json.Unmarshal(enc, token)
+	})
+	return token, err
+}
+
+// APITokens retrieves the API tokens of the validator API, sorted by creation time.
+func (store *Store) APITokens(ctx context.Context) ([]*APIToken, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.APITokens")
+	defer span.End()
+
+	var tokens []*APIToken
+	err := store.view(func(tx *bolt.Tx) error {
+		return tx.Bucket(apiTokensBucket).ForEach(func(_, enc []byte) error {
+			token := &APIToken{}
+			if err := json.Unmarshal(enc, token); err != nil {
+				return err
+			}
+			tokens = append(tokens, token)
+			return nil
+		})
+	})
+	sort.SliceStable(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.Before(tokens[j].CreatedAt)
+	})
+	return tokens, err
+}
+
+// SaveAuthEvent appends an event to the auth events of the validator API. Only the most
+// recent events are kept.
+func (store *Store) SaveAuthEvent(ctx context.Context, event *AuthEvent) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveAuthEvent")
+	defer span.End()
+
+	enc, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "could not encode auth event")
+	}
+	return store.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(apiAuthEventsBucket)
+		seq, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put(bytesutil.Uint64ToBytesBigEndian(seq), enc); err != nil {
+			return err
+		}
+		if seq > maxAuthEvents {
+			return bucket.Delete(bytesutil.Uint64ToBytesBigEndian(seq - maxAuthEvents))
+		}
+		return nil
+	})
+}
+
+// AuthEvents retrieves up to limit of the most recent auth events of the validator API,
+// from the newest to the oldest.
+func (store *Store) AuthEvents(ctx context.Context, limit int) ([]*AuthEvent, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.AuthEvents")
+	defer span.End()
+
+	var events []*AuthEvent
+	err := store.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(apiAuthEventsBucket).Cursor()
+		for k, enc := c.Last(); k != nil && len(events) < limit; k, enc = c.Prev() {
+			event := &AuthEvent{}
+			if err := json.Unmarshal(enc, event); err != nil {
+				return err
+			}
+			events = append(events, event)
+		}
+		return nil
+	})
+	return events, err
+}
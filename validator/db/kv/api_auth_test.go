@@ -0,0 +1,143 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_APIUsers(t *testing.T) {
+	db := setupDB(t, [][48]byte{})
+	ctx := context.Background()
+
+	user, err := db.APIUser(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, (*APIUser)(nil), user)
+
+	require.NoError(t, db.SaveAPIUser(ctx, &APIUser{Username: "bob", HashedPassword: []byte("b"), Role: ReadOnlyRole}))
+	require.NoError(t, db.SaveAPIUser(ctx, &APIUser{Username: "alice", HashedPassword: []byte("a"), Role: AdminRole}))
+	require.ErrorContains(t, "user without a username", db.SaveAPIUser(ctx, &APIUser{}))
+
+	user, err = db.APIUser(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, AdminRole, user.Role)
+	assert.DeepEqual(t, []byte("a"), user.HashedPassword)
+
+	users, err := db.APIUsers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(users))
+	assert.Equal(t, "alice", users[0].Username)
+	assert.Equal(t, "bob", users[1].Username)
+
+	// Deleting a user deletes its API tokens.
+	require.NoError(t, db.SaveAPIToken(ctx, &APIToken{ID: "1", CreatedBy: "bob"}))
+	require.NoError(t, db.SaveAPIToken(ctx, &APIToken{ID: "2", CreatedBy: "alice"}))
+	require.NoError(t, db.DeleteAPIUser(ctx, "bob"))
+	users, err = db.APIUsers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(users))
+	tokens, err := db.APITokens(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tokens))
+	assert.Equal(t, "2", tokens[0].ID)
+}
+
+func TestStore_SaveWalletOwnerPassword(t *testing.T) {
+	db := setupDB(t, [][48]byte{})
+	ctx := context.Background()
+
+	// Nothing is saved until the validator API has been signed up to.
+	saved, err := db.SaveWalletOwnerPassword(ctx, []byte("a"))
+	require.NoError(t, err)
+	assert.Equal(t, false, saved)
+	hashedPassword, err := db.HashedPasswordForAPI(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(hashedPassword))
+
+	require.NoError(t, db.SaveHashedPasswordForAPI(ctx, []byte("a")))
+	require.NoError(t, db.SaveAPIUser(ctx, &APIUser{Username: "admin", HashedPassword: []byte("a"), Role: AdminRole, WalletOwner: true}))
+	require.NoError(t, db.SaveAPIUser(ctx, &APIUser{Username: "bob", HashedPassword: []byte("b"), Role: ReadOnlyRole}))
+	saved, err = db.SaveWalletOwnerPassword(ctx, []byte("c"))
+	require.NoError(t, err)
+	assert.Equal(t, true, saved)
+	hashedPassword, err = db.HashedPasswordForAPI(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("c"), hashedPassword)
+	user, err := db.APIUser(ctx, "admin")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("c"), user.HashedPassword)
+	assert.Equal(t, true, user.WalletOwner)
+	user, err = db.APIUser(ctx, "bob")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("b"), user.HashedPassword)
+}
+
+func TestStore_APITokens(t *testing.T) {
+	db := setupDB(t, [][48]byte{})
+	ctx := context.Background()
+
+	now := time.Now()
+	require.NoError(t, db.SaveAPIToken(ctx, &APIToken{ID: "b", Name: "later", Role: OperatorRole, CreatedAt: now}))
+	require.NoError(t, db.SaveAPIToken(ctx, &APIToken{ID: "a", Name: "earlier", Role: ReadOnlyRole, CreatedAt: now.Add(-time.Hour)}))
+	require.ErrorContains(t, "API token without an ID", db.SaveAPIToken(ctx, &APIToken{}))
+
+	tokens, err := db.APITokens(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tokens))
+	assert.Equal(t, "earlier", tokens[0].Name)
+	assert.Equal(t, "later", tokens[1].Name)
+
+	token, err := db.APIToken(ctx, "b")
+	require.NoError(t, err)
+	token.Revoked = true
+	require.NoError(t, db.SaveAPIToken(ctx, token))
+	token, err = db.APIToken(ctx, "b")
+	require.NoError(t, err)
+	assert.Equal(t, true, token.Revoked)
+
+	token, err = db.APIToken(ctx, "c")
+	require.NoError(t, err)
+	assert.Equal(t, (*APIToken)(nil), token)
+}
+
+func TestStore_AuthEvents(t *testing.T) {
+	db := setupDB(t, [][48]byte{})
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, db.SaveAuthEvent(ctx, &AuthEvent{Type: fmt.Sprintf("event %d", i)}))
+	}
+	events, err := db.AuthEvents(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(events))
+	assert.Equal(t, "event 4", events[0].Type)
+	assert.Equal(t, "event 2", events[2].Type)
+
+	events, err = db.AuthEvents(ctx, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 5, len(events))
+}
+
+func TestStore_MigrateAPIPassword(t *testing.T) {
+	db := setupDB(t, [][48]byte{})
+	ctx := context.Background()
+
+	// Restore a database with a single password, from before the migration.
+	require.NoError(t, db.SaveHashedPasswordForAPI(ctx, []byte("hash")))
+	require.NoError(t, db.update(func(tx *bolt.Tx) error {
+		return tx.Bucket(migrationsBucket).Delete(migrationAPIPassword0Key)
+	}))
+	require.NoError(t, db.RunMigrations(ctx))
+
+	user, err := db.APIUser(ctx, legacyAPIUsername)
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	assert.DeepEqual(t, []byte("hash"), user.HashedPassword)
+	assert.Equal(t, AdminRole, user.Role)
+	assert.Equal(t, true, user.WalletOwner)
+}
@@ -22,6 +22,8 @@ func unmarshalAttestationHistory(ctx context.Context, enc []byte) (*slashpb.Atte
 }
 
 // AttestationHistoryForPubKeys accepts an array of validator public keys and returns a mapping of corresponding attestation history.
+// Attestation histories are deprecated in favor of attestation watermarks, and only kept for migration
+// and database management.
 func (store *Store) AttestationHistoryForPubKeys(ctx context.Context, publicKeys [][48]byte) (map[[48]byte]*slashpb.AttestationHistory, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.AttestationHistoryForPubKeys")
 	defer span.End()
@@ -38,11 +40,7 @@ func (store *Store) AttestationHistoryForPubKeys(ctx context.Context, publicKeys
 			enc := bucket.Get(key[:])
 			var attestationHistory *slashpb.AttestationHistory
 			if len(enc) == 0 {
-				newMap := make(map[uint64]uint64)
-				newMap[0] = params.BeaconConfig().FarFutureEpoch
-				attestationHistory = &slashpb.AttestationHistory{
-					TargetToSource: newMap,
-				}
+				attestationHistory = newAttestationHistory()
 			} else {
 				attestationHistory, err = unmarshalAttestationHistory(ctx, enc)
 				if err != nil {
@@ -81,3 +79,12 @@ func (store *Store) SaveAttestationHistoryForPubKeys(ctx context.Context, histor
 	})
 	return err
 }
+
+// newAttestationHistory returns an attestation history without any attestations.
+func newAttestationHistory() *slashpb.AttestationHistory {
+	newMap := make(map[uint64]uint64)
+	newMap[0] = params.BeaconConfig().FarFutureEpoch
+	return &slashpb.AttestationHistory{
+		TargetToSource: newMap,
+	}
+}
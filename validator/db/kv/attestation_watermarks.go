@@ -0,0 +1,213 @@
+package kv
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ErrSlashableAttestation is returned when an attestation would be slashable with respect
+// to the attestation history of its validator.
+var ErrSlashableAttestation = errors.New("attestation is slashable with respect to the attestation history")
+
+// attestationWatermarksSize is the size of encoded attestation watermarks, four epochs
+// followed by a signing root.
+const attestationWatermarksSize = 4*8 + 32
+
+// AttestationWatermarks is the attestation slashing protection of a validator public key.
+// The lowest source and target epochs are the watermarks below which nothing was signed,
+// and the highest source and target epochs are the span every signed attestation lies in.
+// An attestation starting at or after the highest source epoch and ending after the highest
+// target epoch can neither be a double vote, nor surround or be surrounded by any signed
+// attestation, however old it is. This makes the check constant in time and size, and
+// independent of the weak subjectivity period.
+type AttestationWatermarks struct {
+	LowestSourceEpoch  uint64
+	LowestTargetEpoch  uint64
+	HighestSourceEpoch uint64
+	HighestTargetEpoch uint64
+	// SigningRoot is the signing root of the attestation signed for HighestTargetEpoch,
+	// or zero if it is unknown.
+	SigningRoot [32]byte
+}
+
+// IsSlashable returns whether signing an attestation of sourceEpoch and targetEpoch could be
+// slashable with respect to the watermarks. Re-signing the attestation recorded for the highest
+// target epoch, with the same signing root, is not slashable.
+func (w *AttestationWatermarks) IsSlashable(sourceEpoch uint64, targetEpoch uint64, signingRoot [32]byte) bool {
+	if w == nil {
+		return false
+	}
+	if targetEpoch == w.HighestTargetEpoch && signingRoot != [32]byte{} && signingRoot == w.SigningRoot {
+		return false
+	}
+	return sourceEpoch < w.HighestSourceEpoch || targetEpoch <= w.HighestTargetEpoch
+}
+
+// Record returns the watermarks extended with a signed attestation. Nil watermarks have
+// no signed attestation yet.
+func (w *AttestationWatermarks) Record(sourceEpoch uint64, targetEpoch uint64, signingRoot [32]byte) *AttestationWatermarks {
+	return w.merge(&AttestationWatermarks{
+		LowestSourceEpoch:  sourceEpoch,
+		LowestTargetEpoch:  targetEpoch,
+		HighestSourceEpoch: sourceEpoch,
+		HighestTargetEpoch: targetEpoch,
+		SigningRoot:        signingRoot,
+	})
+}
+
+// merge returns watermarks covering the attestations of both watermarks.
+func (w *AttestationWatermarks) merge(other *AttestationWatermarks) *AttestationWatermarks {
+	if w == nil {
+		merged := *other
+		return &merged
+	}
+	if other == nil {
+		merged := *w
+		return &merged
+	}
+	merged := *w
+	if other.LowestSourceEpoch < merged.LowestSourceEpoch {
+		merged.LowestSourceEpoch = other.LowestSourceEpoch
+	}
+	if other.LowestTargetEpoch < merged.LowestTargetEpoch {
+		merged.LowestTargetEpoch = other.LowestTargetEpoch
+	}
+	if other.HighestSourceEpoch > merged.HighestSourceEpoch {
+		merged.HighestSourceEpoch = other.HighestSourceEpoch
+	}
+	switch {
+	case other.HighestTargetEpoch > merged.HighestTargetEpoch:
+		merged.HighestTargetEpoch = other.HighestTargetEpoch
+		merged.SigningRoot = other.SigningRoot
+	case other.HighestTargetEpoch == merged.HighestTargetEpoch && other.SigningRoot != merged.SigningRoot:
+		// Two different attestations for the same target epoch, neither may be re-signed.
+		merged.SigningRoot = [32]byte{}
+	}
+	return &merged
+}
+
+func (w *AttestationWatermarks) marshal() []byte {
+	enc := make([]byte, attestationWatermarksSize)
+	binary.BigEndian.PutUint64(enc[0:8], w.LowestSourceEpoch)
+	binary.BigEndian.PutUint64(enc[8:16], w.LowestTargetEpoch)
+	binary.BigEndian.PutUint64(enc[16:24], w.HighestSourceEpoch)
+	binary.BigEndian.PutUint64(enc[24:32], w.HighestTargetEpoch)
+	copy(enc[32:], w.SigningRoot[:])
+	return enc
+}
+
+func unmarshalAttestationWatermarks(enc []byte) (*AttestationWatermarks, error) {
+	if len(enc) != attestationWatermarksSize {
+		return nil, errors.Errorf("wanted %d bytes of attestation watermarks, got %d", attestationWatermarksSize, len(enc))
+	}
+	w := &AttestationWatermarks{
+		LowestSourceEpoch:  binary.BigEndian.Uint64(enc[0:8]),
+		LowestTargetEpoch:  binary.BigEndian.Uint64(enc[8:16]),
+		HighestSourceEpoch: binary.BigEndian.Uint64(enc[16:24]),
+		HighestTargetEpoch: binary.BigEndian.Uint64(enc[24:32]),
+	}
+	copy(w.SigningRoot[:], enc[32:])
+	return w, nil
+}
+
+// attestationWatermarksInTx returns the attestation watermarks of a public key, or nil if it
+// has not signed any attestation.
+func attestationWatermarksInTx(tx *bolt.Tx, pubKey []byte) (*AttestationWatermarks, error) {
+	enc := tx.Bucket(attestationWatermarksBucket).Get(pubKey)
+	if len(enc) == 0 {
+		return nil, nil
+	}
+	return unmarshalAttestationWatermarks(enc)
+}
+
+// AttestationWatermarksForPubKeys accepts an array of validator public keys and returns a mapping
+// of their attestation watermarks. Public keys without any signed attestation are not in the mapping.
+func (store *Store) AttestationWatermarksForPubKeys(ctx context.Context, publicKeys [][48]byte) (map[[48]byte]*AttestationWatermarks, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.AttestationWatermarksForPubKeys")
+	defer span.End()
+
+	watermarksByPubKey := make(map[[48]byte]*AttestationWatermarks)
+	err := store.view(func(tx *bolt.Tx) error {
+		for _, pubKey := range publicKeys {
+			w, err := attestationWatermarksInTx(tx, pubKey[:])
+			if err != nil {
+				return errors.Wrapf(err, "could not decode attestation watermarks of public key %#x", pubKey)
+			}
+			if w != nil {
+				watermarksByPubKey[pubKey] = w
+			}
+		}
+		return nil
+	})
+	return watermarksByPubKey, err
+}
+
+// MergeAttestationWatermarks merges the attestation watermarks of validator public keys into the
+// stored ones, such that every attestation protected before stays protected.
+func (store *Store) MergeAttestationWatermarks(ctx context.Context, watermarksByPubKey map[[48]byte]*AttestationWatermarks) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.MergeAttestationWatermarks")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		return mergeAttestationWatermarksInTx(tx, watermarksByPubKey)
+	})
+}
+
+func mergeAttestationWatermarksInTx(tx *bolt.Tx, watermarksByPubKey map[[48]byte]*AttestationWatermarks) error {
+	bucket := tx.Bucket(attestationWatermarksBucket)
+	for pubKey, w := range watermarksByPubKey {
+		if w == nil {
+			continue
+		}
+		existing, err := attestationWatermarksInTx(tx, pubKey[:])
+		if err != nil {
+			return errors.Wrapf(err, "could not decode attestation watermarks of public key %#x", pubKey)
+		}
+		if err := bucket.Put(pubKey[:], existing.merge(w).marshal()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckAndSaveAttestation checks an attestation a validator intends to sign against its attestation
+// watermarks and, if it is safe, records the attestation and its signing root before returning.
+// Signing an attestation with the same signing root as the one recorded for the highest target
+// epoch is allowed. Returns ErrSlashableAttestation if the attestation must not be signed.
+// Concurrent calls for different validators are committed together in a single transaction.
+func (store *Store) CheckAndSaveAttestation(
+	ctx context.Context,
+	pubKey [48]byte,
+	signingRoot [32]byte,
+	sourceEpoch uint64,
+	targetEpoch uint64,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.CheckAndSaveAttestation")
+	defer span.End()
+
+	var slashable bool
+	err := store.batch(func(tx *bolt.Tx) error {
+		// The batch may run this function again, so the outcome is reset on every run.
+		slashable = false
+		w, err := attestationWatermarksInTx(tx, pubKey[:])
+		if err != nil {
+			return errors.Wrapf(err, "could not decode attestation watermarks of public key %#x", pubKey)
+		}
+		if w.IsSlashable(sourceEpoch, targetEpoch, signingRoot) {
+			slashable = true
+			return nil
+		}
+		return tx.Bucket(attestationWatermarksBucket).Put(pubKey[:], w.Record(sourceEpoch, targetEpoch, signingRoot).marshal())
+	})
+	if err != nil {
+		return err
+	}
+	if slashable {
+		return ErrSlashableAttestation
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestAttestationWatermarks_IsSlashable(t *testing.T) {
+	w := &AttestationWatermarks{
+		LowestSourceEpoch:  1,
+		LowestTargetEpoch:  2,
+		HighestSourceEpoch: 4,
+		HighestTargetEpoch: 6,
+		SigningRoot:        [32]byte{1},
+	}
+	tests := []struct {
+		name        string
+		source      uint64
+		target      uint64
+		signingRoot [32]byte
+		slashable   bool
+	}{
+		{name: "next attestation", source: 6, target: 7, signingRoot: [32]byte{2}, slashable: false},
+		{name: "same source", source: 4, target: 7, signingRoot: [32]byte{2}, slashable: false},
+		{name: "identical attestation", source: 4, target: 6, signingRoot: [32]byte{1}, slashable: false},
+		{name: "double vote", source: 4, target: 6, signingRoot: [32]byte{2}, slashable: true},
+		{name: "double vote with unknown signing root", source: 4, target: 6, signingRoot: [32]byte{}, slashable: true},
+		{name: "surrounding", source: 3, target: 7, signingRoot: [32]byte{2}, slashable: true},
+		{name: "surrounded", source: 5, target: 5, signingRoot: [32]byte{2}, slashable: true},
+		{name: "below the lowest watermarks", source: 0, target: 1, signingRoot: [32]byte{2}, slashable: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.slashable, w.IsSlashable(tt.source, tt.target, tt.signingRoot))
+		})
+	}
+
+	var empty *AttestationWatermarks
+	require.Equal(t, false, empty.IsSlashable(0, 0, [32]byte{}), "Expected no attestation to be slashable without history")
+}
+
+func TestAttestationWatermarks_MergeKeepsWidestSpan(t *testing.T) {
+	w := (*AttestationWatermarks)(nil).Record(2, 3, [32]byte{1})
+	w = w.merge(&AttestationWatermarks{
+		LowestSourceEpoch:  0,
+		LowestTargetEpoch:  1,
+		HighestSourceEpoch: 5,
+		HighestTargetEpoch: 3,
+		SigningRoot:        [32]byte{2},
+	})
+	require.DeepEqual(t, &AttestationWatermarks{
+		LowestSourceEpoch:  0,
+		LowestTargetEpoch:  1,
+		HighestSourceEpoch: 5,
+		HighestTargetEpoch: 3,
+	}, w, "Expected conflicting signing roots of the highest target epoch to be dropped")
+
+	enc := w.marshal()
+	decoded, err := unmarshalAttestationWatermarks(enc)
+	require.NoError(t, err)
+	require.DeepEqual(t, w, decoded)
+}
+
+func TestCheckAndSaveAttestation_AllowsIdenticalSigningRoot(t *testing.T) {
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{pubKey})
+	ctx := context.Background()
+
+	root := [32]byte{1}
+	require.NoError(t, db.CheckAndSaveAttestation(ctx, pubKey, root, 1, 2))
+	require.NoError(t, db.CheckAndSaveAttestation(ctx, pubKey, root, 1, 2), "Expected re-signing the same attestation to be allowed")
+
+	err := db.CheckAndSaveAttestation(ctx, pubKey, [32]byte{2}, 1, 2)
+	require.ErrorContains(t, ErrSlashableAttestation.Error(), err)
+}
+
+func TestCheckAndSaveAttestation_BlocksSurroundVotes(t *testing.T) {
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{pubKey})
+	ctx := context.Background()
+
+	require.NoError(t, db.CheckAndSaveAttestation(ctx, pubKey, [32]byte{1}, 1, 2))
+	err := db.CheckAndSaveAttestation(ctx, pubKey, [32]byte{2}, 0, 3)
+	require.ErrorContains(t, ErrSlashableAttestation.Error(), err, "Expected surrounding vote to be slashable")
+
+	// The rejected attestation must not have been recorded.
+	require.NoError(t, db.CheckAndSaveAttestation(ctx, pubKey, [32]byte{3}, 2, 3))
+}
+
+func TestCheckAndSaveAttestation_ProtectsBeyondWeakSubjectivityPeriod(t *testing.T) {
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{pubKey})
+	ctx := context.Background()
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+
+	require.NoError(t, db.CheckAndSaveAttestation(ctx, pubKey, [32]byte{1}, 3*wsPeriod, 3*wsPeriod+1))
+	// An old attestation far outside of the weak subjectivity period is still refused.
+	err := db.CheckAndSaveAttestation(ctx, pubKey, [32]byte{2}, 0, 1)
+	require.ErrorContains(t, ErrSlashableAttestation.Error(), err)
+	// And so is an attestation surrounding it after a long time offline.
+	err = db.CheckAndSaveAttestation(ctx, pubKey, [32]byte{3}, 0, 5*wsPeriod)
+	require.ErrorContains(t, ErrSlashableAttestation.Error(), err)
+}
+
+func TestMergeAttestationWatermarks(t *testing.T) {
+	pubKeys := [][48]byte{{1}, {2}}
+	db := setupDB(t, pubKeys)
+	ctx := context.Background()
+
+	require.NoError(t, db.CheckAndSaveAttestation(ctx, pubKeys[0], [32]byte{1}, 5, 6))
+	require.NoError(t, db.MergeAttestationWatermarks(ctx, map[[48]byte]*AttestationWatermarks{
+		pubKeys[0]: (*AttestationWatermarks)(nil).Record(1, 2, [32]byte{}),
+		pubKeys[1]: (*AttestationWatermarks)(nil).Record(1, 2, [32]byte{}),
+	}))
+
+	watermarks, err := db.AttestationWatermarksForPubKeys(ctx, pubKeys)
+	require.NoError(t, err)
+	require.DeepEqual(t, &AttestationWatermarks{
+		LowestSourceEpoch:  1,
+		LowestTargetEpoch:  2,
+		HighestSourceEpoch: 5,
+		HighestTargetEpoch: 6,
+		SigningRoot:        [32]byte{1},
+	}, watermarks[pubKeys[0]])
+	require.DeepEqual(t, (*AttestationWatermarks)(nil).Record(1, 2, [32]byte{}), watermarks[pubKeys[1]])
+}
+
+func TestCheckAndSaveAttestation_ConcurrentKeys(t *testing.T) {
+	numKeys := 64
+	pubKeys := make([][48]byte, numKeys)
+	for i := range pubKeys {
+		pubKeys[i] = [48]byte{byte(i)}
+	}
+	db := setupDB(t, pubKeys)
+	ctx := context.Background()
+
+	// Every key attempts the same target twice with different roots, exactly one must succeed per key.
+	var wg sync.WaitGroup
+	errs := make([]error, 2*numKeys)
+	for i, pubKey := range pubKeys {
+		for j := 0; j < 2; j++ {
+			wg.Add(1)
+			go func(i, j int, pubKey [48]byte) {
+				defer wg.Done()
+				errs[2*i+j] = db.CheckAndSaveAttestation(ctx, pubKey, [32]byte{byte(j + 1)}, 1, 2)
+			}(i, j, pubKey)
+		}
+	}
+	wg.Wait()
+
+	for i := range pubKeys {
+		succeeded := 0
+		for j := 0; j < 2; j++ {
+			if err := errs[2*i+j]; err == nil {
+				succeeded++
+			} else if err != ErrSlashableAttestation {
+				t.Fatal(err)
+			}
+		}
+		require.Equal(t, 1, succeeded, fmt.Sprintf("Unexpected number of signed attestations for key %d", i))
+	}
+
+	watermarks, err := db.AttestationWatermarksForPubKeys(ctx, pubKeys)
+	require.NoError(t, err)
+	for _, pubKey := range pubKeys {
+		require.Equal(t, uint64(2), watermarks[pubKey].HighestTargetEpoch)
+	}
+}
@@ -2,12 +2,17 @@
 package kv
 
 import (
+	"bytes"
+	"context"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
 )
 
 // ProtectionDbFileName Validator slashing protection db file name.
@@ -28,6 +33,13 @@ func (store *Store) Close() error {
 func (store *Store) update(fn func(*bolt.Tx) error) error {
 	return store.db.Update(fn)
 }
+
+// batch runs fn in a write transaction shared with any other concurrent batch calls.
+// The function may be run more than once, so it must be idempotent.
+func (store *Store) batch(fn func(*bolt.Tx) error) error {
+	return store.db.Batch(fn)
+}
+
 func (store *Store) view(fn func(*bolt.Tx) error) error {
 	return store.db.View(fn)
 }
@@ -45,6 +57,37 @@ func (store *Store) DatabasePath() string {
 	return store.databasePath
 }
 
+// ProtectedPublicKeys returns the validator public keys which have a proposal or an
+// attestation history, in ascending order.
+func (store *Store) ProtectedPublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProtectedPublicKeys")
+	defer span.End()
+
+	seen := make(map[[48]byte]bool)
+	var pubKeys [][48]byte
+	collect := func(k, _ []byte) error {
+		pubKey := bytesutil.ToBytes48(k)
+		if !seen[pubKey] {
+			seen[pubKey] = true
+			pubKeys = append(pubKeys, pubKey)
+		}
+		return nil
+	}
+	err := store.view(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(historicProposalsBucket).ForEach(collect); err != nil {
+			return err
+		}
+		if err := tx.Bucket(historicAttestationsBucket).ForEach(collect); err != nil {
+			return err
+		}
+		return tx.Bucket(attestationWatermarksBucket).ForEach(collect)
+	})
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) < 0
+	})
+	return pubKeys, err
+}
+
 func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
@@ -77,11 +120,22 @@ func NewKVStore(dirPath string, pubKeys [][48]byte) (*Store, error) {
 			tx,
 			historicProposalsBucket,
 			historicAttestationsBucket,
+			attestationWatermarksBucket,
+			proposalSigningRootsBucket,
+			lowestSignedProposalsBucket,
 			validatorAPIBucket,
+			apiUsersBucket,
+			apiTokensBucket,
+			apiAuthEventsBucket,
+			genesisInfoBucket,
+			migrationsBucket,
 		)
 	}); err != nil {
 		return nil, err
 	}
+	if err := kv.RunMigrations(context.Background()); err != nil {
+		return nil, errors.Wrap(err, "could not migrate validator database")
+	}
 
 	// Initialize the required public keys into the DB to ensure they're not empty.
 	if pubKeys != nil {
@@ -107,7 +161,14 @@ func GetKVStore(directory string) (*Store, error) {
 		return nil, err
 	}
 
-	return &Store{db: boltDb, databasePath: directory}, nil
+	store := &Store{db: boltDb, databasePath: directory}
+	if err := store.RunMigrations(context.Background()); err != nil {
+		if closeErr := boltDb.Close(); closeErr != nil {
+			return nil, errors.Wrap(closeErr, "could not close validator database after a failed migration")
+		}
+		return nil, errors.Wrap(err, "could not migrate validator database")
+	}
+	return store, nil
 }
 
 // Size returns the db size in bytes.
@@ -1,11 +1,13 @@
 package kv
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
@@ -35,3 +37,16 @@ func tempdir() string {
 	}
 	return d
 }
+
+func TestStore_ProtectedPublicKeys(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][48]byte{{3}, {1}})
+	require.NoError(t, db.SaveAttestationHistoryForPubKeys(ctx, map[[48]byte]*slashpb.AttestationHistory{
+		{2}: {TargetToSource: map[uint64]uint64{0: 0}},
+		{1}: {TargetToSource: map[uint64]uint64{0: 0}},
+	}))
+	require.NoError(t, db.CheckAndSaveAttestation(ctx, [48]byte{4}, [32]byte{}, 0, 1))
+	pubKeys, err := db.ProtectedPublicKeys(ctx)
+	require.NoError(t, err)
+	require.DeepEqual(t, [][48]byte{{1}, {2}, {3}, {4}}, pubKeys)
+}
@@ -0,0 +1,60 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveGenesisValidatorsRoot saves the genesis validators root of the network the
+// slashing protection history belongs to. The root cannot be changed once saved.
+func (store *Store) SaveGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveGenesisValidatorsRoot")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		return saveGenesisValidatorsRootInTx(tx, genValRoot)
+	})
+}
+
+func saveGenesisValidatorsRootInTx(tx *bolt.Tx, genValRoot []byte) error {
+	bucket, err := tx.CreateBucketIfNotExists(genesisInfoBucket)
+	if err != nil {
+		return err
+	}
+	enc := bucket.Get(genesisValidatorsRootKey)
+	if len(enc) != 0 {
+		if !bytes.Equal(enc, genValRoot) {
+			return errors.Errorf("cannot overwrite existing genesis validators root %#x", enc)
+		}
+		return nil
+	}
+	return bucket.Put(genesisValidatorsRootKey, genValRoot)
+}
+
+// GenesisValidatorsRoot retrieves the genesis validators root of the network the
+// slashing protection history belongs to. Returns nil if it is not known yet.
+func (store *Store) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.GenesisValidatorsRoot")
+	defer span.End()
+
+	var genValRoot []byte
+	err := store.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(genesisInfoBucket)
+		// Stores opened without initializing their buckets may not have one.
+		if bucket == nil {
+			return nil
+		}
+		enc := bucket.Get(genesisValidatorsRootKey)
+		if len(enc) == 0 {
+			return nil
+		}
+		genValRoot = make([]byte, len(enc))
+		copy(genValRoot, enc)
+		return nil
+	})
+	return genValRoot, err
+}
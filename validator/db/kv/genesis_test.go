@@ -0,0 +1,30 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_GenesisValidatorsRoot(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][48]byte{})
+
+	root, err := db.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(root))
+
+	genValRoot := make([]byte, 32)
+	genValRoot[0] = 1
+	require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, genValRoot))
+	root, err = db.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, genValRoot, root)
+
+	// Saving the same root again is a no-op, a different root is refused.
+	require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, genValRoot))
+	otherRoot := make([]byte, 32)
+	assert.ErrorContains(t, "cannot overwrite existing genesis validators root", db.SaveGenesisValidatorsRoot(ctx, otherRoot))
+}
@@ -0,0 +1,96 @@
+package kv
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	sharedbytes "github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/wealdtech/go-bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// ImportSlashingProtection saves the genesis validators root of a slashing protection history
+// and merges the proposals and attestation watermarks of validator public keys into the stored
+// ones, in a single transaction, so that nothing is saved if any of it fails. The proposals of a
+// public key map the slots of its blocks to their signing roots, which are zero if unknown. The
+// signing root already stored for a slot is kept. As the blocks before the imported ones are not
+// known, the lowest imported slot becomes a watermark at or below which no other block is signed.
+func (store *Store) ImportSlashingProtection(
+	ctx context.Context,
+	genValRoot [32]byte,
+	proposalsByPubKey map[[48]byte]map[uint64][32]byte,
+	watermarksByPubKey map[[48]byte]*AttestationWatermarks,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.ImportSlashingProtection")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		if err := saveGenesisValidatorsRootInTx(tx, genValRoot[:]); err != nil {
+			return err
+		}
+		for pubKey, proposals := range proposalsByPubKey {
+			if err := importProposalsInTx(tx, pubKey, proposals); err != nil {
+				return errors.Wrapf(err, "could not import proposal history for public key %#x", pubKey)
+			}
+		}
+		return mergeAttestationWatermarksInTx(tx, watermarksByPubKey)
+	})
+}
+
+func importProposalsInTx(tx *bolt.Tx, pubKey [48]byte, proposals map[uint64][32]byte) error {
+	valBucket, err := tx.Bucket(historicProposalsBucket).CreateBucketIfNotExists(pubKey[:])
+	if err != nil {
+		return errors.Wrap(err, "failed to create proposal history bucket")
+	}
+	rootsBucket, err := tx.Bucket(proposalSigningRootsBucket).CreateBucketIfNotExists(pubKey[:])
+	if err != nil {
+		return errors.Wrap(err, "failed to create proposal signing roots bucket")
+	}
+	slots := make([]uint64, 0, len(proposals))
+	for slot := range proposals {
+		slots = append(slots, slot)
+	}
+	// Saving the proposal history of an epoch prunes the epochs older than the weak
+	// subjectivity period, so the slots are imported by ascending order.
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i] < slots[j]
+	})
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	for _, slot := range slots {
+		epoch := slot / slotsPerEpoch
+		slotBits := proposalHistoryInBucket(valBucket, epoch)
+		slotBits.SetBitAt(slot%slotsPerEpoch, true)
+		if err := valBucket.Put(bytesutil.Bytes8(epoch), slotBits); err != nil {
+			return err
+		}
+		if err := pruneProposalHistory(valBucket, epoch); err != nil {
+			return err
+		}
+		signingRoot := proposals[slot]
+		slotKey := sharedbytes.Uint64ToBytesBigEndian(slot)
+		if signingRoot == [32]byte{} || len(rootsBucket.Get(slotKey)) != 0 {
+			continue
+		}
+		if err := rootsBucket.Put(slotKey, signingRoot[:]); err != nil {
+			return err
+		}
+	}
+	if len(slots) == 0 {
+		return nil
+	}
+	if lowest, ok := lowestSignedProposalInTx(tx, pubKey[:]); !ok || slots[0] < lowest {
+		if err := tx.Bucket(lowestSignedProposalsBucket).Put(pubKey[:], sharedbytes.Uint64ToBytesBigEndian(slots[0])); err != nil {
+			return err
+		}
+	}
+	// Only keep the signing roots of the epochs still covered by the history.
+	epoch := slots[len(slots)-1] / slotsPerEpoch
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	if epoch < wsPeriod {
+		return nil
+	}
+	return pruneSigningRoots(rootsBucket, (epoch-wsPeriod+1)*slotsPerEpoch)
+}
@@ -0,0 +1,78 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestImportSlashingProtection_KeepsStoredSigningRoots(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{})
+	require.NoError(t, db.CheckAndSaveProposal(ctx, pubKey, [32]byte{1}, 10))
+
+	require.NoError(t, db.ImportSlashingProtection(ctx, [32]byte{9}, map[[48]byte]map[uint64][32]byte{
+		pubKey: {10: {2}, 11: {3}, 12: {}},
+	}, map[[48]byte]*AttestationWatermarks{
+		pubKey: {HighestSourceEpoch: 2, HighestTargetEpoch: 3},
+	}))
+
+	slots, err := db.ProposedSlotsForPubKey(ctx, pubKey[:])
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{10, 11, 12}, slots)
+	roots, err := db.ProposalSigningRootsForPubKey(ctx, pubKey[:])
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[uint64][32]byte{10: {1}, 11: {3}}, roots)
+	// The imported block can be signed again, but not another one at its slot.
+	require.NoError(t, db.CheckAndSaveProposal(ctx, pubKey, [32]byte{3}, 11))
+	assert.ErrorContains(t, ErrSlashableProposal.Error(), db.CheckAndSaveProposal(ctx, pubKey, [32]byte{4}, 12))
+	watermarks, err := db.AttestationWatermarksForPubKeys(ctx, [][48]byte{pubKey})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), watermarks[pubKey].HighestTargetEpoch)
+}
+
+func TestImportSlashingProtection_SavesNothingOnFailure(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{})
+	genValRoot := [32]byte{9}
+	require.NoError(t, db.SaveGenesisValidatorsRoot(ctx, genValRoot[:]))
+
+	err := db.ImportSlashingProtection(ctx, [32]byte{8}, map[[48]byte]map[uint64][32]byte{
+		pubKey: {10: {2}},
+	}, map[[48]byte]*AttestationWatermarks{
+		pubKey: {HighestSourceEpoch: 2, HighestTargetEpoch: 3},
+	})
+	assert.ErrorContains(t, "cannot overwrite existing genesis validators root", err)
+	pubKeys, err := db.ProtectedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pubKeys))
+}
+
+func TestImportSlashingProtection_RefusesBlocksBelowLowestImportedSlot(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{})
+
+	// Minimal interchange files only list the latest block.
+	require.NoError(t, db.ImportSlashingProtection(ctx, [32]byte{9}, map[[48]byte]map[uint64][32]byte{
+		pubKey: {20: {2}},
+	}, nil))
+
+	assert.ErrorContains(t, ErrSlashableProposal.Error(), db.CheckAndSaveProposal(ctx, pubKey, [32]byte{3}, 19))
+	assert.ErrorContains(t, ErrSlashableProposal.Error(), db.CheckAndSaveProposal(ctx, pubKey, [32]byte{3}, 1))
+	assert.ErrorContains(t, ErrSlashableProposal.Error(), db.CheckAndSaveProposal(ctx, pubKey, [32]byte{3}, 20))
+	// The imported block may be signed again, and blocks after it may be signed.
+	require.NoError(t, db.CheckAndSaveProposal(ctx, pubKey, [32]byte{2}, 20))
+	require.NoError(t, db.CheckAndSaveProposal(ctx, pubKey, [32]byte{3}, 21))
+
+	// Importing a later history does not raise the watermark.
+	require.NoError(t, db.ImportSlashingProtection(ctx, [32]byte{9}, map[[48]byte]map[uint64][32]byte{
+		pubKey: {30: {4}},
+	}, nil))
+	assert.ErrorContains(t, ErrSlashableProposal.Error(), db.CheckAndSaveProposal(ctx, pubKey, [32]byte{3}, 19))
+	require.NoError(t, db.CheckAndSaveProposal(ctx, pubKey, [32]byte{5}, 25))
+}
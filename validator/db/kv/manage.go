@@ -27,6 +27,7 @@ type pubKeyProposals struct {
 type pubKeyAttestations struct {
 	PubKey       []byte
 	Attestations []byte
+	Watermarks   []byte
 }
 
 // Merge merges data from sourceStores into a new store, which is created in targetDirectory.
@@ -112,9 +113,8 @@ func createMergeTargetStore(
 				return err
 			}
 		}
-		attestationsBucket := tx.Bucket(historicAttestationsBucket)
 		for _, attestations := range allAttestations {
-			if err := addAttestations(attestationsBucket, attestations); err != nil {
+			if err := addAttestations(tx, attestations); err != nil {
 				return err
 			}
 		}
@@ -165,10 +165,9 @@ func createSplitTargetStores(
 				return err
 			}
 
-			attestationsBucket := tx.Bucket(historicAttestationsBucket)
 			for _, pubKeyAttestations := range allAttestations {
 				if string(pubKeyAttestations.PubKey) == string(pubKeyProposals.PubKey) {
-					if err := addAttestations(attestationsBucket, pubKeyAttestations); err != nil {
+					if err := addAttestations(tx, pubKeyAttestations); err != nil {
 						return err
 					}
 					break
@@ -200,8 +199,7 @@ func createSplitTargetStores(
 			storesToClose = append(storesToClose, newStore)
 
 			if err := newStore.update(func(tx *bolt.Tx) error {
-				attestationsBucket := tx.Bucket(historicAttestationsBucket)
-				if err := addAttestations(attestationsBucket, pubKeyAttestations); err != nil {
+				if err := addAttestations(tx, pubKeyAttestations); err != nil {
 					return err
 				}
 
@@ -245,6 +243,16 @@ func getAllProposalsAndAllAttestations(stores []*Store) ([]pubKeyProposals, []pu
 				return errors.Wrapf(err, "could not retrieve attestations for source in %s", store.databasePath)
 			}
 
+			watermarksBucket := tx.Bucket(attestationWatermarksBucket)
+			if err := watermarksBucket.ForEach(func(pubKey, _ []byte) error {
+				pubKeyCopy := make([]byte, len(pubKey))
+				copy(pubKeyCopy, pubKey)
+				allKeys = append(allKeys, pubKeyCopy)
+				return nil
+			}); err != nil {
+				return errors.Wrapf(err, "could not retrieve attestation watermarks for source in %s", store.databasePath)
+			}
+
 			return nil
 		}); err != nil {
 			return nil, nil, err
@@ -261,14 +269,16 @@ func getAllProposalsAndAllAttestations(stores []*Store) ([]pubKeyProposals, []pu
 				}
 				allProposals = append(allProposals, *pubKeyProposals)
 
-				attestationsBucket := tx.Bucket(historicAttestationsBucket)
-				v := attestationsBucket.Get(pubKey)
-				if v != nil {
+				v := tx.Bucket(historicAttestationsBucket).Get(pubKey)
+				w := tx.Bucket(attestationWatermarksBucket).Get(pubKey)
+				if v != nil || w != nil {
 					attestations := pubKeyAttestations{
 						PubKey:       pubKey,
 						Attestations: make([]byte, len(v)),
+						Watermarks:   make([]byte, len(w)),
 					}
 					copy(attestations.Attestations, v)
+					copy(attestations.Watermarks, w)
 					allAttestations = append(allAttestations, attestations)
 				}
 
@@ -299,12 +309,31 @@ func addEpochProposals(bucket *bolt.Bucket, proposals []epochProposals) error {
 	return nil
 }
 
-func addAttestations(bucket *bolt.Bucket, attestations pubKeyAttestations) error {
-	if err := bucket.Put(attestations.PubKey, attestations.Attestations); err != nil {
-		return errors.Wrapf(
-			err,
-			"could not add public key attestations for public key %x",
-			attestations.PubKey[:12])
+func addAttestations(tx *bolt.Tx, attestations pubKeyAttestations) error {
+	if len(attestations.Attestations) != 0 {
+		if err := tx.Bucket(historicAttestationsBucket).Put(attestations.PubKey, attestations.Attestations); err != nil {
+			return errors.Wrapf(
+				err,
+				"could not add public key attestations for public key %x",
+				attestations.PubKey[:12])
+		}
+	}
+	if len(attestations.Watermarks) != 0 {
+		w, err := unmarshalAttestationWatermarks(attestations.Watermarks)
+		if err != nil {
+			return err
+		}
+		// The same public key may come from several stores, whose watermarks are all kept.
+		existing, err := attestationWatermarksInTx(tx, attestations.PubKey)
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket(attestationWatermarksBucket).Put(attestations.PubKey, existing.merge(w).marshal()); err != nil {
+			return errors.Wrapf(
+				err,
+				"could not add attestation watermarks for public key %x",
+				attestations.PubKey[:12])
+		}
 	}
 	return nil
 }
@@ -19,6 +19,7 @@ import (
 type storeHistory struct {
 	Proposals    map[[48]byte]bitfield.Bitlist
 	Attestations map[[48]byte]map[uint64]uint64
+	Watermarks   map[[48]byte]*AttestationWatermarks
 }
 
 func TestMerge(t *testing.T) {
@@ -45,9 +46,17 @@ func TestMerge(t *testing.T) {
 	for k, v := range storeHistory2.Attestations {
 		mergedAttestations[k] = v
 	}
+	mergedWatermarks := make(map[[48]byte]*AttestationWatermarks)
+	for k, v := range storeHistory1.Watermarks {
+		mergedWatermarks[k] = v
+	}
+	for k, v := range storeHistory2.Watermarks {
+		mergedWatermarks[k] = v
+	}
 	mergedStoreHistory := storeHistory{
 		Proposals:    mergedProposals,
 		Attestations: mergedAttestations,
+		Watermarks:   mergedWatermarks,
 	}
 
 	targetDirectory := testutil.TempDir() + "/target"
@@ -164,9 +173,14 @@ func prepareStore(store *Store, pubKeys [][48]byte) (*storeHistory, error) {
 	if err != nil {
 		return nil, err
 	}
+	watermarks, err := prepareStoreWatermarks(store, pubKeys)
+	if err != nil {
+		return nil, err
+	}
 	history := storeHistory{
 		Proposals:    proposals,
 		Attestations: attestations,
+		Watermarks:   watermarks,
 	}
 	return &history, nil
 }
@@ -206,6 +220,24 @@ func prepareStoreAttestations(store *Store, pubKeys [][48]byte) (map[[48]byte]ma
 	return attestations, nil
 }
 
+func prepareStoreWatermarks(store *Store, pubKeys [][48]byte) (map[[48]byte]*AttestationWatermarks, error) {
+	watermarks := make(map[[48]byte]*AttestationWatermarks)
+	for i, key := range pubKeys {
+		signingRoot := [32]byte{byte(i + 1)}
+		if err := store.CheckAndSaveAttestation(context.Background(), key, signingRoot, uint64(i), uint64(i+1)); err != nil {
+			return nil, errors.Wrapf(err, "Saving attestation watermarks failed")
+		}
+		watermarks[key] = &AttestationWatermarks{
+			LowestSourceEpoch:  uint64(i),
+			LowestTargetEpoch:  uint64(i + 1),
+			HighestSourceEpoch: uint64(i),
+			HighestTargetEpoch: uint64(i + 1),
+			SigningRoot:        signingRoot,
+		}
+	}
+	return watermarks, nil
+}
+
 func assertStore(t *testing.T, store *Store, pubKeys [][48]byte, expectedHistory *storeHistory) {
 	for _, key := range pubKeys {
 		proposalHistory, err := store.ProposalHistoryForEpoch(context.Background(), key[:], 0)
@@ -220,4 +252,10 @@ func assertStore(t *testing.T, store *Store, pubKeys [][48]byte, expectedHistory
 		expectedAttestations := expectedHistory.Attestations[key]
 		require.Equal(t, expectedAttestations[0], attestationHistory[key].TargetToSource[0], "Attestations are incorrect")
 	}
+
+	watermarks, err := store.AttestationWatermarksForPubKeys(context.Background(), pubKeys)
+	require.NoError(t, err, "Retrieving attestation watermarks failed")
+	for _, key := range pubKeys {
+		require.DeepEqual(t, expectedHistory.Watermarks[key], watermarks[key], "Attestation watermarks are incorrect")
+	}
 }
@@ -0,0 +1,54 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	migrationAPIPassword0Key = []byte("api_password_0")
+	// legacyAPIUsername is the username of the user of the single password of the
+	// validator API, from before it had several users.
+	legacyAPIUsername = "admin"
+)
+
+// migrateAPIPassword turns the single hashed password of the validator API into the admin
+// user owning the wallet, so that its password keeps working once the API has several users.
+func migrateAPIPassword(tx *bolt.Tx) error {
+	mb, err := tx.CreateBucketIfNotExists(migrationsBucket)
+	if err != nil {
+		return err
+	}
+	if b := mb.Get(migrationAPIPassword0Key); bytes.Equal(b, migrationCompleted) {
+		return nil // Migration already completed.
+	}
+
+	users, err := tx.CreateBucketIfNotExists(apiUsersBucket)
+	if err != nil {
+		return err
+	}
+	if apiBucket := tx.Bucket(validatorAPIBucket); apiBucket != nil {
+		hashedPassword := apiBucket.Get(apiHashedPasswordKey)
+		if k, _ := users.Cursor().First(); len(hashedPassword) != 0 && k == nil {
+			enc, err := json.Marshal(&APIUser{
+				Username:       legacyAPIUsername,
+				HashedPassword: append([]byte{}, hashedPassword...),
+				Role:           AdminRole,
+				WalletOwner:    true,
+				CreatedAt:      time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+			if err := users.Put([]byte(legacyAPIUsername), enc); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Mark migration complete.
+	return mb.Put(migrationAPIPassword0Key, migrationCompleted)
+}
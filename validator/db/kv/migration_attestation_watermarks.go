@@ -0,0 +1,87 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+)
+
+var migrationAttestationWatermarks0Key = []byte("attestation_watermarks_0")
+
+// migrateAttestationWatermarks converts the attestation histories of the weak subjectivity ring
+// format into attestation watermarks, along with the signing root recorded for their highest
+// target epoch, and removes the signing roots which are now kept in the watermarks.
+func migrateAttestationWatermarks(tx *bolt.Tx) error {
+	mb, err := tx.CreateBucketIfNotExists(migrationsBucket)
+	if err != nil {
+		return err
+	}
+	if b := mb.Get(migrationAttestationWatermarks0Key); bytes.Equal(b, migrationCompleted) {
+		return nil // Migration already completed.
+	}
+
+	watermarksBucket, err := tx.CreateBucketIfNotExists(attestationWatermarksBucket)
+	if err != nil {
+		return err
+	}
+	rootsBucket := tx.Bucket(attestationSigningRootsBucket)
+	if historyBucket := tx.Bucket(historicAttestationsBucket); historyBucket != nil {
+		if err := historyBucket.ForEach(func(pubKey, enc []byte) error {
+			history, err := unmarshalAttestationHistory(context.TODO(), enc)
+			if err != nil {
+				return errors.Wrapf(err, "could not decode attestation history of public key %#x", pubKey)
+			}
+			w := watermarksFromHistory(history)
+			if w == nil {
+				return nil
+			}
+			if rootsBucket != nil {
+				if valRoots := rootsBucket.Bucket(pubKey); valRoots != nil {
+					copy(w.SigningRoot[:], valRoots.Get(bytesutil.Uint64ToBytesBigEndian(w.HighestTargetEpoch)))
+				}
+			}
+			existing, err := attestationWatermarksInTx(tx, pubKey)
+			if err != nil {
+				return err
+			}
+			return watermarksBucket.Put(pubKey, existing.merge(w).marshal())
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Delete deprecated buckets.
+	if rootsBucket != nil {
+		if err := tx.DeleteBucket(attestationSigningRootsBucket); err != nil {
+			return err
+		}
+	}
+
+	// Mark migration complete.
+	return mb.Put(migrationAttestationWatermarks0Key, migrationCompleted)
+}
+
+// watermarksFromHistory returns the watermarks of the attestations kept in the last weak
+// subjectivity period of an attestation history, or nil if there are none.
+func watermarksFromHistory(history *slashpb.AttestationHistory) *AttestationWatermarks {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	start := uint64(0)
+	if history.LatestEpochWritten >= wsPeriod {
+		start = history.LatestEpochWritten - wsPeriod + 1
+	}
+	var w *AttestationWatermarks
+	for target := start; target <= history.LatestEpochWritten; target++ {
+		source, ok := history.TargetToSource[target%wsPeriod]
+		if !ok || source == farFuture {
+			continue
+		}
+		w = w.Record(source, target, [32]byte{})
+	}
+	return w
+}
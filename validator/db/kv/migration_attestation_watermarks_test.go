@@ -0,0 +1,67 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestMigrateAttestationWatermarks(t *testing.T) {
+	pubKeys := [][48]byte{{1}, {2}}
+	db := setupDB(t, pubKeys)
+	ctx := context.Background()
+	farFuture := params.BeaconConfig().FarFutureEpoch
+
+	// The first key attested for target epochs 2 and 3, the second one never attested.
+	histories := map[[48]byte]*slashpb.AttestationHistory{
+		pubKeys[0]: {
+			TargetToSource:     map[uint64]uint64{0: farFuture, 1: farFuture, 2: 1, 3: 2},
+			LatestEpochWritten: 3,
+		},
+		pubKeys[1]: newAttestationHistory(),
+	}
+	require.NoError(t, db.SaveAttestationHistoryForPubKeys(ctx, histories))
+	require.NoError(t, db.update(func(tx *bolt.Tx) error {
+		rootsBucket, err := tx.CreateBucketIfNotExists(attestationSigningRootsBucket)
+		if err != nil {
+			return err
+		}
+		valRoots, err := rootsBucket.CreateBucketIfNotExists(pubKeys[0][:])
+		if err != nil {
+			return err
+		}
+		if err := valRoots.Put(bytesutil.Uint64ToBytesBigEndian(3), []byte{'r', 'o', 'o', 't'}); err != nil {
+			return err
+		}
+		return tx.Bucket(migrationsBucket).Delete(migrationAttestationWatermarks0Key)
+	}))
+
+	require.NoError(t, db.RunMigrations(ctx))
+
+	watermarks, err := db.AttestationWatermarksForPubKeys(ctx, pubKeys)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(watermarks), "Expected only the key which attested to be migrated")
+	require.DeepEqual(t, &AttestationWatermarks{
+		LowestSourceEpoch:  1,
+		LowestTargetEpoch:  2,
+		HighestSourceEpoch: 2,
+		HighestTargetEpoch: 3,
+		SigningRoot:        [32]byte{'r', 'o', 'o', 't'},
+	}, watermarks[pubKeys[0]])
+	require.NoError(t, db.view(func(tx *bolt.Tx) error {
+		require.Equal(t, (*bolt.Bucket)(nil), tx.Bucket(attestationSigningRootsBucket), "Expected deprecated bucket to be deleted")
+		return nil
+	}))
+
+	// Running the migrations again leaves newer watermarks untouched.
+	require.NoError(t, db.CheckAndSaveAttestation(ctx, pubKeys[0], [32]byte{1}, 3, 4))
+	require.NoError(t, db.RunMigrations(ctx))
+	watermarks, err = db.AttestationWatermarksForPubKeys(ctx, pubKeys)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), watermarks[pubKeys[0]].HighestTargetEpoch)
+}
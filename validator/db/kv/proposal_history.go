@@ -1,12 +1,15 @@
 package kv
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"fmt"
+	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
+	sharedbytes "github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/wealdtech/go-bytesutil"
 	bolt "go.etcd.io/bbolt"
@@ -61,6 +64,126 @@ func (store *Store) SaveProposalHistoryForEpoch(ctx context.Context, pubKey []by
 	return err
 }
 
+// ErrSlashableProposal is returned when a block would be a double proposal with respect to
+// the proposal history of its validator.
+var ErrSlashableProposal = errors.New("block is a double proposal with respect to the proposal history")
+
+// CheckAndSaveProposal checks a block a validator intends to sign against its proposal history
+// and, if it is safe, records the proposal and its signing root before returning. Signing a block
+// with the same signing root as the one recorded for its slot is allowed. Blocks at or below the
+// lowest slot of an imported proposal history are refused otherwise. Returns
+// ErrSlashableProposal if the block must not be signed. Concurrent calls for different
+// validators are committed together in a single transaction.
+func (store *Store) CheckAndSaveProposal(ctx context.Context, pubKey [48]byte, signingRoot [32]byte, slot uint64) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.CheckAndSaveProposal")
+	defer span.End()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	epoch := slot / slotsPerEpoch
+	var slashable bool
+	err := store.batch(func(tx *bolt.Tx) error {
+		// The batch may run this function again, so the outcome is reset on every run.
+		slashable = false
+		valBucket, err := tx.Bucket(historicProposalsBucket).CreateBucketIfNotExists(pubKey[:])
+		if err != nil {
+			return errors.Wrap(err, "failed to create proposal history bucket")
+		}
+		rootsBucket, err := tx.Bucket(proposalSigningRootsBucket).CreateBucketIfNotExists(pubKey[:])
+		if err != nil {
+			return errors.Wrap(err, "failed to create proposal signing roots bucket")
+		}
+		slotKey := sharedbytes.Uint64ToBytesBigEndian(slot)
+
+		slotBits := proposalHistoryInBucket(valBucket, epoch)
+		if slotBits.BitAt(slot % slotsPerEpoch) {
+			// Re-signing the exact same block cannot be slashed.
+			slashable = !bytes.Equal(rootsBucket.Get(slotKey), signingRoot[:])
+			return nil
+		}
+		if lowest, ok := lowestSignedProposalInTx(tx, pubKey[:]); ok && slot <= lowest {
+			slashable = true
+			return nil
+		}
+
+		slotBits.SetBitAt(slot%slotsPerEpoch, true)
+		if err := valBucket.Put(bytesutil.Bytes8(epoch), slotBits); err != nil {
+			return err
+		}
+		if err := pruneProposalHistory(valBucket, epoch); err != nil {
+			return err
+		}
+		if err := rootsBucket.Put(slotKey, signingRoot[:]); err != nil {
+			return err
+		}
+		// Only keep the signing roots of the epochs still covered by the history.
+		wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+		if epoch < wsPeriod {
+			return nil
+		}
+		return pruneSigningRoots(rootsBucket, (epoch-wsPeriod+1)*slotsPerEpoch)
+	})
+	if err != nil {
+		return err
+	}
+	if slashable {
+		return ErrSlashableProposal
+	}
+	return nil
+}
+
+// ProposalSigningRootsForPubKey returns the signing roots of the blocks proposed by a validator
+// public key which are kept in its proposal history, by slot. Blocks whose signing root is not
+// known are not in the mapping.
+func (store *Store) ProposalSigningRootsForPubKey(ctx context.Context, publicKey []byte) (map[uint64][32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposalSigningRootsForPubKey")
+	defer span.End()
+
+	roots := make(map[uint64][32]byte)
+	err := store.view(func(tx *bolt.Tx) error {
+		rootsBucket := tx.Bucket(proposalSigningRootsBucket).Bucket(publicKey)
+		if rootsBucket == nil {
+			return nil
+		}
+		return rootsBucket.ForEach(func(k, v []byte) error {
+			roots[sharedbytes.BytesToUint64BigEndian(k)] = sharedbytes.ToBytes32(v)
+			return nil
+		})
+	})
+	return roots, err
+}
+
+// ProposedSlotsForPubKey returns the slots of all the blocks proposed by a validator
+// public key which are kept in its proposal history, in ascending order.
+func (store *Store) ProposedSlotsForPubKey(ctx context.Context, publicKey []byte) ([]uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposedSlotsForPubKey")
+	defer span.End()
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var slots []uint64
+	err := store.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(historicProposalsBucket)
+		valBucket := bucket.Bucket(publicKey)
+		if valBucket == nil {
+			return nil
+		}
+		// Epoch keys are little endian, so the bucket order is not the epoch order.
+		return valBucket.ForEach(func(k, v []byte) error {
+			epoch := binary.LittleEndian.Uint64(k)
+			slotBits := bitfield.Bitlist(v)
+			for i := uint64(0); i < slotsPerEpoch && i < slotBits.Len(); i++ {
+				if slotBits.BitAt(i) {
+					slots = append(slots, epoch*slotsPerEpoch+i)
+				}
+			}
+			return nil
+		})
+	})
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i] < slots[j]
+	})
+	return slots, err
+}
+
 // UpdatePublicKeysBuckets for a specified list of keys.
 func (store *Store) UpdatePublicKeysBuckets(pubKeys [][48]byte) error {
 	return store.update(func(tx *bolt.Tx) error {
@@ -74,6 +197,30 @@ func (store *Store) UpdatePublicKeysBuckets(pubKeys [][48]byte) error {
 	})
 }
 
+// lowestSignedProposalInTx returns the lowest slot of the imported proposal history of a
+// public key, and false if none was imported.
+func lowestSignedProposalInTx(tx *bolt.Tx, pubKey []byte) (uint64, bool) {
+	enc := tx.Bucket(lowestSignedProposalsBucket).Get(pubKey)
+	if len(enc) == 0 {
+		return 0, false
+	}
+	return sharedbytes.BytesToUint64BigEndian(enc), true
+}
+
+// proposalHistoryInBucket returns a copy of the proposal history of an epoch in the proposal
+// history bucket of a validator, which is empty if nothing was proposed.
+func proposalHistoryInBucket(valBucket *bolt.Bucket, epoch uint64) bitfield.Bitlist {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	enc := valBucket.Get(bytesutil.Bytes8(epoch))
+	if len(enc) == 0 {
+		return bitfield.NewBitlist(slotsPerEpoch)
+	}
+	// Adding an extra byte for the bitlist length.
+	slotBits := make(bitfield.Bitlist, slotsPerEpoch/8+1)
+	copy(slotBits, enc)
+	return slotBits
+}
+
 func pruneProposalHistory(valBucket *bolt.Bucket, newestEpoch uint64) error {
 	c := valBucket.Cursor()
 	for k, _ := c.First(); k != nil; k, _ = c.First() {
@@ -90,3 +237,18 @@ func pruneProposalHistory(valBucket *bolt.Bucket, newestEpoch uint64) error {
 	}
 	return nil
 }
+
+// pruneSigningRoots deletes the signing roots stored under a key lower than minKey. Keys are
+// big endian, so the bucket is iterated from the oldest entry.
+func pruneSigningRoots(rootsBucket *bolt.Bucket, minKey uint64) error {
+	c := rootsBucket.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.First() {
+		if sharedbytes.BytesToUint64BigEndian(k) >= minKey {
+			break
+		}
+		if err := c.Delete(); err != nil {
+			return errors.Wrap(err, "could not prune signing root")
+		}
+	}
+	return nil
+}
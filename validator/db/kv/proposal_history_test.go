@@ -194,3 +194,61 @@ func TestPruneProposalHistory_OK(t *testing.T) {
 		}
 	}
 }
+
+func TestProposedSlotsForPubKey(t *testing.T) {
+	pubkey := [48]byte{4}
+	db := setupDB(t, [][48]byte{pubkey})
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	slots, err := db.ProposedSlotsForPubKey(ctx, pubkey[:])
+	require.NoError(t, err)
+	require.Equal(t, 0, len(slots))
+
+	// Epoch 256 is stored before epoch 1 in the bucket, its key being little endian.
+	for _, slot := range []uint64{256*slotsPerEpoch + 3, slotsPerEpoch + 1, 2} {
+		epoch := helpers.SlotToEpoch(slot)
+		slotBits, err := db.ProposalHistoryForEpoch(ctx, pubkey[:], epoch)
+		require.NoError(t, err)
+		slotBits.SetBitAt(slot%slotsPerEpoch, true)
+		require.NoError(t, db.SaveProposalHistoryForEpoch(ctx, pubkey[:], epoch, slotBits))
+	}
+	slots, err = db.ProposedSlotsForPubKey(ctx, pubkey[:])
+	require.NoError(t, err)
+	require.DeepEqual(t, []uint64{2, slotsPerEpoch + 1, 256*slotsPerEpoch + 3}, slots)
+
+	unknown := [48]byte{5}
+	slots, err = db.ProposedSlotsForPubKey(ctx, unknown[:])
+	require.NoError(t, err)
+	require.Equal(t, 0, len(slots))
+}
+
+func TestCheckAndSaveProposal_AllowsIdenticalSigningRoot(t *testing.T) {
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{pubKey})
+	ctx := context.Background()
+	slot := params.BeaconConfig().SlotsPerEpoch*5 + 2
+
+	root := [32]byte{1}
+	require.NoError(t, db.CheckAndSaveProposal(ctx, pubKey, root, slot))
+	slotBits, err := db.ProposalHistoryForEpoch(ctx, pubKey[:], helpers.SlotToEpoch(slot))
+	require.NoError(t, err)
+	require.Equal(t, true, slotBits.BitAt(2), "Expected proposal to be recorded")
+
+	require.NoError(t, db.CheckAndSaveProposal(ctx, pubKey, root, slot), "Expected re-signing the same block to be allowed")
+	err = db.CheckAndSaveProposal(ctx, pubKey, [32]byte{2}, slot)
+	require.ErrorContains(t, ErrSlashableProposal.Error(), err)
+
+	// Other slots of the same epoch remain available.
+	require.NoError(t, db.CheckAndSaveProposal(ctx, pubKey, [32]byte{2}, slot+1))
+}
+
+func TestCheckAndSaveProposal_UnknownPubKey(t *testing.T) {
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{})
+
+	require.NoError(t, db.CheckAndSaveProposal(context.Background(), pubKey, [32]byte{1}, 10))
+	slots, err := db.ProposedSlotsForPubKey(context.Background(), pubKey[:])
+	require.NoError(t, err)
+	require.DeepEqual(t, []uint64{10}, slots)
+}
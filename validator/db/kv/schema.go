@@ -3,12 +3,36 @@ package kv
 var (
 	// Validator slashing protection from double proposals.
 	historicProposalsBucket = []byte("proposal-history-bucket")
-	// Validator slashing protection from slashable attestations.
+	// Deprecated: validator attestation histories in the weak subjectivity ring format,
+	// kept for migration and database management only.
 	historicAttestationsBucket = []byte("attestation-history-bucket")
+	// Validator slashing protection from slashable attestations, keyed by public key.
+	attestationWatermarksBucket = []byte("attestation-watermarks-bucket")
+	// Signing roots of the blocks a validator intended to propose, keyed by public key then slot.
+	proposalSigningRootsBucket = []byte("proposal-signing-roots-bucket")
+	// Lowest slot of the imported blocks of a validator, keyed by public key. No block may be
+	// signed at or below it, as the history before it is unknown.
+	lowestSignedProposalsBucket = []byte("lowest-signed-proposals-bucket")
+	// Deprecated: signing roots of the attestations a validator intended to sign, keyed by
+	// public key then target epoch. They are kept in the attestation watermarks instead.
+	attestationSigningRootsBucket = []byte("attestation-signing-roots-bucket")
+	// Bucket for storing the completed database migrations.
+	migrationsBucket = []byte("migrations-bucket")
+	// Bucket for storing the genesis information of the network the slashing
+	// protection history belongs to.
+	genesisInfoBucket = []byte("genesis-info-bucket")
+	// Bucket key for retrieving the genesis validators root.
+	genesisValidatorsRootKey = []byte("genesis-val-root")
 	// Bucket for storing important information regarding the validator API
 	// such as a password hash for API authentication.
 	validatorAPIBucket = []byte("validator-api-bucket")
 	// Bucket key for retrieving the hashed password used for
 	// authentication to the validator API.
 	apiHashedPasswordKey = []byte("hashed-password")
+	// Users of the validator API, keyed by username.
+	apiUsersBucket = []byte("api-users-bucket")
+	// API tokens of the validator API, keyed by token ID.
+	apiTokensBucket = []byte("api-tokens-bucket")
+	// Authentication and authorization events of the validator API, keyed by sequence.
+	apiAuthEventsBucket = []byte("api-auth-events-bucket")
 )
@@ -27,12 +27,19 @@ var (
 			"of validating keys may wish to disable granular prometheus metrics as it increases " +
 			"the data cardinality.",
 	}
-	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
+	// BeaconRPCProviderFlag defines the beacon node RPC endpoints, by order of preference.
 	BeaconRPCProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Name: "beacon-rpc-provider",
+		Usage: "Beacon node RPC provider endpoint, or a comma separated list of endpoints by order of " +
+			"preference. The validator client uses the first healthy endpoint and fails over to the next ones",
 		Value: "127.0.0.1:4000",
 	}
+	// BroadcastToBeaconNodesFlag enables broadcasting signed objects to every healthy beacon node.
+	BroadcastToBeaconNodesFlag = &cli.BoolFlag{
+		Name: "broadcast-to-all-beacon-nodes",
+		Usage: "Submit signed blocks, attestations, aggregates and exits to every healthy beacon node " +
+			"listed in --beacon-rpc-provider, instead of only the one in use",
+	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
 	BeaconRPCGatewayProviderFlag = &cli.StringFlag{
 		Name:  "beacon-rpc-gateway-provider",
@@ -72,11 +79,31 @@ var (
 		Name:  "disable-rewards-penalties-logging",
 		Usage: "Disable reward/penalty logging during cluster deployment",
 	}
+	// EnableDoppelgangerDetectionFlag enables watching for doppelgangers before signing.
+	EnableDoppelgangerDetectionFlag = &cli.BoolFlag{
+		Name: "enable-doppelganger-detection",
+		Usage: "Watch the chain for attestations or blocks made by the validating keys before signing, " +
+			"and shut down if another validator client is found using the same keys. Signing is delayed " +
+			"by the number of epochs of --doppelganger-detection-epochs on every start",
+	}
+	// DoppelgangerDetectionEpochsFlag defines how many epochs to watch for doppelgangers before signing.
+	DoppelgangerDetectionEpochsFlag = &cli.Uint64Flag{
+		Name:  "doppelganger-detection-epochs",
+		Usage: "Number of epochs to watch the chain for doppelgangers before signing, when doppelganger detection is enabled",
+		Value: 2,
+	}
 	// GraffitiFlag defines the graffiti value included in proposed blocks
 	GraffitiFlag = &cli.StringFlag{
 		Name:  "graffiti",
 		Usage: "String to include in proposed blocks",
 	}
+	// ValidatorsConfigFileFlag defines the path to the config file of the validating keys.
+	ValidatorsConfigFileFlag = &cli.StringFlag{
+		Name: "validators-config-file",
+		Usage: "Path to a YAML file listing wallets to load validating keys from, along with the " +
+			"graffiti, enabled state and metrics labels of each key. Keys enabled or disabled " +
+			"through the validator RPC are saved to this file",
+	}
 	// GrpcRetriesFlag defines the number of times to retry a failed gRPC request.
 	GrpcRetriesFlag = &cli.UintFlag{
 		Name:  "grpc-retries",
@@ -236,6 +263,52 @@ var (
 		Usage: "Path to a directory where accounts will be backed up into a zip file",
 		Value: DefaultValidatorDir(),
 	}
+	// NewWalletPasswordFileFlag is the path to a file containing the new password of a wallet.
+	NewWalletPasswordFileFlag = &cli.StringFlag{
+		Name:  "new-wallet-password-file",
+		Usage: "Path to a plain-text, .txt file containing the new password of your wallet",
+	}
+	// NewAccountPasswordFileFlag is the path to a file containing the new password of keystores.
+	NewAccountPasswordFileFlag = &cli.StringFlag{
+		Name:  "new-account-password-file",
+		Usage: "Path to a plain-text, .txt file containing the new password of the keystores",
+	}
+	// KeystoresPathFlag defines the path of a keystore file, or of a directory of keystore files,
+	// to change the password of.
+	KeystoresPathFlag = &cli.StringFlag{
+		Name:  "keystores-path",
+		Usage: "Path to an EIP-2335 keystore file, or to a directory of keystore files, to change the password of",
+	}
+	// KDFFlag defines the key derivation function encrypting keystores.
+	KDFFlag = &cli.StringFlag{
+		Name:  "kdf",
+		Usage: "Key derivation function encrypting the keystores: scrypt or pbkdf2",
+		Value: "scrypt",
+	}
+	// KDFScryptNFlag defines the scrypt CPU/memory cost parameter.
+	KDFScryptNFlag = &cli.IntFlag{
+		Name:  "kdf-scrypt-n",
+		Usage: "The scrypt CPU/memory cost parameter N, a power of 2 of at most 1048576",
+		Value: 262144,
+	}
+	// KDFScryptRFlag defines the scrypt block size parameter.
+	KDFScryptRFlag = &cli.IntFlag{
+		Name:  "kdf-scrypt-r",
+		Usage: "The scrypt block size parameter r, of at most 32",
+		Value: 8,
+	}
+	// KDFScryptPFlag defines the scrypt parallelization parameter.
+	KDFScryptPFlag = &cli.IntFlag{
+		Name:  "kdf-scrypt-p",
+		Usage: "The scrypt parallelization parameter p, of at most 4",
+		Value: 1,
+	}
+	// KDFPBKDF2CFlag defines the number of pbkdf2 iterations.
+	KDFPBKDF2CFlag = &cli.IntFlag{
+		Name:  "kdf-pbkdf2-c",
+		Usage: "The number of iterations of pbkdf2, of at most 1048576",
+		Value: 262144,
+	}
 	// KeysDirFlag defines the path for a directory where keystores to be imported at stored.
 	KeysDirFlag = &cli.StringFlag{
 		Name:  "keys-dir",
@@ -268,10 +341,87 @@ var (
 		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
 		Value: "",
 	}
+	// RemoteSignerURLFlag defines the URL of a remote signer server serving the HTTP signing API.
+	RemoteSignerURLFlag = &cli.StringFlag{
+		Name:  "remote-signer-url",
+		Usage: "URL of a remote signer server for a remote-http keymanager, such as https://host.example.com:9000",
+		Value: "",
+	}
+	// RemoteSignerTokenFileFlag defines the path to a file containing the bearer token sent to
+	// a remote signer server serving the HTTP signing API.
+	RemoteSignerTokenFileFlag = &cli.StringFlag{
+		Name:  "remote-signer-token-file",
+		Usage: "Path to a file containing the bearer token authenticating to a remote signer server for a remote-http keymanager",
+		Value: "",
+	}
+	// ThresholdFlag defines the number of share-holders whose partial signatures are required to
+	// sign with a validator key, for a threshold wallet.
+	ThresholdFlag = &cli.Uint64Flag{
+		Name:  "threshold",
+		Usage: "Number of share-holders required to sign with a validator key of a threshold wallet",
+	}
+	// ThresholdShareIDFlag defines the share ID of the share-holder of a threshold wallet.
+	ThresholdShareIDFlag = &cli.Uint64Flag{
+		Name:  "threshold-share-id",
+		Usage: "Non-zero share ID of the validator key shares held by this threshold wallet",
+	}
+	// ThresholdCosignersFlag defines the co-signers of a threshold wallet.
+	ThresholdCosignersFlag = &cli.StringFlag{
+		Name:  "threshold-cosigners",
+		Usage: "Comma-separated share IDs and URLs of the other share-holders of a threshold wallet, such as 2=https://10.0.0.2:7600,3=https://10.0.0.3:7600",
+	}
+	// ThresholdListenAddressFlag defines the address a threshold wallet serves partial signatures on.
+	ThresholdListenAddressFlag = &cli.StringFlag{
+		Name:  "threshold-listen-address",
+		Usage: "Host:port on which a threshold wallet serves partial signatures to its co-signers",
+		Value: "127.0.0.1:7600",
+	}
+	// ThresholdTokenFileFlag defines the path to the bearer token shared by the share-holders of
+	// a threshold wallet.
+	ThresholdTokenFileFlag = &cli.StringFlag{
+		Name:  "threshold-token-file",
+		Usage: "Path to a file containing the bearer token authenticating the share-holders of a threshold wallet to each other",
+	}
+	// ThresholdTLSCertPathFlag defines the path to the TLS certificate a threshold wallet serves
+	// partial signatures with.
+	ThresholdTLSCertPathFlag = &cli.StringFlag{
+		Name:  "threshold-tls-crt-path",
+		Usage: "/path/to/server.crt for serving partial signatures over TLS",
+	}
+	// ThresholdTLSKeyPathFlag defines the path to the TLS key a threshold wallet serves partial
+	// signatures with.
+	ThresholdTLSKeyPathFlag = &cli.StringFlag{
+		Name:  "threshold-tls-key-path",
+		Usage: "/path/to/server.key for serving partial signatures over TLS",
+	}
+	// ThresholdTLSCACertPathFlag defines the path to the CA certificate of the co-signers of a
+	// threshold wallet.
+	ThresholdTLSCACertPathFlag = &cli.StringFlag{
+		Name:  "threshold-tls-ca-crt-path",
+		Usage: "/path/to/ca.crt verifying the TLS certificates of the co-signers of a threshold wallet",
+	}
+	// ThresholdSharesDirFlag defines the directory the shares of the other share-holders are
+	// written to when creating a threshold wallet.
+	ThresholdSharesDirFlag = &cli.StringFlag{
+		Name:  "threshold-shares-dir",
+		Usage: "Directory to write the encrypted shares of the other share-holders to when generating a threshold wallet",
+	}
+	// ThresholdShareFileFlag defines the encrypted shares file generated by another share-holder
+	// to create a threshold wallet from.
+	ThresholdShareFileFlag = &cli.StringFlag{
+		Name:  "threshold-share-file",
+		Usage: "Path to an encrypted shares file generated by another share-holder, to create a threshold wallet from instead of generating shares",
+	}
+	// ThresholdSharePasswordFileFlag defines the path to the password of the encrypted shares
+	// file generated by another share-holder.
+	ThresholdSharePasswordFileFlag = &cli.StringFlag{
+		Name:  "threshold-share-password-file",
+		Usage: "Path to a file containing the password of the encrypted shares file given with --threshold-share-file",
+	}
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
-		Usage: "Kind of keymanager, either direct, derived, or remote, specified during wallet creation",
+		Usage: "Kind of keymanager, either direct, derived, remote, remote-http, or threshold, specified during wallet creation",
 		Value: "",
 	}
 	// Eth1KeystoreUTCPathFlag defines the path to an eth1 utc keystore containing eth1 private keys.
@@ -328,12 +478,118 @@ var (
 		Usage: "Sends a 32 ETH deposit for each of a user's validator accounts in their wallet",
 		Value: false,
 	}
+	// WithdrawalPublicKeyFlag is the BLS public key the withdrawal credentials of deposits are derived from.
+	WithdrawalPublicKeyFlag = &cli.StringFlag{
+		Name:  "withdrawal-public-key",
+		Usage: "Hex string of the BLS withdrawal public key of the deposits, required unless the wallet derives withdrawal keys",
+		Value: "",
+	}
+	// DepositDataDirFlag is the directory deposit data files are written to.
+	DepositDataDirFlag = &cli.StringFlag{
+		Name:  "deposit-data-dir",
+		Usage: "Path to a directory where a deposit_data-*.json file for the eth2 launchpad will be written",
+		Value: DefaultValidatorDir(),
+	}
+	// DepositDataFileFlag is the path of a deposit data file to verify.
+	DepositDataFileFlag = &cli.StringFlag{
+		Name:  "deposit-data-file",
+		Usage: "Path to a deposit_data-*.json file in the format of the eth2 launchpad",
+		Value: "",
+	}
+	// WithdrawalKeysDirFlag is the directory withdrawal keystores are exported to.
+	WithdrawalKeysDirFlag = &cli.StringFlag{
+		Name:  "withdrawal-keys-dir",
+		Usage: "Path to a directory outside of the wallet where withdrawal keystores will be exported, ideally on offline storage",
+		Value: "",
+	}
+	// WithdrawalKeysPasswordFileFlag is the path to a file containing the password of exported withdrawal keystores.
+	WithdrawalKeysPasswordFileFlag = &cli.StringFlag{
+		Name:  "withdrawal-keys-password-file",
+		Usage: "Path to a plain-text, .txt file containing the password of the exported withdrawal keystores",
+		Value: "",
+	}
+	// WithdrawalAccountIndicesFlag selects the accounts of a derived wallet to manage the withdrawal keys of.
+	WithdrawalAccountIndicesFlag = &cli.StringFlag{
+		Name:  "withdrawal-account-indices",
+		Usage: "Comma-separated list of the indices of the accounts of a derived wallet, all accounts if not set",
+		Value: "",
+	}
+	// ExitsDirFlag is the directory pre-signed voluntary exits are written to.
+	ExitsDirFlag = &cli.StringFlag{
+		Name:  "exits-dir",
+		Usage: "Path to a directory where pre-signed voluntary exit files will be written",
+		Value: "",
+	}
+	// ExitsPathFlag is the path of a pre-signed voluntary exit file, or of a directory of them.
+	ExitsPathFlag = &cli.StringFlag{
+		Name:  "exits-path",
+		Usage: "Path to a pre-signed voluntary exit file, or to a directory of exit-*.json files",
+		Value: "",
+	}
+	// ExitEpochFlag is the epoch from which pre-signed voluntary exits are valid.
+	ExitEpochFlag = &cli.Uint64Flag{
+		Name:  "exit-epoch",
+		Usage: "Epoch from which the pre-signed voluntary exits are valid, the current epoch if not set",
+	}
+	// ExitsPerEpochFlag caps the number of voluntary exits submitted per epoch.
+	ExitsPerEpochFlag = &cli.Uint64Flag{
+		Name:  "exits-per-epoch",
+		Usage: "Maximum number of voluntary exits to submit per epoch, the churn limit of the network if lower or not set",
+	}
+	// WatchExitsFlag keeps tracking the exits of validators until they are withdrawable.
+	WatchExitsFlag = &cli.BoolFlag{
+		Name:  "watch",
+		Usage: "Keeps tracking the exits every epoch until all the validators are withdrawable",
+		Value: false,
+	}
+	// ReportFromEpochFlag is the first epoch of the performance report.
+	ReportFromEpochFlag = &cli.Uint64Flag{
+		Name:  "from-epoch",
+		Usage: "First epoch of the performance report, the 225 epochs up to the last epoch of the report if not set",
+	}
+	// ReportToEpochFlag is the last epoch of the performance report.
+	ReportToEpochFlag = &cli.Uint64Flag{
+		Name:  "to-epoch",
+		Usage: "Last epoch of the performance report, the last epoch whose votes can no longer be included if not set",
+	}
+	// ReportFormatFlag is the format of the performance report.
+	ReportFormatFlag = &cli.StringFlag{
+		Name:  "format",
+		Usage: "Format of the performance report: table, csv or json",
+		Value: "table",
+	}
+	// ReportOutputFileFlag is the file the performance report is written to.
+	ReportOutputFileFlag = &cli.StringFlag{
+		Name:  "output-file",
+		Usage: "File to write the performance report to, the standard output if not set",
+		Value: "",
+	}
 	// EnableWebFlag enables controlling the validator client via the Prysm web ui. This is a work in progress.
 	EnableWebFlag = &cli.BoolFlag{
 		Name:  "web",
 		Usage: "Enables the web portal for the validator client (work in progress)",
 		Value: false,
 	}
+	// SlashingProtectionJSONFileFlag is used to specify the path of an EIP-3076 slashing
+	// protection interchange file to import from or export to.
+	SlashingProtectionJSONFileFlag = &cli.StringFlag{
+		Name:  "slashing-protection-json-file",
+		Usage: "Path to an EIP-3076 compliant slashing protection interchange JSON file to import or export",
+		Value: "",
+	}
+	// AuditLogDirFlag defines the directory of the audit log of every message the validator client signs.
+	AuditLogDirFlag = &cli.StringFlag{
+		Name: "audit-log-dir",
+		Usage: "Directory of a hash-chained, append-only log of every message the validator client signs " +
+			"or refuses to sign. No audit log is kept if empty",
+		Value: "",
+	}
+	// AuditLogMaxSizeFlag defines the size at which the audit log is rotated to a new file.
+	AuditLogMaxSizeFlag = &cli.Int64Flag{
+		Name:  "audit-log-max-size-mb",
+		Usage: "Size in megabytes at which the audit log is rotated to a new file",
+		Value: 100,
+	}
 )
 
 // Deprecated flags list.
@@ -0,0 +1,281 @@
+// Package keyconfig defines the configuration of the validator client for each of its
+// validating keys, such as the graffiti of their blocks or whether they are enabled. The
+// configuration is loaded from a YAML file, which may also list several wallets to load
+// the keys from, and keys can be enabled or disabled at runtime.
+package keyconfig
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+var log = logrus.WithField("prefix", "keyconfig")
+
+// File is the configuration file of the validating keys, such as:
+//
+//	wallets:
+//	  - wallet_dir: /var/lib/validator/customer-a
+//	    wallet_password_file: /etc/validator/customer-a.txt
+//	default_config:
+//	  graffiti: "hosted"
+//	validators:
+//	  0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a:
+//	    graffiti: "customer a"
+//	    enabled: false
+//	    labels:
+//	      customer: a
+type File struct {
+	Wallets    []*Wallet           `yaml:"wallets,omitempty"`
+	Default    *Options            `yaml:"default_config,omitempty"`
+	Validators map[string]*Options `yaml:"validators,omitempty"`
+}
+
+// Wallet to load validating keys from.
+type Wallet struct {
+	Dir          string `yaml:"wallet_dir"`
+	PasswordFile string `yaml:"wallet_password_file,omitempty"`
+}
+
+// Options of a validating key. Unset options fall back to the default options of the file.
+type Options struct {
+	Graffiti *string           `yaml:"graffiti,omitempty"`
+	Enabled  *bool             `yaml:"enabled,omitempty"`
+	Labels   map[string]string `yaml:"labels,omitempty"`
+}
+
+// Password of the wallet, read from its password file.
+func (w *Wallet) Password() (string, error) {
+	if w.PasswordFile == "" {
+		return "", errors.Errorf("no password file for wallet %s", w.Dir)
+	}
+	data, err := fileutil.ReadFileAsBytes(w.PasswordFile)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not read password file of wallet %s", w.Dir)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// Store holds the options of every validating key. It is safe for concurrent use.
+type Store struct {
+	path       string
+	lock       sync.RWMutex
+	file       *File
+	keys       map[[48]byte]*Options
+	generation uint64
+}
+
+// Load the configuration file of the validating keys. Keys enabled or disabled at runtime
+// are saved back to the file, so that they remain so after a restart.
+func Load(path string) (*Store, error) {
+	expanded, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	enc, err := ioutil.ReadFile(expanded)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read validating keys config file")
+	}
+	file := &File{}
+	if err := yaml.UnmarshalStrict(enc, file); err != nil {
+		return nil, errors.Wrap(err, "could not parse validating keys config file")
+	}
+	s, err := NewStore(file)
+	if err != nil {
+		return nil, err
+	}
+	s.path = expanded
+	return s, nil
+}
+
+// NewStore of the options of a configuration file, which are not saved to disk when changed.
+func NewStore(file *File) (*Store, error) {
+	if file.Validators == nil {
+		file.Validators = make(map[string]*Options)
+	}
+	keys := make(map[[48]byte]*Options, len(file.Validators))
+	for key, opts := range file.Validators {
+		pubKey, err := parsePublicKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := keys[pubKey]; ok {
+			return nil, errors.Errorf("validating key %s is configured more than once", key)
+		}
+		if opts == nil {
+			opts = &Options{}
+			file.Validators[key] = opts
+		}
+		keys[pubKey] = opts
+	}
+	for _, w := range file.Wallets {
+		if w.Dir == "" {
+			return nil, errors.New("wallet without a wallet_dir")
+		}
+	}
+	s := &Store{
+		file: file,
+		keys: keys,
+	}
+	for pubKey := range keys {
+		s.updateMetrics(pubKey)
+	}
+	return s, nil
+}
+
+// Wallets to load validating keys from.
+func (s *Store) Wallets() []*Wallet {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.file.Wallets
+}
+
+// Graffiti of the blocks proposed by a validating key, if one is configured.
+func (s *Store) Graffiti(pubKey [48]byte) ([]byte, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if opts, ok := s.keys[pubKey]; ok && opts.Graffiti != nil {
+		return []byte(*opts.Graffiti), true
+	}
+	if s.file.Default != nil && s.file.Default.Graffiti != nil {
+		return []byte(*s.file.Default.Graffiti), true
+	}
+	return nil, false
+}
+
+// Enabled returns whether a validating key performs its duties, which it does unless it
+// is disabled.
+func (s *Store) Enabled(pubKey [48]byte) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.enabled(pubKey)
+}
+
+// Labels of the metrics of a validating key, on top of the default labels.
+func (s *Store) Labels(pubKey [48]byte) map[string]string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.labels(pubKey)
+}
+
+// SetEnabled enables or disables a validating key, and saves it to the configuration file.
+func (s *Store) SetEnabled(pubKey [48]byte, enabled bool) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	opts, ok := s.keys[pubKey]
+	if !ok {
+		opts = &Options{}
+		s.keys[pubKey] = opts
+		s.file.Validators[fmt.Sprintf("%#x", pubKey)] = opts
+	}
+	previous := opts.Enabled
+	opts.Enabled = &enabled
+	if err := s.save(); err != nil {
+		opts.Enabled = previous
+		return err
+	}
+	s.generation++
+	s.updateMetrics(pubKey)
+	log.WithFields(logrus.Fields{
+		"pubKey":  fmt.Sprintf("%#x", pubKey),
+		"enabled": enabled,
+	}).Info("Changed validating key")
+	return nil
+}
+
+// Generation of the configuration, which changes every time a key is enabled or disabled.
+func (s *Store) Generation() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.generation
+}
+
+func (s *Store) enabled(pubKey [48]byte) bool {
+	if opts, ok := s.keys[pubKey]; ok && opts.Enabled != nil {
+		return *opts.Enabled
+	}
+	if s.file.Default != nil && s.file.Default.Enabled != nil {
+		return *s.file.Default.Enabled
+	}
+	return true
+}
+
+func (s *Store) labels(pubKey [48]byte) map[string]string {
+	labels := make(map[string]string)
+	if s.file.Default != nil {
+		for name, value := range s.file.Default.Labels {
+			labels[name] = value
+		}
+	}
+	if opts, ok := s.keys[pubKey]; ok {
+		for name, value := range opts.Labels {
+			labels[name] = value
+		}
+	}
+	return labels
+}
+
+// save the configuration to its file, if it was loaded from one. The file is replaced as
+// a whole, so that it is never partially written.
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	enc, err := yaml.Marshal(s.file)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal validating keys config")
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "could not write validating keys config file")
+	}
+	if _, err := tmp.Write(enc); err != nil {
+		return s.abortSave(tmp, err)
+	}
+	if err := tmp.Chmod(params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return s.abortSave(tmp, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return s.abortSave(tmp, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return s.abortSave(tmp, err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return s.abortSave(tmp, err)
+	}
+	return nil
+}
+
+func (s *Store) abortSave(tmp *os.File, err error) error {
+	if closeErr := tmp.Close(); closeErr != nil && !errors.Is(closeErr, os.ErrClosed) {
+		log.WithError(closeErr).Debug("Could not close temporary config file")
+	}
+	if removeErr := os.Remove(tmp.Name()); removeErr != nil {
+		log.WithError(removeErr).Error("Could not remove temporary config file")
+	}
+	return errors.Wrap(err, "could not write validating keys config file")
+}
+
+func parsePublicKey(key string) ([48]byte, error) {
+	var pubKey [48]byte
+	enc, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+	if err != nil {
+		return pubKey, errors.Wrapf(err, "could not decode validating key %s", key)
+	}
+	if len(enc) != len(pubKey) {
+		return pubKey, errors.Errorf("validating key %s is %d bytes long, expected %d", key, len(enc), len(pubKey))
+	}
+	copy(pubKey[:], enc)
+	return pubKey, nil
+}
@@ -0,0 +1,136 @@
+package keyconfig
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var (
+	firstKey  = [48]byte{1}
+	secondKey = [48]byte{2}
+	thirdKey  = [48]byte{3}
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	randPath, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	require.NoError(t, err, "Could not generate random file path")
+	dir := filepath.Join(testutil.TempDir(), fmt.Sprintf("keyconfig-%d", randPath))
+	require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir), "Failed to remove directory")
+	})
+	path := filepath.Join(dir, "validators.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeConfigFile(t, fmt.Sprintf(`
+wallets:
+  - wallet_dir: /var/lib/validator/a
+    wallet_password_file: /etc/validator/a.txt
+  - wallet_dir: /var/lib/validator/b
+default_config:
+  graffiti: "hosted"
+  labels:
+    operator: us
+validators:
+  "%#x":
+    graffiti: "customer a"
+    labels:
+      customer: a
+  "%#x":
+    enabled: false
+`, firstKey, secondKey))
+	s, err := Load(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, len(s.Wallets()))
+	assert.Equal(t, "/var/lib/validator/b", s.Wallets()[1].Dir)
+
+	graffiti, ok := s.Graffiti(firstKey)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "customer a", string(graffiti))
+	graffiti, ok = s.Graffiti(thirdKey)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "hosted", string(graffiti))
+
+	assert.Equal(t, true, s.Enabled(firstKey))
+	assert.Equal(t, false, s.Enabled(secondKey))
+	assert.Equal(t, true, s.Enabled(thirdKey))
+
+	assert.DeepEqual(t, map[string]string{"operator": "us", "customer": "a"}, s.Labels(firstKey))
+	assert.DeepEqual(t, map[string]string{"operator": "us"}, s.Labels(thirdKey))
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{
+			name:    "unknown field",
+			content: "validators:\n  \"0x01\":\n    grafiti: typo\n",
+			err:     "could not parse",
+		},
+		{
+			name:    "short key",
+			content: "validators:\n  \"0x01\":\n    enabled: false\n",
+			err:     "is 1 bytes long",
+		},
+		{
+			name:    "duplicate key",
+			content: fmt.Sprintf("validators:\n  \"%#x\": {}\n  \"%x\": {}\n", firstKey, firstKey),
+			err:     "is configured more than once",
+		},
+		{
+			name:    "wallet without dir",
+			content: "wallets:\n  - wallet_password_file: /etc/validator/a.txt\n",
+			err:     "wallet without a wallet_dir",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeConfigFile(t, tt.content))
+			assert.ErrorContains(t, tt.err, err)
+		})
+	}
+}
+
+func TestStore_SetEnabled_SavesFile(t *testing.T) {
+	path := writeConfigFile(t, fmt.Sprintf("validators:\n  \"%#x\":\n    graffiti: \"customer a\"\n", firstKey))
+	s, err := Load(path)
+	require.NoError(t, err)
+	require.NoError(t, s.SetEnabled(firstKey, false))
+	require.NoError(t, s.SetEnabled(secondKey, false))
+	assert.Equal(t, uint64(2), s.Generation())
+	assert.Equal(t, false, s.Enabled(firstKey))
+
+	// The keys stay disabled when the file is loaded again, and other options are kept.
+	s, err = Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, false, s.Enabled(firstKey))
+	assert.Equal(t, false, s.Enabled(secondKey))
+	graffiti, _ := s.Graffiti(firstKey)
+	assert.Equal(t, "customer a", string(graffiti))
+}
+
+func TestWallet_Password(t *testing.T) {
+	w := &Wallet{Dir: "/var/lib/validator/a", PasswordFile: writeConfigFile(t, "secret\n")}
+	password, err := w.Password()
+	require.NoError(t, err)
+	assert.Equal(t, "secret", password)
+
+	_, err = (&Wallet{Dir: "/var/lib/validator/b"}).Password()
+	assert.ErrorContains(t, "no password file", err)
+}
@@ -0,0 +1,58 @@
+package keyconfig
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	keyEnabledGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "key_enabled",
+			Help:      "Whether a validating key performs its duties: 1 enabled, 0 disabled",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// keyLabelGaugeVec exposes the labels of the validating keys as a series set to 1 for
+	// each of them, which can be joined on the pubkey label with the other metrics of the
+	// validator client.
+	keyLabelGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "key_label",
+			Help:      "Labels of the validating keys, from the validating keys config file",
+		},
+		[]string{
+			"pubkey",
+			"name",
+			"value",
+		},
+	)
+)
+
+func (s *Store) updateMetrics(pubKey [48]byte) {
+	fmtKey := fmt.Sprintf("%#x", pubKey)
+	if s.enabled(pubKey) {
+		keyEnabledGaugeVec.WithLabelValues(fmtKey).Set(1)
+	} else {
+		keyEnabledGaugeVec.WithLabelValues(fmtKey).Set(0)
+	}
+	for name, value := range s.labels(pubKey) {
+		keyLabelGaugeVec.WithLabelValues(fmtKey, name, value).Set(1)
+	}
+}
+
+// UpdateMetrics of validating keys, including the keys which are only configured by the
+// default options of the file.
+func (s *Store) UpdateMetrics(pubKeys [][48]byte) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	for _, pubKey := range pubKeys {
+		s.updateMetrics(pubKey)
+	}
+}
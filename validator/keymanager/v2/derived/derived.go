@@ -22,6 +22,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/petnames"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/prysmaticlabs/prysm/validator/accounts/v2/iface"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/tyler-smith/go-bip39"
 	util "github.com/wealdtech/go-eth2-util"
@@ -246,6 +247,33 @@ func (dr *Keymanager) WriteEncryptedSeedToWallet(ctx context.Context, mnemonic s
 	return nil
 }
 
+// ReencryptSeed writes the seed of the wallet again, encrypted with the current password of
+// the wallet, such as after the password of the wallet changed. The key derivation function
+// of the seed keystore is the default one if kdf is nil.
+func (dr *Keymanager) ReencryptSeed(ctx context.Context, kdf *v2keymanager.KDFConfig) error {
+	encryptor := keystorev4.New()
+	cryptoFields, err := v2keymanager.EncryptKeystore(dr.seed, dr.wallet.Password(), kdf)
+	if err != nil {
+		return errors.Wrap(err, "could not encrypt seed phrase into keystore")
+	}
+	seedCfg := &SeedConfig{
+		Crypto:      cryptoFields,
+		ID:          dr.seedCfg.ID,
+		NextAccount: dr.seedCfg.NextAccount,
+		Version:     encryptor.Version(),
+		Name:        encryptor.Name(),
+	}
+	encodedSeedFile, err := marshalEncryptedSeedFile(seedCfg)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal encrypted wallet seed file")
+	}
+	if err := dr.wallet.WriteEncryptedSeedToDisk(ctx, encodedSeedFile); err != nil {
+		return errors.Wrap(err, "could not write encrypted wallet seed config to disk")
+	}
+	dr.seedCfg = seedCfg
+	return nil
+}
+
 // ValidatingAccountNames for the derived keymanager.
 func (dr *Keymanager) ValidatingAccountNames(ctx context.Context) ([]string, error) {
 	dr.lock.RLock()
@@ -0,0 +1,54 @@
+package derived
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	util "github.com/wealdtech/go-eth2-util"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// ExtractWithdrawalKeystores derives the withdrawal keys of the specified accounts, encrypts
+// them using the specified password, and returns their respective EIP-2335 keystores along
+// with their derivation path. Withdrawal keys are not needed to validate, so they can be kept
+// apart from the wallet.
+func (dr *Keymanager) ExtractWithdrawalKeystores(
+	ctx context.Context, accountIndices []uint64, password string,
+) ([]*v2keymanager.Keystore, error) {
+	encryptor := keystorev4.New()
+	keystores := make([]*v2keymanager.Keystore, len(accountIndices))
+	for i, accountIndex := range accountIndices {
+		if accountIndex >= dr.seedCfg.NextAccount {
+			return nil, fmt.Errorf(
+				"account %d does not exist, the wallet has %d accounts",
+				accountIndex,
+				dr.seedCfg.NextAccount,
+			)
+		}
+		withdrawalKeyPath := fmt.Sprintf(WithdrawalKeyDerivationPathTemplate, accountIndex)
+		withdrawalKey, err := util.PrivateKeyFromSeedAndPath(dr.seed, withdrawalKeyPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to derive withdrawal key for account %d", accountIndex)
+		}
+		cryptoFields, err := encryptor.Encrypt(withdrawalKey.Marshal(), password)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not encrypt withdrawal key for account %d", accountIndex)
+		}
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return nil, err
+		}
+		keystores[i] = &v2keymanager.Keystore{
+			Crypto:  cryptoFields,
+			ID:      id.String(),
+			Pubkey:  fmt.Sprintf("%x", withdrawalKey.PublicKey().Marshal()),
+			Version: encryptor.Version(),
+			Name:    encryptor.Name(),
+			Path:    withdrawalKeyPath,
+		}
+	}
+	return keystores, nil
+}
@@ -0,0 +1,47 @@
+package derived
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestDerivedKeymanager_ExtractWithdrawalKeystores(t *testing.T) {
+	seed := make([]byte, 32)
+	_, err := rand.NewGenerator().Read(seed)
+	require.NoError(t, err)
+	dr := &Keymanager{
+		seed:    seed,
+		seedCfg: &SeedConfig{NextAccount: 3},
+	}
+	ctx := context.Background()
+	password := "password"
+	withdrawalPublicKeys, err := dr.FetchWithdrawalPublicKeys(ctx)
+	require.NoError(t, err)
+
+	keystores, err := dr.ExtractWithdrawalKeystores(ctx, []uint64{0, 2}, password)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(keystores))
+	decryptor := keystorev4.New()
+	for i, accountIndex := range []uint64{0, 2} {
+		assert.Equal(t, fmt.Sprintf(WithdrawalKeyDerivationPathTemplate, accountIndex), keystores[i].Path)
+		pubKeyBytes, err := hex.DecodeString(keystores[i].Pubkey)
+		require.NoError(t, err)
+		assert.DeepEqual(t, withdrawalPublicKeys[accountIndex][:], pubKeyBytes)
+		secretKeyBytes, err := decryptor.Decrypt(keystores[i].Crypto, password)
+		require.NoError(t, err)
+		secretKey, err := bls.SecretKeyFromBytes(secretKeyBytes)
+		require.NoError(t, err)
+		assert.DeepEqual(t, pubKeyBytes, secretKey.PublicKey().Marshal())
+	}
+
+	_, err = dr.ExtractWithdrawalKeystores(ctx, []uint64{3}, password)
+	assert.ErrorContains(t, "account 3 does not exist", err)
+}
@@ -323,6 +323,28 @@ func (dr *Keymanager) DeleteAccounts(ctx context.Context, publicKeys [][]byte) e
 	return nil
 }
 
+// ReencryptAccounts writes the accounts keystore of the wallet again, encrypted with the
+// current password of the wallet, such as after the password of the wallet changed. The
+// key derivation function of the keystore is the default one if kdf is nil.
+func (dr *Keymanager) ReencryptAccounts(ctx context.Context, kdf *v2keymanager.KDFConfig) error {
+	store := dr.accountsStore
+	if store == nil {
+		store = &AccountStore{}
+	}
+	newStore, err := encryptAccountsStore(store, dr.wallet.Password(), kdf)
+	if err != nil {
+		return errors.Wrap(err, "could not rewrite accounts keystore")
+	}
+	encoded, err := json.MarshalIndent(newStore, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err := dr.wallet.WriteFileAtPath(ctx, AccountsPath, accountsKeystoreFileName, encoded); err != nil {
+		return errors.Wrap(err, "could not write keystore file for accounts")
+	}
+	return nil
+}
+
 // FetchValidatingPublicKeys fetches the list of public keys from the direct account keystores.
 func (dr *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
 	dr.lock.RLock()
@@ -400,11 +422,6 @@ func (dr *Keymanager) createAccountsKeystore(
 	privateKeys [][]byte,
 	publicKeys [][]byte,
 ) (*v2keymanager.Keystore, error) {
-	encryptor := keystorev4.New()
-	id, err := uuid.NewRandom()
-	if err != nil {
-		return nil, err
-	}
 	if len(privateKeys) != len(publicKeys) {
 		return nil, fmt.Errorf(
 			"number of private keys and public keys is not equal: %d != %d", len(privateKeys), len(publicKeys),
@@ -436,15 +453,28 @@ func (dr *Keymanager) createAccountsKeystore(
 			dr.accountsStore.PrivateKeys = append(dr.accountsStore.PrivateKeys, sk)
 		}
 	}
-	err = dr.initializeKeysCachesFromKeystore()
-	if err != nil {
+	if err := dr.initializeKeysCachesFromKeystore(); err != nil {
 		return nil, errors.Wrap(err, "failed to initialize keys caches")
 	}
-	encodedStore, err := json.MarshalIndent(dr.accountsStore, "", "\t")
+	return encryptAccountsStore(dr.accountsStore, dr.wallet.Password(), nil)
+}
+
+// encryptAccountsStore encrypts the accounts of the wallet into a keystore.
+func encryptAccountsStore(
+	store *AccountStore,
+	password string,
+	kdf *v2keymanager.KDFConfig,
+) (*v2keymanager.Keystore, error) {
+	encryptor := keystorev4.New()
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	encodedStore, err := json.MarshalIndent(store, "", "\t")
 	if err != nil {
 		return nil, err
 	}
-	cryptoFields, err := encryptor.Encrypt(encodedStore, dr.wallet.Password())
+	cryptoFields, err := v2keymanager.EncryptKeystore(encodedStore, password, kdf)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not encrypt accounts")
 	}
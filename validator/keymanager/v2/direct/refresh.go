@@ -40,8 +40,11 @@ func (dr *Keymanager) listenForAccountChanges(ctx context.Context) {
 			log.WithError(err).Error("Could not close file watcher")
 		}
 	}()
-	if err := watcher.Add(accountsFilePath); err != nil {
-		log.WithError(err).Errorf("Could not add file %s to file watcher", accountsFilePath)
+	// We watch the directory of the file rather than the file itself, as the file
+	// is replaced by a new one when it is written atomically.
+	accountsDir := filepath.Dir(accountsFilePath)
+	if err := watcher.Add(accountsDir); err != nil {
+		log.WithError(err).Errorf("Could not add directory %s to file watcher", accountsDir)
 		return
 	}
 	ctx, cancel := context.WithCancel(ctx)
@@ -79,9 +82,12 @@ func (dr *Keymanager) listenForAccountChanges(ctx context.Context) {
 	for {
 		select {
 		case event := <-watcher.Events:
-			// If a file was modified, we attempt to read that file
+			// If the file was modified or replaced, we attempt to read that file
 			// and parse it into our accounts store.
-			if event.Op&fsnotify.Write == fsnotify.Write {
+			if filepath.Base(event.Name) != accountsKeystoreFileName {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
 				fileChangesChan <- event
 			}
 		case err := <-watcher.Errors:
@@ -0,0 +1,228 @@
+package v2
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// The key derivation functions which can encrypt EIP-2335 keystores.
+const (
+	ScryptKDF = "scrypt"
+	PBKDF2KDF = "pbkdf2"
+)
+
+// The default parameters of the key derivation functions, from EIP-2335.
+const (
+	defaultScryptN = 262144
+	defaultScryptR = 8
+	defaultScryptP = 1
+	defaultPBKDF2C = 262144
+)
+
+// The maximum parameters of the key derivation functions, 4 times the default ones, so that
+// a keystore cannot be made too costly to decrypt.
+const (
+	maxScryptN = 4 * defaultScryptN
+	maxScryptR = 4 * defaultScryptR
+	maxScryptP = 4 * defaultScryptP
+	maxPBKDF2C = 4 * defaultPBKDF2C
+)
+
+// KDFConfig defines the key derivation function encrypting EIP-2335 keystores, along with
+// its parameters.
+type KDFConfig struct {
+	Function string
+	// ScryptN, ScryptR and ScryptP are the cost parameters of scrypt.
+	ScryptN int
+	ScryptR int
+	ScryptP int
+	// PBKDF2C is the number of iterations of pbkdf2.
+	PBKDF2C int
+}
+
+// DefaultKDFConfig returns the default parameters of a key derivation function.
+func DefaultKDFConfig(function string) (*KDFConfig, error) {
+	switch function {
+	case ScryptKDF:
+		return &KDFConfig{
+			Function: ScryptKDF,
+			ScryptN:  defaultScryptN,
+			ScryptR:  defaultScryptR,
+			ScryptP:  defaultScryptP,
+		}, nil
+	case PBKDF2KDF:
+		return &KDFConfig{
+			Function: PBKDF2KDF,
+			PBKDF2C:  defaultPBKDF2C,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key derivation function %q, expected %s or %s", function, ScryptKDF, PBKDF2KDF)
+	}
+}
+
+// NewKDFConfig returns the config of a key derivation function, with its default
+// parameters for the ones which are 0. The parameters are validated.
+func NewKDFConfig(function string, scryptN, scryptR, scryptP, pbkdf2C int) (*KDFConfig, error) {
+	cfg, err := DefaultKDFConfig(function)
+	if err != nil {
+		return nil, err
+	}
+	switch function {
+	case ScryptKDF:
+		if scryptN != 0 {
+			cfg.ScryptN = scryptN
+		}
+		if scryptR != 0 {
+			cfg.ScryptR = scryptR
+		}
+		if scryptP != 0 {
+			cfg.ScryptP = scryptP
+		}
+	case PBKDF2KDF:
+		if pbkdf2C != 0 {
+			cfg.PBKDF2C = pbkdf2C
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate the parameters of the key derivation function.
+func (c *KDFConfig) Validate() error {
+	switch c.Function {
+	case ScryptKDF:
+		if c.ScryptN <= 1 || c.ScryptN&(c.ScryptN-1) != 0 {
+			return fmt.Errorf("scrypt N must be a power of 2 greater than 1, received %d", c.ScryptN)
+		}
+		if c.ScryptN > maxScryptN {
+			return fmt.Errorf("scrypt N must be at most %d, received %d", maxScryptN, c.ScryptN)
+		}
+		if c.ScryptR <= 0 || c.ScryptP <= 0 {
+			return fmt.Errorf("scrypt r and p must be positive, received %d and %d", c.ScryptR, c.ScryptP)
+		}
+		if c.ScryptR > maxScryptR || c.ScryptP > maxScryptP {
+			return fmt.Errorf(
+				"scrypt r and p must be at most %d and %d, received %d and %d",
+				maxScryptR, maxScryptP, c.ScryptR, c.ScryptP,
+			)
+		}
+	case PBKDF2KDF:
+		if c.PBKDF2C <= 0 {
+			return fmt.Errorf("pbkdf2 c must be positive, received %d", c.PBKDF2C)
+		}
+		if c.PBKDF2C > maxPBKDF2C {
+			return fmt.Errorf("pbkdf2 c must be at most %d, received %d", maxPBKDF2C, c.PBKDF2C)
+		}
+	default:
+		return fmt.Errorf("unsupported key derivation function %q, expected %s or %s", c.Function, ScryptKDF, PBKDF2KDF)
+	}
+	return nil
+}
+
+// EncryptKeystore encrypts a secret with a password into the crypto fields of an EIP-2335
+// keystore, deriving the encryption key with the given function. The default encryptor
+// is used if the config is nil. The result is checked to decrypt to the secret.
+func EncryptKeystore(secret []byte, password string, kdfCfg *KDFConfig) (map[string]interface{}, error) {
+	encryptor := keystorev4.New()
+	if kdfCfg == nil {
+		return encryptor.Encrypt(secret, password)
+	}
+	if err := kdfCfg.Validate(); err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Wrap(err, "could not generate salt")
+	}
+	var key []byte
+	var kdf map[string]interface{}
+	switch kdfCfg.Function {
+	case ScryptKDF:
+		var err error
+		key, err = scrypt.Key([]byte(password), salt, kdfCfg.ScryptN, kdfCfg.ScryptR, kdfCfg.ScryptP, 32)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not derive key with scrypt")
+		}
+		kdf = map[string]interface{}{
+			"function": ScryptKDF,
+			"params": map[string]interface{}{
+				"dklen": 32,
+				"n":     kdfCfg.ScryptN,
+				"r":     kdfCfg.ScryptR,
+				"p":     kdfCfg.ScryptP,
+				"salt":  hex.EncodeToString(salt),
+			},
+			"message": "",
+		}
+	case PBKDF2KDF:
+		key = pbkdf2.Key([]byte(password), salt, kdfCfg.PBKDF2C, 32, sha256.New)
+		kdf = map[string]interface{}{
+			"function": PBKDF2KDF,
+			"params": map[string]interface{}{
+				"dklen": 32,
+				"c":     kdfCfg.PBKDF2C,
+				"prf":   "hmac-sha256",
+				"salt":  hex.EncodeToString(salt),
+			},
+			"message": "",
+		}
+	}
+
+	iv := make([]byte, 16)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, errors.Wrap(err, "could not generate iv")
+	}
+	block, err := aes.NewCipher(key[:16])
+	if err != nil {
+		return nil, err
+	}
+	cipherMsg := make([]byte, len(secret))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherMsg, secret)
+	checksum := sha256.Sum256(append(append([]byte{}, key[16:32]...), cipherMsg...))
+
+	// The crypto fields are encoded and decoded as JSON, to be in the same form as the
+	// ones read from keystore files.
+	enc, err := json.Marshal(map[string]interface{}{
+		"kdf": kdf,
+		"checksum": map[string]interface{}{
+			"function": "sha256",
+			"params":   map[string]interface{}{},
+			"message":  hex.EncodeToString(checksum[:]),
+		},
+		"cipher": map[string]interface{}{
+			"function": "aes-128-ctr",
+			"params": map[string]interface{}{
+				"iv": hex.EncodeToString(iv),
+			},
+			"message": hex.EncodeToString(cipherMsg),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	cryptoFields := make(map[string]interface{})
+	if err := json.Unmarshal(enc, &cryptoFields); err != nil {
+		return nil, err
+	}
+	decrypted, err := encryptor.Decrypt(cryptoFields, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt keystore after encrypting it")
+	}
+	if !bytes.Equal(decrypted, secret) {
+		return nil, errors.New("keystore does not decrypt to the encrypted secret")
+	}
+	return cryptoFields, nil
+}
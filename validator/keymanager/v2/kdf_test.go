@@ -0,0 +1,80 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestEncryptKeystore(t *testing.T) {
+	secret := []byte("secret of the keystore")
+	password := "Passw0rdz4938%%"
+	for _, kdf := range []*v2keymanager.KDFConfig{
+		nil,
+		{Function: v2keymanager.ScryptKDF, ScryptN: 1024, ScryptR: 8, ScryptP: 2},
+		{Function: v2keymanager.PBKDF2KDF, PBKDF2C: 1000},
+	} {
+		cryptoFields, err := v2keymanager.EncryptKeystore(secret, password, kdf)
+		require.NoError(t, err)
+		decrypted, err := keystorev4.New().Decrypt(cryptoFields, password)
+		require.NoError(t, err)
+		assert.DeepEqual(t, secret, decrypted)
+		_, err = keystorev4.New().Decrypt(cryptoFields, "wrong")
+		assert.ErrorContains(t, "invalid checksum", err)
+		if kdf == nil {
+			continue
+		}
+		kdfFields, ok := cryptoFields["kdf"].(map[string]interface{})
+		require.Equal(t, true, ok)
+		assert.Equal(t, kdf.Function, kdfFields["function"])
+		params, ok := kdfFields["params"].(map[string]interface{})
+		require.Equal(t, true, ok)
+		if kdf.Function == v2keymanager.ScryptKDF {
+			assert.Equal(t, float64(1024), params["n"])
+			assert.Equal(t, float64(2), params["p"])
+		} else {
+			assert.Equal(t, float64(1000), params["c"])
+		}
+	}
+}
+
+func TestKDFConfig_Validate(t *testing.T) {
+	for _, function := range []string{v2keymanager.ScryptKDF, v2keymanager.PBKDF2KDF} {
+		kdf, err := v2keymanager.DefaultKDFConfig(function)
+		require.NoError(t, err)
+		assert.NoError(t, kdf.Validate())
+	}
+	_, err := v2keymanager.DefaultKDFConfig("argon2")
+	assert.ErrorContains(t, "unsupported key derivation function", err)
+
+	kdf := &v2keymanager.KDFConfig{Function: v2keymanager.ScryptKDF, ScryptN: 1000, ScryptR: 8, ScryptP: 1}
+	assert.ErrorContains(t, "scrypt N must be a power of 2", kdf.Validate())
+	kdf = &v2keymanager.KDFConfig{Function: v2keymanager.ScryptKDF, ScryptN: 1024, ScryptR: 0, ScryptP: 1}
+	assert.ErrorContains(t, "scrypt r and p must be positive", kdf.Validate())
+	kdf = &v2keymanager.KDFConfig{Function: v2keymanager.ScryptKDF, ScryptN: 1 << 21, ScryptR: 8, ScryptP: 1}
+	assert.ErrorContains(t, "scrypt N must be at most 1048576", kdf.Validate())
+	kdf = &v2keymanager.KDFConfig{Function: v2keymanager.ScryptKDF, ScryptN: 1024, ScryptR: 33, ScryptP: 1}
+	assert.ErrorContains(t, "scrypt r and p must be at most 32 and 4", kdf.Validate())
+	kdf = &v2keymanager.KDFConfig{Function: v2keymanager.ScryptKDF, ScryptN: 1024, ScryptR: 8, ScryptP: 5}
+	assert.ErrorContains(t, "scrypt r and p must be at most 32 and 4", kdf.Validate())
+	kdf = &v2keymanager.KDFConfig{Function: v2keymanager.PBKDF2KDF, PBKDF2C: 4*262144 + 1}
+	assert.ErrorContains(t, "pbkdf2 c must be at most 1048576", kdf.Validate())
+	kdf = &v2keymanager.KDFConfig{Function: v2keymanager.PBKDF2KDF}
+	assert.ErrorContains(t, "pbkdf2 c must be positive", kdf.Validate())
+	_, err = v2keymanager.EncryptKeystore([]byte("secret"), "password", kdf)
+	assert.ErrorContains(t, "pbkdf2 c must be positive", err)
+}
+
+func TestNewKDFConfig(t *testing.T) {
+	kdf, err := v2keymanager.NewKDFConfig(v2keymanager.ScryptKDF, 1024, 0, 4, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &v2keymanager.KDFConfig{Function: v2keymanager.ScryptKDF, ScryptN: 1024, ScryptR: 8, ScryptP: 4}, kdf)
+	kdf, err = v2keymanager.NewKDFConfig(v2keymanager.PBKDF2KDF, 0, 0, 0, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &v2keymanager.KDFConfig{Function: v2keymanager.PBKDF2KDF, PBKDF2C: 262144}, kdf)
+	_, err = v2keymanager.NewKDFConfig(v2keymanager.ScryptKDF, 1000, 0, 0, 0)
+	assert.ErrorContains(t, "scrypt N must be a power of 2", err)
+}
@@ -0,0 +1,149 @@
+// Package multi defines a keymanager merging the validating keys of several keymanagers,
+// such as those of several wallets, into a single validator client. Each of its keys is
+// configured by a validating keys config, which may disable the key at runtime.
+package multi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+)
+
+var (
+	// ErrKeyDisabled is returned when signing with a disabled validating key.
+	ErrKeyDisabled = errors.New("validating key is disabled")
+	// ErrUnknownKey is returned for a validating key none of the keymanagers has.
+	ErrUnknownKey = errors.New("no keymanager has the validating key")
+)
+
+// Keymanager merging the validating keys of several keymanagers.
+type Keymanager struct {
+	keymanagers []v2keymanager.IKeymanager
+	config      *keyconfig.Store
+	lock        sync.RWMutex
+	owners      map[[48]byte]v2keymanager.IKeymanager
+}
+
+// NewKeymanager merging the keys of keymanagers, configured by a validating keys config.
+func NewKeymanager(keymanagers []v2keymanager.IKeymanager, config *keyconfig.Store) (*Keymanager, error) {
+	if len(keymanagers) == 0 {
+		return nil, errors.New("no keymanager to merge")
+	}
+	if config == nil {
+		return nil, errors.New("no validating keys config")
+	}
+	return &Keymanager{
+		keymanagers: keymanagers,
+		config:      config,
+		owners:      make(map[[48]byte]v2keymanager.IKeymanager),
+	}, nil
+}
+
+// Config of the validating keys of the keymanager.
+func (km *Keymanager) Config() *keyconfig.Store {
+	return km.config
+}
+
+// Keymanagers merged by the keymanager.
+func (km *Keymanager) Keymanagers() []v2keymanager.IKeymanager {
+	return km.keymanagers
+}
+
+// FetchValidatingPublicKeys fetches the enabled validating keys of every keymanager.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	pubKeys, err := km.FetchAllValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enabled := make([][48]byte, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		if km.config.Enabled(pubKey) {
+			enabled = append(enabled, pubKey)
+		}
+	}
+	return enabled, nil
+}
+
+// FetchAllValidatingPublicKeys fetches the validating keys of every keymanager, including
+// the disabled ones.
+func (km *Keymanager) FetchAllValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	owners := make(map[[48]byte]v2keymanager.IKeymanager)
+	var pubKeys [][48]byte
+	for i, keymanager := range km.keymanagers {
+		keys, err := keymanager.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not fetch validating keys of keymanager %d", i)
+		}
+		for _, pubKey := range keys {
+			// A key in two keymanagers could be used to sign twice for the same duty.
+			if _, ok := owners[pubKey]; ok {
+				return nil, fmt.Errorf("validating key %#x is in more than one keymanager", bytesutil.Trunc(pubKey[:]))
+			}
+			owners[pubKey] = keymanager
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	km.lock.Lock()
+	km.owners = owners
+	km.lock.Unlock()
+	km.config.UpdateMetrics(pubKeys)
+	return pubKeys, nil
+}
+
+// Sign with the keymanager of the validating key of a request, unless the key is disabled.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	if !km.config.Enabled(pubKey) {
+		return nil, errors.Wrapf(ErrKeyDisabled, "could not sign with %#x", bytesutil.Trunc(req.PublicKey))
+	}
+	keymanager, err := km.owner(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return keymanager.Sign(ctx, req)
+}
+
+// SetEnabled enables or disables one of the validating keys of the keymanager.
+func (km *Keymanager) SetEnabled(ctx context.Context, pubKey [48]byte, enabled bool) error {
+	if _, err := km.owner(ctx, pubKey); err != nil {
+		return err
+	}
+	return km.config.SetEnabled(pubKey, enabled)
+}
+
+// SetGenesisValidatorsRoot of the keymanagers which need it to sign.
+func (km *Keymanager) SetGenesisValidatorsRoot(root []byte) {
+	for _, keymanager := range km.keymanagers {
+		if setter, ok := keymanager.(v2keymanager.GenesisValidatorsRootSetter); ok {
+			setter.SetGenesisValidatorsRoot(root)
+		}
+	}
+}
+
+// owner returns the keymanager of a validating key, fetching the keys of the keymanagers
+// again if it is not known, as keys may have been added since they were last fetched.
+func (km *Keymanager) owner(ctx context.Context, pubKey [48]byte) (v2keymanager.IKeymanager, error) {
+	km.lock.RLock()
+	keymanager, ok := km.owners[pubKey]
+	km.lock.RUnlock()
+	if ok {
+		return keymanager, nil
+	}
+	if _, err := km.FetchAllValidatingPublicKeys(ctx); err != nil {
+		return nil, err
+	}
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	keymanager, ok = km.owners[pubKey]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownKey, "could not find %#x", bytesutil.Trunc(pubKey[:]))
+	}
+	return keymanager, nil
+}
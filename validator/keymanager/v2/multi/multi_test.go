@@ -0,0 +1,91 @@
+package multi
+
+import (
+	"context"
+	"testing"
+
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
+)
+
+func setupKeymanager(t *testing.T, offsets ...uint64) *Keymanager {
+	ctx := context.Background()
+	keymanagers := make([]v2keymanager.IKeymanager, len(offsets))
+	for i, offset := range offsets {
+		km, err := direct.NewInteropKeymanager(ctx, offset, 2)
+		require.NoError(t, err)
+		keymanagers[i] = km
+	}
+	config, err := keyconfig.NewStore(&keyconfig.File{})
+	require.NoError(t, err)
+	km, err := NewKeymanager(keymanagers, config)
+	require.NoError(t, err)
+	return km
+}
+
+func TestKeymanager_MergesKeys(t *testing.T) {
+	ctx := context.Background()
+	km := setupKeymanager(t, 0, 2)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(pubKeys))
+
+	// Every key signs with the keymanager holding it.
+	for _, pubKey := range pubKeys {
+		_, err := km.Sign(ctx, &validatorpb.SignRequest{
+			PublicKey:   pubKey[:],
+			SigningRoot: make([]byte, 32),
+		})
+		require.NoError(t, err)
+	}
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:   make([]byte, 48),
+		SigningRoot: make([]byte, 32),
+	})
+	assert.ErrorContains(t, ErrUnknownKey.Error(), err)
+}
+
+func TestKeymanager_DuplicateKeys(t *testing.T) {
+	km := setupKeymanager(t, 0, 1)
+	_, err := km.FetchValidatingPublicKeys(context.Background())
+	assert.ErrorContains(t, "is in more than one keymanager", err)
+}
+
+func TestKeymanager_DisabledKeys(t *testing.T) {
+	ctx := context.Background()
+	km := setupKeymanager(t, 0, 2)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	disabled := pubKeys[1]
+	generation := km.Config().Generation()
+	require.NoError(t, km.SetEnabled(ctx, disabled, false))
+	assert.Equal(t, generation+1, km.Config().Generation())
+
+	enabled, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(enabled))
+	for _, pubKey := range enabled {
+		assert.NotEqual(t, disabled, pubKey)
+	}
+	all, err := km.FetchAllValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(all))
+
+	req := &validatorpb.SignRequest{
+		PublicKey:   disabled[:],
+		SigningRoot: make([]byte, 32),
+	}
+	_, err = km.Sign(ctx, req)
+	assert.ErrorContains(t, ErrKeyDisabled.Error(), err)
+
+	// Enabled again without a restart.
+	require.NoError(t, km.SetEnabled(ctx, disabled, true))
+	_, err = km.Sign(ctx, req)
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, ErrUnknownKey.Error(), km.SetEnabled(ctx, [48]byte{1}, false))
+}
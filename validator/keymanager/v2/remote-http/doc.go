@@ -0,0 +1,45 @@
+/*
+Package remotehttp defines a keymanager implementation which connects to a remote
+signer server over HTTP with JSON payloads. The connection may use TLS with supplied
+paths to a CA certificate as well as a client certificate and key, and requests may
+be authenticated with a bearer token read from a file.
+
+The remote signer is expected to serve the following endpoints:
+
+	GET  /api/v1/eth2/publicKeys
+	    Returns a JSON array of the 0x-prefixed hex public keys available for signing.
+
+	POST /api/v1/eth2/sign/{0x-prefixed hex public key}
+	    Signs a typed payload and returns {"signature": "0x..."}.
+
+Sign requests carry the type of the object signed along with the object itself, the
+fork info of the chain at the epoch of the object and the signing root the validator
+client computed. Numbers are encoded as decimal strings and bytes as 0x-prefixed hex
+strings, and blocks are sent as their header, which has the same signing root:
+
+	{
+	    "type": "ATTESTATION",
+	    "fork_info": {
+	        "fork": {
+	            "previous_version": "0x00000000",
+	            "current_version": "0x00000000",
+	            "epoch": "0"
+	        },
+	        "genesis_validators_root": "0x..."
+	    },
+	    "signing_root": "0x...",
+	    "signature_domain": "0x...",
+	    "attestation_data": {
+	        "slot": "32",
+	        "index": "0",
+	        "beacon_block_root": "0x...",
+	        "source": {"epoch": "0", "root": "0x..."},
+	        "target": {"epoch": "1", "root": "0x..."}
+	    }
+	}
+
+A remote signer responds with 403 or 412 to deny a signing request, for example
+when signing would be slashable. Requests failing to reach the remote signer or
+failing with a server error are retried with a linear backoff.
+*/
+package remotehttp
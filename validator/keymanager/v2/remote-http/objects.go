@@ -0,0 +1,264 @@
+package remotehttp
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// The objects of the signing requests are encoded explicitly rather than as their protobuf
+// structs, with numbers as decimal strings and bytes as 0x-prefixed hex strings.
+
+// Checkpoint of a signing request.
+type Checkpoint struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+// AttestationData of a signing request.
+type AttestationData struct {
+	Slot            string      `json:"slot"`
+	Index           string      `json:"index"`
+	BeaconBlockRoot string      `json:"beacon_block_root"`
+	Source          *Checkpoint `json:"source"`
+	Target          *Checkpoint `json:"target"`
+}
+
+// Attestation of a signing request.
+type Attestation struct {
+	AggregationBits string           `json:"aggregation_bits"`
+	Data            *AttestationData `json:"data"`
+	Signature       string           `json:"signature"`
+}
+
+// AggregateAndProof of a signing request.
+type AggregateAndProof struct {
+	AggregatorIndex string       `json:"aggregator_index"`
+	Aggregate       *Attestation `json:"aggregate"`
+	SelectionProof  string       `json:"selection_proof"`
+}
+
+// BeaconBlockHeader of a signing request. A block is sent as its header, which has the
+// same signing root, rather than with its whole body.
+type BeaconBlockHeader struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+// VoluntaryExit of a signing request.
+type VoluntaryExit struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+func checkpointFromProto(c *ethpb.Checkpoint) *Checkpoint {
+	if c == nil {
+		return nil
+	}
+	return &Checkpoint{
+		Epoch: strconv.FormatUint(c.Epoch, 10),
+		Root:  fmt.Sprintf("%#x", c.Root),
+	}
+}
+
+func attestationDataFromProto(data *ethpb.AttestationData) *AttestationData {
+	if data == nil {
+		return nil
+	}
+	return &AttestationData{
+		Slot:            strconv.FormatUint(data.Slot, 10),
+		Index:           strconv.FormatUint(data.CommitteeIndex, 10),
+		BeaconBlockRoot: fmt.Sprintf("%#x", data.BeaconBlockRoot),
+		Source:          checkpointFromProto(data.Source),
+		Target:          checkpointFromProto(data.Target),
+	}
+}
+
+func aggregateAndProofFromProto(agg *ethpb.AggregateAttestationAndProof) *AggregateAndProof {
+	if agg == nil {
+		return nil
+	}
+	res := &AggregateAndProof{
+		AggregatorIndex: strconv.FormatUint(agg.AggregatorIndex, 10),
+		SelectionProof:  fmt.Sprintf("%#x", agg.SelectionProof),
+	}
+	if agg.Aggregate != nil {
+		res.Aggregate = &Attestation{
+			AggregationBits: fmt.Sprintf("%#x", []byte(agg.Aggregate.AggregationBits)),
+			Data:            attestationDataFromProto(agg.Aggregate.Data),
+			Signature:       fmt.Sprintf("%#x", agg.Aggregate.Signature),
+		}
+	}
+	return res
+}
+
+func beaconBlockHeaderFromProto(block *ethpb.BeaconBlock) (*BeaconBlockHeader, error) {
+	if block == nil {
+		return nil, nil
+	}
+	if block.Body == nil {
+		return nil, errors.New("missing block body")
+	}
+	bodyRoot, err := block.Body.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block body root")
+	}
+	return &BeaconBlockHeader{
+		Slot:          strconv.FormatUint(block.Slot, 10),
+		ProposerIndex: strconv.FormatUint(block.ProposerIndex, 10),
+		ParentRoot:    fmt.Sprintf("%#x", block.ParentRoot),
+		StateRoot:     fmt.Sprintf("%#x", block.StateRoot),
+		BodyRoot:      fmt.Sprintf("%#x", bodyRoot),
+	}, nil
+}
+
+func voluntaryExitFromProto(exit *ethpb.VoluntaryExit) *VoluntaryExit {
+	if exit == nil {
+		return nil
+	}
+	return &VoluntaryExit{
+		Epoch:          strconv.FormatUint(exit.Epoch, 10),
+		ValidatorIndex: strconv.FormatUint(exit.ValidatorIndex, 10),
+	}
+}
+
+// Proto decodes the checkpoint.
+func (c *Checkpoint) Proto() (*ethpb.Checkpoint, error) {
+	if c == nil {
+		return nil, errors.New("missing checkpoint")
+	}
+	epoch, err := strconv.ParseUint(c.Epoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid epoch")
+	}
+	root, err := bytesFromHex(c.Root)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid root")
+	}
+	return &ethpb.Checkpoint{Epoch: epoch, Root: root}, nil
+}
+
+// Proto decodes the attestation data.
+func (d *AttestationData) Proto() (*ethpb.AttestationData, error) {
+	if d == nil {
+		return nil, errors.New("missing attestation data")
+	}
+	slot, err := strconv.ParseUint(d.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid slot")
+	}
+	index, err := strconv.ParseUint(d.Index, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid committee index")
+	}
+	blockRoot, err := bytesFromHex(d.BeaconBlockRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid beacon block root")
+	}
+	source, err := d.Source.Proto()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid source")
+	}
+	target, err := d.Target.Proto()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid target")
+	}
+	return &ethpb.AttestationData{
+		Slot:            slot,
+		CommitteeIndex:  index,
+		BeaconBlockRoot: blockRoot,
+		Source:          source,
+		Target:          target,
+	}, nil
+}
+
+// Proto decodes the aggregate and proof.
+func (a *AggregateAndProof) Proto() (*ethpb.AggregateAttestationAndProof, error) {
+	if a == nil || a.Aggregate == nil {
+		return nil, errors.New("missing aggregate")
+	}
+	aggregatorIndex, err := strconv.ParseUint(a.AggregatorIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid aggregator index")
+	}
+	selectionProof, err := bytesFromHex(a.SelectionProof)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid selection proof")
+	}
+	bits, err := bytesFromHex(a.Aggregate.AggregationBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid aggregation bits")
+	}
+	data, err := a.Aggregate.Data.Proto()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bytesFromHex(a.Aggregate.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid aggregate signature")
+	}
+	return &ethpb.AggregateAttestationAndProof{
+		AggregatorIndex: aggregatorIndex,
+		Aggregate: &ethpb.Attestation{
+			AggregationBits: bits,
+			Data:            data,
+			Signature:       sig,
+		},
+		SelectionProof: selectionProof,
+	}, nil
+}
+
+// Proto decodes the block header.
+func (h *BeaconBlockHeader) Proto() (*ethpb.BeaconBlockHeader, error) {
+	if h == nil {
+		return nil, errors.New("missing block header")
+	}
+	slot, err := strconv.ParseUint(h.Slot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid slot")
+	}
+	proposerIndex, err := strconv.ParseUint(h.ProposerIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid proposer index")
+	}
+	parentRoot, err := bytesFromHex(h.ParentRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid parent root")
+	}
+	stateRoot, err := bytesFromHex(h.StateRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid state root")
+	}
+	bodyRoot, err := bytesFromHex(h.BodyRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid body root")
+	}
+	return &ethpb.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    parentRoot,
+		StateRoot:     stateRoot,
+		BodyRoot:      bodyRoot,
+	}, nil
+}
+
+// Proto decodes the voluntary exit.
+func (e *VoluntaryExit) Proto() (*ethpb.VoluntaryExit, error) {
+	if e == nil {
+		return nil, errors.New("missing voluntary exit")
+	}
+	epoch, err := strconv.ParseUint(e.Epoch, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid epoch")
+	}
+	validatorIndex, err := strconv.ParseUint(e.ValidatorIndex, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid validator index")
+	}
+	return &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: validatorIndex}, nil
+}
@@ -0,0 +1,439 @@
+package remotehttp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log = logrus.WithField("prefix", "remote-http-keymanager-v2")
+	// ErrSigningFailed defines a failure from the remote server
+	// when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in the remote server")
+	// ErrSigningDenied defines a failure from the remote server when
+	// performing a signing operation was denied by a remote server.
+	ErrSigningDenied = errors.New("signing request was denied by remote server")
+)
+
+const (
+	// PublicKeysPath is the path of the remote signer endpoint listing its public keys.
+	PublicKeysPath = "/api/v1/eth2/publicKeys"
+	// SignPath is the path of the remote signer endpoint signing with a public key, which
+	// is appended to it.
+	SignPath = "/api/v1/eth2/sign/"
+
+	defaultTimeoutSeconds = 5
+	defaultMaxRetries     = 3
+	retryDelay            = 100 * time.Millisecond
+	// maxResponseSize bounds the size of the remote signer responses which are read.
+	maxResponseSize = 1 << 20
+)
+
+// Types of the objects signed by a remote signer.
+const (
+	BlockType             = "BLOCK"
+	AttestationType       = "ATTESTATION"
+	AggregateAndProofType = "AGGREGATE_AND_PROOF"
+	VoluntaryExitType     = "VOLUNTARY_EXIT"
+	AggregationSlotType   = "AGGREGATION_SLOT"
+	RandaoRevealType      = "RANDAO_REVEAL"
+	SigningRootType       = "SIGNING_ROOT"
+)
+
+// KeymanagerOpts for a remote HTTP keymanager.
+type KeymanagerOpts struct {
+	URL             string `json:"url"`
+	BearerTokenPath string `json:"bearer_token_path,omitempty"`
+	CACertPath      string `json:"ca_crt_path,omitempty"`
+	ClientCertPath  string `json:"crt_path,omitempty"`
+	ClientKeyPath   string `json:"key_path,omitempty"`
+	TimeoutSeconds  uint64 `json:"timeout_seconds,omitempty"`
+	MaxRetries      uint64 `json:"max_retries,omitempty"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a keymanager, such as passwords, the wallet, and more.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+}
+
+// Fork is the fork of the chain a signing request is for.
+type Fork struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+// ForkInfo identifies the chain a signing request is for.
+type ForkInfo struct {
+	Fork                  *Fork  `json:"fork"`
+	GenesisValidatorsRoot string `json:"genesis_validators_root"`
+}
+
+// SignRequest is the JSON body of a request to the sign endpoint of a remote signer. Only
+// the object field matching the type is set.
+type SignRequest struct {
+	Type              string             `json:"type"`
+	ForkInfo          *ForkInfo          `json:"fork_info,omitempty"`
+	SigningRoot       string             `json:"signing_root"`
+	SignatureDomain   string             `json:"signature_domain,omitempty"`
+	BlockHeader       *BeaconBlockHeader `json:"block_header,omitempty"`
+	AttestationData   *AttestationData   `json:"attestation_data,omitempty"`
+	AggregateAndProof *AggregateAndProof `json:"aggregate_and_proof,omitempty"`
+	VoluntaryExit     *VoluntaryExit     `json:"voluntary_exit,omitempty"`
+	Slot              string             `json:"slot,omitempty"`
+	Epoch             string             `json:"epoch,omitempty"`
+}
+
+// SignResponse is the JSON body of a successful response of the sign endpoint.
+type SignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// Keymanager implementation using remote signing keys via a REST API.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	client                *http.Client
+	baseURL               string
+	bearerToken           string
+	maxRetries            uint64
+	lock                  sync.RWMutex
+	genesisValidatorsRoot []byte
+}
+
+// NewKeymanager instantiates a new remote HTTP keymanager from configuration options.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil {
+		return nil, errors.New("options are required")
+	}
+	u, err := url.Parse(cfg.Opts.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid remote signer URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("remote signer URL must be http or https, got %q", cfg.Opts.URL)
+	}
+
+	var bearerToken string
+	if cfg.Opts.BearerTokenPath != "" {
+		enc, err := ioutil.ReadFile(cfg.Opts.BearerTokenPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read bearer token")
+		}
+		bearerToken = strings.TrimSpace(string(enc))
+	}
+
+	tlsCfg := &tls.Config{}
+	if cfg.Opts.CACertPath != "" {
+		serverCA, err := ioutil.ReadFile(cfg.Opts.CACertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain server's CA certificate")
+		}
+		cp := x509.NewCertPool()
+		if !cp.AppendCertsFromPEM(serverCA) {
+			return nil, errors.New("failed to add server's CA certificate to pool")
+		}
+		tlsCfg.RootCAs = cp
+	}
+	if cfg.Opts.ClientCertPath != "" || cfg.Opts.ClientKeyPath != "" {
+		clientPair, err := tls.LoadX509KeyPair(cfg.Opts.ClientCertPath, cfg.Opts.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
+		}
+		tlsCfg.Certificates = []tls.Certificate{clientPair}
+	}
+
+	timeout := cfg.Opts.TimeoutSeconds
+	if timeout == 0 {
+		timeout = defaultTimeoutSeconds
+	}
+	maxRetries := cfg.Opts.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultMaxRetries
+	}
+	return &Keymanager{
+		opts: cfg.Opts,
+		client: &http.Client{
+			Timeout:   time.Duration(timeout) * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsCfg},
+		},
+		baseURL:     strings.TrimRight(cfg.Opts.URL, "/"),
+		bearerToken: bearerToken,
+		maxRetries:  maxRetries,
+	}, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(ctx context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of a remote HTTP keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	lines := []struct {
+		name  string
+		value string
+	}{
+		{"Remote signer URL", opts.URL},
+		{"Bearer token path", opts.BearerTokenPath},
+		{"CA cert path", opts.CACertPath},
+		{"Client cert path", opts.ClientCertPath},
+		{"Client key path", opts.ClientKeyPath},
+	}
+	for _, line := range lines {
+		if line.value == "" {
+			continue
+		}
+		if _, err := b.WriteString(fmt.Sprintf("%s: %s\n", au.BrightMagenta(line.name), line.value)); err != nil {
+			log.Error(err)
+			return ""
+		}
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the remote HTTP keymanager.
+func (k *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return k.opts
+}
+
+// SetGenesisValidatorsRoot sets the genesis validators root of the chain, which is sent
+// within the fork info of the signing requests.
+func (k *Keymanager) SetGenesisValidatorsRoot(root []byte) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.genesisValidatorsRoot = root
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys that should be used to validate with.
+func (k *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	statusCode, enc, err := k.do(ctx, http.MethodGet, PublicKeysPath, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list accounts from remote server")
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list accounts from remote server: status %d", statusCode)
+	}
+	var hexKeys []string
+	if err := json.Unmarshal(enc, &hexKeys); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal public keys")
+	}
+	pubKeys := make([][48]byte, len(hexKeys))
+	for i, hexKey := range hexKeys {
+		pubKey, err := bytesFromHex(hexKey)
+		if err != nil || len(pubKey) != 48 {
+			return nil, fmt.Errorf("invalid public key %q from remote server", hexKey)
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	return pubKeys, nil
+}
+
+// Sign signs a message for a validator key via a HTTP request.
+func (k *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	signReq, err := k.signRequest(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not build signing request")
+	}
+	body, err := json.Marshal(signReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal signing request")
+	}
+	statusCode, enc, err := k.do(ctx, http.MethodPost, fmt.Sprintf("%s%#x", SignPath, req.PublicKey), body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not send signing request to remote server")
+	}
+	switch statusCode {
+	case http.StatusOK:
+	case http.StatusForbidden, http.StatusPreconditionFailed:
+		return nil, ErrSigningDenied
+	default:
+		return nil, errors.Wrapf(ErrSigningFailed, "status %d", statusCode)
+	}
+	resp := &SignResponse{}
+	if err := json.Unmarshal(enc, resp); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal signing response")
+	}
+	sig, err := bytesFromHex(resp.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signature from remote server")
+	}
+	return bls.SignatureFromBytes(sig)
+}
+
+// signRequest builds the typed JSON payload of a signing request. The fork info is the
+// fork at the epoch of the object signed, as scheduled in the beacon chain config shared
+// with the beacon node, so that it matches the fork of the signature domain.
+func (k *Keymanager) signRequest(req *validatorpb.SignRequest) (*SignRequest, error) {
+	signReq := &SignRequest{
+		Type:        SigningRootType,
+		SigningRoot: fmt.Sprintf("%#x", req.SigningRoot),
+	}
+	if len(req.SignatureDomain) > 0 {
+		signReq.SignatureDomain = fmt.Sprintf("%#x", req.SignatureDomain)
+	}
+	var epoch uint64
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		header, err := beaconBlockHeaderFromProto(obj.Block)
+		if err != nil {
+			return nil, err
+		}
+		signReq.Type = BlockType
+		signReq.BlockHeader = header
+		epoch = helpers.SlotToEpoch(obj.Block.GetSlot())
+	case *validatorpb.SignRequest_AttestationData:
+		signReq.Type = AttestationType
+		signReq.AttestationData = attestationDataFromProto(obj.AttestationData)
+		epoch = obj.AttestationData.GetTarget().GetEpoch()
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		signReq.Type = AggregateAndProofType
+		signReq.AggregateAndProof = aggregateAndProofFromProto(obj.AggregateAttestationAndProof)
+		epoch = helpers.SlotToEpoch(obj.AggregateAttestationAndProof.GetAggregate().GetData().GetSlot())
+	case *validatorpb.SignRequest_Exit:
+		signReq.Type = VoluntaryExitType
+		signReq.VoluntaryExit = voluntaryExitFromProto(obj.Exit)
+		epoch = obj.Exit.GetEpoch()
+	case *validatorpb.SignRequest_Slot:
+		signReq.Type = AggregationSlotType
+		signReq.Slot = fmt.Sprintf("%d", obj.Slot)
+		epoch = helpers.SlotToEpoch(obj.Slot)
+	case *validatorpb.SignRequest_Epoch:
+		signReq.Type = RandaoRevealType
+		signReq.Epoch = fmt.Sprintf("%d", obj.Epoch)
+		epoch = obj.Epoch
+	}
+	k.lock.RLock()
+	genesisValidatorsRoot := k.genesisValidatorsRoot
+	k.lock.RUnlock()
+	// Requests signing a bare signing root have no epoch to find the fork of.
+	if len(genesisValidatorsRoot) > 0 && signReq.Type != SigningRootType {
+		signReq.ForkInfo = NewForkInfo(epoch, genesisValidatorsRoot)
+	}
+	return signReq, nil
+}
+
+// NewForkInfo returns the fork info of an epoch of the chain with the given genesis
+// validators root.
+func NewForkInfo(epoch uint64, genesisValidatorsRoot []byte) *ForkInfo {
+	// The fork schedule is static, so looking the fork up never fails.
+	fork, _ := p2putils.Fork(epoch)
+	return &ForkInfo{
+		Fork: &Fork{
+			PreviousVersion: fmt.Sprintf("%#x", fork.PreviousVersion),
+			CurrentVersion:  fmt.Sprintf("%#x", fork.CurrentVersion),
+			Epoch:           fmt.Sprintf("%d", fork.Epoch),
+		},
+		GenesisValidatorsRoot: fmt.Sprintf("%#x", genesisValidatorsRoot),
+	}
+}
+
+// do sends a request to the remote signer and returns the status code and body of its
+// response. Requests are retried when the remote signer cannot be reached or fails with
+// a server error, which are safe to retry as signing is idempotent.
+func (k *Keymanager) do(ctx context.Context, method string, path string, body []byte) (int, []byte, error) {
+	var lastErr error
+	for attempt := uint64(0); attempt <= k.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(time.Duration(attempt) * retryDelay):
+			case <-ctx.Done():
+				return 0, nil, ctx.Err()
+			}
+		}
+		statusCode, enc, err := k.doOnce(ctx, method, path, body)
+		if err == nil && statusCode < http.StatusInternalServerError {
+			return statusCode, enc, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("remote server responded with status %d", statusCode)
+		}
+		if ctx.Err() != nil {
+			return 0, nil, ctx.Err()
+		}
+		lastErr = err
+		log.WithError(err).WithField("attempt", attempt+1).Debug("Remote signer request failed")
+	}
+	return 0, nil, lastErr
+}
+
+func (k *Keymanager) doOnce(ctx context.Context, method string, path string, body []byte) (int, []byte, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, k.baseURL+path, r)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if k.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+k.bearerToken)
+	}
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	enc, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, enc, nil
+}
+
+func bytesFromHex(str string) ([]byte, error) {
+	if !strings.HasPrefix(str, "0x") {
+		return nil, errors.New("missing 0x prefix")
+	}
+	return hex.DecodeString(strings.TrimPrefix(str, "0x"))
+}
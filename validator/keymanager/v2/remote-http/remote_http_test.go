@@ -0,0 +1,297 @@
+package remotehttp_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+	remotehttptest "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http/testing"
+)
+
+func setupKeymanager(t *testing.T, opts *remotehttp.KeymanagerOpts) (*remotehttp.Keymanager, *remotehttptest.StubSigner, bls.SecretKey) {
+	key := bls.RandKey()
+	signer := remotehttptest.NewStubSigner([]bls.SecretKey{key})
+	srv := httptest.NewServer(signer)
+	t.Cleanup(srv.Close)
+	opts.URL = srv.URL
+	km, err := remotehttp.NewKeymanager(context.Background(), &remotehttp.SetupConfig{Opts: opts})
+	require.NoError(t, err)
+	return km, signer, key
+}
+
+func writeFile(t *testing.T, name string, content []byte) string {
+	dir := filepath.Join(testutil.TempDir(), t.Name())
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	t.Cleanup(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, content, 0600))
+	return path
+}
+
+func TestNewKeymanager(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *remotehttp.KeymanagerOpts
+		err  string
+	}{
+		{
+			name: "Missing options",
+			err:  "options are required",
+		},
+		{
+			name: "Invalid scheme",
+			opts: &remotehttp.KeymanagerOpts{URL: "ftp://localhost:9000"},
+			err:  "must be http or https",
+		},
+		{
+			name: "Missing bearer token file",
+			opts: &remotehttp.KeymanagerOpts{URL: "http://localhost:9000", BearerTokenPath: "/does/not/exist"},
+			err:  "failed to read bearer token",
+		},
+		{
+			name: "Missing CA certificate",
+			opts: &remotehttp.KeymanagerOpts{URL: "https://localhost:9000", CACertPath: "/does/not/exist"},
+			err:  "failed to obtain server's CA certificate",
+		},
+		{
+			name: "Client certificate without key",
+			opts: &remotehttp.KeymanagerOpts{URL: "https://localhost:9000", ClientCertPath: "/does/not/exist"},
+			err:  "failed to obtain client's certificate and/or key",
+		},
+		{
+			name: "Valid",
+			opts: &remotehttp.KeymanagerOpts{URL: "https://localhost:9000/"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := remotehttp.NewKeymanager(context.Background(), &remotehttp.SetupConfig{Opts: tt.opts})
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, tt.err, err)
+			}
+		})
+	}
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	km, _, key := setupKeymanager(t, &remotehttp.KeymanagerOpts{})
+	pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{bytesutil.ToBytes48(key.PublicKey().Marshal())}, pubKeys)
+}
+
+func TestKeymanager_BearerToken(t *testing.T) {
+	km, signer, _ := setupKeymanager(t, &remotehttp.KeymanagerOpts{
+		BearerTokenPath: writeFile(t, "token", []byte("secret\n")),
+	})
+	signer.BearerToken = "secret"
+	_, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+
+	signer.BearerToken = "other"
+	_, err = km.FetchValidatingPublicKeys(context.Background())
+	assert.ErrorContains(t, "status 401", err)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	km, signer, key := setupKeymanager(t, &remotehttp.KeymanagerOpts{})
+	km.SetGenesisValidatorsRoot([]byte{9})
+	signingRoot := bytesutil.PadTo([]byte{1}, 32)
+	attestationData := &ethpb.AttestationData{
+		Slot:            5,
+		BeaconBlockRoot: []byte{0},
+		Source:          &ethpb.Checkpoint{Epoch: 2, Root: []byte{1}},
+		Target:          &ethpb.Checkpoint{Epoch: 3, Root: []byte{2}},
+	}
+	sig, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:       key.PublicKey().Marshal(),
+		SigningRoot:     signingRoot,
+		SignatureDomain: []byte{2},
+		Object: &validatorpb.SignRequest_AttestationData{
+			AttestationData: attestationData,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(key.PublicKey(), signingRoot))
+
+	reqs := signer.SignRequests()
+	require.Equal(t, 1, len(reqs))
+	assert.Equal(t, remotehttp.AttestationType, reqs[0].Type)
+	assert.Equal(t, fmt.Sprintf("%#x", signingRoot), reqs[0].SigningRoot)
+	assert.Equal(t, "0x02", reqs[0].SignatureDomain)
+	require.NotNil(t, reqs[0].AttestationData)
+	assert.Equal(t, "5", reqs[0].AttestationData.Slot)
+	assert.Equal(t, "0x00", reqs[0].AttestationData.BeaconBlockRoot)
+	assert.Equal(t, "3", reqs[0].AttestationData.Target.Epoch)
+	require.NotNil(t, reqs[0].ForkInfo)
+	assert.Equal(t, "0x09", reqs[0].ForkInfo.GenesisValidatorsRoot)
+	genesisForkVersion := fmt.Sprintf("%#x", params.BeaconConfig().GenesisForkVersion)
+	assert.DeepEqual(t, &remotehttp.Fork{
+		PreviousVersion: genesisForkVersion,
+		CurrentVersion:  genesisForkVersion,
+		Epoch:           "0",
+	}, reqs[0].ForkInfo.Fork)
+
+	data, err := reqs[0].AttestationData.Proto()
+	require.NoError(t, err)
+	assert.DeepEqual(t, attestationData, data)
+}
+
+func TestKeymanager_Sign_Block(t *testing.T) {
+	km, signer, key := setupKeymanager(t, &remotehttp.KeymanagerOpts{})
+	block := testutil.NewBeaconBlock().Block
+	block.Slot = 9
+	block.ProposerIndex = 2
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   key.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Block{Block: block},
+	})
+	require.NoError(t, err)
+
+	reqs := signer.SignRequests()
+	require.Equal(t, 1, len(reqs))
+	require.NotNil(t, reqs[0].BlockHeader)
+	assert.Equal(t, "9", reqs[0].BlockHeader.Slot)
+	header, err := reqs[0].BlockHeader.Proto()
+	require.NoError(t, err)
+	// The block header has the same signing root as the block.
+	blockRoot, err := block.HashTreeRoot()
+	require.NoError(t, err)
+	headerRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, headerRoot)
+}
+
+func TestKeymanager_Sign_Types(t *testing.T) {
+	tests := []struct {
+		req *validatorpb.SignRequest
+		typ string
+	}{
+		{
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Block{Block: testutil.NewBeaconBlock().Block}},
+			typ: remotehttp.BlockType,
+		},
+		{
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AggregateAttestationAndProof{
+				AggregateAttestationAndProof: &ethpb.AggregateAttestationAndProof{Aggregate: &ethpb.Attestation{}},
+			}},
+			typ: remotehttp.AggregateAndProofType,
+		},
+		{
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{}}},
+			typ: remotehttp.VoluntaryExitType,
+		},
+		{
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Slot{Slot: 3}},
+			typ: remotehttp.AggregationSlotType,
+		},
+		{
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: 4}},
+			typ: remotehttp.RandaoRevealType,
+		},
+		{
+			req: &validatorpb.SignRequest{},
+			typ: remotehttp.SigningRootType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			km, signer, key := setupKeymanager(t, &remotehttp.KeymanagerOpts{})
+			tt.req.PublicKey = key.PublicKey().Marshal()
+			tt.req.SigningRoot = make([]byte, 32)
+			_, err := km.Sign(context.Background(), tt.req)
+			require.NoError(t, err)
+			reqs := signer.SignRequests()
+			require.Equal(t, 1, len(reqs))
+			assert.Equal(t, tt.typ, reqs[0].Type)
+			// The genesis validators root is not known yet.
+			assert.Equal(t, (*remotehttp.ForkInfo)(nil), reqs[0].ForkInfo)
+		})
+	}
+}
+
+func TestKeymanager_Sign_Denied(t *testing.T) {
+	km, signer, key := setupKeymanager(t, &remotehttp.KeymanagerOpts{})
+	signer.DenySigning = true
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   key.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+	})
+	assert.Equal(t, remotehttp.ErrSigningDenied, err)
+}
+
+func TestKeymanager_Sign_UnknownKey(t *testing.T) {
+	km, _, _ := setupKeymanager(t, &remotehttp.KeymanagerOpts{})
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   bls.RandKey().PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+	})
+	assert.ErrorContains(t, remotehttp.ErrSigningFailed.Error(), err)
+}
+
+func TestKeymanager_Retries(t *testing.T) {
+	km, signer, _ := setupKeymanager(t, &remotehttp.KeymanagerOpts{MaxRetries: 2})
+	signer.FailNext(2)
+	_, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+
+	signer.FailNext(3)
+	_, err = km.FetchValidatingPublicKeys(context.Background())
+	assert.ErrorContains(t, "status 503", err)
+}
+
+func TestKeymanager_TLS(t *testing.T) {
+	key := bls.RandKey()
+	srv := httptest.NewTLSServer(remotehttptest.NewStubSigner([]bls.SecretKey{key}))
+	defer srv.Close()
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+
+	// The self-signed certificate of the server is not trusted without its CA.
+	km, err := remotehttp.NewKeymanager(context.Background(), &remotehttp.SetupConfig{
+		Opts: &remotehttp.KeymanagerOpts{URL: srv.URL, MaxRetries: 1},
+	})
+	require.NoError(t, err)
+	_, err = km.FetchValidatingPublicKeys(context.Background())
+	assert.ErrorContains(t, "certificate", err)
+
+	km, err = remotehttp.NewKeymanager(context.Background(), &remotehttp.SetupConfig{
+		Opts: &remotehttp.KeymanagerOpts{URL: srv.URL, CACertPath: writeFile(t, "ca.crt", caCert)},
+	})
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(pubKeys))
+}
+
+func TestMarshalOptionsFile(t *testing.T) {
+	opts := &remotehttp.KeymanagerOpts{
+		URL:             "https://localhost:9000",
+		BearerTokenPath: "/token",
+		TimeoutSeconds:  10,
+	}
+	enc, err := remotehttp.MarshalOptionsFile(context.Background(), opts)
+	require.NoError(t, err)
+	unmarshaled, err := remotehttp.UnmarshalOptionsFile(ioutil.NopCloser(bytes.NewReader(enc)))
+	require.NoError(t, err)
+	assert.DeepEqual(t, opts, unmarshaled)
+}
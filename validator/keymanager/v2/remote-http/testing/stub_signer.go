@@ -0,0 +1,110 @@
+package testing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+)
+
+// StubSigner is a remote signer serving the HTTP API of a remote HTTP keymanager with
+// local keys, for use in tests.
+type StubSigner struct {
+	// BearerToken is required in the requests if set.
+	BearerToken string
+	// DenySigning makes the signing requests fail with a 412 status.
+	DenySigning bool
+
+	lock     sync.Mutex
+	keys     map[string]bls.SecretKey
+	failures int
+	requests []*remotehttp.SignRequest
+}
+
+// NewStubSigner creates a stub remote signer holding the given keys.
+func NewStubSigner(keys []bls.SecretKey) *StubSigner {
+	s := &StubSigner{keys: make(map[string]bls.SecretKey, len(keys))}
+	for _, key := range keys {
+		s.keys[fmt.Sprintf("%#x", key.PublicKey().Marshal())] = key
+	}
+	return s
+}
+
+// FailNext makes the next requests fail with a 503 status.
+func (s *StubSigner) FailNext(failures int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.failures = failures
+}
+
+// SignRequests returns the signing requests received.
+func (s *StubSigner) SignRequests() []*remotehttp.SignRequest {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.requests
+}
+
+// ServeHTTP serves the remote signer API.
+func (s *StubSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	if s.BearerToken != "" && r.Header.Get("Authorization") != "Bearer "+s.BearerToken {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == remotehttp.PublicKeysPath:
+		pubKeys := make([]string, 0, len(s.keys))
+		for pubKey := range s.keys {
+			pubKeys = append(pubKeys, pubKey)
+		}
+		writeJSON(w, pubKeys)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, remotehttp.SignPath):
+		s.sign(w, r, strings.TrimPrefix(r.URL.Path, remotehttp.SignPath))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *StubSigner) sign(w http.ResponseWriter, r *http.Request, pubKey string) {
+	key, ok := s.keys[pubKey]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	req := &remotehttp.SignRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.requests = append(s.requests, req)
+	if s.DenySigning {
+		http.Error(w, "denied", http.StatusPreconditionFailed)
+		return
+	}
+	signingRoot, err := hex.DecodeString(strings.TrimPrefix(req.SigningRoot, "0x"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, &remotehttp.SignResponse{
+		Signature: fmt.Sprintf("%#x", key.Sign(signingRoot).Marshal()),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,274 @@
+package threshold
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+)
+
+// maxRequestSize bounds the size of the signing requests which are read.
+const maxRequestSize = 1 << 20
+
+// SlashingProtection is the slashing protection of a share-holder, which records what it
+// signs and refuses to sign anything slashable.
+type SlashingProtection interface {
+	CheckAndSaveProposal(ctx context.Context, pubKey [48]byte, signingRoot [32]byte, slot uint64) error
+	CheckAndSaveAttestation(ctx context.Context, pubKey [48]byte, signingRoot [32]byte, sourceEpoch uint64, targetEpoch uint64) error
+}
+
+// CosignerServer serves the partial signatures of a share-holder to its co-signers, over
+// the HTTP signing API of a remote signer. It computes the signing root of the objects
+// it is requested to sign itself, and checks them against its own slashing protection.
+type CosignerServer struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	km           *Keymanager
+	protection   SlashingProtection
+	bearerToken  string
+	server       *http.Server
+	lock         sync.Mutex
+	startFailure error
+}
+
+// NewCosignerServer creates a co-signer server for the shares of a threshold keymanager.
+func NewCosignerServer(ctx context.Context, km *Keymanager, protection SlashingProtection) (*CosignerServer, error) {
+	enc, err := ioutil.ReadFile(km.opts.BearerTokenPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read bearer token")
+	}
+	bearerToken := strings.TrimSpace(string(enc))
+	if bearerToken == "" {
+		return nil, errors.New("empty bearer token")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &CosignerServer{
+		ctx:         ctx,
+		cancel:      cancel,
+		km:          km,
+		protection:  protection,
+		bearerToken: bearerToken,
+	}
+	s.server = &http.Server{
+		Addr:         km.opts.ListenAddr,
+		Handler:      s,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 5 * time.Second,
+	}
+	return s, nil
+}
+
+// Start the co-signer server.
+func (s *CosignerServer) Start() {
+	go func() {
+		log.WithField("address", s.server.Addr).Info("Serving partial signatures to co-signers")
+		var err error
+		if s.km.opts.CertPath != "" {
+			err = s.server.ListenAndServeTLS(s.km.opts.CertPath, s.km.opts.KeyPath)
+		} else {
+			log.Warn("Serving partial signatures to co-signers without TLS")
+			err = s.server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("Could not serve partial signatures to co-signers")
+			s.lock.Lock()
+			s.startFailure = err
+			s.lock.Unlock()
+		}
+	}()
+}
+
+// Stop the co-signer server.
+func (s *CosignerServer) Stop() error {
+	s.cancel()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return s.server.Shutdown(ctx)
+}
+
+// Status of the co-signer server, returning an error if it failed to start.
+func (s *CosignerServer) Status() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.startFailure
+}
+
+// ServeHTTP serves the signing API of a remote signer with the shares of the share-holder.
+func (s *CosignerServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := []byte(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if subtle.ConstantTimeCompare(token, []byte(s.bearerToken)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == remotehttp.PublicKeysPath:
+		pubKeys, err := s.km.FetchValidatingPublicKeys(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		hexKeys := make([]string, len(pubKeys))
+		for i, pubKey := range pubKeys {
+			hexKeys[i] = fmt.Sprintf("%#x", pubKey)
+		}
+		writeJSON(w, hexKeys)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, remotehttp.SignPath):
+		s.sign(w, r, strings.TrimPrefix(r.URL.Path, remotehttp.SignPath))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *CosignerServer) sign(w http.ResponseWriter, r *http.Request, hexPubKey string) {
+	pubKey, err := hexBytes(hexPubKey)
+	if err != nil || len(pubKey) != 48 {
+		http.Error(w, "invalid public key", http.StatusBadRequest)
+		return
+	}
+	req := &remotehttp.SignRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.km.lock.RLock()
+	genesisValidatorsRoot := s.km.genesisValidatorsRoot
+	s.km.lock.RUnlock()
+	if len(genesisValidatorsRoot) == 0 {
+		http.Error(w, "genesis validators root is not known yet", http.StatusServiceUnavailable)
+		return
+	}
+	signingRoot, object, err := checkSigningRoot(req, genesisValidatorsRoot)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := s.protect(r.Context(), bytesutil.ToBytes48(pubKey), signingRoot, object); err != nil {
+		log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey))).Warn(
+			"Refused to provide a slashable partial signature",
+		)
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+	sig, err := s.km.signShare(bytesutil.ToBytes48(pubKey), signingRoot[:])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	writeJSON(w, &remotehttp.SignResponse{
+		Signature: fmt.Sprintf("%#x", sig.Marshal()),
+	})
+}
+
+// checkSigningRoot decodes the object of a signing request and computes its signing root,
+// checking that it is the root requested to be signed. The signature domain is computed from
+// the fork at the epoch of the object and the genesis validators root of the co-signer's own
+// chain, rather than trusted from the request.
+func checkSigningRoot(req *remotehttp.SignRequest, genesisValidatorsRoot []byte) ([32]byte, interface{}, error) {
+	var object interface{}
+	var epoch uint64
+	var domainType [4]byte
+	var err error
+	switch req.Type {
+	case remotehttp.BlockType:
+		var header *ethpb.BeaconBlockHeader
+		header, err = req.BlockHeader.Proto()
+		if err == nil {
+			object, epoch, domainType = header, helpers.SlotToEpoch(header.Slot), params.BeaconConfig().DomainBeaconProposer
+		}
+	case remotehttp.AttestationType:
+		var data *ethpb.AttestationData
+		data, err = req.AttestationData.Proto()
+		if err == nil {
+			object, epoch, domainType = data, data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester
+		}
+	case remotehttp.AggregateAndProofType:
+		var agg *ethpb.AggregateAttestationAndProof
+		agg, err = req.AggregateAndProof.Proto()
+		if err == nil {
+			object, epoch, domainType = agg, helpers.SlotToEpoch(agg.Aggregate.Data.Slot), params.BeaconConfig().DomainAggregateAndProof
+		}
+	case remotehttp.VoluntaryExitType:
+		var exit *ethpb.VoluntaryExit
+		exit, err = req.VoluntaryExit.Proto()
+		if err == nil {
+			object, epoch, domainType = exit, exit.Epoch, params.BeaconConfig().DomainVoluntaryExit
+		}
+	case remotehttp.AggregationSlotType:
+		slot, err := strconv.ParseUint(req.Slot, 10, 64)
+		if err != nil {
+			return [32]byte{}, nil, errors.Wrap(err, "invalid slot")
+		}
+		object, epoch, domainType = slot, helpers.SlotToEpoch(slot), params.BeaconConfig().DomainSelectionProof
+	case remotehttp.RandaoRevealType:
+		e, err := strconv.ParseUint(req.Epoch, 10, 64)
+		if err != nil {
+			return [32]byte{}, nil, errors.Wrap(err, "invalid epoch")
+		}
+		object, epoch, domainType = e, e, params.BeaconConfig().DomainRandao
+	default:
+		return [32]byte{}, nil, fmt.Errorf("cannot sign %s without the object it is the signing root of", req.Type)
+	}
+	if err != nil {
+		return [32]byte{}, nil, errors.Wrapf(err, "invalid object to sign for %s", req.Type)
+	}
+	fork, err := p2putils.Fork(epoch)
+	if err != nil {
+		return [32]byte{}, nil, errors.Wrap(err, "could not get fork")
+	}
+	domain, err := helpers.Domain(fork, epoch, domainType, genesisValidatorsRoot)
+	if err != nil {
+		return [32]byte{}, nil, errors.Wrap(err, "could not compute signature domain")
+	}
+	if req.SignatureDomain != "" && req.SignatureDomain != fmt.Sprintf("%#x", domain) {
+		return [32]byte{}, nil, errors.New("signature domain does not match the fork and genesis validators root of the chain")
+	}
+	signingRoot, err := helpers.ComputeSigningRoot(object, domain)
+	if err != nil {
+		return [32]byte{}, nil, errors.Wrap(err, "could not compute signing root")
+	}
+	if req.SigningRoot != fmt.Sprintf("%#x", signingRoot) {
+		return [32]byte{}, nil, errors.New("signing root does not match the object to sign")
+	}
+	return signingRoot, object, nil
+}
+
+// protect records blocks and attestations in the slashing protection of the share-holder
+// before it signs them, refusing to sign if they are slashable.
+func (s *CosignerServer) protect(ctx context.Context, pubKey [48]byte, signingRoot [32]byte, object interface{}) error {
+	switch obj := object.(type) {
+	case *ethpb.BeaconBlockHeader:
+		return s.protection.CheckAndSaveProposal(ctx, pubKey, signingRoot, obj.Slot)
+	case *ethpb.AttestationData:
+		return s.protection.CheckAndSaveAttestation(ctx, pubKey, signingRoot, obj.Source.Epoch, obj.Target.Epoch)
+	}
+	return nil
+}
+
+func hexBytes(str string) ([]byte, error) {
+	if !strings.HasPrefix(str, "0x") {
+		return nil, errors.New("missing 0x prefix")
+	}
+	return hex.DecodeString(strings.TrimPrefix(str, "0x"))
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.WithError(err).Error("Could not write response")
+	}
+}
@@ -0,0 +1,30 @@
+/*
+Package threshold defines a keymanager implementation removing the single point of failure
+of a validator key, by splitting it into BLS threshold shares held by several share-holders.
+Each share-holder runs a validator client with a threshold wallet holding its share of each
+validator key, and serves partial signatures to the other share-holders, its co-signers.
+
+To sign, a share-holder signs with its own share and requests partial signatures from its
+co-signers over HTTP, authenticated with a bearer token shared by the share-holders and
+optionally over TLS. Once it has the partial signatures of a threshold of share-holders, it
+recovers the signature of the validator key with Lagrange interpolation.
+
+Every share-holder runs its own slashing protection before producing a partial signature:
+the validator client of a share-holder records what it signs before signing with its share
+as usual, and the co-signer server recomputes the signing root of the blocks and attestations
+it is requested to sign and records them in the same slashing protection database. A
+slashable object is thus never signed by a threshold of share-holders, even if one of them
+is compromised.
+
+Shares are generated by a single share-holder during wallet creation, which encrypts the
+shares of every other share-holder with a password of their own into files to distribute to
+them, and deletes these files once distributed. Shares are stored in the wallet according to
+the following structure:
+
+	wallet-dir/
+	  threshold/
+	    keymanageropts.json
+	    accounts/
+	      shares.keystore.json
+*/
+package threshold
@@ -0,0 +1,448 @@
+package threshold
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/v2/iface"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+	"github.com/sirupsen/logrus"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+var log = logrus.WithField("prefix", "threshold-keymanager-v2")
+
+const (
+	// AccountsPath where the shares of a threshold keymanager are kept.
+	AccountsPath = "accounts"
+	// SharesKeystoreFileName is the name of the encrypted file holding the shares of a
+	// share-holder, both in a wallet and when distributed to the other share-holders.
+	SharesKeystoreFileName = "shares.keystore.json"
+)
+
+// KeymanagerOpts for a threshold keymanager.
+type KeymanagerOpts struct {
+	Threshold       uint64          `json:"threshold"`
+	ShareID         uint64          `json:"share_id"`
+	ListenAddr      string          `json:"listen_address"`
+	Cosigners       []*CosignerOpts `json:"cosigners"`
+	BearerTokenPath string          `json:"bearer_token_path"`
+	CACertPath      string          `json:"ca_crt_path,omitempty"`
+	CertPath        string          `json:"crt_path,omitempty"`
+	KeyPath         string          `json:"key_path,omitempty"`
+}
+
+// CosignerOpts locates the co-signer holding a share.
+type CosignerOpts struct {
+	ShareID uint64 `json:"share_id"`
+	URL     string `json:"url"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a keymanager, such as passwords, the wallet, and more.
+type SetupConfig struct {
+	Wallet iface.Wallet
+	Opts   *KeymanagerOpts
+}
+
+// ShareStore defines the shares of the validator keys held by a share-holder, along with the
+// public keys of the shares of every share-holder, which verify their partial signatures.
+type ShareStore struct {
+	ShareID    uint64             `json:"share_id"`
+	Threshold  uint64             `json:"threshold"`
+	Validators []*ValidatorShares `json:"validators"`
+}
+
+// ValidatorShares defines the shares of a validator key.
+type ValidatorShares struct {
+	PublicKey       []byte            `json:"public_key"`
+	Share           []byte            `json:"share"`
+	SharePublicKeys map[uint64][]byte `json:"share_public_keys"`
+}
+
+// validatorKey is a validator key whose secret key is split across share-holders.
+type validatorKey struct {
+	publicKey       bls.PublicKey
+	share           bls.SecretKey
+	sharePublicKeys map[uint64]bls.PublicKey
+}
+
+// Keymanager implementation signing with a share of each validator key, which recovers the
+// signature of the validator key from the partial signatures of a threshold of share-holders.
+type Keymanager struct {
+	wallet    iface.Wallet
+	opts      *KeymanagerOpts
+	cosigners map[uint64]*remotehttp.Keymanager
+	lock      sync.RWMutex
+	pubKeys   [][48]byte
+	keys      map[[48]byte]*validatorKey
+	// genesisValidatorsRoot of the chain, which the co-signer server computes the signature
+	// domains of the signing requests with.
+	genesisValidatorsRoot []byte
+}
+
+// NewKeymanager instantiates a new threshold keymanager from configuration options.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil {
+		return nil, errors.New("options are required")
+	}
+	if cfg.Opts.BearerTokenPath == "" {
+		return nil, errors.New("a bearer token is required to authenticate co-signers")
+	}
+	if cfg.Opts.Threshold == 0 || cfg.Opts.Threshold > uint64(len(cfg.Opts.Cosigners)+1) {
+		return nil, fmt.Errorf(
+			"threshold must be between 1 and the number of share-holders %d, got %d",
+			len(cfg.Opts.Cosigners)+1,
+			cfg.Opts.Threshold,
+		)
+	}
+	cosigners := make(map[uint64]*remotehttp.Keymanager, len(cfg.Opts.Cosigners))
+	for _, c := range cfg.Opts.Cosigners {
+		if c.ShareID == cfg.Opts.ShareID {
+			return nil, fmt.Errorf("co-signer %s has the share ID %d of this share-holder", c.URL, c.ShareID)
+		}
+		if _, ok := cosigners[c.ShareID]; ok {
+			return nil, fmt.Errorf("duplicate co-signer share ID %d", c.ShareID)
+		}
+		client, err := remotehttp.NewKeymanager(ctx, &remotehttp.SetupConfig{
+			Opts: &remotehttp.KeymanagerOpts{
+				URL:             c.URL,
+				BearerTokenPath: cfg.Opts.BearerTokenPath,
+				CACertPath:      cfg.Opts.CACertPath,
+			},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not initialize co-signer %d", c.ShareID)
+		}
+		cosigners[c.ShareID] = client
+	}
+	k := &Keymanager{
+		wallet:    cfg.Wallet,
+		opts:      cfg.Opts,
+		cosigners: cosigners,
+		keys:      make(map[[48]byte]*validatorKey),
+	}
+	if err := k.initializeShares(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to initialize shares")
+	}
+	return k, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a threshold keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile returns a marshaled options file for a keymanager.
+func MarshalOptionsFile(ctx context.Context, opts *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(opts, "", "\t")
+}
+
+// KeymanagerOpts for the threshold keymanager.
+func (k *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return k.opts
+}
+
+// String pretty-print of a threshold keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	lines := []string{
+		fmt.Sprintf("%s: %d of %d\n", au.BrightMagenta("Threshold"), opts.Threshold, len(opts.Cosigners)+1),
+		fmt.Sprintf("%s: %d\n", au.BrightMagenta("Share ID"), opts.ShareID),
+		fmt.Sprintf("%s: %s\n", au.BrightMagenta("Listen address"), opts.ListenAddr),
+	}
+	for _, c := range opts.Cosigners {
+		lines = append(lines, fmt.Sprintf("%s %d: %s\n", au.BrightMagenta("Co-signer"), c.ShareID, c.URL))
+	}
+	for _, line := range lines {
+		if _, err := b.WriteString(line); err != nil {
+			log.Error(err)
+			return ""
+		}
+	}
+	return b.String()
+}
+
+// SetGenesisValidatorsRoot sets the genesis validators root of the chain, which is sent to
+// the co-signers within the fork info of the signing requests, and which the co-signer
+// server checks the signing requests of the co-signers against.
+func (k *Keymanager) SetGenesisValidatorsRoot(root []byte) {
+	k.lock.Lock()
+	k.genesisValidatorsRoot = root
+	k.lock.Unlock()
+	for _, c := range k.cosigners {
+		c.SetGenesisValidatorsRoot(root)
+	}
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys that should be used to validate with.
+func (k *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	pubKeys := make([][48]byte, len(k.pubKeys))
+	copy(pubKeys, k.pubKeys)
+	return pubKeys, nil
+}
+
+// Sign signs a message with the share of a validator key, and requests the partial signatures
+// of the co-signers concurrently until a threshold of them recovers the signature of the
+// validator key. Co-signers run their own slashing protection before signing.
+func (k *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	if req.PublicKey == nil {
+		return nil, errors.New("nil public key in request")
+	}
+	key, ok := k.validatorKey(bytesutil.ToBytes48(req.PublicKey))
+	if !ok {
+		return nil, errors.New("no share found for public key")
+	}
+	partials := map[uint64]bls.Signature{
+		k.opts.ShareID: key.share.Sign(req.SigningRoot),
+	}
+
+	type partial struct {
+		shareID uint64
+		sig     bls.Signature
+		err     error
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan *partial, len(k.cosigners))
+	for shareID, c := range k.cosigners {
+		go func(shareID uint64, c *remotehttp.Keymanager) {
+			sig, err := c.Sign(ctx, req)
+			results <- &partial{shareID: shareID, sig: sig, err: err}
+		}(shareID, c)
+	}
+	var failures []string
+	for i := 0; i < len(k.cosigners) && uint64(len(partials)) < k.opts.Threshold; i++ {
+		p := <-results
+		if p.err == nil && !p.sig.Verify(key.sharePublicKeys[p.shareID], req.SigningRoot) {
+			p.err = errors.New("invalid partial signature")
+		}
+		if p.err != nil {
+			log.WithError(p.err).WithField("shareID", p.shareID).Warn("Co-signer did not provide a partial signature")
+			failures = append(failures, fmt.Sprintf("co-signer %d: %v", p.shareID, p.err))
+			continue
+		}
+		partials[p.shareID] = p.sig
+	}
+	if uint64(len(partials)) < k.opts.Threshold {
+		return nil, fmt.Errorf(
+			"collected %d of the %d partial signatures required: %s",
+			len(partials),
+			k.opts.Threshold,
+			strings.Join(failures, ", "),
+		)
+	}
+	sig, err := bls.RecoverSignature(partials)
+	if err != nil {
+		return nil, err
+	}
+	if !sig.Verify(key.publicKey, req.SigningRoot) {
+		return nil, errors.New("recovered signature is invalid")
+	}
+	return sig, nil
+}
+
+// signShare signs a message with the share of a validator key, without co-signers.
+func (k *Keymanager) signShare(pubKey [48]byte, msg []byte) (bls.Signature, error) {
+	key, ok := k.validatorKey(pubKey)
+	if !ok {
+		return nil, errors.New("no share found for public key")
+	}
+	return key.share.Sign(msg), nil
+}
+
+func (k *Keymanager) validatorKey(pubKey [48]byte) (*validatorKey, bool) {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+	key, ok := k.keys[pubKey]
+	return key, ok
+}
+
+func (k *Keymanager) initializeShares(ctx context.Context) error {
+	encoded, err := k.wallet.ReadFileAtPath(ctx, AccountsPath, SharesKeystoreFileName)
+	if err != nil && strings.Contains(err.Error(), "no files found") {
+		// If there are no shares to initialize at all, just exit.
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "could not read keystore file for shares %s", SharesKeystoreFileName)
+	}
+	store, err := DecryptShareStore(encoded, k.wallet.Password())
+	if err != nil {
+		return err
+	}
+	if store.ShareID != k.opts.ShareID {
+		return fmt.Errorf("shares are for share ID %d, but the share ID is %d", store.ShareID, k.opts.ShareID)
+	}
+	if store.Threshold != k.opts.Threshold {
+		return fmt.Errorf("shares are for a threshold of %d, but the threshold is %d", store.Threshold, k.opts.Threshold)
+	}
+	pubKeys := make([][48]byte, 0, len(store.Validators))
+	keys := make(map[[48]byte]*validatorKey, len(store.Validators))
+	for _, v := range store.Validators {
+		key, err := parseValidatorShares(v)
+		if err != nil {
+			return err
+		}
+		for _, c := range k.opts.Cosigners {
+			if _, ok := key.sharePublicKeys[c.ShareID]; !ok {
+				return fmt.Errorf("no share public key for co-signer %d", c.ShareID)
+			}
+		}
+		pubKey := bytesutil.ToBytes48(v.PublicKey)
+		pubKeys = append(pubKeys, pubKey)
+		keys[pubKey] = key
+	}
+	k.lock.Lock()
+	k.pubKeys = pubKeys
+	k.keys = keys
+	k.lock.Unlock()
+	return nil
+}
+
+func parseValidatorShares(v *ValidatorShares) (*validatorKey, error) {
+	publicKey, err := bls.PublicKeyFromBytes(v.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse validator public key")
+	}
+	share, err := bls.SecretKeyFromBytes(v.Share)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse share")
+	}
+	sharePublicKeys := make(map[uint64]bls.PublicKey, len(v.SharePublicKeys))
+	for shareID, enc := range v.SharePublicKeys {
+		sharePublicKeys[shareID], err = bls.PublicKeyFromBytes(enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse public key of share %d", shareID)
+		}
+	}
+	return &validatorKey{
+		publicKey:       publicKey,
+		share:           share,
+		sharePublicKeys: sharePublicKeys,
+	}, nil
+}
+
+// GenerateShares generates new validator keys and splits each of them into shares for the
+// given share IDs, any threshold of which sign with the validator key. It returns the
+// share store of each share-holder.
+func GenerateShares(numKeys uint64, threshold uint64, shareIDs []uint64) (map[uint64]*ShareStore, error) {
+	stores := make(map[uint64]*ShareStore, len(shareIDs))
+	for _, shareID := range shareIDs {
+		stores[shareID] = &ShareStore{
+			ShareID:    shareID,
+			Threshold:  threshold,
+			Validators: make([]*ValidatorShares, 0, numKeys),
+		}
+	}
+	for i := uint64(0); i < numKeys; i++ {
+		secretKey := bls.RandKey()
+		shares, err := bls.SplitSecretKey(secretKey, threshold, shareIDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not split validator key")
+		}
+		sharePublicKeys := make(map[uint64][]byte, len(shares))
+		for shareID, share := range shares {
+			sharePublicKeys[shareID] = share.PublicKey().Marshal()
+		}
+		for shareID, share := range shares {
+			stores[shareID].Validators = append(stores[shareID].Validators, &ValidatorShares{
+				PublicKey:       secretKey.PublicKey().Marshal(),
+				Share:           share.Marshal(),
+				SharePublicKeys: sharePublicKeys,
+			})
+		}
+	}
+	return stores, nil
+}
+
+// EncryptShareStore encrypts a share store into an EIP-2335 keystore with a password.
+func EncryptShareStore(store *ShareStore, password string) ([]byte, error) {
+	encodedStore, err := json.MarshalIndent(store, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(encodedStore, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt shares")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(&v2keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+	}, "", "\t")
+}
+
+// DecryptShareStore decrypts a share store from an EIP-2335 keystore with a password.
+func DecryptShareStore(encoded []byte, password string) (*ShareStore, error) {
+	keystoreFile := &v2keymanager.Keystore{}
+	if err := json.Unmarshal(encoded, keystoreFile); err != nil {
+		return nil, errors.Wrap(err, "could not decode keystore file for shares")
+	}
+	enc, err := keystorev4.New().Decrypt(keystoreFile.Crypto, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt shares")
+	}
+	store := &ShareStore{}
+	if err := json.Unmarshal(enc, store); err != nil {
+		return nil, errors.Wrap(err, "could not decode shares")
+	}
+	return store, nil
+}
+
+// SaveShareStore writes the share store of the share-holder to its wallet, encrypted with
+// the wallet password.
+func SaveShareStore(ctx context.Context, wallet iface.Wallet, store *ShareStore) error {
+	encoded, err := EncryptShareStore(store, wallet.Password())
+	if err != nil {
+		return err
+	}
+	return wallet.WriteFileAtPath(ctx, AccountsPath, SharesKeystoreFileName, encoded)
+}
+
+// ShareIDs returns the share IDs of the share-holder and its co-signers, in increasing order.
+func (opts *KeymanagerOpts) ShareIDs() []uint64 {
+	ids := []uint64{opts.ShareID}
+	for _, c := range opts.Cosigners {
+		ids = append(ids, c.ShareID)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return ids
+}
@@ -0,0 +1,306 @@
+package threshold
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	mock "github.com/prysmaticlabs/prysm/validator/accounts/v2/testing"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+)
+
+const password = "Passw0rdz4321!"
+
+var genesisValidatorsRoot = bytesutil.PadTo([]byte{7}, 32)
+
+type shareHolder struct {
+	km      *Keymanager
+	db      db.Database
+	server  *httptest.Server
+	handler http.Handler
+}
+
+func writeBearerToken(t *testing.T) string {
+	tokenPath := filepath.Join(testutil.TempDir(), t.Name()+"-token")
+	require.NoError(t, os.MkdirAll(filepath.Dir(tokenPath), 0700))
+	require.NoError(t, ioutil.WriteFile(tokenPath, []byte("token"), 0600))
+	t.Cleanup(func() {
+		require.NoError(t, os.Remove(tokenPath))
+	})
+	return tokenPath
+}
+
+// setupShareHolders creates the share-holders of validator keys split with a threshold,
+// each serving partial signatures to the others.
+func setupShareHolders(t *testing.T, threshold uint64, count uint64) []*shareHolder {
+	ctx := context.Background()
+	tokenPath := writeBearerToken(t)
+
+	holders := make([]*shareHolder, count)
+	ids := make([]uint64, count)
+	for i := range holders {
+		h := &shareHolder{}
+		h.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h.handler.ServeHTTP(w, r)
+		}))
+		t.Cleanup(h.server.Close)
+		holders[i] = h
+		ids[i] = uint64(i + 1)
+	}
+	stores, err := GenerateShares(1, threshold, ids)
+	require.NoError(t, err)
+	for i, h := range holders {
+		opts := &KeymanagerOpts{
+			Threshold:       threshold,
+			ShareID:         ids[i],
+			BearerTokenPath: tokenPath,
+		}
+		for j, other := range holders {
+			if i != j {
+				opts.Cosigners = append(opts.Cosigners, &CosignerOpts{ShareID: ids[j], URL: other.server.URL})
+			}
+		}
+		wallet := &mock.Wallet{
+			Files:          make(map[string]map[string][]byte),
+			WalletPassword: password,
+		}
+		require.NoError(t, SaveShareStore(ctx, wallet, stores[ids[i]]))
+		h.km, err = NewKeymanager(ctx, &SetupConfig{Wallet: wallet, Opts: opts})
+		require.NoError(t, err)
+		h.km.SetGenesisValidatorsRoot(genesisValidatorsRoot)
+		pubKeys, err := h.km.FetchValidatingPublicKeys(ctx)
+		require.NoError(t, err)
+		h.db = dbTest.SetupDB(t, pubKeys)
+		cosigner, err := NewCosignerServer(ctx, h.km, h.db)
+		require.NoError(t, err)
+		h.handler = cosigner
+	}
+	return holders
+}
+
+func attestationSignRequest(t *testing.T, pubKey [48]byte, data *ethpb.AttestationData) *validatorpb.SignRequest {
+	fork, err := p2putils.Fork(data.Target.Epoch)
+	require.NoError(t, err)
+	domain, err := helpers.Domain(fork, data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester, genesisValidatorsRoot)
+	require.NoError(t, err)
+	root, err := helpers.ComputeSigningRoot(data, domain)
+	require.NoError(t, err)
+	return &validatorpb.SignRequest{
+		PublicKey:       pubKey[:],
+		SigningRoot:     root[:],
+		SignatureDomain: domain,
+		Object:          &validatorpb.SignRequest_AttestationData{AttestationData: data},
+	}
+}
+
+// signAttestation signs an attestation as the validator client of a share-holder does, which
+// records it in its slashing protection before signing.
+func (h *shareHolder) signAttestation(t *testing.T, pubKey [48]byte, data *ethpb.AttestationData) (bls.Signature, error) {
+	req := attestationSignRequest(t, pubKey, data)
+	require.NoError(t, h.db.CheckAndSaveAttestation(
+		context.Background(), pubKey, bytesutil.ToBytes32(req.SigningRoot), data.Source.Epoch, data.Target.Epoch,
+	))
+	return h.km.Sign(context.Background(), req)
+}
+
+func attestationData(source uint64, target uint64, root byte) *ethpb.AttestationData {
+	return &ethpb.AttestationData{
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          &ethpb.Checkpoint{Epoch: source, Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: target, Root: append([]byte{root}, make([]byte, 31)...)},
+	}
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	ctx := context.Background()
+	holders := setupShareHolders(t, 2, 3)
+	pubKeys, err := holders[0].km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pubKeys))
+	pubKey, err := bls.PublicKeyFromBytes(pubKeys[0][:])
+	require.NoError(t, err)
+
+	req := attestationSignRequest(t, pubKeys[0], attestationData(1, 2, 0))
+	sig, err := holders[0].signAttestation(t, pubKeys[0], attestationData(1, 2, 0))
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(pubKey, req.SigningRoot))
+
+	// Any share-holder signs with the validator key, and signing again is not slashable.
+	otherSig, err := holders[2].signAttestation(t, pubKeys[0], attestationData(1, 2, 0))
+	require.NoError(t, err)
+	assert.DeepEqual(t, sig.Marshal(), otherSig.Marshal())
+}
+
+func TestKeymanager_Sign_CosignerDown(t *testing.T) {
+	ctx := context.Background()
+	holders := setupShareHolders(t, 2, 3)
+	holders[1].server.Close()
+	pubKeys, err := holders[0].km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	_, err = holders[0].signAttestation(t, pubKeys[0], attestationData(1, 2, 0))
+	require.NoError(t, err)
+
+	holders[2].server.Close()
+	_, err = holders[0].signAttestation(t, pubKeys[0], attestationData(2, 3, 0))
+	assert.ErrorContains(t, "collected 1 of the 2 partial signatures required", err)
+}
+
+func TestKeymanager_Sign_CosignersRefuseSlashable(t *testing.T) {
+	ctx := context.Background()
+	holders := setupShareHolders(t, 2, 3)
+	pubKeys, err := holders[0].km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	_, err = holders[0].signAttestation(t, pubKeys[0], attestationData(1, 2, 0))
+	require.NoError(t, err)
+	// A double vote requested by a share-holder skipping its own slashing protection.
+	_, err = holders[1].km.Sign(ctx, attestationSignRequest(t, pubKeys[0], attestationData(1, 2, 1)))
+	assert.ErrorContains(t, remotehttp.ErrSigningDenied.Error(), err)
+}
+
+func TestCosignerServer_ChecksSigningRoot(t *testing.T) {
+	ctx := context.Background()
+	holders := setupShareHolders(t, 2, 2)
+	pubKeys, err := holders[0].km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	cosigner := holders[0].km.cosigners[2]
+
+	req := attestationSignRequest(t, pubKeys[0], attestationData(1, 2, 0))
+	req.SigningRoot = make([]byte, 32)
+	_, err = cosigner.Sign(ctx, req)
+	assert.ErrorContains(t, "status 400", err)
+
+	// Only objects whose signing root the co-signer computes are signed.
+	req = attestationSignRequest(t, pubKeys[0], attestationData(1, 2, 0))
+	req.Object = nil
+	_, err = cosigner.Sign(ctx, req)
+	assert.ErrorContains(t, "status 400", err)
+
+	// The signature domain is computed by the co-signer rather than trusted from the request.
+	data := attestationData(1, 2, 0)
+	req = attestationSignRequest(t, pubKeys[0], data)
+	req.SignatureDomain = make([]byte, 32)
+	req.SigningRoot, err = signingRoot(data, req.SignatureDomain)
+	require.NoError(t, err)
+	_, err = cosigner.Sign(ctx, req)
+	assert.ErrorContains(t, "status 400", err)
+	req.SignatureDomain = nil
+	_, err = cosigner.Sign(ctx, req)
+	assert.ErrorContains(t, "status 400", err)
+}
+
+func signingRoot(object interface{}, domain []byte) ([]byte, error) {
+	root, err := helpers.ComputeSigningRoot(object, domain)
+	return root[:], err
+}
+
+func TestCosignerServer_UnknownGenesisValidatorsRoot(t *testing.T) {
+	ctx := context.Background()
+	holders := setupShareHolders(t, 2, 2)
+	pubKeys, err := holders[0].km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	holders[1].km.lock.Lock()
+	holders[1].km.genesisValidatorsRoot = nil
+	holders[1].km.lock.Unlock()
+
+	_, err = holders[0].km.cosigners[2].Sign(ctx, attestationSignRequest(t, pubKeys[0], attestationData(1, 2, 0)))
+	assert.ErrorContains(t, "status 503", err)
+}
+
+func TestCosignerServer_Unauthorized(t *testing.T) {
+	holders := setupShareHolders(t, 2, 2)
+	res, err := http.Get(holders[0].server.URL + remotehttp.PublicKeysPath)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+}
+
+func TestNewKeymanager_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *KeymanagerOpts
+		err  string
+	}{
+		{
+			name: "Missing bearer token",
+			opts: &KeymanagerOpts{Threshold: 1, ShareID: 1},
+			err:  "bearer token is required",
+		},
+		{
+			name: "Threshold above the number of share-holders",
+			opts: &KeymanagerOpts{Threshold: 2, ShareID: 1, BearerTokenPath: "/token"},
+			err:  "threshold must be between 1 and the number of share-holders 1",
+		},
+		{
+			name: "Co-signer with the same share ID",
+			opts: &KeymanagerOpts{
+				Threshold:       2,
+				ShareID:         1,
+				BearerTokenPath: "/token",
+				Cosigners:       []*CosignerOpts{{ShareID: 1, URL: "http://localhost:7600"}},
+			},
+			err: "has the share ID 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: tt.opts})
+			assert.ErrorContains(t, tt.err, err)
+		})
+	}
+}
+
+func TestNewKeymanager_WrongShareID(t *testing.T) {
+	ctx := context.Background()
+	stores, err := GenerateShares(1, 1, []uint64{1, 2})
+	require.NoError(t, err)
+	wallet := &mock.Wallet{
+		Files:          make(map[string]map[string][]byte),
+		WalletPassword: password,
+	}
+	require.NoError(t, SaveShareStore(ctx, wallet, stores[2]))
+	_, err = NewKeymanager(ctx, &SetupConfig{
+		Wallet: wallet,
+		Opts: &KeymanagerOpts{
+			Threshold:       1,
+			ShareID:         1,
+			BearerTokenPath: writeBearerToken(t),
+			Cosigners:       []*CosignerOpts{{ShareID: 2, URL: "http://localhost:7600"}},
+		},
+	})
+	assert.ErrorContains(t, "shares are for share ID 2", err)
+}
+
+func TestEncryptShareStore(t *testing.T) {
+	stores, err := GenerateShares(2, 2, []uint64{1, 2, 3})
+	require.NoError(t, err)
+	enc, err := EncryptShareStore(stores[3], password)
+	require.NoError(t, err)
+	store, err := DecryptShareStore(enc, password)
+	require.NoError(t, err)
+	assert.DeepEqual(t, stores[3], store)
+	for i, v := range store.Validators {
+		assert.DeepEqual(t, stores[1].Validators[i].PublicKey, v.PublicKey, fmt.Sprintf("validator %d", i))
+	}
+
+	_, err = DecryptShareStore(enc, "wrong")
+	assert.ErrorContains(t, "could not decrypt shares", err)
+}
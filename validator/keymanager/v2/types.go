@@ -16,6 +16,12 @@ type IKeymanager interface {
 	Sign(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
 }
 
+// GenesisValidatorsRootSetter is implemented by keymanagers which need the genesis
+// validators root of the chain to sign, such as to send it to a remote signer.
+type GenesisValidatorsRootSetter interface {
+	SetGenesisValidatorsRoot(root []byte)
+}
+
 // Keystore json file representation as a Go struct.
 type Keystore struct {
 	Crypto  map[string]interface{} `json:"crypto"`
@@ -23,6 +29,8 @@ type Keystore struct {
 	Pubkey  string                 `json:"pubkey"`
 	Version uint                   `json:"version"`
 	Name    string                 `json:"name"`
+	// Path is the EIP-2334 derivation path of the key, if it was derived.
+	Path string `json:"path,omitempty"`
 }
 
 // Kind defines an enum for either direct, derived, or remote-signing
@@ -36,6 +44,10 @@ const (
 	Direct
 	// Remote keymanager capable of remote-signing data.
 	Remote
+	// RemoteHTTP keymanager capable of remote-signing data over HTTP.
+	RemoteHTTP
+	// Threshold keymanager signing with a share of each key, along with co-signers.
+	Threshold
 )
 
 // String marshals a keymanager kind to a string value.
@@ -47,6 +59,10 @@ func (k Kind) String() string {
 		return "direct"
 	case Remote:
 		return "remote"
+	case RemoteHTTP:
+		return "remote-http"
+	case Threshold:
+		return "threshold"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -61,6 +77,10 @@ func ParseKind(k string) (Kind, error) {
 		return Direct, nil
 	case "remote":
 		return Remote, nil
+	case "remote-http":
+		return RemoteHTTP, nil
+	case "threshold":
+		return Threshold, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
@@ -5,10 +5,16 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote"
+	remotehttp "github.com/prysmaticlabs/prysm/validator/keymanager/v2/remote-http"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/threshold"
 )
 
 var (
 	_ = v2keymanager.IKeymanager(&direct.Keymanager{})
 	_ = v2keymanager.IKeymanager(&derived.Keymanager{})
 	_ = v2keymanager.IKeymanager(&remote.Keymanager{})
+	_ = v2keymanager.IKeymanager(&remotehttp.Keymanager{})
+	_ = v2keymanager.GenesisValidatorsRootSetter(&remotehttp.Keymanager{})
+	_ = v2keymanager.IKeymanager(&threshold.Keymanager{})
+	_ = v2keymanager.GenesisValidatorsRootSetter(&threshold.Keymanager{})
 )
@@ -26,6 +26,8 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/node"
+	"github.com/prysmaticlabs/prysm/validator/report"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/interchange"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 	"github.com/urfave/cli/v2/altsrc"
@@ -49,15 +51,21 @@ func startNode(ctx *cli.Context) error {
 
 var appFlags = []cli.Flag{
 	flags.BeaconRPCProviderFlag,
+	flags.BroadcastToBeaconNodesFlag,
 	flags.BeaconRPCGatewayProviderFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
+	flags.ValidatorsConfigFileFlag,
 	flags.KeystorePathFlag,
 	flags.SourceDirectories,
 	flags.SourceDirectory,
 	flags.TargetDirectory,
 	flags.PasswordFlag,
 	flags.DisablePenaltyRewardLogFlag,
+	flags.EnableDoppelgangerDetectionFlag,
+	flags.DoppelgangerDetectionEpochsFlag,
+	flags.AuditLogDirFlag,
+	flags.AuditLogMaxSizeFlag,
 	flags.UnencryptedKeysFlag,
 	flags.InteropStartIndex,
 	flags.InteropNumValidators,
@@ -117,6 +125,8 @@ func main() {
 	app.Commands = []*cli.Command{
 		v2.WalletCommands,
 		v2.AccountCommands,
+		interchange.Commands,
+		report.Command,
 		{
 			Name:     "accounts",
 			Category: "accounts",
@@ -205,7 +215,7 @@ contract in order to activate the validator client`,
 							cliCtx.Uint(flags.GrpcRetriesFlag.Name),
 							cliCtx.Duration(flags.GrpcRetryDelayFlag.Name),
 							grpc.WithBlock())
-						endpoint := cliCtx.String(flags.BeaconRPCProviderFlag.Name)
+						endpoint := strings.Split(cliCtx.String(flags.BeaconRPCProviderFlag.Name), ",")[0]
 						conn, err := grpc.DialContext(ctx, endpoint, dialOpts...)
 						if err != nil {
 							log.WithError(err).Errorf("Failed to dial beacon node endpoint at %s", endpoint)
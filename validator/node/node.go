@@ -20,17 +20,22 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	accountsv2 "github.com/prysmaticlabs/prysm/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/validator/audit"
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
 	v1 "github.com/prysmaticlabs/prysm/validator/keymanager/v1"
 	v2 "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/multi"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/threshold"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
 	"github.com/prysmaticlabs/prysm/validator/rpc/gateway"
 	slashing_protection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
@@ -40,6 +45,9 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
+// logStreamSize is the number of recent log entries kept to be streamed to the web UI.
+const logStreamSize = 1000
+
 // ValidatorClient defines an instance of an eth2 validator that manages
 // the entire lifecycle of services attached to it participating in eth2.
 type ValidatorClient struct {
@@ -48,7 +56,11 @@ type ValidatorClient struct {
 	services          *shared.ServiceRegistry // Lifecycle and service store.
 	lock              sync.RWMutex
 	wallet            *accountsv2.Wallet
+	wallets           []*accountsv2.Wallet
+	multiKeymanager   *multi.Keymanager
 	walletInitialized *event.Feed
+	auditLog          *audit.Log
+	logStreamer       *logutil.StreamHook
 	stop              chan struct{} // Channel to wait for termination notifications.
 }
 
@@ -70,12 +82,15 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 		return nil, err
 	}
 	logrus.SetLevel(level)
+	logStreamer := logutil.NewStreamHook(logStreamSize)
+	logrus.AddHook(logStreamer)
 
 	registry := shared.NewServiceRegistry()
 	ValidatorClient := &ValidatorClient{
 		cliCtx:            cliCtx,
 		services:          registry,
 		walletInitialized: new(event.Feed),
+		logStreamer:       logStreamer,
 		stop:              make(chan struct{}),
 	}
 
@@ -143,9 +158,16 @@ func (s *ValidatorClient) Close() {
 
 	s.services.StopAll()
 	log.Info("Stopping Prysm validator")
+	if s.auditLog != nil {
+		if err := s.auditLog.Close(); err != nil {
+			log.WithError(err).Error("Failed to close audit log")
+		}
+	}
 	if !s.cliCtx.IsSet(flags.InteropNumValidators.Name) {
-		if err := s.wallet.UnlockWalletConfigFile(); err != nil {
-			log.WithError(err).Errorf("Failed to unlock wallet config file.")
+		for _, wallet := range s.wallets {
+			if err := wallet.UnlockWalletConfigFile(); err != nil {
+				log.WithError(err).Errorf("Failed to unlock wallet config file.")
+			}
 		}
 	}
 	close(s.stop)
@@ -156,6 +178,16 @@ func (s *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	var keyManagerV2 v2.IKeymanager
 	var err error
 	var accountsDir string
+	var keyConfig *keyconfig.Store
+	if path := cliCtx.String(flags.ValidatorsConfigFileFlag.Name); path != "" {
+		if !featureconfig.Get().EnableAccountsV2 {
+			return errors.Errorf("--%s requires accounts-v2", flags.ValidatorsConfigFileFlag.Name)
+		}
+		keyConfig, err = keyconfig.Load(path)
+		if err != nil {
+			return errors.Wrap(err, "could not load validating keys config")
+		}
+	}
 	if featureconfig.Get().EnableAccountsV2 {
 		if cliCtx.IsSet(flags.InteropNumValidators.Name) {
 			numValidatorKeys := cliCtx.Uint64(flags.InteropNumValidators.Name)
@@ -165,6 +197,18 @@ func (s *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 				return errors.Wrap(err, "could not generate interop keys")
 			}
 			accountsDir = cliCtx.String(flags.KeystorePathFlag.Name)
+		} else if keyConfig != nil && len(keyConfig.Wallets()) > 0 {
+			// The keys are merged from several wallets, so the database stays in the data
+			// directory rather than in the directory of one of them.
+			keymanagers, err := s.openConfiguredWallets(cliCtx, keyConfig.Wallets())
+			if err != nil {
+				return err
+			}
+			s.multiKeymanager, err = multi.NewKeymanager(keymanagers, keyConfig)
+			if err != nil {
+				return errors.Wrap(err, "could not merge keymanagers of wallets")
+			}
+			keyManagerV2 = s.multiKeymanager
 		} else {
 			// Read the wallet from the specified path.
 			wallet, err := accountsv2.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*accountsv2.Wallet, error) {
@@ -174,6 +218,7 @@ func (s *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 				return errors.Wrap(err, "could not open wallet")
 			}
 			s.wallet = wallet
+			s.wallets = append(s.wallets, wallet)
 			keyManagerV2, err = wallet.InitializeKeymanager(
 				cliCtx.Context, false, /* skipMnemonicConfirm */
 			)
@@ -185,6 +230,13 @@ func (s *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 			}
 			accountsDir = s.wallet.AccountsDir()
 		}
+		if keyConfig != nil && s.multiKeymanager == nil {
+			s.multiKeymanager, err = multi.NewKeymanager([]v2.IKeymanager{keyManagerV2}, keyConfig)
+			if err != nil {
+				return errors.Wrap(err, "could not configure validating keys")
+			}
+			keyManagerV2 = s.multiKeymanager
+		}
 	} else {
 		keyManagerV1, err = selectV1Keymanager(cliCtx)
 		if err != nil {
@@ -228,6 +280,9 @@ func (s *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	if err := s.registerClientService(keyManagerV1, keyManagerV2); err != nil {
 		return err
 	}
+	if err := s.registerThresholdCosignerService(keyManagerV2); err != nil {
+		return err
+	}
 	if err := s.registerRPCService(cliCtx); err != nil {
 		return err
 	}
@@ -237,6 +292,46 @@ func (s *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 	return nil
 }
 
+// openConfiguredWallets opens the wallets of the validating keys config, and returns
+// their keymanagers.
+func (s *ValidatorClient) openConfiguredWallets(
+	cliCtx *cli.Context,
+	wallets []*keyconfig.Wallet,
+) ([]v2.IKeymanager, error) {
+	keymanagers := make([]v2.IKeymanager, 0, len(wallets))
+	for _, w := range wallets {
+		password, err := w.Password()
+		if err != nil {
+			return nil, err
+		}
+		walletDir, err := fileutil.ExpandPath(w.Dir)
+		if err != nil {
+			return nil, err
+		}
+		wallet, err := accountsv2.OpenWallet(cliCtx.Context, &accountsv2.WalletConfig{
+			WalletDir:      walletDir,
+			WalletPassword: password,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not open wallet %s", w.Dir)
+		}
+		keymanager, err := wallet.InitializeKeymanager(cliCtx.Context, false /* skipMnemonicConfirm */)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read keymanager for wallet %s", w.Dir)
+		}
+		if err := wallet.LockWalletConfigFile(cliCtx.Context); err != nil {
+			return nil, errors.Wrapf(err, "could not get a lock on wallet %s, check if another validator instance is using it", w.Dir)
+		}
+		s.wallets = append(s.wallets, wallet)
+		keymanagers = append(keymanagers, keymanager)
+		log.WithFields(logrus.Fields{
+			"walletDir":      w.Dir,
+			"keymanagerKind": wallet.KeymanagerKind().String(),
+		}).Info("Opened wallet")
+	}
+	return keymanagers, nil
+}
+
 func moveDb(cliCtx *cli.Context, accountsDir string) string {
 	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
 	if accountsDir != "" {
@@ -324,6 +419,22 @@ func (s *ValidatorClient) registerClientService(
 	maxCallRecvMsgSize := s.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	grpcRetries := s.cliCtx.Uint(flags.GrpcRetriesFlag.Name)
 	grpcRetryDelay := s.cliCtx.Duration(flags.GrpcRetryDelayFlag.Name)
+	doppelgangerEpochs := doppelgangerDetectionEpochs(s.cliCtx)
+	if doppelgangerEpochs > 0 {
+		log.WithField("epochs", doppelgangerEpochs).Info("Doppelganger detection is enabled, signing is delayed on start")
+	}
+	if dir := s.cliCtx.String(flags.AuditLogDirFlag.Name); dir != "" && s.auditLog == nil {
+		maxSize := s.cliCtx.Int64(flags.AuditLogMaxSizeFlag.Name) * 1024 * 1024
+		auditLog, err := audit.NewLog(dir, maxSize)
+		if err != nil {
+			return errors.Wrap(err, "could not open audit log")
+		}
+		s.auditLog = auditLog
+	}
+	var keyConfig *keyconfig.Store
+	if s.multiKeymanager != nil {
+		keyConfig = s.multiKeymanager.Config()
+	}
 	var sp *slashing_protection.Service
 	var protector slashing_protection.Protector
 	if err := s.services.FetchService(&sp); err == nil {
@@ -331,6 +442,9 @@ func (s *ValidatorClient) registerClientService(
 	}
 	v, err := client.NewValidatorService(s.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
+		BroadcastToBeaconNodes:     s.cliCtx.Bool(flags.BroadcastToBeaconNodesFlag.Name),
+		DoppelgangerEpochs:         doppelgangerEpochs,
+		AuditLog:                   s.auditLog,
 		DataDir:                    dataDir,
 		KeyManager:                 keyManager,
 		KeyManagerV2:               keyManagerV2,
@@ -338,6 +452,7 @@ func (s *ValidatorClient) registerClientService(
 		EmitAccountMetrics:         emitAccountMetrics,
 		CertFlag:                   cert,
 		GraffitiFlag:               graffiti,
+		KeyConfig:                  keyConfig,
 		GrpcMaxCallRecvMsgSizeFlag: maxCallRecvMsgSize,
 		GrpcRetriesFlag:            grpcRetries,
 		GrpcRetryDelay:             grpcRetryDelay,
@@ -353,6 +468,36 @@ func (s *ValidatorClient) registerClientService(
 	}
 	return s.services.RegisterService(v)
 }
+
+// registerThresholdCosignerService serves the partial signatures of a threshold keymanager
+// to its co-signers, checked against the slashing protection of this validator client.
+func (s *ValidatorClient) registerThresholdCosignerService(keyManagerV2 v2.IKeymanager) error {
+	keymanagers := []v2.IKeymanager{keyManagerV2}
+	if s.multiKeymanager != nil {
+		keymanagers = s.multiKeymanager.Keymanagers()
+	}
+	var km *threshold.Keymanager
+	for _, keymanager := range keymanagers {
+		thresholdKm, ok := keymanager.(*threshold.Keymanager)
+		if !ok {
+			continue
+		}
+		// The co-signers of every share-holder reach it at a single address.
+		if km != nil {
+			return errors.New("only one threshold wallet can be loaded at once")
+		}
+		km = thresholdKm
+	}
+	if km == nil {
+		return nil
+	}
+	server, err := threshold.NewCosignerServer(s.cliCtx.Context, km, s.db)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize co-signer server")
+	}
+	return s.services.RegisterService(server)
+}
+
 func (s *ValidatorClient) registerSlasherClientService() error {
 	endpoint := s.cliCtx.String(flags.SlasherRPCProviderFlag.Name)
 	if endpoint == "" {
@@ -393,11 +538,26 @@ func (s *ValidatorClient) registerRPCService(cliCtx *cli.Context) error {
 		ValidatorService:      vs,
 		SyncChecker:           vs,
 		GenesisFetcher:        vs,
+		BeaconNodeFetcher:     vs,
+		EventSubscriber:       vs,
+		LogStreamer:           s.logStreamer,
+		AuditLog:              s.auditLog,
 		NodeGatewayEndpoint:   nodeGatewayEndpoint,
+		MultiKeymanager:       s.multiKeymanager,
+		DoppelgangerEpochs:    doppelgangerDetectionEpochs(cliCtx),
 	})
 	return s.services.RegisterService(server)
 }
 
+// doppelgangerDetectionEpochs returns the number of epochs to watch for doppelgangers
+// before signing, which is 0 when doppelganger detection is not enabled.
+func doppelgangerDetectionEpochs(cliCtx *cli.Context) uint64 {
+	if !cliCtx.Bool(flags.EnableDoppelgangerDetectionFlag.Name) {
+		return 0
+	}
+	return cliCtx.Uint64(flags.DoppelgangerDetectionEpochsFlag.Name)
+}
+
 func (s *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 	gatewayHost := cliCtx.String(flags.GRPCGatewayHost.Name)
 	gatewayPort := cliCtx.Int(flags.GRPCGatewayPort.Name)
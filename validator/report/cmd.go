@@ -0,0 +1,127 @@
+package report
+
+import (
+	"io"
+	"os"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	v2 "github.com/prysmaticlabs/prysm/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// defaultReportEpochs is the number of epochs reported on when the first epoch is not given,
+// about a day of epochs on mainnet.
+const defaultReportEpochs = 225
+
+// Command reports the performance of the validators of the wallet over a range of epochs.
+var Command = &cli.Command{
+	Name:     "report",
+	Category: "report",
+	Usage:    "reports the performance of the validators of the wallet over a range of epochs",
+	Description: `reports, for each validator of the wallet, the attestation effectiveness, the inclusion distance
+distribution, the missed and late votes, the proposals made and missed, the balance change and the APR from --from-epoch
+to --to-epoch, both included, as a table, CSV or JSON. The report covers the last 225 epochs if --from-epoch is not set.`,
+	Flags: []cli.Flag{
+		flags.WalletDirFlag,
+		flags.WalletPasswordFileFlag,
+		flags.ReportFromEpochFlag,
+		flags.ReportToEpochFlag,
+		flags.ReportFormatFlag,
+		flags.ReportOutputFileFlag,
+		flags.BeaconRPCProviderFlag,
+		cmd.GrpcMaxCallRecvMsgSizeFlag,
+		flags.CertFlag,
+		flags.GrpcHeadersFlag,
+		flags.GrpcRetriesFlag,
+		flags.GrpcRetryDelayFlag,
+		featureconfig.AltonaTestnet,
+		featureconfig.OnyxTestnet,
+	},
+	Action: func(cliCtx *cli.Context) error {
+		featureconfig.ConfigureValidator(cliCtx)
+		if err := ReportCli(cliCtx); err != nil {
+			log.Fatalf("Could not report performance: %v", err)
+		}
+		return nil
+	},
+}
+
+// ReportCli reports the performance of the validators of the wallet over the range of
+// epochs given by the cli flags.
+func ReportCli(cliCtx *cli.Context) error {
+	format := cliCtx.String(flags.ReportFormatFlag.Name)
+	if format != FormatTable && format != FormatCSV && format != FormatJSON {
+		return errors.Errorf("unknown report format %q, use --%s with %s, %s or %s",
+			format, flags.ReportFormatFlag.Name, FormatTable, FormatCSV, FormatJSON)
+	}
+	wallet, err := v2.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*v2.Wallet, error) {
+		return nil, errors.New("no wallet found, no validators to report on")
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	keymanager, err := wallet.InitializeKeymanager(cliCtx.Context, true /* skip mnemonic confirm */)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	pubKeys, err := keymanager.FetchValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(pubKeys) == 0 {
+		return errors.New("wallet is empty, no validators to report on")
+	}
+
+	conns, err := v2.DialBeaconNodes(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer conns.Close()
+	beaconClient := conns.BeaconClient
+
+	toEpoch := cliCtx.Uint64(flags.ReportToEpochFlag.Name)
+	if !cliCtx.IsSet(flags.ReportToEpochFlag.Name) {
+		head, err := beaconClient.GetChainHead(cliCtx.Context, &ptypes.Empty{})
+		if err != nil {
+			return errors.Wrap(err, "could not get chain head")
+		}
+		// The votes of an epoch can be included until the end of the next one.
+		if head.HeadEpoch < 2 {
+			return errors.New("no epoch has all its votes included yet")
+		}
+		toEpoch = head.HeadEpoch - 2
+	}
+	fromEpoch := cliCtx.Uint64(flags.ReportFromEpochFlag.Name)
+	if !cliCtx.IsSet(flags.ReportFromEpochFlag.Name) && toEpoch >= defaultReportEpochs {
+		fromEpoch = toEpoch - defaultReportEpochs + 1
+	}
+	report, err := Generate(cliCtx.Context, beaconClient, pubKeys, fromEpoch, toEpoch)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if outputPath := cliCtx.String(flags.ReportOutputFileFlag.Name); outputPath != "" {
+		outputPath, err = fileutil.ExpandPath(outputPath)
+		if err != nil {
+			return errors.Wrap(err, "could not expand output file path")
+		}
+		f, err := os.OpenFile(outputPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+		if err != nil {
+			return errors.Wrapf(err, "could not create output file %s", outputPath)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.WithError(err).Error("Could not close output file")
+			}
+		}()
+		w = f
+	}
+	return Write(w, format, report)
+}
@@ -0,0 +1,142 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Formats of the performance report.
+const (
+	FormatTable = "table"
+	FormatCSV   = "csv"
+	FormatJSON  = "json"
+)
+
+// Write writes a performance report in a format.
+func Write(w io.Writer, format string, report *Report) error {
+	switch format {
+	case FormatTable:
+		return writeTable(w, report)
+	case FormatCSV:
+		return writeCSV(w, report)
+	case FormatJSON:
+		enc, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "could not encode report")
+		}
+		_, err = w.Write(append(enc, '\n'))
+		return err
+	default:
+		return fmt.Errorf("unknown report format %q, expected %s, %s or %s", format, FormatTable, FormatCSV, FormatJSON)
+	}
+}
+
+func writeCSV(w io.Writer, report *Report) error {
+	labels := inclusionDistanceLabels()
+	header := []string{
+		"pubkey", "validator_index", "missing", "votes", "included_votes", "missed_votes", "late_votes",
+		"correct_target_votes", "correct_head_votes",
+	}
+	for _, label := range labels {
+		header = append(header, "inclusion_distance_"+label)
+	}
+	header = append(header,
+		"attestation_effectiveness", "proposals_made", "proposals_missed",
+		"start_balance", "end_balance", "balance_change", "apr",
+	)
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, r := range report.Validators {
+		record := []string{
+			r.PublicKey,
+			strconv.FormatUint(r.ValidatorIndex, 10),
+			strconv.FormatBool(r.Missing),
+			strconv.FormatUint(r.Votes, 10),
+			strconv.FormatUint(r.IncludedVotes, 10),
+			strconv.FormatUint(r.MissedVotes, 10),
+			strconv.FormatUint(r.LateVotes, 10),
+			strconv.FormatUint(r.CorrectTargetVotes, 10),
+			strconv.FormatUint(r.CorrectHeadVotes, 10),
+		}
+		for _, label := range labels {
+			record = append(record, strconv.FormatUint(r.InclusionDistances[label], 10))
+		}
+		record = append(record,
+			strconv.FormatFloat(r.Effectiveness, 'f', 4, 64),
+			strconv.FormatUint(r.ProposalsMade, 10),
+			strconv.FormatUint(r.ProposalsMissed, 10),
+			strconv.FormatUint(r.StartBalance, 10),
+			strconv.FormatUint(r.EndBalance, 10),
+			strconv.FormatInt(r.BalanceChange, 10),
+			strconv.FormatFloat(r.APR, 'f', 4, 64),
+		)
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeTable(w io.Writer, report *Report) error {
+	labels := inclusionDistanceLabels()
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintf(tw, "Performance from epoch %d to epoch %d\n\n", report.FromEpoch, report.ToEpoch); err != nil {
+		return err
+	}
+	header := []string{
+		"PUBLIC KEY", "INDEX", "VOTES", "MISSED", "LATE", "TARGET", "HEAD",
+		"INCLUSION DISTANCE (" + strings.Join(labels, "/") + ")", "EFFECTIVENESS",
+		"PROPOSED", "MISSED PROPOSALS", "BALANCE CHANGE (ETH)", "APR",
+	}
+	if _, err := fmt.Fprintln(tw, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+	gweiPerEth := float64(params.BeaconConfig().GweiPerEth)
+	for _, r := range report.Validators {
+		pubKey := r.PublicKey
+		if len(pubKey) > 12 {
+			pubKey = pubKey[:12]
+		}
+		if r.Missing {
+			if _, err := fmt.Fprintf(tw, "%s\tunknown to the beacon node\n", pubKey); err != nil {
+				return err
+			}
+			continue
+		}
+		distances := make([]string, len(labels))
+		for i, label := range labels {
+			distances[i] = strconv.FormatUint(r.InclusionDistances[label], 10)
+		}
+		if _, err := fmt.Fprintf(
+			tw,
+			"%s\t%d\t%d\t%d\t%d\t%d\t%d\t%s\t%.2f%%\t%d\t%d\t%.6f\t%.2f%%\n",
+			pubKey,
+			r.ValidatorIndex,
+			r.Votes,
+			r.MissedVotes,
+			r.LateVotes,
+			r.CorrectTargetVotes,
+			r.CorrectHeadVotes,
+			strings.Join(distances, "/"),
+			r.Effectiveness*100,
+			r.ProposalsMade,
+			r.ProposalsMissed,
+			float64(r.BalanceChange)/gweiPerEth,
+			r.APR*100,
+		); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
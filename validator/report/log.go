@@ -0,0 +1,5 @@
+package report
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "report")
@@ -0,0 +1,472 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// pageSize is the page size of the paginated queries made to the beacon node.
+const pageSize = 250
+
+// inclusionDistanceBuckets are the upper bounds of the buckets of the inclusion distance
+// distribution, the last bucket holding the longer distances.
+var inclusionDistanceBuckets = []uint64{1, 2, 3, 5, 10}
+
+// ValidatorReport is the performance of a validator over a range of epochs. Votes are the
+// attestations the validator had to make while it was active, and balances are in Gwei.
+type ValidatorReport struct {
+	PublicKey      string `json:"pubkey"`
+	ValidatorIndex uint64 `json:"validator_index"`
+	// Missing is true if the beacon node does not know the validator, which has no
+	// performance then.
+	Missing            bool              `json:"missing"`
+	Votes              uint64            `json:"votes"`
+	IncludedVotes      uint64            `json:"included_votes"`
+	MissedVotes        uint64            `json:"missed_votes"`
+	LateVotes          uint64            `json:"late_votes"`
+	CorrectTargetVotes uint64            `json:"correct_target_votes"`
+	CorrectHeadVotes   uint64            `json:"correct_head_votes"`
+	InclusionDistances map[string]uint64 `json:"inclusion_distances"`
+	// Effectiveness is the average of the inverse of the inclusion distances of the votes,
+	// missed votes counting as zero, so 1 if all votes were included in the next slot.
+	Effectiveness   float64 `json:"attestation_effectiveness"`
+	ProposalsMade   uint64  `json:"proposals_made"`
+	ProposalsMissed uint64  `json:"proposals_missed"`
+	StartBalance    uint64  `json:"start_balance"`
+	EndBalance      uint64  `json:"end_balance"`
+	BalanceChange   int64   `json:"balance_change"`
+	// APR is the annual percentage rate of the balance change over the range.
+	APR float64 `json:"apr"`
+}
+
+// Report is the performance of validators over a range of epochs.
+type Report struct {
+	FromEpoch  uint64             `json:"from_epoch"`
+	ToEpoch    uint64             `json:"to_epoch"`
+	Validators []*ValidatorReport `json:"validators"`
+}
+
+// Generate reports the performance of validators from an epoch to another, both included.
+// The votes of an epoch can be included until the end of the next one, so the range must
+// end before the previous epoch of the beacon node.
+func Generate(ctx context.Context, beaconClient ethpb.BeaconChainClient, pubKeys [][48]byte, fromEpoch, toEpoch uint64) (*Report, error) {
+	if fromEpoch > toEpoch {
+		return nil, fmt.Errorf("from epoch %d is after to epoch %d", fromEpoch, toEpoch)
+	}
+	head, err := beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain head")
+	}
+	if toEpoch >= head.HeadEpoch {
+		return nil, fmt.Errorf("to epoch %d is not finished, the current epoch is %d", toEpoch, head.HeadEpoch)
+	}
+	if toEpoch+1 >= head.HeadEpoch {
+		return nil, fmt.Errorf("votes of to epoch %d can still be included, the current epoch is %d", toEpoch, head.HeadEpoch)
+	}
+
+	// Validators unknown to the beacon node are reported as missing, and left out of the
+	// queries as the beacon node rejects them.
+	rawPubKeys := bytesutil.FromBytes48Array(pubKeys)
+	performance, err := beaconClient.GetValidatorPerformance(ctx, &ethpb.ValidatorPerformanceRequest{
+		PublicKeys: rawPubKeys,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validator performance")
+	}
+	missing := make(map[string]bool, len(performance.MissingValidators))
+	for _, pubKey := range performance.MissingValidators {
+		missing[fmt.Sprintf("%#x", pubKey)] = true
+	}
+	report := &Report{FromEpoch: fromEpoch, ToEpoch: toEpoch}
+	byPubKey := make(map[string]*ValidatorReport, len(pubKeys))
+	var known [][]byte
+	for _, pubKey := range rawPubKeys {
+		r := &ValidatorReport{
+			PublicKey:          fmt.Sprintf("%#x", pubKey),
+			Missing:            missing[fmt.Sprintf("%#x", pubKey)],
+			InclusionDistances: make(map[string]uint64, len(inclusionDistanceBuckets)+1),
+		}
+		for _, label := range inclusionDistanceLabels() {
+			r.InclusionDistances[label] = 0
+		}
+		report.Validators = append(report.Validators, r)
+		byPubKey[r.PublicKey] = r
+		if !r.Missing {
+			known = append(known, pubKey)
+		}
+	}
+	if len(known) == 0 {
+		return report, nil
+	}
+
+	effectiveness := make(map[string]float64, len(known))
+	blocks := &blockCache{beaconClient: beaconClient, byEpoch: make(map[uint64][]*ethpb.BeaconBlockContainer)}
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		if err := addVotes(ctx, beaconClient, blocks, known, epoch, byPubKey, effectiveness); err != nil {
+			return nil, err
+		}
+		if err := addProposals(ctx, beaconClient, blocks, known, epoch, byPubKey); err != nil {
+			return nil, err
+		}
+		blocks.prune(epoch)
+	}
+	if err := addBalances(ctx, beaconClient, known, fromEpoch, toEpoch+1, byPubKey); err != nil {
+		return nil, err
+	}
+	for _, r := range report.Validators {
+		if r.Votes > 0 {
+			r.Effectiveness = effectiveness[r.PublicKey] / float64(r.Votes)
+		}
+	}
+	return report, nil
+}
+
+// addVotes adds the votes of an epoch to the reports of the validators. The votes of an
+// epoch are the ones of the previous epoch at the start of the next one, which only holds the
+// votes included during the epoch. The votes included during the next epoch, such as the ones
+// of its last slot, are looked up in the blocks of the next epoch.
+func addVotes(
+	ctx context.Context,
+	beaconClient ethpb.BeaconChainClient,
+	blocks *blockCache,
+	pubKeys [][]byte,
+	epoch uint64,
+	byPubKey map[string]*ValidatorReport,
+	effectiveness map[string]float64,
+) error {
+	res, err := beaconClient.GetIndividualVotes(ctx, &ethpb.IndividualVotesRequest{
+		Epoch:      epoch + 1,
+		PublicKeys: pubKeys,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "could not get votes of epoch %d", epoch)
+	}
+	notIncluded := make(map[uint64]*ValidatorReport)
+	for _, vote := range res.IndividualVotes {
+		r, ok := byPubKey[fmt.Sprintf("%#x", vote.PublicKey)]
+		if !ok || vote.ValidatorIndex == ^uint64(0) || !vote.IsActiveInPreviousEpoch {
+			continue
+		}
+		r.ValidatorIndex = vote.ValidatorIndex
+		r.Votes++
+		if !vote.IsPreviousEpochAttester {
+			notIncluded[vote.ValidatorIndex] = r
+			continue
+		}
+		addIncludedVote(r, &includedVote{
+			distance:      vote.InclusionDistance,
+			correctTarget: vote.IsPreviousEpochTargetAttester,
+			correctHead:   vote.IsPreviousEpochHeadAttester,
+		}, effectiveness)
+	}
+	if len(notIncluded) == 0 {
+		return nil
+	}
+	late, err := lateVotes(ctx, beaconClient, blocks, epoch, notIncluded)
+	if err != nil {
+		return err
+	}
+	for index, r := range notIncluded {
+		if vote, ok := late[index]; ok {
+			addIncludedVote(r, vote, effectiveness)
+		} else {
+			r.MissedVotes++
+		}
+	}
+	return nil
+}
+
+// includedVote is a vote included in a block.
+type includedVote struct {
+	distance      uint64
+	correctTarget bool
+	correctHead   bool
+}
+
+// addIncludedVote adds an included vote to the report of its validator.
+func addIncludedVote(r *ValidatorReport, vote *includedVote, effectiveness map[string]float64) {
+	r.IncludedVotes++
+	if vote.correctTarget {
+		r.CorrectTargetVotes++
+	}
+	if vote.correctHead {
+		r.CorrectHeadVotes++
+	}
+	if vote.distance > 1 {
+		r.LateVotes++
+	}
+	if vote.distance > 0 {
+		effectiveness[r.PublicKey] += 1 / float64(vote.distance)
+	}
+	r.InclusionDistances[inclusionDistanceLabel(vote.distance)]++
+}
+
+// lateVotes returns the votes of an epoch included in the blocks of the next epoch, by index
+// of the given validators. The first inclusion of a vote is the one kept.
+func lateVotes(
+	ctx context.Context,
+	beaconClient ethpb.BeaconChainClient,
+	blocks *blockCache,
+	epoch uint64,
+	validators map[uint64]*ValidatorReport,
+) (map[uint64]*includedVote, error) {
+	committees, err := beaconClient.ListBeaconCommittees(ctx, &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: epoch},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not list committees of epoch %d", epoch)
+	}
+	next, err := blocks.blocks(ctx, epoch+1)
+	if err != nil {
+		return nil, err
+	}
+	votes := make(map[uint64]*includedVote)
+	for _, ctr := range next {
+		blk := ctr.GetBlock().GetBlock()
+		for _, att := range blk.GetBody().GetAttestations() {
+			data := att.Data
+			if data.Target.Epoch != epoch {
+				continue
+			}
+			list, ok := committees.Committees[data.Slot]
+			if !ok || data.CommitteeIndex >= uint64(len(list.Committees)) {
+				continue
+			}
+			for i, index := range list.Committees[data.CommitteeIndex].ValidatorIndices {
+				if _, ok := validators[index]; !ok || votes[index] != nil || !att.AggregationBits.BitAt(uint64(i)) {
+					continue
+				}
+				targetRoot, err := blocks.rootAt(ctx, epoch*params.BeaconConfig().SlotsPerEpoch)
+				if err != nil {
+					return nil, err
+				}
+				headRoot, err := blocks.rootAt(ctx, data.Slot)
+				if err != nil {
+					return nil, err
+				}
+				correctTarget := bytes.Equal(data.Target.Root, targetRoot)
+				votes[index] = &includedVote{
+					distance:      blk.Slot - data.Slot,
+					correctTarget: correctTarget,
+					correctHead:   correctTarget && bytes.Equal(data.BeaconBlockRoot, headRoot),
+				}
+			}
+		}
+	}
+	return votes, nil
+}
+
+// addProposals adds the blocks the validators proposed or missed during an epoch to their
+// reports.
+func addProposals(
+	ctx context.Context,
+	beaconClient ethpb.BeaconChainClient,
+	blocks *blockCache,
+	pubKeys [][]byte,
+	epoch uint64,
+	byPubKey map[string]*ValidatorReport,
+) error {
+	proposerSlots := make(map[uint64]*ValidatorReport)
+	assignmentsReq := &ethpb.ListValidatorAssignmentsRequest{
+		QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: epoch},
+		PublicKeys:  pubKeys,
+		PageSize:    pageSize,
+	}
+	for {
+		res, err := beaconClient.ListValidatorAssignments(ctx, assignmentsReq)
+		if err != nil {
+			return errors.Wrapf(err, "could not list assignments of epoch %d", epoch)
+		}
+		for _, assignment := range res.Assignments {
+			r, ok := byPubKey[fmt.Sprintf("%#x", assignment.PublicKey)]
+			if !ok {
+				continue
+			}
+			r.ValidatorIndex = assignment.ValidatorIndex
+			for _, slot := range assignment.ProposerSlots {
+				proposerSlots[slot] = r
+			}
+		}
+		if res.NextPageToken == "" || len(res.Assignments) == 0 {
+			break
+		}
+		assignmentsReq.PageToken = res.NextPageToken
+	}
+	if len(proposerSlots) == 0 {
+		return nil
+	}
+
+	containers, err := blocks.blocks(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	proposed := make(map[uint64]bool)
+	for _, ctr := range containers {
+		blk := ctr.Block.Block
+		if r, ok := proposerSlots[blk.Slot]; ok && r.ValidatorIndex == blk.ProposerIndex {
+			proposed[blk.Slot] = true
+		}
+	}
+	for slot, r := range proposerSlots {
+		if proposed[slot] {
+			r.ProposalsMade++
+		} else {
+			r.ProposalsMissed++
+		}
+	}
+	return nil
+}
+
+// blockCache lists the blocks of an epoch from the beacon node once, by ascending slot.
+type blockCache struct {
+	beaconClient ethpb.BeaconChainClient
+	byEpoch      map[uint64][]*ethpb.BeaconBlockContainer
+}
+
+// blocks returns the blocks of an epoch.
+func (c *blockCache) blocks(ctx context.Context, epoch uint64) ([]*ethpb.BeaconBlockContainer, error) {
+	if containers, ok := c.byEpoch[epoch]; ok {
+		return containers, nil
+	}
+	var containers []*ethpb.BeaconBlockContainer
+	req := &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Epoch{Epoch: epoch},
+		PageSize:    pageSize,
+	}
+	for {
+		res, err := c.beaconClient.ListBlocks(ctx, req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list blocks of epoch %d", epoch)
+		}
+		containers = append(containers, res.BlockContainers...)
+		if res.NextPageToken == "" || len(res.BlockContainers) == 0 {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	sort.SliceStable(containers, func(i, j int) bool {
+		return containers[i].GetBlock().GetBlock().GetSlot() < containers[j].GetBlock().GetBlock().GetSlot()
+	})
+	c.byEpoch[epoch] = containers
+	return containers, nil
+}
+
+// rootAt returns the root of the latest block at or before a slot, which is the head the
+// votes of the slot should be for, or nil if there is none.
+func (c *blockCache) rootAt(ctx context.Context, slot uint64) ([]byte, error) {
+	for epoch := slot / params.BeaconConfig().SlotsPerEpoch; ; epoch-- {
+		containers, err := c.blocks(ctx, epoch)
+		if err != nil {
+			return nil, err
+		}
+		for i := len(containers) - 1; i >= 0; i-- {
+			if containers[i].GetBlock().GetBlock().GetSlot() <= slot {
+				return containers[i].BlockRoot, nil
+			}
+		}
+		if epoch == 0 {
+			return nil, nil
+		}
+	}
+}
+
+// prune forgets the blocks of the epochs before an epoch.
+func (c *blockCache) prune(epoch uint64) {
+	for e := range c.byEpoch {
+		if e < epoch {
+			delete(c.byEpoch, e)
+		}
+	}
+}
+
+// addBalances adds the balances of the validators at the start of the range and at its end,
+// which is the start of the next epoch, to their reports.
+func addBalances(
+	ctx context.Context,
+	beaconClient ethpb.BeaconChainClient,
+	pubKeys [][]byte,
+	startEpoch, endEpoch uint64,
+	byPubKey map[string]*ValidatorReport,
+) error {
+	start, err := balances(ctx, beaconClient, pubKeys, startEpoch)
+	if err != nil {
+		return err
+	}
+	end, err := balances(ctx, beaconClient, pubKeys, endEpoch)
+	if err != nil {
+		return err
+	}
+	secondsPerEpoch := float64(params.BeaconConfig().SecondsPerSlot * params.BeaconConfig().SlotsPerEpoch)
+	epochsPerYear := 365.25 * 24 * 3600 / secondsPerEpoch
+	for pubKey, r := range byPubKey {
+		if r.Missing {
+			continue
+		}
+		r.StartBalance = start[pubKey]
+		r.EndBalance = end[pubKey]
+		r.BalanceChange = int64(r.EndBalance) - int64(r.StartBalance)
+		if r.StartBalance > 0 {
+			r.APR = float64(r.BalanceChange) / float64(r.StartBalance) * epochsPerYear / float64(endEpoch-startEpoch)
+		}
+	}
+	return nil
+}
+
+// balances returns the balances of validators at the start of an epoch, by public key.
+func balances(ctx context.Context, beaconClient ethpb.BeaconChainClient, pubKeys [][]byte, epoch uint64) (map[string]uint64, error) {
+	balances := make(map[string]uint64, len(pubKeys))
+	req := &ethpb.ListValidatorBalancesRequest{
+		QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: epoch},
+		PublicKeys:  pubKeys,
+		PageSize:    pageSize,
+	}
+	for {
+		res, err := beaconClient.ListValidatorBalances(ctx, req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list balances of epoch %d", epoch)
+		}
+		for _, b := range res.Balances {
+			balances[fmt.Sprintf("%#x", b.PublicKey)] = b.Balance
+		}
+		if res.NextPageToken == "" || len(res.Balances) == 0 {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	return balances, nil
+}
+
+// inclusionDistanceLabel returns the label of the bucket of an inclusion distance.
+func inclusionDistanceLabel(distance uint64) string {
+	labels := inclusionDistanceLabels()
+	for i, bound := range inclusionDistanceBuckets {
+		if distance <= bound {
+			return labels[i]
+		}
+	}
+	return labels[len(labels)-1]
+}
+
+// inclusionDistanceLabels returns the labels of the buckets of the inclusion distance
+// distribution, such as 1, 4-5 or 11+.
+func inclusionDistanceLabels() []string {
+	labels := make([]string, 0, len(inclusionDistanceBuckets)+1)
+	lower := uint64(1)
+	for _, bound := range inclusionDistanceBuckets {
+		if lower == bound {
+			labels = append(labels, fmt.Sprintf("%d", bound))
+		} else {
+			labels = append(labels, fmt.Sprintf("%d-%d", lower, bound))
+		}
+		lower = bound + 1
+	}
+	return append(labels, fmt.Sprintf("%d+", lower))
+}
@@ -0,0 +1,263 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGenerate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	pubKeys := [][48]byte{{1}, {2}, {3}}
+	known := [][]byte{pubKeys[0][:], pubKeys[1][:]}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	farFuture := params.BeaconConfig().FarFutureEpoch
+
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 10}, nil)
+	beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys:        known,
+		MissingValidators: [][]byte{pubKeys[2][:]},
+	}, nil)
+	beaconClient.EXPECT().GetIndividualVotes(gomock.Any(), &ethpb.IndividualVotesRequest{Epoch: 6, PublicKeys: known}).Return(
+		&ethpb.IndividualVotesRespond{IndividualVotes: []*ethpb.IndividualVotesRespond_IndividualVote{
+			{
+				PublicKey: known[0], ValidatorIndex: 1, IsActiveInPreviousEpoch: true, IsPreviousEpochAttester: true,
+				IsPreviousEpochTargetAttester: true, IsPreviousEpochHeadAttester: true, InclusionDistance: 1,
+			},
+			{
+				PublicKey: known[1], ValidatorIndex: 2, IsActiveInPreviousEpoch: true, InclusionDistance: farFuture,
+			},
+		}}, nil)
+	beaconClient.EXPECT().GetIndividualVotes(gomock.Any(), &ethpb.IndividualVotesRequest{Epoch: 7, PublicKeys: known}).Return(
+		&ethpb.IndividualVotesRespond{IndividualVotes: []*ethpb.IndividualVotesRespond_IndividualVote{
+			{
+				PublicKey: known[0], ValidatorIndex: 1, IsActiveInPreviousEpoch: true, IsPreviousEpochAttester: true,
+				IsPreviousEpochTargetAttester: true, InclusionDistance: 4,
+			},
+			{
+				PublicKey: known[1], ValidatorIndex: 2, IsActiveInPreviousEpoch: true, IsPreviousEpochAttester: true,
+				IsPreviousEpochTargetAttester: true, IsPreviousEpochHeadAttester: true, InclusionDistance: 2,
+			},
+		}}, nil)
+	// The vote of the second validator in epoch 5 was not included in epoch 6 either.
+	beaconClient.EXPECT().ListBeaconCommittees(gomock.Any(), &ethpb.ListCommitteesRequest{
+		QueryFilter: &ethpb.ListCommitteesRequest_Epoch{Epoch: 5},
+	}).Return(&ethpb.BeaconCommittees{Epoch: 5}, nil)
+	// The first validator proposed a block in epoch 5, and the second one missed its proposal in epoch 6.
+	beaconClient.EXPECT().ListValidatorAssignments(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ListValidatorAssignmentsRequest) (*ethpb.ValidatorAssignments, error) {
+			epoch := req.QueryFilter.(*ethpb.ListValidatorAssignmentsRequest_Epoch).Epoch
+			if epoch == 5 {
+				return &ethpb.ValidatorAssignments{Assignments: []*ethpb.ValidatorAssignments_CommitteeAssignment{
+					{PublicKey: known[0], ValidatorIndex: 1, ProposerSlots: []uint64{5*slotsPerEpoch + 3}},
+				}}, nil
+			}
+			return &ethpb.ValidatorAssignments{Assignments: []*ethpb.ValidatorAssignments_CommitteeAssignment{
+				{PublicKey: known[1], ValidatorIndex: 2, ProposerSlots: []uint64{6*slotsPerEpoch + 1}},
+			}}, nil
+		}).Times(2)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ListBlocksRequest) (*ethpb.ListBlocksResponse, error) {
+			epoch := req.QueryFilter.(*ethpb.ListBlocksRequest_Epoch).Epoch
+			if epoch == 5 {
+				return &ethpb.ListBlocksResponse{BlockContainers: []*ethpb.BeaconBlockContainer{
+					{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5*slotsPerEpoch + 3, ProposerIndex: 1}}},
+				}}, nil
+			}
+			return &ethpb.ListBlocksResponse{BlockContainers: []*ethpb.BeaconBlockContainer{
+				{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 6*slotsPerEpoch + 1, ProposerIndex: 9}}},
+			}}, nil
+		}).Times(2)
+	beaconClient.EXPECT().ListValidatorBalances(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ListValidatorBalancesRequest) (*ethpb.ValidatorBalances, error) {
+			epoch := req.QueryFilter.(*ethpb.ListValidatorBalancesRequest_Epoch).Epoch
+			if epoch == 5 {
+				return &ethpb.ValidatorBalances{Balances: []*ethpb.ValidatorBalances_Balance{
+					{PublicKey: known[0], Index: 1, Balance: 32e9},
+					{PublicKey: known[1], Index: 2, Balance: 32e9},
+				}}, nil
+			}
+			require.Equal(t, uint64(7), epoch)
+			return &ethpb.ValidatorBalances{Balances: []*ethpb.ValidatorBalances_Balance{
+				{PublicKey: known[0], Index: 1, Balance: 32e9 + 1e6},
+				{PublicKey: known[1], Index: 2, Balance: 32e9 - 5e5},
+			}}, nil
+		}).Times(2)
+
+	report, err := Generate(context.Background(), beaconClient, pubKeys, 5, 6)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(report.Validators))
+
+	first := report.Validators[0]
+	assert.Equal(t, uint64(1), first.ValidatorIndex)
+	assert.Equal(t, uint64(2), first.Votes)
+	assert.Equal(t, uint64(2), first.IncludedVotes)
+	assert.Equal(t, uint64(0), first.MissedVotes)
+	assert.Equal(t, uint64(1), first.LateVotes)
+	assert.Equal(t, uint64(2), first.CorrectTargetVotes)
+	assert.Equal(t, uint64(1), first.CorrectHeadVotes)
+	assert.Equal(t, uint64(1), first.InclusionDistances["1"])
+	assert.Equal(t, uint64(1), first.InclusionDistances["4-5"])
+	assert.Equal(t, (1+0.25)/2, first.Effectiveness)
+	assert.Equal(t, uint64(1), first.ProposalsMade)
+	assert.Equal(t, uint64(0), first.ProposalsMissed)
+	assert.Equal(t, int64(1e6), first.BalanceChange)
+	assert.Equal(t, true, first.APR > 0)
+
+	second := report.Validators[1]
+	assert.Equal(t, uint64(2), second.Votes)
+	assert.Equal(t, uint64(1), second.MissedVotes)
+	assert.Equal(t, uint64(1), second.LateVotes)
+	assert.Equal(t, 0.25, second.Effectiveness)
+	assert.Equal(t, uint64(0), second.ProposalsMade)
+	assert.Equal(t, uint64(1), second.ProposalsMissed)
+	assert.Equal(t, int64(-5e5), second.BalanceChange)
+	assert.Equal(t, true, second.APR < 0)
+
+	assert.Equal(t, true, report.Validators[2].Missing)
+	assert.Equal(t, uint64(0), report.Validators[2].Votes)
+}
+
+func TestGenerate_VoteIncludedInNextEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	pubKey := [48]byte{1}
+	known := [][]byte{pubKey[:]}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	lastSlot := 6*slotsPerEpoch - 1
+
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 10}, nil)
+	beaconClient.EXPECT().GetValidatorPerformance(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorPerformanceResponse{
+		PublicKeys: known,
+	}, nil)
+	// The vote of the last slot of epoch 5 is not in the state at the start of epoch 6.
+	beaconClient.EXPECT().GetIndividualVotes(gomock.Any(), &ethpb.IndividualVotesRequest{Epoch: 6, PublicKeys: known}).Return(
+		&ethpb.IndividualVotesRespond{IndividualVotes: []*ethpb.IndividualVotesRespond_IndividualVote{
+			{PublicKey: known[0], ValidatorIndex: 3, IsActiveInPreviousEpoch: true},
+		}}, nil)
+	beaconClient.EXPECT().ListValidatorAssignments(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorAssignments{}, nil)
+	beaconClient.EXPECT().ListBeaconCommittees(gomock.Any(), gomock.Any()).Return(&ethpb.BeaconCommittees{
+		Epoch: 5,
+		Committees: map[uint64]*ethpb.BeaconCommittees_CommitteesList{
+			lastSlot: {Committees: []*ethpb.BeaconCommittees_CommitteeItem{{ValidatorIndices: []uint64{7, 3}}}},
+		},
+	}, nil)
+	aggregationBits := bitfield.NewBitlist(2)
+	aggregationBits.SetBitAt(1, true)
+	beaconClient.EXPECT().ListBlocks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *ethpb.ListBlocksRequest) (*ethpb.ListBlocksResponse, error) {
+			epoch := req.QueryFilter.(*ethpb.ListBlocksRequest_Epoch).Epoch
+			if epoch == 5 {
+				return &ethpb.ListBlocksResponse{BlockContainers: []*ethpb.BeaconBlockContainer{
+					{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5*slotsPerEpoch + 4}}, BlockRoot: []byte{2}},
+					{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5 * slotsPerEpoch}}, BlockRoot: []byte{1}},
+				}}, nil
+			}
+			require.Equal(t, uint64(6), epoch)
+			return &ethpb.ListBlocksResponse{BlockContainers: []*ethpb.BeaconBlockContainer{
+				{Block: &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{
+					Slot: 6 * slotsPerEpoch,
+					Body: &ethpb.BeaconBlockBody{Attestations: []*ethpb.Attestation{{
+						AggregationBits: aggregationBits,
+						Data: &ethpb.AttestationData{
+							Slot:            lastSlot,
+							BeaconBlockRoot: []byte{2},
+							Source:          &ethpb.Checkpoint{Epoch: 4},
+							Target:          &ethpb.Checkpoint{Epoch: 5, Root: []byte{1}},
+						},
+					}}},
+				}}},
+			}}, nil
+		}).Times(2)
+	beaconClient.EXPECT().ListValidatorBalances(gomock.Any(), gomock.Any()).Return(&ethpb.ValidatorBalances{}, nil).Times(2)
+
+	report, err := Generate(context.Background(), beaconClient, [][48]byte{pubKey}, 5, 5)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(report.Validators))
+	r := report.Validators[0]
+	assert.Equal(t, uint64(3), r.ValidatorIndex)
+	assert.Equal(t, uint64(1), r.Votes)
+	assert.Equal(t, uint64(1), r.IncludedVotes)
+	assert.Equal(t, uint64(0), r.MissedVotes)
+	assert.Equal(t, uint64(0), r.LateVotes)
+	assert.Equal(t, uint64(1), r.CorrectTargetVotes)
+	assert.Equal(t, uint64(1), r.CorrectHeadVotes)
+	assert.Equal(t, uint64(1), r.InclusionDistances["1"])
+	assert.Equal(t, float64(1), r.Effectiveness)
+}
+
+func TestGenerate_UnfinishedEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadEpoch: 10}, nil)
+
+	_, err := Generate(context.Background(), beaconClient, [][48]byte{{1}}, 5, 10)
+	assert.ErrorContains(t, "to epoch 10 is not finished", err)
+	_, err = Generate(context.Background(), beaconClient, [][48]byte{{1}}, 5, 9)
+	assert.ErrorContains(t, "votes of to epoch 9 can still be included", err)
+	_, err = Generate(context.Background(), beaconClient, [][48]byte{{1}}, 6, 5)
+	assert.ErrorContains(t, "from epoch 6 is after to epoch 5", err)
+}
+
+func TestInclusionDistanceLabel(t *testing.T) {
+	assert.DeepEqual(t, []string{"1", "2", "3", "4-5", "6-10", "11+"}, inclusionDistanceLabels())
+	assert.Equal(t, "1", inclusionDistanceLabel(1))
+	assert.Equal(t, "4-5", inclusionDistanceLabel(5))
+	assert.Equal(t, "6-10", inclusionDistanceLabel(7))
+	assert.Equal(t, "11+", inclusionDistanceLabel(32))
+}
+
+func TestWrite(t *testing.T) {
+	report := &Report{
+		FromEpoch: 5,
+		ToEpoch:   6,
+		Validators: []*ValidatorReport{
+			{
+				PublicKey:          "0x01",
+				ValidatorIndex:     1,
+				Votes:              2,
+				IncludedVotes:      2,
+				InclusionDistances: map[string]uint64{"1": 2},
+				Effectiveness:      1,
+				BalanceChange:      1e6,
+			},
+			{PublicKey: "0x02", Missing: true},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, Write(buf, FormatJSON, report))
+	decoded := &Report{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), decoded))
+	assert.DeepEqual(t, report, decoded)
+
+	buf.Reset()
+	require.NoError(t, Write(buf, FormatCSV, report))
+	records, err := csv.NewReader(buf).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, "pubkey", records[0][0])
+	assert.Equal(t, "inclusion_distance_1", records[0][9])
+	assert.Equal(t, "2", records[1][9])
+
+	buf.Reset()
+	require.NoError(t, Write(buf, FormatTable, report))
+	assert.Equal(t, true, strings.Contains(buf.String(), "unknown to the beacon node"))
+
+	assert.ErrorContains(t, "unknown report format", Write(buf, "xml", report))
+}
@@ -1,13 +1,19 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/petnames"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	v2 "github.com/prysmaticlabs/prysm/validator/accounts/v2"
 	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/derived"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
@@ -23,8 +29,10 @@ func (s *Server) CreateAccount(ctx context.Context, _ *ptypes.Empty) (*pb.Create
 	var pubKey []byte
 	var err error
 	switch s.wallet.KeymanagerKind() {
-	case v2keymanager.Remote:
+	case v2keymanager.Remote, v2keymanager.RemoteHTTP:
 		return nil, status.Error(codes.InvalidArgument, "Cannot create account for remote keymanager")
+	case v2keymanager.Threshold:
+		return nil, status.Error(codes.InvalidArgument, "Cannot create account for threshold keymanager")
 	case v2keymanager.Direct:
 		km, ok := s.keymanager.(*direct.Keymanager)
 		if !ok {
@@ -77,3 +85,124 @@ func (s *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest)
 		Accounts: accounts,
 	}, nil
 }
+
+// ImportKeystores allows importing EIP-2335 keystore files as new accounts in a
+// user's direct wallet via RPC.
+func (s *Server) ImportKeystores(ctx context.Context, req *pb.ImportKeystoresRequest) (*pb.ImportKeystoresResponse, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	if s.wallet.KeymanagerKind() != v2keymanager.Direct {
+		return nil, status.Error(codes.FailedPrecondition, "Only direct wallets can import keystores")
+	}
+	if len(req.KeystoresImported) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No keystores included for import")
+	}
+	keystores, err := unmarshalKeystores(req.KeystoresImported)
+	if err != nil {
+		return nil, err
+	}
+	importedPubKeys := make([][]byte, len(keystores))
+	for i, keystore := range keystores {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(keystore.Pubkey, "0x"))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Not a valid public key in keystore: %v", err)
+		}
+		importedPubKeys[i] = pubKey
+	}
+	if err := v2.ImportAccounts(ctx, &v2.ImportAccountsConfig{
+		Wallet:          s.wallet,
+		Keystores:       keystores,
+		AccountPassword: req.KeystoresPassword,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not import keystores: %v", err)
+	}
+	return &pb.ImportKeystoresResponse{
+		ImportedPublicKeys: importedPubKeys,
+	}, nil
+}
+
+// DeleteAccounts allows deletion of accounts in a user's wallet via RPC.
+func (s *Server) DeleteAccounts(ctx context.Context, req *pb.DeleteAccountsRequest) (*pb.DeleteAccountsResponse, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	if len(req.PublicKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No public keys specified to delete")
+	}
+	if err := v2.DeleteAccount(ctx, &v2.DeleteAccountConfig{
+		Wallet:     s.wallet,
+		Keymanager: s.keymanager,
+		PublicKeys: req.PublicKeys,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete accounts: %v", err)
+	}
+	return &pb.DeleteAccountsResponse{
+		DeletedKeys: req.PublicKeys,
+	}, nil
+}
+
+// BackupAccounts allows a user to back up accounts of their wallet via RPC, as a zip
+// file of EIP-2335 keystore files encrypted with a backup password.
+func (s *Server) BackupAccounts(ctx context.Context, req *pb.BackupAccountsRequest) (*pb.BackupAccountsResponse, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	if len(req.PublicKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No public keys specified to backup")
+	}
+	if err := promptutil.ValidatePasswordInput(req.BackupPassword); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Could not validate backup password input")
+	}
+	pubKeys := make([]bls.PublicKey, len(req.PublicKeys))
+	for i, key := range req.PublicKeys {
+		pubKey, err := bls.PublicKeyFromBytes(key)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%#x is not a valid public key: %v", key, err)
+		}
+		pubKeys[i] = pubKey
+	}
+	var buf bytes.Buffer
+	if err := v2.BackupAccounts(ctx, &v2.BackupAccountsConfig{
+		Wallet:         s.wallet,
+		Keymanager:     s.keymanager,
+		PublicKeys:     pubKeys,
+		BackupPassword: req.BackupPassword,
+	}, &buf); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not backup accounts: %v", err)
+	}
+	return &pb.BackupAccountsResponse{
+		ZipFile: buf.Bytes(),
+	}, nil
+}
+
+// VoluntaryExit performs a voluntary exit on accounts of a user's wallet via RPC, and
+// returns the accounts for which the exit was submitted to the beacon node.
+func (s *Server) VoluntaryExit(ctx context.Context, req *pb.VoluntaryExitRequest) (*pb.VoluntaryExitResponse, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	if len(req.PublicKeys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No public keys specified to exit")
+	}
+	if s.beaconNodeFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Validator client is not connected to a beacon node")
+	}
+	validatorClient, nodeClient, err := s.beaconNodeFetcher.BeaconNodeClients()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not connect to beacon node: %v", err)
+	}
+	exitedKeys, err := v2.ExitAccounts(ctx, &v2.ExitAccountsConfig{
+		ValidatorClient: validatorClient,
+		NodeClient:      nodeClient,
+		Keymanager:      s.keymanager,
+		AuditLog:        s.auditLog,
+		PublicKeys:      req.PublicKeys,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not perform voluntary exit: %v", err)
+	}
+	return &pb.VoluntaryExitResponse{
+		ExitedKeys: exitedKeys,
+	}, nil
+}
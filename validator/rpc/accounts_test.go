@@ -1,16 +1,28 @@
 package rpc
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"testing"
+	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	v2 "github.com/prysmaticlabs/prysm/validator/accounts/v2"
 	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
 func TestServer_CreateAccount(t *testing.T) {
@@ -76,3 +88,168 @@ func TestServer_ListAccounts(t *testing.T) {
 		assert.DeepEqual(t, resp.Accounts[i].ValidatingPublicKey, keys[i])
 	}
 }
+
+type mockBeaconNodeFetcher struct {
+	validatorClient ethpb.BeaconNodeValidatorClient
+	nodeClient      ethpb.NodeClient
+}
+
+func (m *mockBeaconNodeFetcher) BeaconNodeClients() (ethpb.BeaconNodeValidatorClient, ethpb.NodeClient, error) {
+	return m.validatorClient, m.nodeClient, nil
+}
+
+func setupDirectWalletServer(t *testing.T, numAccounts int) (*Server, [][]byte) {
+	ctx := context.Background()
+	localWalletDir := setupWalletDir(t)
+	defaultWalletPath = localWalletDir
+	strongPass := "29384283xasjasd32%%&*@*#*"
+	wallet, err := v2.CreateWalletWithKeymanager(ctx, &v2.CreateWalletConfig{
+		WalletCfg: &v2.WalletConfig{
+			WalletDir:      defaultWalletPath,
+			KeymanagerKind: v2keymanager.Direct,
+			WalletPassword: strongPass,
+		},
+		SkipMnemonicConfirm: true,
+	})
+	require.NoError(t, err)
+	km, err := wallet.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	keys := make([][]byte, numAccounts)
+	for i := 0; i < numAccounts; i++ {
+		key, err := km.(*direct.Keymanager).CreateAccount(ctx)
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	return &Server{
+		keymanager:        km,
+		walletInitialized: true,
+		wallet:            wallet,
+	}, keys
+}
+
+func createEncodedKeystore(t *testing.T, password string) (string, []byte) {
+	encryptor := keystorev4.New()
+	privKey := bls.RandKey()
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	cryptoFields, err := encryptor.Encrypt(privKey.Marshal(), password)
+	require.NoError(t, err)
+	item := &v2keymanager.Keystore{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Pubkey:  fmt.Sprintf("%x", privKey.PublicKey().Marshal()),
+		Name:    encryptor.Name(),
+	}
+	encodedFile, err := json.MarshalIndent(item, "", "\t")
+	require.NoError(t, err)
+	return string(encodedFile), privKey.PublicKey().Marshal()
+}
+
+func TestServer_ImportKeystores(t *testing.T) {
+	ctx := context.Background()
+	s, _ := setupDirectWalletServer(t, 0)
+	_, err := s.ImportKeystores(ctx, &pb.ImportKeystoresRequest{})
+	require.ErrorContains(t, "No keystores included for import", err)
+
+	keystorePass := "Passw0rdz4938%%"
+	keystores := make([]string, 2)
+	pubKeys := make([][]byte, 2)
+	for i := 0; i < len(keystores); i++ {
+		keystores[i], pubKeys[i] = createEncodedKeystore(t, keystorePass)
+	}
+	resp, err := s.ImportKeystores(ctx, &pb.ImportKeystoresRequest{
+		KeystoresImported: keystores,
+		KeystoresPassword: keystorePass,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKeys, resp.ImportedPublicKeys)
+
+	km, err := s.wallet.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	imported, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(imported))
+}
+
+func TestServer_DeleteAccounts(t *testing.T) {
+	ctx := context.Background()
+	s, keys := setupDirectWalletServer(t, 3)
+	_, err := s.DeleteAccounts(ctx, &pb.DeleteAccountsRequest{})
+	require.ErrorContains(t, "No public keys specified to delete", err)
+
+	resp, err := s.DeleteAccounts(ctx, &pb.DeleteAccountsRequest{
+		PublicKeys: keys[:2],
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, keys[:2], resp.DeletedKeys)
+	listResp, err := s.ListAccounts(ctx, &pb.ListAccountsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listResp.Accounts))
+	assert.DeepEqual(t, keys[2], listResp.Accounts[0].ValidatingPublicKey)
+}
+
+func TestServer_BackupAccounts(t *testing.T) {
+	ctx := context.Background()
+	s, keys := setupDirectWalletServer(t, 3)
+	_, err := s.BackupAccounts(ctx, &pb.BackupAccountsRequest{
+		PublicKeys:     keys,
+		BackupPassword: "weak",
+	})
+	require.ErrorContains(t, "Could not validate backup password input", err)
+
+	resp, err := s.BackupAccounts(ctx, &pb.BackupAccountsRequest{
+		PublicKeys:     keys[:2],
+		BackupPassword: "Passw0rdz4938%%",
+	})
+	require.NoError(t, err)
+	r, err := zip.NewReader(bytes.NewReader(resp.ZipFile), int64(len(resp.ZipFile)))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(r.File))
+	backedUp := make(map[string]bool)
+	for _, unzipped := range r.File {
+		f, err := unzipped.Open()
+		require.NoError(t, err)
+		encoded, err := ioutil.ReadAll(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		keystore := &v2keymanager.Keystore{}
+		require.NoError(t, json.Unmarshal(encoded, keystore))
+		backedUp[keystore.Pubkey] = true
+	}
+	assert.Equal(t, true, backedUp[fmt.Sprintf("%x", keys[0])])
+	assert.Equal(t, true, backedUp[fmt.Sprintf("%x", keys[1])])
+}
+
+func TestServer_VoluntaryExit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	mockNodeClient := mock.NewMockNodeClient(ctrl)
+	mockValidatorClient.EXPECT().
+		ValidatorIndex(gomock.Any(), gomock.Any()).
+		Return(&ethpb.ValidatorIndexResponse{Index: 1}, nil)
+	mockNodeClient.EXPECT().
+		GetGenesis(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Genesis{GenesisTime: &ptypes.Timestamp{
+			Seconds: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		}}, nil)
+	mockValidatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
+	mockValidatorClient.EXPECT().
+		ProposeExit(gomock.Any(), gomock.AssignableToTypeOf(&ethpb.SignedVoluntaryExit{})).
+		Return(&ethpb.ProposeExitResponse{}, nil)
+
+	ctx := context.Background()
+	s, keys := setupDirectWalletServer(t, 1)
+	s.beaconNodeFetcher = &mockBeaconNodeFetcher{
+		validatorClient: mockValidatorClient,
+		nodeClient:      mockNodeClient,
+	}
+	resp, err := s.VoluntaryExit(ctx, &pb.VoluntaryExitRequest{
+		PublicKeys: keys,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, keys, resp.ExitedKeys)
+}
@@ -0,0 +1,171 @@
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// API tokens are sent as bearer tokens of the form api_<id>_<secret>, where both the ID
+// and the secret are hex encoded, to tell them apart from the JWTs of logged in users.
+const (
+	apiTokenPrefix     = "api_"
+	apiTokenIDLength   = 8
+	apiTokenSecretSize = 32
+)
+
+// CreateApiToken creates a long-lived API token for automation. The token cannot have a
+// more privileged role than the user creating it, and its secret is only returned once.
+func (s *Server) CreateApiToken(ctx context.Context, req *pb.CreateApiTokenRequest) (*pb.CreateApiTokenResponse, error) {
+	if err := validRole(req.Role); err != nil {
+		return nil, err
+	}
+	creator := &principal{username: defaultUsername, role: kv.AdminRole}
+	if p := principalFromContext(ctx); p != nil {
+		creator = p
+	}
+	if !roleAllows(creator.role, req.Role) {
+		return nil, status.Errorf(codes.PermissionDenied, "Cannot create a token with a more privileged role than %s", creator.role)
+	}
+	id, err := randomHex(apiTokenIDLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not generate token ID: %v", err)
+	}
+	secret, err := randomHex(apiTokenSecretSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not generate token secret: %v", err)
+	}
+	hashedSecret := sha256.Sum256([]byte(secret))
+	token := &kv.APIToken{
+		ID:           id,
+		Name:         req.Name,
+		Role:         req.Role,
+		HashedSecret: hashedSecret[:],
+		CreatedBy:    creator.username,
+		CreatedAt:    roughtime.Now(),
+	}
+	if req.ExpiresInSeconds != 0 {
+		token.ExpiresAt = token.CreatedAt.Add(time.Duration(req.ExpiresInSeconds) * time.Second)
+	}
+	if err := s.valDB.SaveAPIToken(ctx, token); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save token: %v", err)
+	}
+	s.recordAuthEvent(ctx, &kv.AuthEvent{
+		Type:     tokenCreatedEvent,
+		Username: creator.username,
+		TokenID:  token.ID,
+		Details:  "created " + token.Name + " as " + token.Role,
+	})
+	return &pb.CreateApiTokenResponse{
+		Token:    apiTokenPrefix + id + "_" + secret,
+		ApiToken: apiTokenToProto(token),
+	}, nil
+}
+
+// ListApiTokens lists the API tokens, including the revoked and expired ones.
+func (s *Server) ListApiTokens(ctx context.Context, _ *ptypes.Empty) (*pb.ListApiTokensResponse, error) {
+	tokens, err := s.valDB.APITokens(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve tokens: %v", err)
+	}
+	res := &pb.ListApiTokensResponse{
+		Tokens: make([]*pb.ApiToken, len(tokens)),
+	}
+	for i, token := range tokens {
+		res.Tokens[i] = apiTokenToProto(token)
+	}
+	return res, nil
+}
+
+// RevokeApiToken revokes an API token, which is refused from then on.
+func (s *Server) RevokeApiToken(ctx context.Context, req *pb.RevokeApiTokenRequest) (*pb.ApiToken, error) {
+	token, err := s.valDB.APIToken(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve token: %v", err)
+	}
+	if token == nil {
+		return nil, status.Errorf(codes.NotFound, "Token %s not found", req.Id)
+	}
+	token.Revoked = true
+	if err := s.valDB.SaveAPIToken(ctx, token); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save token: %v", err)
+	}
+	s.recordAuthEvent(ctx, &kv.AuthEvent{
+		Type:     tokenRevokedEvent,
+		Username: callerUsername(ctx),
+		TokenID:  token.ID,
+	})
+	return apiTokenToProto(token), nil
+}
+
+// authorizeAPIToken checks an API token sent as a bearer token. The role of the token is
+// capped by the current role of the user who created it.
+func (s *Server) authorizeAPIToken(ctx context.Context, bearer string) (*principal, error) {
+	parts := strings.Split(strings.TrimPrefix(bearer, apiTokenPrefix), "_")
+	if len(parts) != 2 || s.valDB == nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid API token")
+	}
+	token, err := s.valDB.APIToken(ctx, parts[0])
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve token: %v", err)
+	}
+	if token == nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid API token")
+	}
+	hashedSecret := sha256.Sum256([]byte(parts[1]))
+	if subtle.ConstantTimeCompare(hashedSecret[:], token.HashedSecret) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "Invalid API token")
+	}
+	if token.Revoked {
+		return nil, status.Error(codes.Unauthenticated, "API token was revoked")
+	}
+	if !token.ExpiresAt.IsZero() && roughtime.Now().After(token.ExpiresAt) {
+		return nil, status.Error(codes.Unauthenticated, "API token expired")
+	}
+	creator, err := s.valDB.APIUser(ctx, token.CreatedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve user: %v", err)
+	}
+	if creator == nil {
+		return nil, status.Error(codes.Unauthenticated, "API token was revoked")
+	}
+	return &principal{
+		username: token.CreatedBy,
+		role:     leastRole(token.Role, creator.Role),
+		tokenID:  token.ID,
+	}, nil
+}
+
+func randomHex(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.NewGenerator().Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func apiTokenToProto(token *kv.APIToken) *pb.ApiToken {
+	res := &pb.ApiToken{
+		Id:        token.ID,
+		Name:      token.Name,
+		Role:      token.Role,
+		CreatedBy: token.CreatedBy,
+		CreatedAt: uint64(token.CreatedAt.Unix()),
+		Revoked:   token.Revoked,
+	}
+	if !token.ExpiresAt.IsZero() {
+		res.ExpiresAt = uint64(token.ExpiresAt.Unix())
+	}
+	return res
+}
@@ -0,0 +1,84 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServer_ApiTokens(t *testing.T) {
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	ctx := context.Background()
+	require.NoError(t, valDB.SaveAPIUser(ctx, &kv.APIUser{Username: "alice", Role: kv.OperatorRole}))
+	s := &Server{valDB: valDB}
+	aliceCtx := withPrincipal(ctx, &principal{username: "alice", role: kv.OperatorRole})
+
+	_, err := s.CreateApiToken(aliceCtx, &pb.CreateApiTokenRequest{Name: "deploy", Role: kv.AdminRole})
+	require.ErrorContains(t, "Cannot create a token with a more privileged role than operator", err)
+	res, err := s.CreateApiToken(aliceCtx, &pb.CreateApiTokenRequest{Name: "deploy", Role: kv.OperatorRole})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", res.ApiToken.CreatedBy)
+	assert.Equal(t, uint64(0), res.ApiToken.ExpiresAt)
+
+	interceptor := s.JWTInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return principalFromContext(ctx), nil
+	}
+	call := func(token, method string) (*principal, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), map[string][]string{
+			"authorization": {"Bearer " + token},
+		})
+		p, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		if err != nil {
+			return nil, err
+		}
+		return p.(*principal), nil
+	}
+	createAccount := "/ethereum.validator.accounts.v2.Accounts/CreateAccount"
+	p, err := call(res.Token, createAccount)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", p.username)
+	assert.Equal(t, res.ApiToken.Id, p.tokenID)
+	_, err = call(res.Token, "/ethereum.validator.accounts.v2.Accounts/DeleteAccounts")
+	require.ErrorContains(t, "Role operator is not allowed", err)
+	_, err = call(res.Token+"0", createAccount)
+	require.ErrorContains(t, "Invalid API token", err)
+
+	// The token cannot be more privileged than its creator.
+	require.NoError(t, valDB.SaveAPIUser(ctx, &kv.APIUser{Username: "alice", Role: kv.ReadOnlyRole}))
+	_, err = call(res.Token, createAccount)
+	require.ErrorContains(t, "Role read-only is not allowed", err)
+	require.NoError(t, valDB.SaveAPIUser(ctx, &kv.APIUser{Username: "alice", Role: kv.OperatorRole}))
+
+	revoked, err := s.RevokeApiToken(ctx, &pb.RevokeApiTokenRequest{Id: res.ApiToken.Id})
+	require.NoError(t, err)
+	assert.Equal(t, true, revoked.Revoked)
+	_, err = call(res.Token, createAccount)
+	require.ErrorContains(t, "API token was revoked", err)
+	_, err = s.RevokeApiToken(ctx, &pb.RevokeApiTokenRequest{Id: "unknown"})
+	require.ErrorContains(t, "Token unknown not found", err)
+
+	expiring, err := s.CreateApiToken(aliceCtx, &pb.CreateApiTokenRequest{Name: "ci", Role: kv.ReadOnlyRole, ExpiresInSeconds: 60})
+	require.NoError(t, err)
+	assert.Equal(t, expiring.ApiToken.CreatedAt+60, expiring.ApiToken.ExpiresAt)
+	token, err := valDB.APIToken(ctx, expiring.ApiToken.Id)
+	require.NoError(t, err)
+	token.ExpiresAt = roughtime.Now().Add(-1)
+	require.NoError(t, valDB.SaveAPIToken(ctx, token))
+	_, err = call(expiring.Token, "/ethereum.validator.accounts.v2.Accounts/ListAccounts")
+	require.ErrorContains(t, "API token expired", err)
+
+	list, err := s.ListApiTokens(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list.Tokens))
+	assert.Equal(t, "deploy", list.Tokens[0].Name)
+	assert.Equal(t, "ci", list.Tokens[1].Name)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
@@ -14,15 +15,24 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/shared/roughtime"
 	v2 "github.com/prysmaticlabs/prysm/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
 )
 
 var (
 	tokenExpiryLength = 20 * time.Minute
-	hashCost          = 8
+	hashCost          = v2.WebPasswordHashCost
 )
 
+// authClaims are the claims of the JWT of a logged in user.
+type authClaims struct {
+	Username string `json:"username"`
+	jwt.StandardClaims
+}
+
 // Signup to authenticate access to the validator RPC API using bcrypt and
-// a sufficiently strong password check.
+// a sufficiently strong password check. This creates the admin user owning the
+// wallet, after which other users are created by admins.
 func (s *Server) Signup(ctx context.Context, req *pb.AuthRequest) (*pb.AuthResponse, error) {
 	// First, we check if the validator already has a password. In this case,
 	// the user should NOT be able to signup and the function will return an error.
@@ -30,7 +40,11 @@ func (s *Server) Signup(ctx context.Context, req *pb.AuthRequest) (*pb.AuthRespo
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not retrieve hashed password from database")
 	}
-	if len(existingPassword) != 0 {
+	users, err := s.valDB.APIUsers(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Could not retrieve users from database")
+	}
+	if len(existingPassword) != 0 || len(users) != 0 {
 		return nil, status.Error(codes.PermissionDenied, "Validator already has a password set, cannot signup")
 	}
 	// We check the strength of the password to ensure it is high-entropy,
@@ -45,43 +59,122 @@ func (s *Server) Signup(ctx context.Context, req *pb.AuthRequest) (*pb.AuthRespo
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not generate hashed password")
 	}
-	// We store the hashed password to disk.
+	// We store the hashed password to disk, both as the password of the API and of
+	// the admin user.
 	if err := s.valDB.SaveHashedPasswordForAPI(ctx, hashedPassword); err != nil {
 		return nil, status.Error(codes.Internal, "Could not save hashed password to database")
 	}
+	if err := s.valDB.SaveAPIUser(ctx, &kv.APIUser{
+		Username:       defaultUsername,
+		HashedPassword: hashedPassword,
+		Role:           kv.AdminRole,
+		WalletOwner:    true,
+		CreatedAt:      roughtime.Now(),
+	}); err != nil {
+		return nil, status.Error(codes.Internal, "Could not save user to database")
+	}
 	if err := s.initializeWallet(ctx, &v2.WalletConfig{
 		WalletDir:      defaultWalletPath,
 		WalletPassword: req.Password,
 	}); err != nil {
 		return nil, status.Error(codes.Internal, "Could not initialize wallet")
 	}
-	return s.sendAuthResponse()
+	s.recordAuthEvent(ctx, &kv.AuthEvent{Type: signupEvent, Username: defaultUsername})
+	return s.sendAuthResponse(defaultUsername)
 }
 
-// Login to authenticate with the validator RPC API using a password.
+// Login to authenticate with the validator RPC API using a username and password,
+// as the admin user owning the wallet if no username is given.
 func (s *Server) Login(ctx context.Context, req *pb.AuthRequest) (*pb.AuthResponse, error) {
-	// We retrieve the hashed password for the validator API from disk.
-	hashedPassword, err := s.valDB.HashedPasswordForAPI(ctx)
+	username := req.Username
+	if username == "" {
+		username = defaultUsername
+	}
+	// We retrieve the hashed password of the user from disk.
+	user, err := s.valDB.APIUser(ctx, username)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Could not retrieve hashed password from database")
+		return nil, status.Error(codes.Internal, "Could not retrieve user from database")
 	}
 	// Compare the stored hashed password, with the hashed version of the password that was received.
-	if err := bcrypt.CompareHashAndPassword(hashedPassword, []byte(req.Password)); err != nil {
+	if user == nil || bcrypt.CompareHashAndPassword(user.HashedPassword, []byte(req.Password)) != nil {
+		s.recordAuthEvent(ctx, &kv.AuthEvent{Type: loginFailedEvent, Username: username})
 		return nil, status.Error(codes.Unauthenticated, "Incorrect password")
 	}
-	if err := s.initializeWallet(ctx, &v2.WalletConfig{
-		WalletDir:      defaultWalletPath,
-		WalletPassword: req.Password,
-	}); err != nil {
-		return nil, status.Error(codes.Internal, "Could not initialize wallet")
+	// Only the password of the user owning the wallet can open it.
+	if user.WalletOwner {
+		if err := s.initializeWallet(ctx, &v2.WalletConfig{
+			WalletDir:      defaultWalletPath,
+			WalletPassword: req.Password,
+		}); err != nil {
+			return nil, status.Error(codes.Internal, "Could not initialize wallet")
+		}
+	}
+	s.recordAuthEvent(ctx, &kv.AuthEvent{Type: loginEvent, Username: username})
+	return s.sendAuthResponse(username)
+}
+
+// ChangePassword of the user calling the validator RPC API. As the password of the
+// user owning the wallet is also the password of the wallet, the wallet is then
+// encrypted again with the new password. The new hashed password is saved first, and
+// the previous one restored if the wallet could not be encrypted.
+func (s *Server) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*ptypes.Empty, error) {
+	user, err := s.valDB.APIUser(ctx, callerUsername(ctx))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Could not retrieve user from database")
+	}
+	if user == nil || bcrypt.CompareHashAndPassword(user.HashedPassword, []byte(req.CurrentPassword)) != nil {
+		return nil, status.Error(codes.Unauthenticated, "Incorrect password")
+	}
+	if req.Password != req.PasswordConfirmation {
+		return nil, status.Error(codes.InvalidArgument, "Password does not match confirmation")
+	}
+	if err := promptutil.ValidatePasswordInput(req.Password); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Could not validate password input")
+	}
+	if user.WalletOwner && !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	var kdf *v2keymanager.KDFConfig
+	if req.Kdf != nil && req.Kdf.Function != "" {
+		kdf, err = v2keymanager.NewKDFConfig(
+			req.Kdf.Function,
+			int(req.Kdf.ScryptN),
+			int(req.Kdf.ScryptR),
+			int(req.Kdf.ScryptP),
+			int(req.Kdf.Pbkdf2C),
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid key derivation function: %v", err)
+		}
+	}
+	if user.WalletOwner {
+		if err := v2.ChangeWalletPassword(ctx, &v2.ChangePasswordConfig{
+			Wallet:      s.wallet,
+			Keymanager:  s.keymanager,
+			NewPassword: req.Password,
+			KDF:         kdf,
+			ValDB:       s.valDB,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not change wallet password: %v", err)
+		}
+	} else {
+		newHashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), hashCost)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Could not generate hashed password")
+		}
+		user.HashedPassword = newHashedPassword
+		if err := s.valDB.SaveAPIUser(ctx, user); err != nil {
+			return nil, status.Error(codes.Internal, "Could not save user to database")
+		}
 	}
-	return s.sendAuthResponse()
+	s.recordAuthEvent(ctx, &kv.AuthEvent{Type: passwordChangedEvent, Username: user.Username})
+	return &ptypes.Empty{}, nil
 }
 
 // Sends an auth response via gRPC containing a new JWT token.
-func (s *Server) sendAuthResponse() (*pb.AuthResponse, error) {
+func (s *Server) sendAuthResponse(username string) (*pb.AuthResponse, error) {
 	// If everything is fine here, construct the auth token.
-	tokenString, expirationTime, err := s.createTokenString()
+	tokenString, expirationTime, err := s.createTokenString(username)
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Could not create jwt token string")
 	}
@@ -91,13 +184,16 @@ func (s *Server) sendAuthResponse() (*pb.AuthResponse, error) {
 	}, nil
 }
 
-// Creates a JWT token string using the JWT key with an expiration timestamp.
-func (s *Server) createTokenString() (string, uint64, error) {
+// Creates a JWT token string for a user using the JWT key with an expiration timestamp.
+func (s *Server) createTokenString(username string) (string, uint64, error) {
 	// Create a new token object, specifying signing method and the claims
 	// you would like it to contain.
 	expirationTime := roughtime.Now().Add(tokenExpiryLength)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.StandardClaims{
-		ExpiresAt: expirationTime.Unix(),
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &authClaims{
+		Username: username,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: expirationTime.Unix(),
+		},
 	})
 	// Sign and get the complete encoded token as a string using the secret
 	tokenString, err := token.SignedString(s.jwtKey)
@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The types of the auth events recorded in the database.
+const (
+	signupEvent           = "signup"
+	loginEvent            = "login"
+	loginFailedEvent      = "login_failed"
+	passwordChangedEvent  = "password_changed"
+	userCreatedEvent      = "user_created"
+	userDeletedEvent      = "user_deleted"
+	roleChangedEvent      = "role_changed"
+	tokenCreatedEvent     = "token_created"
+	tokenRevokedEvent     = "token_revoked"
+	permissionDeniedEvent = "permission_denied"
+)
+
+// defaultAuthEventsLimit is the number of auth events listed when no limit is given.
+const defaultAuthEventsLimit = 100
+
+// recordAuthEvent saves an auth event to the database. As failing to do so should not fail
+// the request, errors are only logged.
+func (s *Server) recordAuthEvent(ctx context.Context, event *kv.AuthEvent) {
+	if s.valDB == nil {
+		return
+	}
+	event.Time = roughtime.Now()
+	if err := s.valDB.SaveAuthEvent(ctx, event); err != nil {
+		log.WithError(err).WithField("type", event.Type).Error("Could not save auth event")
+	}
+}
+
+// ListAuthEvents lists the most recent auth events of the API, such as logins and the
+// creation of API tokens.
+func (s *Server) ListAuthEvents(ctx context.Context, req *pb.ListAuthEventsRequest) (*pb.ListAuthEventsResponse, error) {
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultAuthEventsLimit
+	}
+	events, err := s.valDB.AuthEvents(ctx, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve auth events: %v", err)
+	}
+	res := &pb.ListAuthEventsResponse{
+		Events: make([]*pb.AuthEvent, len(events)),
+	}
+	for i, event := range events {
+		res.Events[i] = &pb.AuthEvent{
+			Time:     uint64(event.Time.Unix()),
+			Type:     event.Type,
+			Username: event.Username,
+			TokenId:  event.TokenID,
+			Method:   event.Method,
+			Details:  event.Details,
+		}
+	}
+	return res, nil
+}
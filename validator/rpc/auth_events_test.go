@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestServer_ListAuthEvents(t *testing.T) {
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	ctx := context.Background()
+	s := &Server{valDB: valDB}
+
+	_, err := s.Login(ctx, &pb.AuthRequest{Username: "mallory", Password: "guess"})
+	require.ErrorContains(t, "Incorrect password", err)
+	p := &principal{username: "bob", role: kv.ReadOnlyRole}
+	require.ErrorContains(t, "not allowed", s.checkMethodRole(ctx, p, "/ethereum.validator.accounts.v2.Wallet/EditConfig"))
+
+	res, err := s.ListAuthEvents(ctx, &pb.ListAuthEventsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Events))
+	assert.Equal(t, permissionDeniedEvent, res.Events[0].Type)
+	assert.Equal(t, "bob", res.Events[0].Username)
+	assert.Equal(t, "/ethereum.validator.accounts.v2.Wallet/EditConfig", res.Events[0].Method)
+	assert.Equal(t, loginFailedEvent, res.Events[1].Type)
+	assert.Equal(t, "mallory", res.Events[1].Username)
+
+	res, err = s.ListAuthEvents(ctx, &pb.ListAuthEventsRequest{Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(res.Events))
+}
@@ -95,3 +95,80 @@ func TestServer_SignupAndLogin_RoundTrip(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestServer_ChangePassword(t *testing.T) {
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	ctx := context.Background()
+
+	localWalletDir := setupWalletDir(t)
+	defaultWalletPath = localWalletDir
+	strongPass := "29384283xasjasd32%%&*@*#*"
+	_, err := v2.CreateWalletWithKeymanager(ctx, &v2.CreateWalletConfig{
+		WalletCfg: &v2.WalletConfig{
+			WalletDir:      defaultWalletPath,
+			KeymanagerKind: v2keymanager.Direct,
+			WalletPassword: strongPass,
+		},
+		SkipMnemonicConfirm: true,
+	})
+	require.NoError(t, err)
+	ss := &Server{
+		valDB:                 valDB,
+		walletInitializedFeed: new(event.Feed),
+	}
+	_, err = ss.Signup(ctx, &pb.AuthRequest{
+		Password: strongPass,
+	})
+	require.NoError(t, err)
+
+	newPass := "NewPassw0rdz4938%%"
+	_, err = ss.ChangePassword(ctx, &pb.ChangePasswordRequest{
+		CurrentPassword:      "wrong",
+		Password:             newPass,
+		PasswordConfirmation: newPass,
+	})
+	require.ErrorContains(t, "Incorrect password", err)
+	_, err = ss.ChangePassword(ctx, &pb.ChangePasswordRequest{
+		CurrentPassword:      strongPass,
+		Password:             newPass,
+		PasswordConfirmation: "other",
+	})
+	require.ErrorContains(t, "Password does not match confirmation", err)
+	_, err = ss.ChangePassword(ctx, &pb.ChangePasswordRequest{
+		CurrentPassword:      strongPass,
+		Password:             newPass,
+		PasswordConfirmation: newPass,
+	})
+	require.NoError(t, err)
+
+	// The new password unlocks both the API and the wallet.
+	_, err = ss.Login(ctx, &pb.AuthRequest{
+		Password: strongPass,
+	})
+	require.ErrorContains(t, "Incorrect password", err)
+	_, err = ss.Login(ctx, &pb.AuthRequest{
+		Password: newPass,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, newPass, ss.wallet.Password())
+
+	// The key derivation function encrypting the wallet can be chosen.
+	_, err = ss.ChangePassword(ctx, &pb.ChangePasswordRequest{
+		CurrentPassword:      newPass,
+		Password:             strongPass,
+		PasswordConfirmation: strongPass,
+		Kdf:                  &pb.KeystoreKdf{Function: "argon2"},
+	})
+	require.ErrorContains(t, "Invalid key derivation function", err)
+	_, err = ss.ChangePassword(ctx, &pb.ChangePasswordRequest{
+		CurrentPassword:      newPass,
+		Password:             strongPass,
+		PasswordConfirmation: strongPass,
+		Kdf:                  &pb.KeystoreKdf{Function: "pbkdf2", Pbkdf2C: 1000},
+	})
+	require.NoError(t, err)
+	_, err = ss.Login(ctx, &pb.AuthRequest{
+		Password: strongPass,
+	})
+	require.NoError(t, err)
+}
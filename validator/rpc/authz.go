@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultUsername is the user authenticating when no username is given, which is the
+// admin user created at signup and owning the wallet.
+const defaultUsername = "admin"
+
+// roleRanks orders the roles of the validator API, from the least to the most privileged.
+var roleRanks = map[string]int{
+	kv.ReadOnlyRole: 1,
+	kv.OperatorRole: 2,
+	kv.AdminRole:    3,
+}
+
+// methodRoles is the least privileged role allowed to call each method of the API.
+// Methods which are not listed require the admin role.
+var methodRoles = map[string]string{
+	"/ethereum.validator.accounts.v2.Accounts/ListAccounts":            kv.ReadOnlyRole,
+	"/ethereum.validator.accounts.v2.Accounts/ListValidatingKeys":      kv.ReadOnlyRole,
+	"/ethereum.validator.accounts.v2.Health/GetBeaconNodeConnection":   kv.ReadOnlyRole,
+	"/ethereum.validator.accounts.v2.Validator/StreamValidatorEvents":  kv.ReadOnlyRole,
+	"/ethereum.validator.accounts.v2.Logs/StreamLogs":                  kv.ReadOnlyRole,
+	"/ethereum.validator.accounts.v2.Auth/ChangePassword":              kv.ReadOnlyRole,
+	"/ethereum.validator.accounts.v2.Accounts/CreateAccount":           kv.OperatorRole,
+	"/ethereum.validator.accounts.v2.Accounts/ImportKeystores":         kv.OperatorRole,
+	"/ethereum.validator.accounts.v2.Accounts/SetValidatingKeyEnabled": kv.OperatorRole,
+}
+
+// principal is the user or API token a request is authenticated as.
+type principal struct {
+	username string
+	role     string
+	// tokenID is set when the request is authenticated with an API token.
+	tokenID string
+}
+
+type principalKey struct{}
+
+// withPrincipal returns a context carrying the principal of a request.
+func withPrincipal(ctx context.Context, p *principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// principalFromContext returns the principal of a request, or nil if the request was not
+// authenticated.
+func principalFromContext(ctx context.Context) *principal {
+	p, ok := ctx.Value(principalKey{}).(*principal)
+	if !ok {
+		return nil
+	}
+	return p
+}
+
+// callerUsername returns the username of the user a request is from, the default user if the
+// request was not authenticated.
+func callerUsername(ctx context.Context) string {
+	if p := principalFromContext(ctx); p != nil {
+		return p.username
+	}
+	return defaultUsername
+}
+
+// validRole checks a role given in a request.
+func validRole(role string) error {
+	if _, ok := roleRanks[role]; !ok {
+		return status.Errorf(codes.InvalidArgument, "Invalid role %q, expected %s, %s or %s", role, kv.ReadOnlyRole, kv.OperatorRole, kv.AdminRole)
+	}
+	return nil
+}
+
+// roleAllows returns whether a role is at least as privileged as another.
+func roleAllows(role, required string) bool {
+	return roleRanks[role] >= roleRanks[required]
+}
+
+// leastRole returns the least privileged of two roles.
+func leastRole(a, b string) string {
+	if roleRanks[a] <= roleRanks[b] {
+		return a
+	}
+	return b
+}
+
+// checkMethodRole ensures the principal of a request may call a method.
+func (s *Server) checkMethodRole(ctx context.Context, p *principal, method string) error {
+	required, ok := methodRoles[method]
+	if !ok {
+		required = kv.AdminRole
+	}
+	if roleAllows(p.role, required) {
+		return nil
+	}
+	s.recordAuthEvent(ctx, &kv.AuthEvent{
+		Type:     permissionDeniedEvent,
+		Username: p.username,
+		TokenID:  p.tokenID,
+		Method:   method,
+		Details:  fmt.Sprintf("role %s, requires %s", p.role, required),
+	})
+	return status.Errorf(codes.PermissionDenied, "Role %s is not allowed to call %s", p.role, method)
+}
@@ -0,0 +1,38 @@
+package rpc
+
+import (
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// eventsBufferSize is the number of events buffered for each stream, so that a client
+// reading the stream slowly does not delay the duties of the validator client.
+const eventsBufferSize = 1024
+
+// StreamValidatorEvents streams the duties assigned to the validating keys of the validator
+// client as they are fetched every epoch, the outcome of each of these duties, and the
+// balances and effectiveness of the keys after every epoch.
+func (s *Server) StreamValidatorEvents(_ *ptypes.Empty, stream pb.Validator_StreamValidatorEventsServer) error {
+	if s.eventSubscriber == nil {
+		return status.Error(codes.FailedPrecondition, "Validator client is not running")
+	}
+	events := make(chan *pb.ValidatorEvent, eventsBufferSize)
+	sub := s.eventSubscriber.SubscribeEvents(events)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case ev := <-events:
+			if err := stream.Send(ev); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-s.ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+type feedEventSubscriber struct {
+	feed *event.Feed
+}
+
+func (f *feedEventSubscriber) SubscribeEvents(ch chan<- *pb.ValidatorEvent) event.Subscription {
+	return f.feed.Subscribe(ch)
+}
+
+type eventStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.ValidatorEvent
+}
+
+func (s *eventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventStream) Send(ev *pb.ValidatorEvent) error {
+	s.sent <- ev
+	return nil
+}
+
+func TestServer_StreamValidatorEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	feed := new(event.Feed)
+	s := &Server{
+		ctx:             context.Background(),
+		eventSubscriber: &feedEventSubscriber{feed: feed},
+	}
+	stream := &eventStream{ctx: ctx, sent: make(chan *pb.ValidatorEvent, 1)}
+	done := make(chan error)
+	go func() {
+		done <- s.StreamValidatorEvents(&ptypes.Empty{}, stream)
+	}()
+
+	want := &pb.ValidatorEvent{
+		Event: &pb.ValidatorEvent_DutyOutcome{
+			DutyOutcome: &pb.DutyOutcome{
+				PublicKey: []byte{1},
+				Slot:      5,
+				Duty:      pb.DutyOutcome_PROPOSAL,
+				Outcome:   pb.DutyOutcome_REJECTED_BY_PROTECTION,
+			},
+		},
+	}
+	// Wait for the stream to subscribe before sending.
+	for feed.Send(want) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.DeepEqual(t, want, <-stream.sent)
+
+	cancel()
+	require.ErrorContains(t, "Context canceled", <-done)
+}
+
+func TestServer_StreamValidatorEvents_NotRunning(t *testing.T) {
+	s := &Server{ctx: context.Background()}
+	stream := &eventStream{ctx: context.Background()}
+	err := s.StreamValidatorEvents(&ptypes.Empty{}, stream)
+	require.ErrorContains(t, "Validator client is not running", err)
+}
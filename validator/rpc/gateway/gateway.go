@@ -61,13 +61,15 @@ func (g *Gateway) Start() {
 		pb.RegisterWalletHandlerFromEndpoint,
 		pb.RegisterHealthHandlerFromEndpoint,
 		pb.RegisterAccountsHandlerFromEndpoint,
+		pb.RegisterValidatorHandlerFromEndpoint,
+		pb.RegisterLogsHandlerFromEndpoint,
 	}
 	for _, h := range handlers {
 		if err := h(ctx, gwmux, g.remoteAddr, opts); err != nil {
 			log.Fatalf("Could not register API handler with grpc endpoint: %v", err)
 		}
 	}
-	g.mux.Handle("/", g.corsMiddleware(gwmux))
+	g.mux.Handle("/", g.corsMiddleware(sseMiddleware(gwmux)))
 	g.server = &http.Server{
 		Addr:    g.gatewayAddr,
 		Handler: g.mux,
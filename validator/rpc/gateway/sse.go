@@ -0,0 +1,84 @@
+package gateway
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+)
+
+// eventStreamPaths are the paths of the server streaming endpoints of the API, which are
+// served as server-sent events to the clients accepting them instead of chunked JSON.
+var eventStreamPaths = map[string]bool{
+	"/v2/validator/events/stream": true,
+	"/v2/validator/logs/stream":   true,
+}
+
+// sseMiddleware serves the server streaming endpoints as server-sent events to the
+// clients which accept text/event-stream, such as the EventSource of browsers. The gateway
+// writes every message of a stream as a line of JSON, which is sent as the data of an event.
+func sseMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !eventStreamPaths[req.URL.Path] || !strings.Contains(req.Header.Get("Accept"), "text/event-stream") {
+			h.ServeHTTP(w, req)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			h.ServeHTTP(w, req)
+			return
+		}
+		// An EventSource cannot set the headers of its request, so its token may be
+		// given as a query parameter instead, which is not a parameter of the stream.
+		query := req.URL.Query()
+		if token := query.Get("token"); token != "" {
+			if req.Header.Get("Authorization") == "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			query.Del("token")
+			req.URL.RawQuery = query.Encode()
+		}
+		h.ServeHTTP(&sseWriter{ResponseWriter: w, flusher: flusher}, req)
+	})
+}
+
+// sseWriter turns the lines of JSON written by the gateway into server-sent events.
+type sseWriter struct {
+	http.ResponseWriter
+	flusher     http.Flusher
+	buf         bytes.Buffer
+	wroteHeader bool
+}
+
+func (w *sseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Del("Transfer-Encoding")
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *sseWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	w.buf.Write(p)
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+		line := bytes.TrimSpace(w.buf.Next(i + 1))
+		if len(line) == 0 {
+			continue
+		}
+		if _, err := w.ResponseWriter.Write(append(append([]byte("data: "), line...), '\n', '\n')); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *sseWriter) Flush() {
+	w.flusher.Flush()
+}
@@ -0,0 +1,41 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestSSEMiddleware(t *testing.T) {
+	var auth, query string
+	h := sseMiddleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		auth = req.Header.Get("Authorization")
+		query = req.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"result":{"dutyOutcome":{"slot":"5"}}}`))
+		assert.NoError(t, err)
+		_, err = w.Write([]byte("\n"))
+		assert.NoError(t, err)
+		w.(http.Flusher).Flush()
+		_, err = w.Write([]byte("{\"result\":{}}\n"))
+		assert.NoError(t, err)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/validator/logs/stream?token=abc&level=warn", nil)
+	req.Header.Set("Accept", "text/event-stream")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, "Bearer abc", auth)
+	assert.Equal(t, "level=warn", query)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "data: {\"result\":{\"dutyOutcome\":{\"slot\":\"5\"}}}\n\ndata: {\"result\":{}}\n\n", rec.Body.String())
+
+	// Other clients get the stream as chunked JSON.
+	req = httptest.NewRequest(http.MethodGet, "/v2/validator/events/stream", nil)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "{\"result\":{\"dutyOutcome\":{\"slot\":\"5\"}}}\n{\"result\":{}}\n", rec.Body.String())
+}
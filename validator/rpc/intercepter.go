@@ -6,7 +6,9 @@ import (
 	"sync"
 
 	"github.com/dgrijalva/jwt-go"
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -40,7 +42,9 @@ func (s *Server) JWTInterceptor() grpc.UnaryServerInterceptor {
 		shouldAuthenticate := !noAuthPaths[info.FullMethod]
 		authLock.RUnlock()
 		if shouldAuthenticate {
-			if err := s.authorize(ctx); err != nil {
+			var err error
+			ctx, err = s.authorize(ctx, info.FullMethod)
+			if err != nil {
 				return nil, err
 			}
 		}
@@ -51,27 +55,85 @@ func (s *Server) JWTInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
-// Authorize the token received is valid.
-func (s *Server) authorize(ctx context.Context) error {
+// JWTStreamInterceptor is a gRPC stream interceptor to authorize incoming streams
+// for methods that are NOT in the noAuthPaths configuration map.
+func (s *Server) JWTStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		authLock.RLock()
+		shouldAuthenticate := !noAuthPaths[info.FullMethod]
+		authLock.RUnlock()
+		if shouldAuthenticate {
+			ctx, err := s.authorize(ss.Context(), info.FullMethod)
+			if err != nil {
+				return err
+			}
+			wrapped := middleware.WrapServerStream(ss)
+			wrapped.WrappedContext = ctx
+			ss = wrapped
+		}
+
+		err := handler(srv, ss)
+		log.Debugf("Stream - Method: %s, Error: %v\n", info.FullMethod, err)
+		return err
+	}
+}
+
+// Authorize the token received is valid, and that the user or API token it authenticates
+// has a role allowed to call the method. Returns a context carrying the principal of the request.
+func (s *Server) authorize(ctx context.Context, method string) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return status.Errorf(codes.InvalidArgument, "Retrieving metadata failed")
+		return nil, status.Errorf(codes.InvalidArgument, "Retrieving metadata failed")
 	}
 
 	authHeader, ok := md["authorization"]
 	if !ok {
-		return status.Errorf(codes.Unauthenticated, "Authorization token could not be found")
+		return nil, status.Errorf(codes.Unauthenticated, "Authorization token could not be found")
 	}
+	if len(authHeader) < 1 || !strings.Contains(authHeader[0], "Bearer ") {
+		return nil, status.Error(codes.Unauthenticated, "Invalid auth header, needs Bearer {token}")
+	}
+	token := strings.Split(authHeader[0], "Bearer ")[1]
+	var p *principal
+	var err error
+	if strings.HasPrefix(token, apiTokenPrefix) {
+		p, err = s.authorizeAPIToken(ctx, token)
+	} else {
+		p, err = s.authorizeJWT(ctx, token)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkMethodRole(ctx, p, method); err != nil {
+		return nil, err
+	}
+	return withPrincipal(ctx, p), nil
+}
+
+// authorizeJWT checks the JWT of a logged in user. The role of the user is retrieved on
+// every request, so that role changes and deleted users apply right away.
+func (s *Server) authorizeJWT(ctx context.Context, token string) (*principal, error) {
 	checkParsedKey := func(*jwt.Token) (interface{}, error) {
 		return s.jwtKey, nil
 	}
-	if len(authHeader) < 1 || !strings.Contains(authHeader[0], "Bearer ") {
-		return status.Error(codes.Unauthenticated, "Invalid auth header, needs Bearer {token}")
+	claims := &authClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, checkParsedKey); err != nil {
+		return nil, errors.Wrap(err, "Could not parse JWT token")
 	}
-	token := strings.Split(authHeader[0], "Bearer ")[1]
-	_, err := jwt.Parse(token, checkParsedKey)
+	if s.valDB == nil {
+		return &principal{username: claims.Username, role: kv.AdminRole}, nil
+	}
+	user, err := s.valDB.APIUser(ctx, claims.Username)
 	if err != nil {
-		return errors.Wrap(err, "Could not parse JWT token")
+		return nil, status.Errorf(codes.Internal, "Could not retrieve user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "User %s no longer exists", claims.Username)
 	}
-	return nil
+	return &principal{username: user.Username, role: user.Role}, nil
 }
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
@@ -22,7 +24,7 @@ func TestServer_JWTInterceptor_Verify(t *testing.T) {
 	unaryHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return nil, nil
 	}
-	token, _, err := s.createTokenString()
+	token, _, err := s.createTokenString(defaultUsername)
 	require.NoError(t, err)
 	ctxMD := map[string][]string{
 		"authorization": {"Bearer " + token},
@@ -49,7 +51,7 @@ func TestServer_JWTInterceptor_BadToken(t *testing.T) {
 	badServer := Server{
 		jwtKey: []byte("badTestKey"),
 	}
-	token, _, err := badServer.createTokenString()
+	token, _, err := badServer.createTokenString(defaultUsername)
 	require.NoError(t, err)
 	ctxMD := map[string][]string{
 		"authorization": {"Bearer " + token},
@@ -64,3 +66,77 @@ func TestServer_JWTInterceptor_BadToken(t *testing.T) {
 		t.Fatalf("Expected error validating signature, received %v", err)
 	}
 }
+
+func TestServer_JWTInterceptor_Roles(t *testing.T) {
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	require.NoError(t, valDB.SaveAPIUser(context.Background(), &kv.APIUser{Username: "bob", Role: kv.ReadOnlyRole}))
+	s := Server{
+		jwtKey: []byte("testKey"),
+		valDB:  valDB,
+	}
+	interceptor := s.JWTInterceptor()
+	unaryHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	token, _, err := s.createTokenString("bob")
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(context.Background(), map[string][]string{
+		"authorization": {"Bearer " + token},
+	})
+
+	listAccounts := &grpc.UnaryServerInfo{FullMethod: "/ethereum.validator.accounts.v2.Accounts/ListAccounts"}
+	_, err = interceptor(ctx, "xyz", listAccounts, unaryHandler)
+	require.NoError(t, err)
+	createAccount := &grpc.UnaryServerInfo{FullMethod: "/ethereum.validator.accounts.v2.Accounts/CreateAccount"}
+	_, err = interceptor(ctx, "xyz", createAccount, unaryHandler)
+	require.ErrorContains(t, "Role read-only is not allowed", err)
+
+	// Role changes apply to the tokens already issued.
+	require.NoError(t, valDB.SaveAPIUser(context.Background(), &kv.APIUser{Username: "bob", Role: kv.OperatorRole}))
+	_, err = interceptor(ctx, "xyz", createAccount, unaryHandler)
+	require.NoError(t, err)
+
+	require.NoError(t, valDB.DeleteAPIUser(context.Background(), "bob"))
+	_, err = interceptor(ctx, "xyz", listAccounts, unaryHandler)
+	require.ErrorContains(t, "User bob no longer exists", err)
+}
+
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestServer_JWTStreamInterceptor(t *testing.T) {
+	s := Server{
+		jwtKey: []byte("testKey"),
+	}
+	interceptor := s.JWTStreamInterceptor()
+
+	streamInfo := &grpc.StreamServerInfo{
+		FullMethod:     "/ethereum.validator.accounts.v2.Validator/StreamValidatorEvents",
+		IsServerStream: true,
+	}
+	called := false
+	streamHandler := func(srv interface{}, stream grpc.ServerStream) error {
+		called = true
+		return nil
+	}
+
+	// Without a token, the stream is refused.
+	ctx := metadata.NewIncomingContext(context.Background(), map[string][]string{})
+	err := interceptor(nil, &authStream{ctx: ctx}, streamInfo, streamHandler)
+	require.ErrorContains(t, "Authorization token could not be found", err)
+	require.Equal(t, false, called)
+
+	token, _, err := s.createTokenString(defaultUsername)
+	require.NoError(t, err)
+	ctx = metadata.NewIncomingContext(context.Background(), map[string][]string{
+		"authorization": {"Bearer " + token},
+	})
+	require.NoError(t, interceptor(nil, &authStream{ctx: ctx}, streamInfo, streamHandler))
+	require.Equal(t, true, called)
+}
@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/multi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListValidatingKeys lists the validating keys of the validator client with their
+// configuration, including the disabled keys.
+func (s *Server) ListValidatingKeys(ctx context.Context, _ *ptypes.Empty) (*pb.ListValidatingKeysResponse, error) {
+	if s.multiKeymanager == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "No validating keys config, start the validator client with --%s", flags.ValidatorsConfigFileFlag.Name)
+	}
+	pubKeys, err := s.multiKeymanager.FetchAllValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch validating keys: %v", err)
+	}
+	keys := make([]*pb.ValidatingKey, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		keys[i] = s.validatingKey(pubKey)
+	}
+	return &pb.ListValidatingKeysResponse{
+		Keys: keys,
+	}, nil
+}
+
+// SetValidatingKeyEnabled enables or disables a validating key at runtime. A disabled key
+// stops performing its duties from the next epoch, and refuses to sign right away. Keys
+// cannot be enabled at runtime while doppelganger detection is on, as they would sign
+// without being watched for doppelgangers first: they are enabled on restart instead.
+func (s *Server) SetValidatingKeyEnabled(ctx context.Context, req *pb.SetValidatingKeyEnabledRequest) (*pb.ValidatingKey, error) {
+	if s.multiKeymanager == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "No validating keys config, start the validator client with --%s", flags.ValidatorsConfigFileFlag.Name)
+	}
+	if len(req.PublicKey) != 48 {
+		return nil, status.Errorf(codes.InvalidArgument, "Public key is %d bytes long, expected 48", len(req.PublicKey))
+	}
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	if req.Enabled && s.doppelgangerEpochs > 0 && !s.multiKeymanager.Config().Enabled(pubKey) {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"Could not enable validating key %#x while doppelganger detection is on, enable it in the validating keys config and restart the validator client",
+			req.PublicKey,
+		)
+	}
+	if err := s.multiKeymanager.SetEnabled(ctx, pubKey, req.Enabled); err != nil {
+		if errors.Is(err, multi.ErrUnknownKey) {
+			return nil, status.Errorf(codes.NotFound, "Could not find validating key %#x", req.PublicKey)
+		}
+		return nil, status.Errorf(codes.Internal, "Could not change validating key: %v", err)
+	}
+	return s.validatingKey(pubKey), nil
+}
+
+func (s *Server) validatingKey(pubKey [48]byte) *pb.ValidatingKey {
+	config := s.multiKeymanager.Config()
+	graffiti, _ := config.Graffiti(pubKey)
+	return &pb.ValidatingKey{
+		PublicKey: pubKey[:],
+		Enabled:   config.Enabled(pubKey),
+		Graffiti:  string(graffiti),
+		Labels:    config.Labels(pubKey),
+	}
+}
@@ -0,0 +1,123 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/keyconfig"
+	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/direct"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/multi"
+)
+
+func setupMultiKeymanager(t *testing.T, file *keyconfig.File) (*multi.Keymanager, [][48]byte) {
+	ctx := context.Background()
+	interop, err := direct.NewInteropKeymanager(ctx, 0, 3)
+	require.NoError(t, err)
+	pubKeys, err := interop.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	config, err := keyconfig.NewStore(file)
+	require.NoError(t, err)
+	km, err := multi.NewKeymanager([]v2keymanager.IKeymanager{interop}, config)
+	require.NoError(t, err)
+	return km, pubKeys
+}
+
+func TestServer_ListValidatingKeys(t *testing.T) {
+	ctx := context.Background()
+	graffiti := "default"
+	km, pubKeys := setupMultiKeymanager(t, &keyconfig.File{
+		Default: &keyconfig.Options{
+			Graffiti: &graffiti,
+			Labels:   map[string]string{"customer": "a"},
+		},
+	})
+	require.NoError(t, km.SetEnabled(ctx, pubKeys[1], false))
+	s := &Server{
+		multiKeymanager: km,
+	}
+	resp, err := s.ListValidatingKeys(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, len(pubKeys), len(resp.Keys))
+	for i, key := range resp.Keys {
+		assert.DeepEqual(t, pubKeys[i][:], key.PublicKey)
+		assert.Equal(t, i != 1, key.Enabled)
+		assert.Equal(t, graffiti, key.Graffiti)
+		assert.DeepEqual(t, map[string]string{"customer": "a"}, key.Labels)
+	}
+}
+
+func TestServer_ListValidatingKeys_NoConfig(t *testing.T) {
+	s := &Server{}
+	_, err := s.ListValidatingKeys(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "No validating keys config", err)
+}
+
+func TestServer_SetValidatingKeyEnabled(t *testing.T) {
+	ctx := context.Background()
+	km, pubKeys := setupMultiKeymanager(t, &keyconfig.File{})
+	s := &Server{
+		multiKeymanager: km,
+	}
+	key, err := s.SetValidatingKeyEnabled(ctx, &pb.SetValidatingKeyEnabledRequest{
+		PublicKey: pubKeys[0][:],
+		Enabled:   false,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, false, key.Enabled)
+	enabled, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKeys[1:], enabled)
+
+	key, err = s.SetValidatingKeyEnabled(ctx, &pb.SetValidatingKeyEnabledRequest{
+		PublicKey: pubKeys[0][:],
+		Enabled:   true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, key.Enabled)
+
+	_, err = s.SetValidatingKeyEnabled(ctx, &pb.SetValidatingKeyEnabledRequest{
+		PublicKey: []byte{1, 2, 3},
+	})
+	assert.ErrorContains(t, "expected 48", err)
+	_, err = s.SetValidatingKeyEnabled(ctx, &pb.SetValidatingKeyEnabledRequest{
+		PublicKey: make([]byte, 48),
+	})
+	assert.ErrorContains(t, "Could not find validating key", err)
+}
+
+func TestServer_SetValidatingKeyEnabled_DoppelgangerDetection(t *testing.T) {
+	ctx := context.Background()
+	km, pubKeys := setupMultiKeymanager(t, &keyconfig.File{})
+	require.NoError(t, km.SetEnabled(ctx, pubKeys[0], false))
+	s := &Server{
+		multiKeymanager:    km,
+		doppelgangerEpochs: 2,
+	}
+	_, err := s.SetValidatingKeyEnabled(ctx, &pb.SetValidatingKeyEnabledRequest{
+		PublicKey: pubKeys[0][:],
+		Enabled:   true,
+	})
+	assert.ErrorContains(t, "while doppelganger detection is on", err)
+	enabled, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, pubKeys[1:], enabled)
+
+	// Keys can still be disabled, and enabling a key which is already enabled is a no-op.
+	key, err := s.SetValidatingKeyEnabled(ctx, &pb.SetValidatingKeyEnabledRequest{
+		PublicKey: pubKeys[1][:],
+		Enabled:   true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, key.Enabled)
+	key, err = s.SetValidatingKeyEnabled(ctx, &pb.SetValidatingKeyEnabledRequest{
+		PublicKey: pubKeys[1][:],
+		Enabled:   false,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, false, key.Enabled)
+}
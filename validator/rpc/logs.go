@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"fmt"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// logsBufferSize is the number of log entries buffered for each stream. Entries are
+// dropped for a client reading the stream slower than the validator client logs.
+const logsBufferSize = 1024
+
+// StreamLogs streams the recent and new log entries of the validator client, of the
+// requested level or more severe.
+func (s *Server) StreamLogs(req *pb.StreamLogsRequest, stream pb.Logs_StreamLogsServer) error {
+	if s.logStreamer == nil {
+		return status.Error(codes.FailedPrecondition, "Log streaming is not enabled")
+	}
+	level := logrus.TraceLevel
+	if req.Level != "" {
+		var err error
+		level, err = logrus.ParseLevel(req.Level)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid log level: %v", err)
+		}
+	}
+	recent, sub := s.logStreamer.Subscribe(int(req.Backfill), logsBufferSize)
+	defer sub.Unsubscribe()
+	for _, entry := range recent {
+		if err := sendLogEntry(stream, entry, level); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case entry := <-sub.Entries():
+			if err := sendLogEntry(stream, entry, level); err != nil {
+				return err
+			}
+		case <-s.ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// sendLogEntry over a stream, unless it is less severe than the level of the stream.
+func sendLogEntry(stream pb.Logs_StreamLogsServer, entry *logutil.Entry, level logrus.Level) error {
+	// Levels are ordered from the most severe, panic, to the least severe, trace.
+	if entry.Level > level {
+		return nil
+	}
+	fields := make(map[string]string, len(entry.Fields))
+	for k, v := range entry.Fields {
+		fields[k] = fmt.Sprint(v)
+	}
+	if err := stream.Send(&pb.LogEntry{
+		Timestamp: entry.Time.UnixNano(),
+		Level:     entry.Level.String(),
+		Message:   entry.Message,
+		Fields:    fields,
+	}); err != nil {
+		return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+	}
+	return nil
+}
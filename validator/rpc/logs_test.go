@@ -0,0 +1,84 @@
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+type logStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *pb.LogEntry
+}
+
+func (s *logStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *logStream) Send(entry *pb.LogEntry) error {
+	s.sent <- entry
+	return nil
+}
+
+func TestServer_StreamLogs(t *testing.T) {
+	hook := logutil.NewStreamHook(10)
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	logger.SetLevel(logrus.DebugLevel)
+	logger.AddHook(hook)
+	logger.WithField("prefix", "client").Warn("Old warning")
+	logger.Info("Old info")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Server{
+		ctx:         context.Background(),
+		logStreamer: hook,
+	}
+	stream := &logStream{ctx: ctx, sent: make(chan *pb.LogEntry, 10)}
+	done := make(chan error)
+	go func() {
+		done <- s.StreamLogs(&pb.StreamLogsRequest{Level: "warn", Backfill: 5}, stream)
+	}()
+
+	// The backfilled info entry is filtered out.
+	entry := <-stream.sent
+	assert.Equal(t, "Old warning", entry.Message)
+	assert.Equal(t, "warning", entry.Level)
+	assert.Equal(t, "client", entry.Fields["prefix"])
+
+	// New entries are streamed once the stream subscribed, which it did before backfilling.
+	logger.Debug("New debug")
+	logger.Error("New error")
+	entry = <-stream.sent
+	assert.Equal(t, "New error", entry.Message)
+	assert.Equal(t, "error", entry.Level)
+
+	cancel()
+	require.ErrorContains(t, "Context canceled", <-done)
+}
+
+func TestServer_StreamLogs_InvalidLevel(t *testing.T) {
+	s := &Server{
+		ctx:         context.Background(),
+		logStreamer: logutil.NewStreamHook(10),
+	}
+	stream := &logStream{ctx: context.Background()}
+	err := s.StreamLogs(&pb.StreamLogsRequest{Level: "loud"}, stream)
+	require.ErrorContains(t, "Invalid log level", err)
+}
+
+func TestServer_StreamLogs_Disabled(t *testing.T) {
+	s := &Server{ctx: context.Background()}
+	stream := &logStream{ctx: context.Background()}
+	err := s.StreamLogs(&pb.StreamLogsRequest{}, stream)
+	require.ErrorContains(t, "Log streaming is not enabled", err)
+}
@@ -11,12 +11,15 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	"github.com/prysmaticlabs/prysm/shared/traceutil"
 	accountsv2 "github.com/prysmaticlabs/prysm/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/validator/audit"
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	v2keymanager "github.com/prysmaticlabs/prysm/validator/keymanager/v2"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/v2/multi"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -40,8 +43,14 @@ type Config struct {
 	ValidatorService      *client.ValidatorService
 	SyncChecker           client.SyncChecker
 	GenesisFetcher        client.GenesisFetcher
+	BeaconNodeFetcher     client.BeaconNodeFetcher
+	EventSubscriber       client.EventSubscriber
+	LogStreamer           *logutil.StreamHook
+	AuditLog              *audit.Log
 	WalletInitializedFeed *event.Feed
 	NodeGatewayEndpoint   string
+	MultiKeymanager       *multi.Keymanager
+	DoppelgangerEpochs    uint64
 }
 
 // Server defining a gRPC server for the remote signer API.
@@ -61,10 +70,16 @@ type Server struct {
 	validatorService      *client.ValidatorService
 	syncChecker           client.SyncChecker
 	genesisFetcher        client.GenesisFetcher
+	beaconNodeFetcher     client.BeaconNodeFetcher
+	eventSubscriber       client.EventSubscriber
+	logStreamer           *logutil.StreamHook
+	auditLog              *audit.Log
 	wallet                *accountsv2.Wallet
 	walletInitializedFeed *event.Feed
 	walletInitialized     bool
 	nodeGatewayEndpoint   string
+	multiKeymanager       *multi.Keymanager
+	doppelgangerEpochs    uint64
 }
 
 // NewServer instantiates a new gRPC server.
@@ -81,9 +96,15 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		validatorService:      cfg.ValidatorService,
 		syncChecker:           cfg.SyncChecker,
 		genesisFetcher:        cfg.GenesisFetcher,
+		beaconNodeFetcher:     cfg.BeaconNodeFetcher,
+		eventSubscriber:       cfg.EventSubscriber,
+		logStreamer:           cfg.LogStreamer,
+		auditLog:              cfg.AuditLog,
 		walletInitializedFeed: cfg.WalletInitializedFeed,
 		walletInitialized:     false,
 		nodeGatewayEndpoint:   cfg.NodeGatewayEndpoint,
+		multiKeymanager:       cfg.MultiKeymanager,
+		doppelgangerEpochs:    cfg.DoppelgangerEpochs,
 	}
 }
 
@@ -98,7 +119,7 @@ func (s *Server) Start() {
 	s.listener = lis
 
 	// Register interceptors for metrics gathering as well as our
-	// own, custom JWT unary interceptor.
+	// own, custom JWT unary and stream interceptors.
 	opts := []grpc.ServerOption{
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
 		grpc.UnaryInterceptor(middleware.ChainUnaryServer(
@@ -109,6 +130,14 @@ func (s *Server) Start() {
 			grpc_opentracing.UnaryServerInterceptor(),
 			s.JWTInterceptor(),
 		)),
+		grpc.StreamInterceptor(middleware.ChainStreamServer(
+			recovery.StreamServerInterceptor(
+				recovery.WithRecoveryHandlerContext(traceutil.RecoveryHandlerFunc),
+			),
+			grpc_prometheus.StreamServerInterceptor,
+			grpc_opentracing.StreamServerInterceptor(),
+			s.JWTStreamInterceptor(),
+		)),
 	}
 	grpc_prometheus.EnableHandlingTimeHistogram()
 
@@ -144,6 +173,8 @@ func (s *Server) Start() {
 	pb.RegisterWalletServer(s.grpcServer, s)
 	pb.RegisterHealthServer(s.grpcServer, s)
 	pb.RegisterAccountsServer(s.grpcServer, s)
+	pb.RegisterValidatorServer(s.grpcServer, s)
+	pb.RegisterLogsServer(s.grpcServer, s)
 
 	go func() {
 		if s.listener != nil {
@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/shared/roughtime"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateUser creates a user of the validator API with a role.
+func (s *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	if req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "Username cannot be empty")
+	}
+	if err := validRole(req.Role); err != nil {
+		return nil, err
+	}
+	if err := promptutil.ValidatePasswordInput(req.Password); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Could not validate password input")
+	}
+	existing, err := s.valDB.APIUser(ctx, req.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve user: %v", err)
+	}
+	if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "User %s already exists", req.Username)
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), hashCost)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Could not generate hashed password")
+	}
+	user := &kv.APIUser{
+		Username:       req.Username,
+		HashedPassword: hashedPassword,
+		Role:           req.Role,
+		CreatedAt:      roughtime.Now(),
+	}
+	if err := s.valDB.SaveAPIUser(ctx, user); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save user: %v", err)
+	}
+	s.recordAuthEvent(ctx, &kv.AuthEvent{
+		Type:     userCreatedEvent,
+		Username: callerUsername(ctx),
+		Details:  "created " + user.Username + " as " + user.Role,
+	})
+	return userToProto(user), nil
+}
+
+// ListUsers lists the users of the validator API.
+func (s *Server) ListUsers(ctx context.Context, _ *ptypes.Empty) (*pb.ListUsersResponse, error) {
+	users, err := s.valDB.APIUsers(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve users: %v", err)
+	}
+	res := &pb.ListUsersResponse{
+		Users: make([]*pb.User, len(users)),
+	}
+	for i, user := range users {
+		res.Users[i] = userToProto(user)
+	}
+	return res, nil
+}
+
+// DeleteUser deletes a user of the validator API, revoking the API tokens it created.
+// The user owning the wallet cannot be deleted.
+func (s *Server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*ptypes.Empty, error) {
+	user, err := s.apiUser(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	if user.WalletOwner {
+		return nil, status.Error(codes.FailedPrecondition, "Cannot delete the user owning the wallet")
+	}
+	if err := s.valDB.DeleteAPIUser(ctx, user.Username); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete user: %v", err)
+	}
+	s.recordAuthEvent(ctx, &kv.AuthEvent{
+		Type:     userDeletedEvent,
+		Username: callerUsername(ctx),
+		Details:  "deleted " + user.Username,
+	})
+	return &ptypes.Empty{}, nil
+}
+
+// SetUserRole changes the role of a user of the validator API, which applies to its next
+// requests. The user owning the wallet is always an admin.
+func (s *Server) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.User, error) {
+	if err := validRole(req.Role); err != nil {
+		return nil, err
+	}
+	user, err := s.apiUser(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	if user.WalletOwner {
+		return nil, status.Error(codes.FailedPrecondition, "Cannot change the role of the user owning the wallet")
+	}
+	previousRole := user.Role
+	user.Role = req.Role
+	if err := s.valDB.SaveAPIUser(ctx, user); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save user: %v", err)
+	}
+	s.recordAuthEvent(ctx, &kv.AuthEvent{
+		Type:     roleChangedEvent,
+		Username: callerUsername(ctx),
+		Details:  "changed " + user.Username + " from " + previousRole + " to " + user.Role,
+	})
+	return userToProto(user), nil
+}
+
+// apiUser retrieves a user of the API, returning a NotFound error if there is none.
+func (s *Server) apiUser(ctx context.Context, username string) (*kv.APIUser, error) {
+	user, err := s.valDB.APIUser(ctx, username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "User %s not found", username)
+	}
+	return user, nil
+}
+
+func userToProto(user *kv.APIUser) *pb.User {
+	return &pb.User{
+		Username:    user.Username,
+		Role:        user.Role,
+		WalletOwner: user.WalletOwner,
+		CreatedAt:   uint64(user.CreatedAt.Unix()),
+	}
+}
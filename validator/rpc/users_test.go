@@ -0,0 +1,70 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestServer_Users(t *testing.T) {
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	ctx := withPrincipal(context.Background(), &principal{username: defaultUsername, role: kv.AdminRole})
+	require.NoError(t, valDB.SaveAPIUser(ctx, &kv.APIUser{
+		Username:    defaultUsername,
+		Role:        kv.AdminRole,
+		WalletOwner: true,
+	}))
+	s := &Server{valDB: valDB}
+
+	strongPass := "29384283xasjasd32%%&*@*#*"
+	_, err := s.CreateUser(ctx, &pb.CreateUserRequest{Username: "alice", Password: strongPass, Role: "root"})
+	require.ErrorContains(t, "Invalid role", err)
+	_, err = s.CreateUser(ctx, &pb.CreateUserRequest{Username: "alice", Password: "password", Role: kv.ReadOnlyRole})
+	require.ErrorContains(t, "Could not validate password input", err)
+	user, err := s.CreateUser(ctx, &pb.CreateUserRequest{Username: "alice", Password: strongPass, Role: kv.ReadOnlyRole})
+	require.NoError(t, err)
+	assert.Equal(t, kv.ReadOnlyRole, user.Role)
+	_, err = s.CreateUser(ctx, &pb.CreateUserRequest{Username: "alice", Password: strongPass, Role: kv.AdminRole})
+	require.ErrorContains(t, "User alice already exists", err)
+
+	// The new user can login, without opening the wallet.
+	_, err = s.Login(ctx, &pb.AuthRequest{Username: "alice", Password: strongPass})
+	require.NoError(t, err)
+	assert.Equal(t, false, s.walletInitialized)
+
+	user, err = s.SetUserRole(ctx, &pb.SetUserRoleRequest{Username: "alice", Role: kv.OperatorRole})
+	require.NoError(t, err)
+	assert.Equal(t, kv.OperatorRole, user.Role)
+	_, err = s.SetUserRole(ctx, &pb.SetUserRoleRequest{Username: defaultUsername, Role: kv.ReadOnlyRole})
+	require.ErrorContains(t, "Cannot change the role of the user owning the wallet", err)
+
+	res, err := s.ListUsers(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Users))
+	assert.Equal(t, defaultUsername, res.Users[0].Username)
+	assert.Equal(t, true, res.Users[0].WalletOwner)
+	assert.Equal(t, "alice", res.Users[1].Username)
+	assert.Equal(t, kv.OperatorRole, res.Users[1].Role)
+
+	_, err = s.DeleteUser(ctx, &pb.DeleteUserRequest{Username: defaultUsername})
+	require.ErrorContains(t, "Cannot delete the user owning the wallet", err)
+	_, err = s.DeleteUser(ctx, &pb.DeleteUserRequest{Username: "alice"})
+	require.NoError(t, err)
+	_, err = s.DeleteUser(ctx, &pb.DeleteUserRequest{Username: "alice"})
+	require.ErrorContains(t, "User alice not found", err)
+
+	events, err := valDB.AuthEvents(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(events))
+	assert.Equal(t, userDeletedEvent, events[0].Type)
+	assert.Equal(t, roleChangedEvent, events[1].Type)
+	assert.Equal(t, "changed alice from read-only to operator", events[1].Details)
+	assert.Equal(t, loginEvent, events[2].Type)
+	assert.Equal(t, userCreatedEvent, events[3].Type)
+	assert.Equal(t, defaultUsername, events[3].Username)
+}
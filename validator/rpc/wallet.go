@@ -8,6 +8,7 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/shared/rand"
 	v2 "github.com/prysmaticlabs/prysm/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/validator/flags"
@@ -28,14 +29,9 @@ func (s *Server) CreateWallet(ctx context.Context, req *pb.CreateWalletRequest)
 		if req.KeystoresImported == nil || len(req.KeystoresImported) < 1 {
 			return nil, status.Error(codes.InvalidArgument, "No keystores included for import")
 		}
-		keystores := make([]*v2keymanager.Keystore, len(req.KeystoresImported))
-		for i := 0; i < len(req.KeystoresImported); i++ {
-			encoded := req.KeystoresImported[i]
-			keystore := &v2keymanager.Keystore{}
-			if err := json.Unmarshal([]byte(encoded), &keystore); err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "Not a valid EIP-2335 keystore JSON file: %v", err)
-			}
-			keystores[i] = keystore
+		keystores, err := unmarshalKeystores(req.KeystoresImported)
+		if err != nil {
+			return nil, err
 		}
 		wallet, err := v2.CreateWalletWithKeymanager(ctx, &v2.CreateWalletConfig{
 			WalletCfg: &v2.WalletConfig{
@@ -106,6 +102,35 @@ func (s *Server) WalletConfig(ctx context.Context, _ *ptypes.Empty) (*pb.WalletR
 	}, nil
 }
 
+// RecoverWallet via an API request, allowing a user to recover a derived wallet
+// and its accounts from a mnemonic.
+func (s *Server) RecoverWallet(ctx context.Context, req *pb.RecoverWalletRequest) (*pb.WalletResponse, error) {
+	if !bip39.IsMnemonicValid(req.Mnemonic) {
+		return nil, status.Error(codes.InvalidArgument, "Invalid mnemonic in request")
+	}
+	if err := promptutil.ValidatePasswordInput(req.WalletPassword); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Could not validate wallet password input")
+	}
+	err := v2.WalletExists(defaultWalletPath)
+	if err == nil {
+		return nil, status.Error(codes.AlreadyExists, "A wallet already exists")
+	}
+	if !errors.Is(err, v2.ErrNoWalletFound) {
+		return nil, status.Errorf(codes.Internal, "Could not check if wallet exists: %v", err)
+	}
+	if _, err := v2.RecoverWallet(ctx, &v2.RecoverWalletConfig{
+		WalletDir:      defaultWalletPath,
+		WalletPassword: req.WalletPassword,
+		Mnemonic:       req.Mnemonic,
+		NumAccounts:    int64(req.NumAccounts),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not recover wallet: %v", err)
+	}
+	return &pb.WalletResponse{
+		WalletPath: defaultWalletPath,
+	}, nil
+}
+
 // GenerateMnemonic creates a new, random bip39 mnemonic phrase.
 func (s *Server) GenerateMnemonic(ctx context.Context, _ *ptypes.Empty) (*pb.GenerateMnemonicResponse, error) {
 	mnemonicRandomness := make([]byte, 32)
@@ -124,3 +149,16 @@ func (s *Server) GenerateMnemonic(ctx context.Context, _ *ptypes.Empty) (*pb.Gen
 		Mnemonic: mnemonic,
 	}, nil
 }
+
+// Unmarshals JSON-encoded EIP-2335 keystore files of a request.
+func unmarshalKeystores(encoded []string) ([]*v2keymanager.Keystore, error) {
+	keystores := make([]*v2keymanager.Keystore, len(encoded))
+	for i := 0; i < len(encoded); i++ {
+		keystore := &v2keymanager.Keystore{}
+		if err := json.Unmarshal([]byte(encoded[i]), &keystore); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Not a valid EIP-2335 keystore JSON file: %v", err)
+		}
+		keystores[i] = keystore
+	}
+	return keystores, nil
+}
@@ -117,3 +117,40 @@ func TestServer_WalletConfig(t *testing.T) {
 		WalletPath: localWalletDir,
 	})
 }
+
+func TestServer_RecoverWallet(t *testing.T) {
+	localWalletDir := setupWalletDir(t)
+	defaultWalletPath = localWalletDir
+	ctx := context.Background()
+	strongPass := "29384283xasjasd32%%&*@*#*"
+	s := &Server{}
+	req := &pb.RecoverWalletRequest{
+		Mnemonic:       "not a mnemonic",
+		NumAccounts:    2,
+		WalletPassword: strongPass,
+	}
+	_, err := s.RecoverWallet(ctx, req)
+	require.ErrorContains(t, "Invalid mnemonic", err)
+
+	mnemonicResp, err := s.GenerateMnemonic(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	req.Mnemonic = mnemonicResp.Mnemonic
+	resp, err := s.RecoverWallet(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, localWalletDir, resp.WalletPath)
+
+	wallet, err := v2.OpenWallet(ctx, &v2.WalletConfig{
+		WalletDir:      localWalletDir,
+		WalletPassword: strongPass,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, v2keymanager.Derived, wallet.KeymanagerKind())
+	km, err := wallet.InitializeKeymanager(ctx, true /* skip mnemonic confirm */)
+	require.NoError(t, err)
+	keys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(keys))
+
+	_, err = s.RecoverWallet(ctx, req)
+	require.ErrorContains(t, "A wallet already exists", err)
+}
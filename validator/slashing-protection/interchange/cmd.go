@@ -0,0 +1,115 @@
+package interchange
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for importing and exporting the slashing protection history of Prysm validators.
+var Commands = &cli.Command{
+	Name:     "slashing-protection",
+	Category: "slashing-protection",
+	Usage:    "defines commands for moving the slashing protection history of validators in the EIP-3076 interchange format",
+	Subcommands: []*cli.Command{
+		{
+			Name: "export",
+			Description: `exports the slashing protection history of all validators in the validator database of the
+--datadir directory, which is the wallet's accounts directory when using a wallet, to an EIP-3076 interchange JSON file.
+The attestation history of each validator is exported as a single attestation at its highest source and target epochs,
+which is a watermark rather than a signed attestation. Only import the file into clients which refuse attestations
+below the source and target epochs of the file, as EIP-3076 requires.`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionJSONFileFlag,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := ExportSlashingProtectionJSONCli(cliCtx); err != nil {
+					log.Fatalf("Could not export slashing protection history: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "import",
+			Description: `imports an EIP-3076 interchange JSON file into the validator database of the --datadir
+directory, which is the wallet's accounts directory when using a wallet. The imported history is merged with the existing one.`,
+			Flags: []cli.Flag{
+				cmd.DataDirFlag,
+				flags.SlashingProtectionJSONFileFlag,
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := ImportSlashingProtectionJSONCli(cliCtx); err != nil {
+					log.Fatalf("Could not import slashing protection history: %v", err)
+				}
+				return nil
+			},
+		},
+	},
+}
+
+// ExportSlashingProtectionJSONCli exports the slashing protection history of the
+// validator database to the interchange file given by the cli flags.
+func ExportSlashingProtectionJSONCli(cliCtx *cli.Context) error {
+	outputPath := cliCtx.String(flags.SlashingProtectionJSONFileFlag.Name)
+	if outputPath == "" {
+		return errors.Errorf("no output file specified, use --%s", flags.SlashingProtectionJSONFileFlag.Name)
+	}
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	validatorDB, err := kv.GetKVStore(dataDir)
+	if err != nil {
+		return errors.Wrapf(err, "could not open validator database in %s", dataDir)
+	}
+	if validatorDB == nil {
+		return errors.Errorf("no validator database found in %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	interchangeJSON, err := ExportStandardProtectionJSON(cliCtx.Context, validatorDB)
+	if err != nil {
+		return err
+	}
+	enc, err := json.MarshalIndent(interchangeJSON, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal interchange file")
+	}
+	if err := ioutil.WriteFile(outputPath, enc, params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return errors.Wrapf(err, "could not write interchange file %s", outputPath)
+	}
+	log.WithField("file", outputPath).Info("Exported slashing protection history")
+	return nil
+}
+
+// ImportSlashingProtectionJSONCli imports the interchange file given by the cli
+// flags into the validator database.
+func ImportSlashingProtectionJSONCli(cliCtx *cli.Context) error {
+	inputPath := cliCtx.String(flags.SlashingProtectionJSONFileFlag.Name)
+	if inputPath == "" {
+		return errors.Errorf("no input file specified, use --%s", flags.SlashingProtectionJSONFileFlag.Name)
+	}
+	enc, err := ioutil.ReadFile(inputPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not read interchange file %s", inputPath)
+	}
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	validatorDB, err := kv.NewKVStore(dataDir, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not open validator database in %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	return ImportStandardProtectionJSON(cliCtx.Context, validatorDB, bytes.NewReader(enc))
+}
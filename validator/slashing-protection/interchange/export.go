@@ -0,0 +1,92 @@
+package interchange
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+// ExportStandardProtectionJSON exports the slashing protection history of every
+// validator public key in the database to the EIP-3076 interchange format, with
+// the signing roots of the blocks which have one recorded. The attestation history
+// is kept as watermarks, so a single synthetic attestation at the highest source and
+// target epochs is exported per public key. It is a watermark rather than an
+// attestation which was signed: it is as protective as the full history only for
+// importers which, as EIP-3076 requires, refuse attestations with a source epoch
+// lower or a target epoch not higher than the ones of the file, as the importer of
+// this package does. An importer checking double and surround votes against the
+// listed attestations alone would not be protected against the unlisted history.
+func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database) (*EIPSlashingProtectionFormat, error) {
+	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve genesis validators root")
+	}
+	if len(genesisValidatorsRoot) == 0 {
+		return nil, errors.New("genesis validators root of the database is unknown, " +
+			"run the validator client against a beacon node before exporting its history")
+	}
+	pubKeys, err := validatorDB.ProtectedPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve public keys")
+	}
+	watermarks, err := validatorDB.AttestationWatermarksForPubKeys(ctx, pubKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve attestation watermarks")
+	}
+
+	interchangeJSON := &EIPSlashingProtectionFormat{}
+	interchangeJSON.Metadata.InterchangeFormatVersion = InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", genesisValidatorsRoot)
+	interchangeJSON.Data = make([]*ProtectionData, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		slots, err := validatorDB.ProposedSlotsForPubKey(ctx, pubKey[:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve proposal history for public key %#x", pubKey)
+		}
+		signingRoots, err := validatorDB.ProposalSigningRootsForPubKey(ctx, pubKey[:])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve proposal signing roots for public key %#x", pubKey)
+		}
+		data := &ProtectionData{
+			Pubkey:             fmt.Sprintf("%#x", pubKey),
+			SignedBlocks:       make([]*SignedBlock, 0, len(slots)),
+			SignedAttestations: signedAttestationsFromWatermarks(watermarks[pubKey]),
+		}
+		for _, slot := range slots {
+			blk := &SignedBlock{
+				Slot: fmt.Sprintf("%d", slot),
+			}
+			if root, ok := signingRoots[slot]; ok {
+				blk.SigningRoot = fmt.Sprintf("%#x", root)
+			}
+			data.SignedBlocks = append(data.SignedBlocks, blk)
+		}
+		if len(data.SignedBlocks) == 0 && len(data.SignedAttestations) == 0 {
+			continue
+		}
+		interchangeJSON.Data = append(interchangeJSON.Data, data)
+	}
+	return interchangeJSON, nil
+}
+
+// signedAttestationsFromWatermarks returns the synthetic attestation at the highest
+// source and target epochs of the watermarks. The signing root is the one of the
+// attestation signed for the highest target epoch, if it is known, so that importers
+// may sign that attestation again, although its source epoch may be lower.
+func signedAttestationsFromWatermarks(w *kv.AttestationWatermarks) []*SignedAttestation {
+	atts := make([]*SignedAttestation, 0, 1)
+	if w == nil {
+		return atts
+	}
+	att := &SignedAttestation{
+		SourceEpoch: fmt.Sprintf("%d", w.HighestSourceEpoch),
+		TargetEpoch: fmt.Sprintf("%d", w.HighestTargetEpoch),
+	}
+	if w.SigningRoot != [32]byte{} {
+		att.SigningRoot = fmt.Sprintf("%#x", w.SigningRoot)
+	}
+	return append(atts, att)
+}
@@ -0,0 +1,38 @@
+// Package interchange implements the import and export of the slashing protection
+// history of the validator client in the interchange format defined by EIP-3076,
+// which allows moving validator keys between machines and clients safely.
+package interchange
+
+// InterchangeFormatVersion is the version of the EIP-3076 interchange format
+// read and written by this package.
+const InterchangeFormatVersion = "5"
+
+// EIPSlashingProtectionFormat is the EIP-3076 slashing protection interchange format.
+// All numbers are encoded as decimal strings and all byte arrays as 0x-prefixed hex.
+type EIPSlashingProtectionFormat struct {
+	Metadata struct {
+		InterchangeFormatVersion string `json:"interchange_format_version"`
+		GenesisValidatorsRoot    string `json:"genesis_validators_root"`
+	} `json:"metadata"`
+	Data []*ProtectionData `json:"data"`
+}
+
+// ProtectionData is the slashing protection history of a single validator public key.
+type ProtectionData struct {
+	Pubkey             string               `json:"pubkey"`
+	SignedBlocks       []*SignedBlock       `json:"signed_blocks"`
+	SignedAttestations []*SignedAttestation `json:"signed_attestations"`
+}
+
+// SignedBlock is a block signed by a validator. The signing root is optional.
+type SignedBlock struct {
+	Slot        string `json:"slot"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
+
+// SignedAttestation is an attestation signed by a validator. The signing root is optional.
+type SignedAttestation struct {
+	SourceEpoch string `json:"source_epoch"`
+	TargetEpoch string `json:"target_epoch"`
+	SigningRoot string `json:"signing_root,omitempty"`
+}
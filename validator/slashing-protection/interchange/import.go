@@ -0,0 +1,208 @@
+package interchange
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+// attestation is the source and target epochs of a signed attestation, and its
+// signing root if the file has it.
+type attestation struct {
+	source      uint64
+	target      uint64
+	signingRoot [32]byte
+}
+
+// protectionHistory is the parsed slashing protection history of a public key.
+type protectionHistory struct {
+	// proposals maps the slots of the signed blocks to their signing roots, which
+	// are zero if the file does not have them.
+	proposals    map[uint64][32]byte
+	attestations []attestation
+}
+
+// ImportStandardProtectionJSON imports the slashing protection history of an
+// EIP-3076 interchange file into the database. The history is merged with the
+// existing one conservatively, so that nothing protected before the import stops
+// being protected. The file is refused if it belongs to another network than the
+// database. The history is saved in a single database transaction, so the database
+// is left untouched if any of the file is invalid or cannot be saved.
+func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader) error {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errors.Wrap(err, "could not read interchange file")
+	}
+	interchangeJSON := &EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(enc, interchangeJSON); err != nil {
+		return errors.Wrap(err, "could not unmarshal interchange file")
+	}
+	if interchangeJSON.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return errors.Errorf(
+			"unsupported interchange format version %q, wanted %q",
+			interchangeJSON.Metadata.InterchangeFormatVersion,
+			InterchangeFormatVersion,
+		)
+	}
+	genesisValidatorsRoot, err := rootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
+	if err != nil {
+		return errors.Wrap(err, "invalid genesis validators root")
+	}
+	existingRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis validators root")
+	}
+	if len(existingRoot) != 0 && !bytes.Equal(existingRoot, genesisValidatorsRoot[:]) {
+		return errors.Errorf(
+			"interchange file belongs to the network with genesis validators root %#x, but the database belongs to %#x",
+			genesisValidatorsRoot,
+			existingRoot,
+		)
+	}
+	histories, err := parseProtectionData(interchangeJSON.Data)
+	if err != nil {
+		return err
+	}
+
+	proposals := make(map[[48]byte]map[uint64][32]byte, len(histories))
+	for pubKey, history := range histories {
+		proposals[pubKey] = history.proposals
+	}
+	if err := validatorDB.ImportSlashingProtection(
+		ctx,
+		genesisValidatorsRoot,
+		proposals,
+		attestationWatermarks(histories),
+	); err != nil {
+		return errors.Wrap(err, "could not save slashing protection history")
+	}
+	log.WithField("validators", len(histories)).Info("Imported slashing protection history")
+	return nil
+}
+
+// parseProtectionData validates and parses the history of every public key. The
+// entries of a public key listed several times are merged.
+func parseProtectionData(data []*ProtectionData) (map[[48]byte]*protectionHistory, error) {
+	histories := make(map[[48]byte]*protectionHistory, len(data))
+	for _, d := range data {
+		pubKey, err := pubKeyFromHex(d.Pubkey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid public key %s", d.Pubkey)
+		}
+		history, ok := histories[pubKey]
+		if !ok {
+			history = &protectionHistory{proposals: make(map[uint64][32]byte)}
+			histories[pubKey] = history
+		}
+		for _, blk := range d.SignedBlocks {
+			slot, err := strconv.ParseUint(blk.Slot, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid block slot for public key %#x", pubKey)
+			}
+			signingRoot, err := optionalRootFromHex(blk.SigningRoot)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid block signing root for public key %#x", pubKey)
+			}
+			if existing, ok := history.proposals[slot]; ok && existing != signingRoot {
+				// Neither of several blocks signed at the same slot may be signed again.
+				signingRoot = [32]byte{}
+			}
+			history.proposals[slot] = signingRoot
+		}
+		for _, att := range d.SignedAttestations {
+			source, err := strconv.ParseUint(att.SourceEpoch, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid attestation source epoch for public key %#x", pubKey)
+			}
+			target, err := strconv.ParseUint(att.TargetEpoch, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid attestation target epoch for public key %#x", pubKey)
+			}
+			if source > target {
+				return nil, errors.Errorf(
+					"attestation of public key %#x has source epoch %d greater than its target epoch %d",
+					pubKey,
+					source,
+					target,
+				)
+			}
+			signingRoot, err := optionalRootFromHex(att.SigningRoot)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid attestation signing root for public key %#x", pubKey)
+			}
+			history.attestations = append(history.attestations, attestation{
+				source:      source,
+				target:      target,
+				signingRoot: signingRoot,
+			})
+		}
+	}
+	return histories, nil
+}
+
+// attestationWatermarks returns the attestation watermarks covering the imported
+// attestations of every public key. They are merged with the existing watermarks
+// when saved, which only ever widens the protected span. The attestations of the
+// file are thus minimums below which nothing is signed, rather than a history to
+// check surround votes against, which keeps synthetic attestations exported from
+// watermarks, such as the ones of this package, protective.
+func attestationWatermarks(histories map[[48]byte]*protectionHistory) map[[48]byte]*kv.AttestationWatermarks {
+	watermarks := make(map[[48]byte]*kv.AttestationWatermarks, len(histories))
+	for pubKey, history := range histories {
+		var w *kv.AttestationWatermarks
+		for _, att := range history.attestations {
+			w = w.Record(att.source, att.target, att.signingRoot)
+		}
+		if w != nil {
+			watermarks[pubKey] = w
+		}
+	}
+	return watermarks
+}
+
+func pubKeyFromHex(str string) ([48]byte, error) {
+	b, err := bytesFromHex(str)
+	if err != nil {
+		return [48]byte{}, err
+	}
+	if len(b) != 48 {
+		return [48]byte{}, errors.Errorf("wanted 48 bytes, got %d", len(b))
+	}
+	return bytesutil.ToBytes48(b), nil
+}
+
+func rootFromHex(str string) ([32]byte, error) {
+	b, err := bytesFromHex(str)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	if len(b) != 32 {
+		return [32]byte{}, errors.Errorf("wanted 32 bytes, got %d", len(b))
+	}
+	return bytesutil.ToBytes32(b), nil
+}
+
+// optionalRootFromHex parses an optional signing root, which is zero if missing.
+func optionalRootFromHex(str string) ([32]byte, error) {
+	if str == "" {
+		return [32]byte{}, nil
+	}
+	return rootFromHex(str)
+}
+
+func bytesFromHex(str string) ([]byte, error) {
+	if !strings.HasPrefix(str, "0x") {
+		return nil, errors.New("missing 0x prefix")
+	}
+	return hex.DecodeString(strings.TrimPrefix(str, "0x"))
+}
@@ -0,0 +1,190 @@
+package interchange
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+var genesisValidatorsRoot = [32]byte{1, 2, 3}
+
+func interchangeFile(t *testing.T, root [32]byte, data ...*ProtectionData) []byte {
+	interchangeJSON := &EIPSlashingProtectionFormat{Data: data}
+	interchangeJSON.Metadata.InterchangeFormatVersion = InterchangeFormatVersion
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", root)
+	enc, err := json.Marshal(interchangeJSON)
+	require.NoError(t, err)
+	return enc
+}
+
+func TestImportExport_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	enc := interchangeFile(t, genesisValidatorsRoot, &ProtectionData{
+		Pubkey: fmt.Sprintf("%#x", pubKey),
+		SignedBlocks: []*SignedBlock{
+			{Slot: "81", SigningRoot: fmt.Sprintf("%#x", [32]byte{4})},
+			{Slot: "3"},
+		},
+		SignedAttestations: []*SignedAttestation{
+			{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", [32]byte{5})},
+			{SourceEpoch: "0", TargetEpoch: "1"},
+		},
+	})
+	validatorDB := dbtest.SetupDB(t, [][48]byte{})
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(enc)))
+
+	exported, err := ExportStandardProtectionJSON(ctx, validatorDB)
+	require.NoError(t, err)
+	assert.Equal(t, InterchangeFormatVersion, exported.Metadata.InterchangeFormatVersion)
+	assert.Equal(t, fmt.Sprintf("%#x", genesisValidatorsRoot), exported.Metadata.GenesisValidatorsRoot)
+	require.Equal(t, 1, len(exported.Data))
+	assert.Equal(t, fmt.Sprintf("%#x", pubKey), exported.Data[0].Pubkey)
+	assert.DeepEqual(t, []*SignedBlock{
+		{Slot: "3"},
+		{Slot: "81", SigningRoot: fmt.Sprintf("%#x", [32]byte{4})},
+	}, exported.Data[0].SignedBlocks)
+	// The attestations are exported as the span of their watermarks.
+	assert.DeepEqual(t, []*SignedAttestation{
+		{SourceEpoch: "2", TargetEpoch: "3", SigningRoot: fmt.Sprintf("%#x", [32]byte{5})},
+	}, exported.Data[0].SignedAttestations)
+}
+
+func TestImport_MergesConservatively(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	validatorDB := dbtest.SetupDB(t, [][48]byte{pubKey})
+	require.NoError(t, validatorDB.CheckAndSaveAttestation(ctx, pubKey, [32]byte{1}, 4, 5))
+	slotBits, err := validatorDB.ProposalHistoryForEpoch(ctx, pubKey[:], 0)
+	require.NoError(t, err)
+	slotBits.SetBitAt(1, true)
+	require.NoError(t, validatorDB.SaveProposalHistoryForEpoch(ctx, pubKey[:], 0, slotBits))
+
+	enc := interchangeFile(t, genesisValidatorsRoot, &ProtectionData{
+		Pubkey:       fmt.Sprintf("%#x", pubKey),
+		SignedBlocks: []*SignedBlock{{Slot: "2"}},
+		SignedAttestations: []*SignedAttestation{
+			// Older than the existing vote, which still bounds the lowest epochs.
+			{SourceEpoch: "1", TargetEpoch: "2"},
+			{SourceEpoch: "3", TargetEpoch: "6"},
+		},
+	})
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(enc)))
+
+	slots, err := validatorDB.ProposedSlotsForPubKey(ctx, pubKey[:])
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1, 2}, slots)
+	watermarks, err := validatorDB.AttestationWatermarksForPubKeys(ctx, [][48]byte{pubKey})
+	require.NoError(t, err)
+	// The highest source epoch of the existing vote is kept.
+	assert.DeepEqual(t, &kv.AttestationWatermarks{
+		LowestSourceEpoch:  1,
+		LowestTargetEpoch:  2,
+		HighestSourceEpoch: 4,
+		HighestTargetEpoch: 6,
+	}, watermarks[pubKey])
+}
+
+func TestImportExport_WatermarkIsMinimum(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	validatorDB := dbtest.SetupDB(t, [][48]byte{pubKey})
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+	// A history with an attestation surrounding another, as imported from another client.
+	var w *kv.AttestationWatermarks
+	w = w.Record(1, 10, [32]byte{1}).Record(5, 6, [32]byte{2})
+	require.NoError(t, validatorDB.MergeAttestationWatermarks(ctx, map[[48]byte]*kv.AttestationWatermarks{pubKey: w}))
+	exported, err := ExportStandardProtectionJSON(ctx, validatorDB)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(exported.Data))
+	assert.DeepEqual(t, []*SignedAttestation{
+		{SourceEpoch: "5", TargetEpoch: "10", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+	}, exported.Data[0].SignedAttestations)
+
+	enc, err := json.Marshal(exported)
+	require.NoError(t, err)
+	importedDB := dbtest.SetupDB(t, [][48]byte{})
+	require.NoError(t, ImportStandardProtectionJSON(ctx, importedDB, bytes.NewReader(enc)))
+	// The attestation surrounded by the first one is not surrounded by the exported one,
+	// but is refused as it is below its epochs.
+	assert.ErrorContains(t, kv.ErrSlashableAttestation.Error(), importedDB.CheckAndSaveAttestation(ctx, pubKey, [32]byte{3}, 2, 7))
+	// The attestation signed for the highest target epoch may be signed again.
+	require.NoError(t, importedDB.CheckAndSaveAttestation(ctx, pubKey, [32]byte{1}, 1, 10))
+	require.NoError(t, importedDB.CheckAndSaveAttestation(ctx, pubKey, [32]byte{4}, 5, 11))
+}
+
+func TestImport_RefusesOtherNetwork(t *testing.T) {
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, [][48]byte{})
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+
+	pubKey := [48]byte{1}
+	enc := interchangeFile(t, [32]byte{9}, &ProtectionData{
+		Pubkey:       fmt.Sprintf("%#x", pubKey),
+		SignedBlocks: []*SignedBlock{{Slot: "2"}},
+	})
+	assert.ErrorContains(t, "interchange file belongs to the network", ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewReader(enc)))
+	pubKeys, err := validatorDB.ProtectedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pubKeys))
+}
+
+func TestImport_InvalidData(t *testing.T) {
+	pubKey := fmt.Sprintf("%#x", [48]byte{1})
+	tests := []struct {
+		name string
+		data *ProtectionData
+		err  string
+	}{
+		{
+			name: "short public key",
+			data: &ProtectionData{Pubkey: "0x0102"},
+			err:  "invalid public key",
+		},
+		{
+			name: "slot not a number",
+			data: &ProtectionData{Pubkey: pubKey, SignedBlocks: []*SignedBlock{{Slot: "0x01"}}},
+			err:  "invalid block slot",
+		},
+		{
+			name: "source after target",
+			data: &ProtectionData{Pubkey: pubKey, SignedAttestations: []*SignedAttestation{{SourceEpoch: "3", TargetEpoch: "2"}}},
+			err:  "greater than its target epoch",
+		},
+		{
+			name: "signing root without prefix",
+			data: &ProtectionData{Pubkey: pubKey, SignedBlocks: []*SignedBlock{{Slot: "1", SigningRoot: "01"}}},
+			err:  "invalid block signing root",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validatorDB := dbtest.SetupDB(t, [][48]byte{})
+			enc := interchangeFile(t, genesisValidatorsRoot, tt.data)
+			assert.ErrorContains(t, tt.err, ImportStandardProtectionJSON(context.Background(), validatorDB, bytes.NewReader(enc)))
+			// Nothing is written when the file is invalid.
+			root, err := validatorDB.GenesisValidatorsRoot(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, 0, len(root))
+		})
+	}
+}
+
+func TestSignedAttestationsFromWatermarks_BeyondWeakSubjectivityPeriod(t *testing.T) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	var w *kv.AttestationWatermarks
+	assert.Equal(t, 0, len(signedAttestationsFromWatermarks(w)))
+	w = w.Record(0, 1, [32]byte{1})
+	w = w.Record(wsPeriod, 3*wsPeriod, [32]byte{})
+	assert.DeepEqual(t, []*SignedAttestation{
+		{SourceEpoch: fmt.Sprintf("%d", wsPeriod), TargetEpoch: fmt.Sprintf("%d", 3*wsPeriod)},
+	}, signedAttestationsFromWatermarks(w))
+}
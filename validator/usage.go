@@ -78,6 +78,7 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "validator",
 		Flags: []cli.Flag{
 			flags.BeaconRPCProviderFlag,
+			flags.BroadcastToBeaconNodesFlag,
 			flags.BeaconRPCGatewayProviderFlag,
 			flags.CertFlag,
 			flags.EnableWebFlag,
@@ -86,8 +87,13 @@ var appHelpFlagGroups = []flagGroup{
 			flags.KeystorePathFlag,
 			flags.PasswordFlag,
 			flags.DisablePenaltyRewardLogFlag,
+			flags.EnableDoppelgangerDetectionFlag,
+			flags.DoppelgangerDetectionEpochsFlag,
+			flags.AuditLogDirFlag,
+			flags.AuditLogMaxSizeFlag,
 			flags.UnencryptedKeysFlag,
 			flags.GraffitiFlag,
+			flags.ValidatorsConfigFileFlag,
 			flags.RPCHost,
 			flags.RPCPort,
 			flags.GRPCGatewayPort,